@@ -0,0 +1,43 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
+
+// StageARN returns the ARN of a Stage, as it is used by services that attach
+// to a stage such as WAF.
+func StageARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Stage)
+		if !ok {
+			return ""
+		}
+		if r.Spec.ForProvider.RestAPIID == nil || r.Spec.ForProvider.StageName == nil {
+			return ""
+		}
+		return fmt.Sprintf("arn:%s:apigateway:%s::/restapis/%s/stages/%s",
+			common.Partition(r.Spec.ForProvider.Region), r.Spec.ForProvider.Region,
+			*r.Spec.ForProvider.RestAPIID, *r.Spec.ForProvider.StageName)
+	}
+}
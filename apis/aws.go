@@ -29,6 +29,7 @@ import (
 	apigatewayv2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/apigatewayv2/v1beta1"
 	athenav1alpha1 "github.com/crossplane-contrib/provider-aws/apis/athena/v1alpha1"
 	autoscalingv1beta1 "github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1beta1"
+	backupv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/backup/v1alpha1"
 	batchmanualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/batch/manualv1alpha1"
 	batchv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/batch/v1alpha1"
 	cachev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/cache/v1alpha1"
@@ -58,6 +59,7 @@ import (
 	elbv2manualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/elbv2/manualv1alpha1"
 	elbv2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
 	emrcontainersv1alpah1 "github.com/crossplane-contrib/provider-aws/apis/emrcontainers/v1alpha1"
+	eventbridgev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
 	firehosev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/firehose/v1alpha1"
 	globalacceleratorv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/globalaccelerator/v1alpha1"
 	gluev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/glue/v1alpha1"
@@ -92,9 +94,12 @@ import (
 	sfnv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/sfn/v1alpha1"
 	snsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/sns/v1beta1"
 	sqsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/sqs/v1beta1"
+	ssmv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+	stsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/sts/v1alpha1"
 	transferv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/transfer/v1alpha1"
 	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	wafv2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
 )
 
 func init() {
@@ -175,6 +180,11 @@ func init() {
 		servicecatalogv1alpha1.SchemeBuilder.AddToScheme,
 		s3control.SchemeBuilder.AddToScheme,
 		firehosev1alpha1.SchemeBuilder.AddToScheme,
+		eventbridgev1alpha1.SchemeBuilder.AddToScheme,
+		ssmv1alpha1.SchemeBuilder.AddToScheme,
+		wafv2v1alpha1.SchemeBuilder.AddToScheme,
+		backupv1alpha1.SchemeBuilder.AddToScheme,
+		stsv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
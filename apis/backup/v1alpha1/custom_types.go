@@ -0,0 +1,265 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomBackupVaultParameters contains the additional fields for
+// BackupVaultParameters.
+type CustomBackupVaultParameters struct {
+	// EncryptionKeyARNRef is a reference to a KMS Key used to set
+	// EncryptionKeyARN.
+	// +optional
+	EncryptionKeyARNRef *xpv1.Reference `json:"encryptionKeyARNRef,omitempty"`
+
+	// EncryptionKeyARNSelector selects references to a KMS Key used to set
+	// EncryptionKeyARN.
+	// +optional
+	EncryptionKeyARNSelector *xpv1.Selector `json:"encryptionKeyARNSelector,omitempty"`
+
+	// LockConfiguration applies Backup Vault Lock to the vault. Once the
+	// grace time given by ChangeableForDays has passed, the lock can neither
+	// be changed nor removed and the vault can no longer be deleted while it
+	// contains recovery points.
+	// +optional
+	LockConfiguration *BackupVaultLockConfiguration `json:"lockConfiguration,omitempty"`
+}
+
+// BackupVaultLockConfiguration is the Backup Vault Lock configuration of a
+// BackupVault.
+type BackupVaultLockConfiguration struct {
+	// The number of days before the lock date. Backup Vault Lock is applied in
+	// compliance mode and becomes immutable once this time has passed. If
+	// omitted, the lock is applied in governance mode and can be removed at any
+	// time by users with sufficient permissions.
+	// +optional
+	ChangeableForDays *int64 `json:"changeableForDays,omitempty"`
+
+	// The Backup Vault Lock configuration that specifies the maximum retention
+	// period that the vault retains its recovery points.
+	// +optional
+	MaxRetentionDays *int64 `json:"maxRetentionDays,omitempty"`
+
+	// The Backup Vault Lock configuration that specifies the minimum retention
+	// period that the vault retains its recovery points.
+	// +optional
+	MinRetentionDays *int64 `json:"minRetentionDays,omitempty"`
+}
+
+// CustomBackupPlanParameters contains the additional fields for
+// BackupPlanParameters.
+type CustomBackupPlanParameters struct {
+	// The display name of the backup plan.
+	// +kubebuilder:validation:Required
+	BackupPlanName string `json:"backupPlanName"`
+
+	// The rules of the backup plan. Each rule specifies a scheduled task that
+	// is used to back up a selection of resources.
+	// +kubebuilder:validation:Required
+	Rules []*BackupRule `json:"rules"`
+
+	// Specifies a list of backup options for each resource type.
+	// +optional
+	AdvancedBackupSettings []*AdvancedBackupSetting `json:"advancedBackupSettings,omitempty"`
+}
+
+// BackupRule is a scheduled task that is used to back up a selection of
+// resources.
+type BackupRule struct {
+	// A display name for the backup rule.
+	RuleName string `json:"ruleName"`
+
+	// The name of the backup vault where the backups are stored.
+	// +optional
+	TargetBackupVaultName *string `json:"targetBackupVaultName,omitempty"`
+
+	// TargetBackupVaultNameRef is a reference to a BackupVault used to set
+	// TargetBackupVaultName.
+	// +optional
+	TargetBackupVaultNameRef *xpv1.Reference `json:"targetBackupVaultNameRef,omitempty"`
+
+	// TargetBackupVaultNameSelector selects references to a BackupVault used
+	// to set TargetBackupVaultName.
+	// +optional
+	TargetBackupVaultNameSelector *xpv1.Selector `json:"targetBackupVaultNameSelector,omitempty"`
+
+	// A CRON expression in UTC specifying when Backup initiates a backup job.
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// The timezone in which the schedule expression is set. By default,
+	// ScheduleExpressions are in UTC.
+	// +optional
+	ScheduleExpressionTimezone *string `json:"scheduleExpressionTimezone,omitempty"`
+
+	// A value in minutes after a backup is scheduled before a job will be
+	// canceled if it doesn't start successfully.
+	// +optional
+	StartWindowMinutes *int64 `json:"startWindowMinutes,omitempty"`
+
+	// A value in minutes after a backup job is successfully started before it
+	// must be completed or it will be canceled by Backup.
+	// +optional
+	CompletionWindowMinutes *int64 `json:"completionWindowMinutes,omitempty"`
+
+	// Specifies whether Backup creates continuous backups.
+	// +optional
+	EnableContinuousBackup *bool `json:"enableContinuousBackup,omitempty"`
+
+	// The lifecycle defines when a protected resource is transitioned to cold
+	// storage and when it expires.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+
+	// The tags to assign to the resources.
+	// +optional
+	RecoveryPointTags map[string]*string `json:"recoveryPointTags,omitempty"`
+
+	// The copy actions of the rule. Each copy action copies the recovery
+	// points to another, possibly cross-region, backup vault.
+	// +optional
+	CopyActions []*BackupCopyAction `json:"copyActions,omitempty"`
+}
+
+// BackupCopyAction copies the recovery points created by a BackupRule to
+// another backup vault.
+type BackupCopyAction struct {
+	// The ARN of the destination backup vault for the copied backup.
+	// +optional
+	DestinationBackupVaultARN *string `json:"destinationBackupVaultARN,omitempty"`
+
+	// DestinationBackupVaultARNRef is a reference to a BackupVault used to set
+	// DestinationBackupVaultARN.
+	// +optional
+	DestinationBackupVaultARNRef *xpv1.Reference `json:"destinationBackupVaultARNRef,omitempty"`
+
+	// DestinationBackupVaultARNSelector selects references to a BackupVault
+	// used to set DestinationBackupVaultARN.
+	// +optional
+	DestinationBackupVaultARNSelector *xpv1.Selector `json:"destinationBackupVaultARNSelector,omitempty"`
+
+	// The lifecycle of the copied recovery points.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+}
+
+// CustomBackupSelectionParameters contains the additional fields for
+// BackupSelectionParameters. Backup selections cannot be updated, all fields
+// are immutable once the selection is created.
+type CustomBackupSelectionParameters struct {
+	// The ID of the backup plan the resources are assigned to.
+	// +immutable
+	// +optional
+	BackupPlanID *string `json:"backupPlanID,omitempty"`
+
+	// BackupPlanIDRef is a reference to a BackupPlan used to set BackupPlanID.
+	// +optional
+	BackupPlanIDRef *xpv1.Reference `json:"backupPlanIDRef,omitempty"`
+
+	// BackupPlanIDSelector selects references to a BackupPlan used to set
+	// BackupPlanID.
+	// +optional
+	BackupPlanIDSelector *xpv1.Selector `json:"backupPlanIDSelector,omitempty"`
+
+	// The display name of the resource selection.
+	// +immutable
+	// +kubebuilder:validation:Required
+	SelectionName string `json:"selectionName"`
+
+	// The ARN of the IAM role that Backup uses to authenticate when backing up
+	// the target resource.
+	// +immutable
+	// +optional
+	IAMRoleARN *string `json:"iamRoleARN,omitempty"`
+
+	// IAMRoleARNRef is a reference to an IAM Role used to set IAMRoleARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleARNRef,omitempty"`
+
+	// IAMRoleARNSelector selects references to an IAM Role used to set
+	// IAMRoleARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleARNSelector,omitempty"`
+
+	// The ARNs or ARN patterns of the resources to assign to the backup plan.
+	// +immutable
+	// +optional
+	Resources []*string `json:"resources,omitempty"`
+
+	// The ARNs or ARN patterns of the resources to exclude from the backup
+	// plan.
+	// +immutable
+	// +optional
+	NotResources []*string `json:"notResources,omitempty"`
+
+	// Tag conditions that are combined with OR to select resources.
+	// +immutable
+	// +optional
+	ListOfTags []*Condition `json:"listOfTags,omitempty"`
+
+	// Tag conditions that are combined with AND to select resources.
+	// +immutable
+	// +optional
+	Conditions *Conditions `json:"conditions,omitempty"`
+
+	// The ARNs of RDS DB clusters to assign to the backup plan. They are
+	// assigned in addition to Resources.
+	// +immutable
+	// +optional
+	DBClusterARNs []*string `json:"dbClusterARNs,omitempty"`
+
+	// DBClusterARNRefs are references to DBClusters used to set DBClusterARNs.
+	// +optional
+	DBClusterARNRefs []xpv1.Reference `json:"dbClusterARNRefs,omitempty"`
+
+	// DBClusterARNSelector selects references to DBClusters used to set
+	// DBClusterARNs.
+	// +optional
+	DBClusterARNSelector *xpv1.Selector `json:"dbClusterARNSelector,omitempty"`
+
+	// The ARNs of DynamoDB tables to assign to the backup plan. They are
+	// assigned in addition to Resources.
+	// +immutable
+	// +optional
+	TableARNs []*string `json:"tableARNs,omitempty"`
+
+	// TableARNRefs are references to Tables used to set TableARNs.
+	// +optional
+	TableARNRefs []xpv1.Reference `json:"tableARNRefs,omitempty"`
+
+	// TableARNSelector selects references to Tables used to set TableARNs.
+	// +optional
+	TableARNSelector *xpv1.Selector `json:"tableARNSelector,omitempty"`
+
+	// The ARNs of EFS file systems to assign to the backup plan. They are
+	// assigned in addition to Resources.
+	// +immutable
+	// +optional
+	FileSystemARNs []*string `json:"fileSystemARNs,omitempty"`
+
+	// FileSystemARNRefs are references to FileSystems used to set
+	// FileSystemARNs.
+	// +optional
+	FileSystemARNRefs []xpv1.Reference `json:"fileSystemARNRefs,omitempty"`
+
+	// FileSystemARNSelector selects references to FileSystems used to set
+	// FileSystemARNs.
+	// +optional
+	FileSystemARNSelector *xpv1.Selector `json:"fileSystemARNSelector,omitempty"`
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dynamodb "github.com/crossplane-contrib/provider-aws/apis/dynamodb/v1alpha1"
+	efs "github.com/crossplane-contrib/provider-aws/apis/efs/v1alpha1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	kms "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	rds "github.com/crossplane-contrib/provider-aws/apis/rds/v1alpha1"
+)
+
+// BackupVaultARN returns the status.atProvider.backupVaultARN of a
+// BackupVault.
+func BackupVaultARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*BackupVault)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.BackupVaultARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.BackupVaultARN
+	}
+}
+
+// ResolveReferences of this BackupVault
+func (mg *BackupVault) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.encryptionKeyARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EncryptionKeyARN),
+		Reference:    mg.Spec.ForProvider.EncryptionKeyARNRef,
+		Selector:     mg.Spec.ForProvider.EncryptionKeyARNSelector,
+		To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
+		Extract:      kms.KMSKeyARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.encryptionKeyARN")
+	}
+	mg.Spec.ForProvider.EncryptionKeyARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EncryptionKeyARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this BackupPlan
+func (mg *BackupPlan) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i, rule := range mg.Spec.ForProvider.Rules {
+		if rule == nil {
+			continue
+		}
+
+		// Resolve spec.forProvider.rules[i].targetBackupVaultName
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(rule.TargetBackupVaultName),
+			Reference:    rule.TargetBackupVaultNameRef,
+			Selector:     rule.TargetBackupVaultNameSelector,
+			To:           reference.To{Managed: &BackupVault{}, List: &BackupVaultList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.rules[%d].targetBackupVaultName", i))
+		}
+		rule.TargetBackupVaultName = reference.ToPtrValue(rsp.ResolvedValue)
+		rule.TargetBackupVaultNameRef = rsp.ResolvedReference
+
+		for j, action := range rule.CopyActions {
+			if action == nil {
+				continue
+			}
+
+			// Resolve spec.forProvider.rules[i].copyActions[j].destinationBackupVaultARN
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(action.DestinationBackupVaultARN),
+				Reference:    action.DestinationBackupVaultARNRef,
+				Selector:     action.DestinationBackupVaultARNSelector,
+				To:           reference.To{Managed: &BackupVault{}, List: &BackupVaultList{}},
+				Extract:      BackupVaultARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.rules[%d].copyActions[%d].destinationBackupVaultARN", i, j))
+			}
+			action.DestinationBackupVaultARN = reference.ToPtrValue(rsp.ResolvedValue)
+			action.DestinationBackupVaultARNRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this BackupSelection
+func (mg *BackupSelection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.backupPlanID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BackupPlanID),
+		Reference:    mg.Spec.ForProvider.BackupPlanIDRef,
+		Selector:     mg.Spec.ForProvider.BackupPlanIDSelector,
+		To:           reference.To{Managed: &BackupPlan{}, List: &BackupPlanList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.backupPlanID")
+	}
+	mg.Spec.ForProvider.BackupPlanID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BackupPlanIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.iamRoleARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMRoleARN),
+		Reference:    mg.Spec.ForProvider.IAMRoleARNRef,
+		Selector:     mg.Spec.ForProvider.IAMRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.iamRoleARN")
+	}
+	mg.Spec.ForProvider.IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IAMRoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dbClusterARNs
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.DBClusterARNs),
+		References:    mg.Spec.ForProvider.DBClusterARNRefs,
+		Selector:      mg.Spec.ForProvider.DBClusterARNSelector,
+		To:            reference.To{Managed: &rds.DBCluster{}, List: &rds.DBClusterList{}},
+		Extract:       rds.DBClusterARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbClusterARNs")
+	}
+	mg.Spec.ForProvider.DBClusterARNs = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.DBClusterARNRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.tableARNs
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.TableARNs),
+		References:    mg.Spec.ForProvider.TableARNRefs,
+		Selector:      mg.Spec.ForProvider.TableARNSelector,
+		To:            reference.To{Managed: &dynamodb.Table{}, List: &dynamodb.TableList{}},
+		Extract:       dynamodb.TableARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.tableARNs")
+	}
+	mg.Spec.ForProvider.TableARNs = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.TableARNRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.fileSystemARNs
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.FileSystemARNs),
+		References:    mg.Spec.ForProvider.FileSystemARNRefs,
+		Selector:      mg.Spec.ForProvider.FileSystemARNSelector,
+		To:            reference.To{Managed: &efs.FileSystem{}, List: &efs.FileSystemList{}},
+		Extract:       efs.FileSystemARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.fileSystemARNs")
+	}
+	mg.Spec.ForProvider.FileSystemARNs = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.FileSystemARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/apis/cache/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	elasticache "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
 	sns "github.com/crossplane-contrib/provider-aws/apis/sns/v1beta1"
 )
 
@@ -116,5 +117,33 @@ func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.NotificationTopicARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.NotificationTopicARNRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.userGroupIDs
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.UserGroupIDs,
+		References:    mg.Spec.ForProvider.UserGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.UserGroupIDSelector,
+		To:            reference.To{Managed: &elasticache.UserGroup{}, List: &elasticache.UserGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.userGroupIDs")
+	}
+	mg.Spec.ForProvider.UserGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.UserGroupIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.globalReplicationGroupID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.GlobalReplicationGroupID),
+		Reference:    mg.Spec.ForProvider.GlobalReplicationGroupIDRef,
+		Selector:     mg.Spec.ForProvider.GlobalReplicationGroupIDSelector,
+		To:           reference.To{Managed: &elasticache.GlobalReplicationGroup{}, List: &elasticache.GlobalReplicationGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.globalReplicationGroupID")
+	}
+	mg.Spec.ForProvider.GlobalReplicationGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GlobalReplicationGroupIDRef = rsp.ResolvedReference
+
 	return nil
 }
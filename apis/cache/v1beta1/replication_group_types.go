@@ -180,6 +180,10 @@ type ReplicationGroupObservation struct {
 	// endpoint to connect to this replication group.
 	ConfigurationEndpoint Endpoint `json:"configurationEndpoint,omitempty"`
 
+	// GlobalReplicationGroupInfo describes the membership of this replication
+	// group in a Global datastore.
+	GlobalReplicationGroupInfo *GlobalReplicationGroupInfo `json:"globalReplicationGroupInfo,omitempty"`
+
 	// MemberClusters is the list of names of all the cache clusters that are
 	// part of this replication group.
 	MemberClusters []string `json:"memberClusters,omitempty"`
@@ -197,6 +201,21 @@ type ReplicationGroupObservation struct {
 	// Status is the current state of this replication group - creating,
 	// available, modifying, deleting, create-failed, snapshotting.
 	Status string `json:"status,omitempty"`
+
+	// UserGroupIDs is the list of user groups associated with this
+	// replication group.
+	UserGroupIDs []string `json:"userGroupIDs,omitempty"`
+}
+
+// GlobalReplicationGroupInfo describes the Global datastore a replication
+// group is a member of.
+type GlobalReplicationGroupInfo struct {
+	// GlobalReplicationGroupID is the ID of the Global datastore.
+	GlobalReplicationGroupID string `json:"globalReplicationGroupID,omitempty"`
+
+	// GlobalReplicationGroupMemberRole is the role of the replication group in
+	// the Global datastore, either PRIMARY or SECONDARY.
+	GlobalReplicationGroupMemberRole string `json:"globalReplicationGroupMemberRole,omitempty"`
 }
 
 // A Tag is used to tag the ElastiCache resources in AWS.
@@ -385,6 +404,28 @@ type ReplicationGroupParameters struct {
 	// +optional
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
+	// GlobalReplicationGroupID is the ID of the Global datastore this
+	// replication group joins as a secondary member. A replication group
+	// becomes the primary member of a Global datastore by being referenced as
+	// primaryReplicationGroupID of a GlobalReplicationGroup instead. The
+	// engine, engine version and cache node type of a secondary member are
+	// inherited from the Global datastore.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupID *string `json:"globalReplicationGroupID,omitempty"`
+
+	// GlobalReplicationGroupIDRef is a reference to a GlobalReplicationGroup
+	// used to set the GlobalReplicationGroupID.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupIDRef *xpv1.Reference `json:"globalReplicationGroupIDRef,omitempty"`
+
+	// GlobalReplicationGroupIDSelector selects a reference to a
+	// GlobalReplicationGroup used to set the GlobalReplicationGroupID.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupIDSelector *xpv1.Selector `json:"globalReplicationGroupIDSelector,omitempty"`
+
 	// MultiAZEnabled specifies if Multi-AZ is enabled to enhance fault tolerance
 	// You must have nodes across two or more Availability Zones in order to enable
 	// this feature.
@@ -589,6 +630,22 @@ type ReplicationGroupParameters struct {
 	// +immutable
 	// +optional
 	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
+
+	// UserGroupIDs specifies the user groups used for role-based access
+	// control of this replication group. User groups require
+	// TransitEncryptionEnabled and replace the AUTH token of AuthEnabled.
+	// +optional
+	UserGroupIDs []string `json:"userGroupIDs,omitempty"`
+
+	// UserGroupIDRefs are references to UserGroups used to set the
+	// UserGroupIDs.
+	// +optional
+	UserGroupIDRefs []xpv1.Reference `json:"userGroupIDRefs,omitempty"`
+
+	// UserGroupIDSelector selects references to UserGroups used to set the
+	// UserGroupIDs.
+	// +optional
+	UserGroupIDSelector *xpv1.Selector `json:"userGroupIDSelector,omitempty"`
 }
 
 // A ReplicationGroupSpec defines the desired state of a ReplicationGroup.
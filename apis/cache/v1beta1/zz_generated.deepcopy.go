@@ -40,6 +40,21 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupInfo) DeepCopyInto(out *GlobalReplicationGroupInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupInfo.
+func (in *GlobalReplicationGroupInfo) DeepCopy() *GlobalReplicationGroupInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 	*out = *in
@@ -176,6 +191,11 @@ func (in *ReplicationGroupList) DeepCopyObject() runtime.Object {
 func (in *ReplicationGroupObservation) DeepCopyInto(out *ReplicationGroupObservation) {
 	*out = *in
 	out.ConfigurationEndpoint = in.ConfigurationEndpoint
+	if in.GlobalReplicationGroupInfo != nil {
+		in, out := &in.GlobalReplicationGroupInfo, &out.GlobalReplicationGroupInfo
+		*out = new(GlobalReplicationGroupInfo)
+		**out = **in
+	}
 	if in.MemberClusters != nil {
 		in, out := &in.MemberClusters, &out.MemberClusters
 		*out = make([]string, len(*in))
@@ -189,6 +209,11 @@ func (in *ReplicationGroupObservation) DeepCopyInto(out *ReplicationGroupObserva
 		}
 	}
 	out.PendingModifiedValues = in.PendingModifiedValues
+	if in.UserGroupIDs != nil {
+		in, out := &in.UserGroupIDs, &out.UserGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupObservation.
@@ -271,6 +296,21 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupIDRef != nil {
+		in, out := &in.GlobalReplicationGroupIDRef, &out.GlobalReplicationGroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalReplicationGroupIDSelector != nil {
+		in, out := &in.GlobalReplicationGroupIDSelector, &out.GlobalReplicationGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MultiAZEnabled != nil {
 		in, out := &in.MultiAZEnabled, &out.MultiAZEnabled
 		*out = new(bool)
@@ -390,6 +430,23 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UserGroupIDs != nil {
+		in, out := &in.UserGroupIDs, &out.UserGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserGroupIDRefs != nil {
+		in, out := &in.UserGroupIDRefs, &out.UserGroupIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserGroupIDSelector != nil {
+		in, out := &in.UserGroupIDSelector, &out.UserGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupParameters.
@@ -16,8 +16,35 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
+
 // CustomDistributionParameters includes the custom fields of Distribution.
-type CustomDistributionParameters struct{}
+type CustomDistributionParameters struct {
+	// WebACLIDRef is a reference to a wafv2 WebACL with the CLOUDFRONT scope
+	// used to set DistributionConfig.WebACLID.
+	// +optional
+	WebACLIDRef *xpv1.Reference `json:"webACLIDRef,omitempty"`
+
+	// WebACLIDSelector selects a reference to a wafv2 WebACL with the
+	// CLOUDFRONT scope used to set DistributionConfig.WebACLID.
+	// +optional
+	WebACLIDSelector *xpv1.Selector `json:"webACLIDSelector,omitempty"`
+
+	// OriginRequestPolicyIDRef is a reference to an OriginRequestPolicy used
+	// to set DistributionConfig.DefaultCacheBehavior.OriginRequestPolicyID.
+	// +optional
+	OriginRequestPolicyIDRef *xpv1.Reference `json:"originRequestPolicyIDRef,omitempty"`
+
+	// OriginRequestPolicyIDSelector selects a reference to an
+	// OriginRequestPolicy used to set
+	// DistributionConfig.DefaultCacheBehavior.OriginRequestPolicyID.
+	// +optional
+	OriginRequestPolicyIDSelector *xpv1.Selector `json:"originRequestPolicyIDSelector,omitempty"`
+}
 
 // CustomCachePolicyParameters includes the custom fields of CachePolicy.
 type CustomCachePolicyParameters struct{}
@@ -30,3 +57,65 @@ type CustomCloudFrontOriginAccessIdentityParameters struct{}
 
 // CustomResponseHeadersPolicyParameters includes metadata about the response headers policy, and a set of configurations
 type CustomResponseHeadersPolicyParameters struct{}
+
+// CustomOriginRequestPolicyParameters includes the custom fields of OriginRequestPolicy.
+type CustomOriginRequestPolicyParameters struct{}
+
+// CustomPublicKeyParameters includes the custom fields of PublicKey.
+type CustomPublicKeyParameters struct{}
+
+// CustomKeyGroupParameters includes the custom fields of KeyGroup.
+type CustomKeyGroupParameters struct {
+	// PublicKeyIDRefs are references to PublicKeys used to set
+	// KeyGroupConfig.Items.
+	// +optional
+	PublicKeyIDRefs []xpv1.Reference `json:"publicKeyIDRefs,omitempty"`
+
+	// PublicKeyIDSelector selects references to PublicKeys used to set
+	// KeyGroupConfig.Items.
+	// +optional
+	PublicKeyIDSelector *xpv1.Selector `json:"publicKeyIDSelector,omitempty"`
+}
+
+// CustomFunctionParameters includes the custom fields of Function.
+type CustomFunctionParameters struct {
+	// FunctionCodeConfigMapRef references the ConfigMap key that contains the
+	// code of the function.
+	// +kubebuilder:validation:Required
+	FunctionCodeConfigMapRef common.ConfigMapKeySelector `json:"functionCodeConfigMapRef"`
+
+	// Publish copies the function from the DEVELOPMENT stage to the LIVE stage
+	// whenever its code or configuration changes. Only the LIVE stage of a
+	// function can be associated with a distribution.
+	// +kubebuilder:default=true
+	// +optional
+	Publish *bool `json:"publish,omitempty"`
+}
+
+// CustomInvalidationParameters includes the custom fields of Invalidation.
+type CustomInvalidationParameters struct {
+	// The ID of the distribution whose cached objects are invalidated.
+	// +optional
+	DistributionID *string `json:"distributionID,omitempty"`
+
+	// DistributionIDRef is a reference to a Distribution used to set the
+	// DistributionID.
+	// +optional
+	DistributionIDRef *xpv1.Reference `json:"distributionIDRef,omitempty"`
+
+	// DistributionIDSelector selects a reference to a Distribution used to
+	// set the DistributionID.
+	// +optional
+	DistributionIDSelector *xpv1.Selector `json:"distributionIDSelector,omitempty"`
+
+	// The paths of the objects to invalidate, e.g. /images/* or /index.html.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	Paths []*string `json:"paths"`
+
+	// Triggers are arbitrary values, e.g. the image tag or revision of a
+	// deployment. A new invalidation of the paths is created whenever the
+	// distribution, the paths or the triggers change.
+	// +optional
+	Triggers map[string]string `json:"triggers,omitempty"`
+}
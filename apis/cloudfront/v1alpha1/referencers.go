@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wafv2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+)
+
+// ResolveReferences of this Distribution
+func (mg *Distribution) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.WebACLIDRef == nil && mg.Spec.ForProvider.WebACLIDSelector == nil &&
+		mg.Spec.ForProvider.OriginRequestPolicyIDRef == nil && mg.Spec.ForProvider.OriginRequestPolicyIDSelector == nil {
+		return nil
+	}
+	if mg.Spec.ForProvider.DistributionConfig == nil {
+		mg.Spec.ForProvider.DistributionConfig = &DistributionConfig{}
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	if mg.Spec.ForProvider.WebACLIDRef != nil || mg.Spec.ForProvider.WebACLIDSelector != nil {
+		// Resolve spec.forProvider.distributionConfig.webACLID
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DistributionConfig.WebACLID),
+			Reference:    mg.Spec.ForProvider.WebACLIDRef,
+			Selector:     mg.Spec.ForProvider.WebACLIDSelector,
+			To:           reference.To{Managed: &wafv2v1alpha1.WebACL{}, List: &wafv2v1alpha1.WebACLList{}},
+			Extract:      wafv2v1alpha1.WebACLARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.distributionConfig.webACLID")
+		}
+		mg.Spec.ForProvider.DistributionConfig.WebACLID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.WebACLIDRef = rsp.ResolvedReference
+	}
+
+	if mg.Spec.ForProvider.OriginRequestPolicyIDRef != nil || mg.Spec.ForProvider.OriginRequestPolicyIDSelector != nil {
+		if mg.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior == nil {
+			mg.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior = &DefaultCacheBehavior{}
+		}
+
+		// Resolve spec.forProvider.distributionConfig.defaultCacheBehavior.originRequestPolicyID
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.OriginRequestPolicyID),
+			Reference:    mg.Spec.ForProvider.OriginRequestPolicyIDRef,
+			Selector:     mg.Spec.ForProvider.OriginRequestPolicyIDSelector,
+			To:           reference.To{Managed: &OriginRequestPolicy{}, List: &OriginRequestPolicyList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.distributionConfig.defaultCacheBehavior.originRequestPolicyID")
+		}
+		mg.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.OriginRequestPolicyID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.OriginRequestPolicyIDRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this KeyGroup
+func (mg *KeyGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.KeyGroupConfig == nil {
+		mg.Spec.ForProvider.KeyGroupConfig = &KeyGroupConfig{}
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.keyGroupConfig.items
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.KeyGroupConfig.Items),
+		References:    mg.Spec.ForProvider.PublicKeyIDRefs,
+		Selector:      mg.Spec.ForProvider.PublicKeyIDSelector,
+		To:            reference.To{Managed: &PublicKey{}, List: &PublicKeyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.keyGroupConfig.items")
+	}
+	mg.Spec.ForProvider.KeyGroupConfig.Items = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.PublicKeyIDRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// ResolveReferences of this Invalidation
+func (mg *Invalidation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.distributionID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DistributionID),
+		Reference:    mg.Spec.ForProvider.DistributionIDRef,
+		Selector:     mg.Spec.ForProvider.DistributionIDSelector,
+		To:           reference.To{Managed: &Distribution{}, List: &DistributionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.distributionID")
+	}
+	mg.Spec.ForProvider.DistributionID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DistributionIDRef = rsp.ResolvedReference
+	return nil
+}
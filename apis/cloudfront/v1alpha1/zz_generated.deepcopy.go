@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -1156,6 +1157,26 @@ func (in *CustomCloudFrontOriginAccessIdentityParameters) DeepCopy() *CustomClou
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDistributionParameters) DeepCopyInto(out *CustomDistributionParameters) {
 	*out = *in
+	if in.WebACLIDRef != nil {
+		in, out := &in.WebACLIDRef, &out.WebACLIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebACLIDSelector != nil {
+		in, out := &in.WebACLIDSelector, &out.WebACLIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OriginRequestPolicyIDRef != nil {
+		in, out := &in.OriginRequestPolicyIDRef, &out.OriginRequestPolicyIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OriginRequestPolicyIDSelector != nil {
+		in, out := &in.OriginRequestPolicyIDSelector, &out.OriginRequestPolicyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDistributionParameters.
@@ -1229,6 +1250,27 @@ func (in *CustomErrorResponses) DeepCopy() *CustomErrorResponses {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomFunctionParameters) DeepCopyInto(out *CustomFunctionParameters) {
+	*out = *in
+	out.FunctionCodeConfigMapRef = in.FunctionCodeConfigMapRef
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFunctionParameters.
+func (in *CustomFunctionParameters) DeepCopy() *CustomFunctionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomFunctionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomHeaders) DeepCopyInto(out *CustomHeaders) {
 	*out = *in
@@ -1255,6 +1297,81 @@ func (in *CustomHeaders) DeepCopy() *CustomHeaders {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomInvalidationParameters) DeepCopyInto(out *CustomInvalidationParameters) {
+	*out = *in
+	if in.DistributionID != nil {
+		in, out := &in.DistributionID, &out.DistributionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DistributionIDRef != nil {
+		in, out := &in.DistributionIDRef, &out.DistributionIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DistributionIDSelector != nil {
+		in, out := &in.DistributionIDSelector, &out.DistributionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomInvalidationParameters.
+func (in *CustomInvalidationParameters) DeepCopy() *CustomInvalidationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomInvalidationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyGroupParameters) DeepCopyInto(out *CustomKeyGroupParameters) {
+	*out = *in
+	if in.PublicKeyIDRefs != nil {
+		in, out := &in.PublicKeyIDRefs, &out.PublicKeyIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicKeyIDSelector != nil {
+		in, out := &in.PublicKeyIDSelector, &out.PublicKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyGroupParameters.
+func (in *CustomKeyGroupParameters) DeepCopy() *CustomKeyGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomOriginAccessControlParameters) DeepCopyInto(out *CustomOriginAccessControlParameters) {
 	*out = *in
@@ -1315,6 +1432,36 @@ func (in *CustomOriginConfig) DeepCopy() *CustomOriginConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOriginRequestPolicyParameters) DeepCopyInto(out *CustomOriginRequestPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOriginRequestPolicyParameters.
+func (in *CustomOriginRequestPolicyParameters) DeepCopy() *CustomOriginRequestPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOriginRequestPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPublicKeyParameters) DeepCopyInto(out *CustomPublicKeyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPublicKeyParameters.
+func (in *CustomPublicKeyParameters) DeepCopy() *CustomPublicKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPublicKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResponseHeadersPolicyParameters) DeepCopyInto(out *CustomResponseHeadersPolicyParameters) {
 	*out = *in
@@ -1753,7 +1900,7 @@ func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
 		*out = new(DistributionConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	out.CustomDistributionParameters = in.CustomDistributionParameters
+	in.CustomDistributionParameters.DeepCopyInto(&out.CustomDistributionParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
@@ -2341,6 +2488,33 @@ func (in *ForwardedValues) DeepCopy() *ForwardedValues {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Function) DeepCopyInto(out *Function) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
+func (in *Function) DeepCopy() *Function {
+	if in == nil {
+		return nil
+	}
+	out := new(Function)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Function) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FunctionAssociation) DeepCopyInto(out *FunctionAssociation) {
 	*out = *in
@@ -2400,6 +2574,11 @@ func (in *FunctionConfig) DeepCopyInto(out *FunctionConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Runtime != nil {
+		in, out := &in.Runtime, &out.Runtime
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionConfig.
@@ -2415,6 +2594,49 @@ func (in *FunctionConfig) DeepCopy() *FunctionConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FunctionList) DeepCopyInto(out *FunctionList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Function, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionList.
+func (in *FunctionList) DeepCopy() *FunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FunctionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionList_SDK) DeepCopyInto(out *FunctionList_SDK) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*FunctionSummary, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FunctionSummary)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.MaxItems != nil {
 		in, out := &in.MaxItems, &out.MaxItems
 		*out = new(int64)
@@ -2432,12 +2654,12 @@ func (in *FunctionList) DeepCopyInto(out *FunctionList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionList.
-func (in *FunctionList) DeepCopy() *FunctionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionList_SDK.
+func (in *FunctionList_SDK) DeepCopy() *FunctionList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(FunctionList)
+	out := new(FunctionList_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2458,6 +2680,11 @@ func (in *FunctionMetadata) DeepCopyInto(out *FunctionMetadata) {
 		in, out := &in.LastModifiedTime, &out.LastModifiedTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionMetadata.
@@ -2470,9 +2697,109 @@ func (in *FunctionMetadata) DeepCopy() *FunctionMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionObservation) DeepCopyInto(out *FunctionObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionSummary != nil {
+		in, out := &in.FunctionSummary, &out.FunctionSummary
+		*out = new(FunctionSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionObservation.
+func (in *FunctionObservation) DeepCopy() *FunctionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionParameters) DeepCopyInto(out *FunctionParameters) {
+	*out = *in
+	if in.FunctionConfig != nil {
+		in, out := &in.FunctionConfig, &out.FunctionConfig
+		*out = new(FunctionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CustomFunctionParameters.DeepCopyInto(&out.CustomFunctionParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionParameters.
+func (in *FunctionParameters) DeepCopy() *FunctionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionSpec) DeepCopyInto(out *FunctionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionSpec.
+func (in *FunctionSpec) DeepCopy() *FunctionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionStatus) DeepCopyInto(out *FunctionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionStatus.
+func (in *FunctionStatus) DeepCopy() *FunctionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FunctionSummary) DeepCopyInto(out *FunctionSummary) {
 	*out = *in
+	if in.FunctionConfig != nil {
+		in, out := &in.FunctionConfig, &out.FunctionConfig
+		*out = new(FunctionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionMetadata != nil {
+		in, out := &in.FunctionMetadata, &out.FunctionMetadata
+		*out = new(FunctionMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -2550,20 +2877,10 @@ func (in *Headers) DeepCopy() *Headers {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Invalidation) DeepCopyInto(out *Invalidation) {
 	*out = *in
-	if in.CreateTime != nil {
-		in, out := &in.CreateTime, &out.CreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Invalidation.
@@ -2576,14 +2893,27 @@ func (in *Invalidation) DeepCopy() *Invalidation {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InvalidationBatch) DeepCopyInto(out *InvalidationBatch) {
-	*out = *in
-	if in.CallerReference != nil {
-		in, out := &in.CallerReference, &out.CallerReference
-		*out = new(string)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Invalidation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationBatch) DeepCopyInto(out *InvalidationBatch) {
+	*out = *in
+	if in.CallerReference != nil {
+		in, out := &in.CallerReference, &out.CallerReference
+		*out = new(string)
 		**out = **in
 	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = new(Paths)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationBatch.
@@ -2599,6 +2929,49 @@ func (in *InvalidationBatch) DeepCopy() *InvalidationBatch {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InvalidationList) DeepCopyInto(out *InvalidationList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Invalidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList.
+func (in *InvalidationList) DeepCopy() *InvalidationList {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InvalidationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationList_SDK) DeepCopyInto(out *InvalidationList_SDK) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*InvalidationSummary, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InvalidationSummary)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.IsTruncated != nil {
 		in, out := &in.IsTruncated, &out.IsTruncated
 		*out = new(bool)
@@ -2626,12 +2999,87 @@ func (in *InvalidationList) DeepCopyInto(out *InvalidationList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList.
-func (in *InvalidationList) DeepCopy() *InvalidationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList_SDK.
+func (in *InvalidationList_SDK) DeepCopy() *InvalidationList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(InvalidationList)
+	out := new(InvalidationList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationObservation) DeepCopyInto(out *InvalidationObservation) {
+	*out = *in
+	if in.Invalidation != nil {
+		in, out := &in.Invalidation, &out.Invalidation
+		*out = new(Invalidation_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationObservation.
+func (in *InvalidationObservation) DeepCopy() *InvalidationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationParameters) DeepCopyInto(out *InvalidationParameters) {
+	*out = *in
+	in.CustomInvalidationParameters.DeepCopyInto(&out.CustomInvalidationParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationParameters.
+func (in *InvalidationParameters) DeepCopy() *InvalidationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationSpec) DeepCopyInto(out *InvalidationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSpec.
+func (in *InvalidationSpec) DeepCopy() *InvalidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationStatus) DeepCopyInto(out *InvalidationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationStatus.
+func (in *InvalidationStatus) DeepCopy() *InvalidationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2665,6 +3113,40 @@ func (in *InvalidationSummary) DeepCopy() *InvalidationSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Invalidation_SDK) DeepCopyInto(out *Invalidation_SDK) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InvalidationBatch != nil {
+		in, out := &in.InvalidationBatch, &out.InvalidationBatch
+		*out = new(InvalidationBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Invalidation_SDK.
+func (in *Invalidation_SDK) DeepCopy() *Invalidation_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(Invalidation_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KGKeyPairIDs) DeepCopyInto(out *KGKeyPairIDs) {
 	*out = *in
@@ -2693,15 +3175,10 @@ func (in *KGKeyPairIDs) DeepCopy() *KGKeyPairIDs {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup.
@@ -2714,6 +3191,14 @@ func (in *KeyGroup) DeepCopy() *KeyGroup {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
 	*out = *in
@@ -2722,6 +3207,17 @@ func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -2741,6 +3237,38 @@ func (in *KeyGroupConfig) DeepCopy() *KeyGroupConfig {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
+func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupList_SDK) DeepCopyInto(out *KeyGroupList_SDK) {
 	*out = *in
 	if in.MaxItems != nil {
 		in, out := &in.MaxItems, &out.MaxItems
@@ -2759,12 +3287,126 @@ func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
-func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList_SDK.
+func (in *KeyGroupList_SDK) DeepCopy() *KeyGroupList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroupList)
+	out := new(KeyGroupList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupObservation) DeepCopyInto(out *KeyGroupObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyGroup != nil {
+		in, out := &in.KeyGroup, &out.KeyGroup
+		*out = new(KeyGroup_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupObservation.
+func (in *KeyGroupObservation) DeepCopy() *KeyGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupParameters) DeepCopyInto(out *KeyGroupParameters) {
+	*out = *in
+	if in.KeyGroupConfig != nil {
+		in, out := &in.KeyGroupConfig, &out.KeyGroupConfig
+		*out = new(KeyGroupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CustomKeyGroupParameters.DeepCopyInto(&out.CustomKeyGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupParameters.
+func (in *KeyGroupParameters) DeepCopy() *KeyGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupSpec) DeepCopyInto(out *KeyGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupSpec.
+func (in *KeyGroupSpec) DeepCopy() *KeyGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupStatus) DeepCopyInto(out *KeyGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupStatus.
+func (in *KeyGroupStatus) DeepCopy() *KeyGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroup_SDK) DeepCopyInto(out *KeyGroup_SDK) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyGroupConfig != nil {
+		in, out := &in.KeyGroupConfig, &out.KeyGroupConfig
+		*out = new(KeyGroupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup_SDK.
+func (in *KeyGroup_SDK) DeepCopy() *KeyGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroup_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3570,140 +4212,319 @@ func (in *OriginGroups) DeepCopy() *OriginGroups {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicy) DeepCopyInto(out *OriginRequestPolicy) {
+func (in *OriginRequestPolicy) DeepCopyInto(out *OriginRequestPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicy.
+func (in *OriginRequestPolicy) DeepCopy() *OriginRequestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OriginRequestPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyConfig) DeepCopyInto(out *OriginRequestPolicyConfig) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CookiesConfig != nil {
+		in, out := &in.CookiesConfig, &out.CookiesConfig
+		*out = new(OriginRequestPolicyCookiesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeadersConfig != nil {
+		in, out := &in.HeadersConfig, &out.HeadersConfig
+		*out = new(OriginRequestPolicyHeadersConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryStringsConfig != nil {
+		in, out := &in.QueryStringsConfig, &out.QueryStringsConfig
+		*out = new(OriginRequestPolicyQueryStringsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyConfig.
+func (in *OriginRequestPolicyConfig) DeepCopy() *OriginRequestPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyCookiesConfig) DeepCopyInto(out *OriginRequestPolicyCookiesConfig) {
+	*out = *in
+	if in.CookieBehavior != nil {
+		in, out := &in.CookieBehavior, &out.CookieBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = new(CookieNames)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyCookiesConfig.
+func (in *OriginRequestPolicyCookiesConfig) DeepCopy() *OriginRequestPolicyCookiesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicyCookiesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyHeadersConfig) DeepCopyInto(out *OriginRequestPolicyHeadersConfig) {
+	*out = *in
+	if in.HeaderBehavior != nil {
+		in, out := &in.HeaderBehavior, &out.HeaderBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = new(Headers)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyHeadersConfig.
+func (in *OriginRequestPolicyHeadersConfig) DeepCopy() *OriginRequestPolicyHeadersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicyHeadersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyList) DeepCopyInto(out *OriginRequestPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OriginRequestPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyList.
+func (in *OriginRequestPolicyList) DeepCopy() *OriginRequestPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OriginRequestPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyList_SDK) DeepCopyInto(out *OriginRequestPolicyList_SDK) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NextMarker != nil {
+		in, out := &in.NextMarker, &out.NextMarker
 		*out = new(string)
 		**out = **in
 	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicy.
-func (in *OriginRequestPolicy) DeepCopy() *OriginRequestPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyList_SDK.
+func (in *OriginRequestPolicyList_SDK) DeepCopy() *OriginRequestPolicyList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicy)
+	out := new(OriginRequestPolicyList_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyConfig) DeepCopyInto(out *OriginRequestPolicyConfig) {
+func (in *OriginRequestPolicyObservation) DeepCopyInto(out *OriginRequestPolicyObservation) {
 	*out = *in
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
 		*out = new(string)
 		**out = **in
 	}
+	if in.OriginRequestPolicy != nil {
+		in, out := &in.OriginRequestPolicy, &out.OriginRequestPolicy
+		*out = new(OriginRequestPolicy_SDK)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyConfig.
-func (in *OriginRequestPolicyConfig) DeepCopy() *OriginRequestPolicyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyObservation.
+func (in *OriginRequestPolicyObservation) DeepCopy() *OriginRequestPolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyConfig)
+	out := new(OriginRequestPolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyCookiesConfig) DeepCopyInto(out *OriginRequestPolicyCookiesConfig) {
+func (in *OriginRequestPolicyParameters) DeepCopyInto(out *OriginRequestPolicyParameters) {
 	*out = *in
-	if in.Cookies != nil {
-		in, out := &in.Cookies, &out.Cookies
-		*out = new(CookieNames)
+	if in.OriginRequestPolicyConfig != nil {
+		in, out := &in.OriginRequestPolicyConfig, &out.OriginRequestPolicyConfig
+		*out = new(OriginRequestPolicyConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	out.CustomOriginRequestPolicyParameters = in.CustomOriginRequestPolicyParameters
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyCookiesConfig.
-func (in *OriginRequestPolicyCookiesConfig) DeepCopy() *OriginRequestPolicyCookiesConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyParameters.
+func (in *OriginRequestPolicyParameters) DeepCopy() *OriginRequestPolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyCookiesConfig)
+	out := new(OriginRequestPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyHeadersConfig) DeepCopyInto(out *OriginRequestPolicyHeadersConfig) {
+func (in *OriginRequestPolicyQueryStringsConfig) DeepCopyInto(out *OriginRequestPolicyQueryStringsConfig) {
 	*out = *in
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = new(Headers)
+	if in.QueryStringBehavior != nil {
+		in, out := &in.QueryStringBehavior, &out.QueryStringBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryStrings != nil {
+		in, out := &in.QueryStrings, &out.QueryStrings
+		*out = new(QueryStringNames)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyHeadersConfig.
-func (in *OriginRequestPolicyHeadersConfig) DeepCopy() *OriginRequestPolicyHeadersConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyQueryStringsConfig.
+func (in *OriginRequestPolicyQueryStringsConfig) DeepCopy() *OriginRequestPolicyQueryStringsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyHeadersConfig)
+	out := new(OriginRequestPolicyQueryStringsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyList) DeepCopyInto(out *OriginRequestPolicyList) {
+func (in *OriginRequestPolicySpec) DeepCopyInto(out *OriginRequestPolicySpec) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
-		*out = new(string)
-		**out = **in
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicySpec.
+func (in *OriginRequestPolicySpec) DeepCopy() *OriginRequestPolicySpec {
+	if in == nil {
+		return nil
 	}
+	out := new(OriginRequestPolicySpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyList.
-func (in *OriginRequestPolicyList) DeepCopy() *OriginRequestPolicyList {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyStatus) DeepCopyInto(out *OriginRequestPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyStatus.
+func (in *OriginRequestPolicyStatus) DeepCopy() *OriginRequestPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyList)
+	out := new(OriginRequestPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyQueryStringsConfig) DeepCopyInto(out *OriginRequestPolicyQueryStringsConfig) {
+func (in *OriginRequestPolicy_SDK) DeepCopyInto(out *OriginRequestPolicy_SDK) {
 	*out = *in
-	if in.QueryStrings != nil {
-		in, out := &in.QueryStrings, &out.QueryStrings
-		*out = new(QueryStringNames)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.OriginRequestPolicyConfig != nil {
+		in, out := &in.OriginRequestPolicyConfig, &out.OriginRequestPolicyConfig
+		*out = new(OriginRequestPolicyConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyQueryStringsConfig.
-func (in *OriginRequestPolicyQueryStringsConfig) DeepCopy() *OriginRequestPolicyQueryStringsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicy_SDK.
+func (in *OriginRequestPolicy_SDK) DeepCopy() *OriginRequestPolicy_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyQueryStringsConfig)
+	out := new(OriginRequestPolicy_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3828,6 +4649,17 @@ func (in *ParametersInCacheKeyAndForwardedToOrigin) DeepCopy() *ParametersInCach
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Paths) DeepCopyInto(out *Paths) {
 	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Quantity != nil {
 		in, out := &in.Quantity, &out.Quantity
 		*out = new(int64)
@@ -3848,15 +4680,10 @@ func (in *Paths) DeepCopy() *Paths {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKey) DeepCopyInto(out *PublicKey) {
 	*out = *in
-	if in.CreatedTime != nil {
-		in, out := &in.CreatedTime, &out.CreatedTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKey.
@@ -3869,6 +4696,14 @@ func (in *PublicKey) DeepCopy() *PublicKey {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyConfig) DeepCopyInto(out *PublicKeyConfig) {
 	*out = *in
@@ -3906,6 +4741,38 @@ func (in *PublicKeyConfig) DeepCopy() *PublicKeyConfig {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyList) DeepCopyInto(out *PublicKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
+func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyList_SDK) DeepCopyInto(out *PublicKeyList_SDK) {
 	*out = *in
 	if in.MaxItems != nil {
 		in, out := &in.MaxItems, &out.MaxItems
@@ -3924,12 +4791,97 @@ func (in *PublicKeyList) DeepCopyInto(out *PublicKeyList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
-func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList_SDK.
+func (in *PublicKeyList_SDK) DeepCopy() *PublicKeyList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicKeyList)
+	out := new(PublicKeyList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyObservation) DeepCopyInto(out *PublicKeyObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = new(PublicKey_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyObservation.
+func (in *PublicKeyObservation) DeepCopy() *PublicKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyParameters) DeepCopyInto(out *PublicKeyParameters) {
+	*out = *in
+	if in.PublicKeyConfig != nil {
+		in, out := &in.PublicKeyConfig, &out.PublicKeyConfig
+		*out = new(PublicKeyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	out.CustomPublicKeyParameters = in.CustomPublicKeyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyParameters.
+func (in *PublicKeyParameters) DeepCopy() *PublicKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeySpec) DeepCopyInto(out *PublicKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeySpec.
+func (in *PublicKeySpec) DeepCopy() *PublicKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyStatus) DeepCopyInto(out *PublicKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyStatus.
+func (in *PublicKeyStatus) DeepCopy() *PublicKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3973,6 +4925,35 @@ func (in *PublicKeySummary) DeepCopy() *PublicKeySummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKey_SDK) DeepCopyInto(out *PublicKey_SDK) {
+	*out = *in
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeyConfig != nil {
+		in, out := &in.PublicKeyConfig, &out.PublicKeyConfig
+		*out = new(PublicKeyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKey_SDK.
+func (in *PublicKey_SDK) DeepCopy() *PublicKey_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKey_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueryArgProfile) DeepCopyInto(out *QueryArgProfile) {
 	*out = *in
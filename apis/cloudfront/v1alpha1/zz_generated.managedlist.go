@@ -47,6 +47,33 @@ func (l *DistributionList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this FunctionList.
+func (l *FunctionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this InvalidationList.
+func (l *InvalidationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this KeyGroupList.
+func (l *KeyGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OriginAccessControlList.
 func (l *OriginAccessControlList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -56,6 +83,24 @@ func (l *OriginAccessControlList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this OriginRequestPolicyList.
+func (l *OriginRequestPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PublicKeyList.
+func (l *PublicKeyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ResponseHeadersPolicyList.
 func (l *ResponseHeadersPolicyList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
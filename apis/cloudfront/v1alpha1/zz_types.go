@@ -858,10 +858,14 @@ type FunctionAssociations struct {
 // +kubebuilder:skipversion
 type FunctionConfig struct {
 	Comment *string `json:"comment,omitempty"`
+
+	Runtime *string `json:"runtime,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type FunctionList struct {
+type FunctionList_SDK struct {
+	Items []*FunctionSummary `json:"items,omitempty"`
+
 	MaxItems *int64 `json:"maxItems,omitempty"`
 
 	NextMarker *string `json:"nextMarker,omitempty"`
@@ -876,10 +880,19 @@ type FunctionMetadata struct {
 	FunctionARN *string `json:"functionARN,omitempty"`
 
 	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+
+	Stage *string `json:"stage,omitempty"`
 }
 
 // +kubebuilder:skipversion
 type FunctionSummary struct {
+	// Contains configuration information about a CloudFront function.
+	FunctionConfig *FunctionConfig `json:"functionConfig,omitempty"`
+	// Contains metadata about a CloudFront function.
+	FunctionMetadata *FunctionMetadata `json:"functionMetadata,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
 	Status *string `json:"status,omitempty"`
 }
 
@@ -895,22 +908,20 @@ type Headers struct {
 	Items []*string `json:"items,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type Invalidation struct {
-	CreateTime *metav1.Time `json:"createTime,omitempty"`
-
-	ID *string `json:"id,omitempty"`
-
-	Status *string `json:"status,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type InvalidationBatch struct {
 	CallerReference *string `json:"callerReference,omitempty"`
+	// A complex type that contains information about the objects that you want
+	// to invalidate. For more information, see Specifying the Objects to Invalidate
+	// (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/Invalidation.html#invalidation-specifying-objects)
+	// in the Amazon CloudFront Developer Guide.
+	Paths *Paths `json:"paths,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type InvalidationList struct {
+type InvalidationList_SDK struct {
+	Items []*InvalidationSummary `json:"items,omitempty"`
+
 	IsTruncated *bool `json:"isTruncated,omitempty"`
 
 	Marker *string `json:"marker,omitempty"`
@@ -931,6 +942,17 @@ type InvalidationSummary struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type Invalidation_SDK struct {
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	ID *string `json:"id,omitempty"`
+	// An invalidation batch.
+	InvalidationBatch *InvalidationBatch `json:"invalidationBatch,omitempty"`
+
+	Status *string `json:"status,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type KGKeyPairIDs struct {
 	KeyGroupID *string `json:"keyGroupID,omitempty"`
@@ -938,22 +960,17 @@ type KGKeyPairIDs struct {
 	KeyPairIDs *KeyPairIDs `json:"keyPairIDs,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type KeyGroup struct {
-	ID *string `json:"id,omitempty"`
-
-	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type KeyGroupConfig struct {
 	Comment *string `json:"comment,omitempty"`
 
+	Items []*string `json:"items,omitempty"`
+
 	Name *string `json:"name,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type KeyGroupList struct {
+type KeyGroupList_SDK struct {
 	MaxItems *int64 `json:"maxItems,omitempty"`
 
 	NextMarker *string `json:"nextMarker,omitempty"`
@@ -961,6 +978,18 @@ type KeyGroupList struct {
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type KeyGroup_SDK struct {
+	ID *string `json:"id,omitempty"`
+	// A key group configuration.
+	//
+	// A key group contains a list of public keys that you can use with CloudFront
+	// signed URLs and signed cookies (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/PrivateContent.html).
+	KeyGroupConfig *KeyGroupConfig `json:"keyGroupConfig,omitempty"`
+
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type KeyPairIDs struct {
 	Items []*string `json:"items,omitempty"`
@@ -1167,34 +1196,40 @@ type OriginGroups struct {
 	Items []*OriginGroup `json:"items,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type OriginRequestPolicy struct {
-	ID *string `json:"id,omitempty"`
-
-	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type OriginRequestPolicyConfig struct {
 	Comment *string `json:"comment,omitempty"`
+	// An object that determines whether any cookies in viewer requests (and if
+	// so, which cookies) are included in requests that CloudFront sends to the
+	// origin.
+	CookiesConfig *OriginRequestPolicyCookiesConfig `json:"cookiesConfig,omitempty"`
+	// An object that determines whether any HTTP headers (and if so, which headers)
+	// are included in requests that CloudFront sends to the origin.
+	HeadersConfig *OriginRequestPolicyHeadersConfig `json:"headersConfig,omitempty"`
 
 	Name *string `json:"name,omitempty"`
+	// An object that determines whether any URL query strings in viewer requests
+	// (and if so, which query strings) are included in requests that CloudFront
+	// sends to the origin.
+	QueryStringsConfig *OriginRequestPolicyQueryStringsConfig `json:"queryStringsConfig,omitempty"`
 }
 
 // +kubebuilder:skipversion
 type OriginRequestPolicyCookiesConfig struct {
+	CookieBehavior *string `json:"cookieBehavior,omitempty"`
 	// Contains a list of cookie names.
 	Cookies *CookieNames `json:"cookies,omitempty"`
 }
 
 // +kubebuilder:skipversion
 type OriginRequestPolicyHeadersConfig struct {
+	HeaderBehavior *string `json:"headerBehavior,omitempty"`
 	// Contains a list of HTTP header names.
 	Headers *Headers `json:"headers,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type OriginRequestPolicyList struct {
+type OriginRequestPolicyList_SDK struct {
 	MaxItems *int64 `json:"maxItems,omitempty"`
 
 	NextMarker *string `json:"nextMarker,omitempty"`
@@ -1204,10 +1239,35 @@ type OriginRequestPolicyList struct {
 
 // +kubebuilder:skipversion
 type OriginRequestPolicyQueryStringsConfig struct {
+	QueryStringBehavior *string `json:"queryStringBehavior,omitempty"`
 	// Contains a list of query string names.
 	QueryStrings *QueryStringNames `json:"queryStrings,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type OriginRequestPolicy_SDK struct {
+	ID *string `json:"id,omitempty"`
+
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+	// An origin request policy configuration.
+	//
+	// This configuration determines the values that CloudFront includes in requests
+	// that it sends to the origin. Each request that CloudFront sends to the origin
+	// includes the following:
+	//
+	//    * The request body and the URL path (without the domain name) from the
+	//    viewer request.
+	//
+	//    * The headers that CloudFront automatically includes in every origin request,
+	//    including Host, User-Agent, and X-Amz-Cf-Id.
+	//
+	//    * All HTTP headers, cookies, and URL query strings that are specified
+	//    in the cache policy or the origin request policy. These can include items
+	//    from the viewer request and, in the case of headers, additional ones that
+	//    are added by CloudFront.
+	OriginRequestPolicyConfig *OriginRequestPolicyConfig `json:"originRequestPolicyConfig,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type OriginSSLProtocols struct {
 	Items []*string `json:"items,omitempty"`
@@ -1247,14 +1307,9 @@ type ParametersInCacheKeyAndForwardedToOrigin struct {
 
 // +kubebuilder:skipversion
 type Paths struct {
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
-// +kubebuilder:skipversion
-type PublicKey struct {
-	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+	Items []*string `json:"items,omitempty"`
 
-	ID *string `json:"id,omitempty"`
+	Quantity *int64 `json:"quantity,omitempty"`
 }
 
 // +kubebuilder:skipversion
@@ -1269,7 +1324,7 @@ type PublicKeyConfig struct {
 }
 
 // +kubebuilder:skipversion
-type PublicKeyList struct {
+type PublicKeyList_SDK struct {
 	MaxItems *int64 `json:"maxItems,omitempty"`
 
 	NextMarker *string `json:"nextMarker,omitempty"`
@@ -1290,6 +1345,17 @@ type PublicKeySummary struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type PublicKey_SDK struct {
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+
+	ID *string `json:"id,omitempty"`
+	// Configuration information about a public key that you can use with signed
+	// URLs and signed cookies (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/PrivateContent.html),
+	// or with field-level encryption (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/field-level-encryption.html).
+	PublicKeyConfig *PublicKeyConfig `json:"publicKeyConfig,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type QueryArgProfile struct {
 	ProfileID *string `json:"profileID,omitempty"`
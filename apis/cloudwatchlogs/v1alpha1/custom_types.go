@@ -43,7 +43,135 @@ type CustomLogGroupParameters struct {
 	// KMSKeyIDSelector selects a reference to a KMS Key used to set KMSKeyID.
 	// +optional
 	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIDSelector,omitempty"`
+
+	// DataProtectionPolicy is the data protection policy document of the log
+	// group, formatted as a JSON string. The data protection policy of the log
+	// group is left untouched if neither this nor DeleteDataProtectionPolicy
+	// is set.
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/mask-sensitive-log-data-start.html
+	// +optional
+	DataProtectionPolicy *string `json:"dataProtectionPolicy,omitempty"`
+
+	// DeleteDataProtectionPolicy removes the data protection policy of the
+	// log group if it is true and DataProtectionPolicy is not set.
+	// +optional
+	DeleteDataProtectionPolicy *bool `json:"deleteDataProtectionPolicy,omitempty"`
 }
 
 // CustomResourcePolicyParameters includes the custom fields of ResourcePolicy.
 type CustomResourcePolicyParameters struct{}
+
+// CustomLogStreamParameters includes the custom fields of LogStream.
+type CustomLogStreamParameters struct {
+	// The name of the log group.
+	// +crossplane:generate:reference:type=LogGroup
+	// +optional
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// LogGroupNameRef is a reference to a LogGroup used to set LogGroupName.
+	// +optional
+	LogGroupNameRef *xpv1.Reference `json:"logGroupNameRef,omitempty"`
+
+	// LogGroupNameSelector selects a reference to a LogGroup used to set
+	// LogGroupName.
+	// +optional
+	LogGroupNameSelector *xpv1.Selector `json:"logGroupNameSelector,omitempty"`
+}
+
+// CustomMetricFilterParameters includes the custom fields of MetricFilter.
+type CustomMetricFilterParameters struct {
+	// The name of the log group.
+	// +crossplane:generate:reference:type=LogGroup
+	// +optional
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// LogGroupNameRef is a reference to a LogGroup used to set LogGroupName.
+	// +optional
+	LogGroupNameRef *xpv1.Reference `json:"logGroupNameRef,omitempty"`
+
+	// LogGroupNameSelector selects a reference to a LogGroup used to set
+	// LogGroupName.
+	// +optional
+	LogGroupNameSelector *xpv1.Selector `json:"logGroupNameSelector,omitempty"`
+}
+
+// CustomSubscriptionFilterParameters includes the custom fields of
+// SubscriptionFilter.
+type CustomSubscriptionFilterParameters struct {
+	// The name of the log group.
+	// +optional
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// LogGroupNameRef is a reference to a LogGroup used to set LogGroupName.
+	// +optional
+	LogGroupNameRef *xpv1.Reference `json:"logGroupNameRef,omitempty"`
+
+	// LogGroupNameSelector selects a reference to a LogGroup used to set
+	// LogGroupName.
+	// +optional
+	LogGroupNameSelector *xpv1.Selector `json:"logGroupNameSelector,omitempty"`
+
+	// The ARN of the destination to deliver matching log events to. Currently,
+	// the supported destinations are:
+	//
+	//    * An Amazon Kinesis stream belonging to the same account as the subscription
+	//    filter, for same-account delivery.
+	//
+	//    * A logical destination (specified using an ARN) belonging to a different
+	//    account, for cross-account delivery.
+	//
+	//    * A Kinesis Data Firehose delivery stream belonging to the same account
+	//    as the subscription filter, for same-account delivery.
+	//
+	//    * A Lambda function belonging to the same account as the subscription
+	//    filter, for same-account delivery.
+	//
+	// Only one of the destination references and selectors below may be set.
+	// +optional
+	DestinationARN *string `json:"destinationARN,omitempty"`
+
+	// DestinationStreamRef is a reference to a Kinesis Stream used to set
+	// DestinationARN.
+	// +optional
+	DestinationStreamRef *xpv1.Reference `json:"destinationStreamRef,omitempty"`
+
+	// DestinationStreamSelector selects a reference to a Kinesis Stream used
+	// to set DestinationARN.
+	// +optional
+	DestinationStreamSelector *xpv1.Selector `json:"destinationStreamSelector,omitempty"`
+
+	// DestinationDeliveryStreamRef is a reference to a Firehose DeliveryStream
+	// used to set DestinationARN.
+	// +optional
+	DestinationDeliveryStreamRef *xpv1.Reference `json:"destinationDeliveryStreamRef,omitempty"`
+
+	// DestinationDeliveryStreamSelector selects a reference to a Firehose
+	// DeliveryStream used to set DestinationARN.
+	// +optional
+	DestinationDeliveryStreamSelector *xpv1.Selector `json:"destinationDeliveryStreamSelector,omitempty"`
+
+	// DestinationFunctionRef is a reference to a Lambda Function used to set
+	// DestinationARN.
+	// +optional
+	DestinationFunctionRef *xpv1.Reference `json:"destinationFunctionRef,omitempty"`
+
+	// DestinationFunctionSelector selects a reference to a Lambda Function used
+	// to set DestinationARN.
+	// +optional
+	DestinationFunctionSelector *xpv1.Selector `json:"destinationFunctionSelector,omitempty"`
+
+	// The ARN of an IAM role that grants CloudWatch Logs permissions to deliver
+	// ingested log events to the destination stream. You don't need to provide
+	// the ARN when you are working with a logical destination for cross-account
+	// delivery.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+}
@@ -14,8 +14,17 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
+
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	resource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	firehose "github.com/crossplane-contrib/provider-aws/apis/firehose/v1alpha1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	kinesis "github.com/crossplane-contrib/provider-aws/apis/kinesis/v1alpha1"
+	lambdav1beta1 "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
 )
 
 // LogGroupARN returns the status.atProvider.ARN of a LogGroup.
@@ -31,3 +40,80 @@ func LogGroupARN() reference.ExtractValueFn {
 		return *r.Status.AtProvider.ARN
 	}
 }
+
+// ResolveReferences of this SubscriptionFilter
+func (mg *SubscriptionFilter) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.logGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LogGroupName),
+		Reference:    mg.Spec.ForProvider.LogGroupNameRef,
+		Selector:     mg.Spec.ForProvider.LogGroupNameSelector,
+		To:           reference.To{Managed: &LogGroup{}, List: &LogGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.logGroupName")
+	}
+	mg.Spec.ForProvider.LogGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LogGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationARN from a Kinesis Stream
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationARN),
+		Reference:    mg.Spec.ForProvider.DestinationStreamRef,
+		Selector:     mg.Spec.ForProvider.DestinationStreamSelector,
+		To:           reference.To{Managed: &kinesis.Stream{}, List: &kinesis.StreamList{}},
+		Extract:      kinesis.StreamARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationARN")
+	}
+	mg.Spec.ForProvider.DestinationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationStreamRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationARN from a Firehose DeliveryStream
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationARN),
+		Reference:    mg.Spec.ForProvider.DestinationDeliveryStreamRef,
+		Selector:     mg.Spec.ForProvider.DestinationDeliveryStreamSelector,
+		To:           reference.To{Managed: &firehose.DeliveryStream{}, List: &firehose.DeliveryStreamList{}},
+		Extract:      firehose.DeliveryStreamARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationARN")
+	}
+	mg.Spec.ForProvider.DestinationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationDeliveryStreamRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationARN from a Lambda Function
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationARN),
+		Reference:    mg.Spec.ForProvider.DestinationFunctionRef,
+		Selector:     mg.Spec.ForProvider.DestinationFunctionSelector,
+		To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+		Extract:      lambdav1beta1.FunctionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationARN")
+	}
+	mg.Spec.ForProvider.DestinationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationFunctionRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.roleARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleARN")
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
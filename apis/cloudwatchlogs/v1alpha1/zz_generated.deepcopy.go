@@ -78,6 +78,16 @@ func (in *CustomLogGroupParameters) DeepCopyInto(out *CustomLogGroupParameters)
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataProtectionPolicy != nil {
+		in, out := &in.DataProtectionPolicy, &out.DataProtectionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteDataProtectionPolicy != nil {
+		in, out := &in.DeleteDataProtectionPolicy, &out.DeleteDataProtectionPolicy
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLogGroupParameters.
@@ -90,6 +100,66 @@ func (in *CustomLogGroupParameters) DeepCopy() *CustomLogGroupParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomLogStreamParameters) DeepCopyInto(out *CustomLogStreamParameters) {
+	*out = *in
+	if in.LogGroupName != nil {
+		in, out := &in.LogGroupName, &out.LogGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogGroupNameRef != nil {
+		in, out := &in.LogGroupNameRef, &out.LogGroupNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogGroupNameSelector != nil {
+		in, out := &in.LogGroupNameSelector, &out.LogGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLogStreamParameters.
+func (in *CustomLogStreamParameters) DeepCopy() *CustomLogStreamParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomLogStreamParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomMetricFilterParameters) DeepCopyInto(out *CustomMetricFilterParameters) {
+	*out = *in
+	if in.LogGroupName != nil {
+		in, out := &in.LogGroupName, &out.LogGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogGroupNameRef != nil {
+		in, out := &in.LogGroupNameRef, &out.LogGroupNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogGroupNameSelector != nil {
+		in, out := &in.LogGroupNameSelector, &out.LogGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomMetricFilterParameters.
+func (in *CustomMetricFilterParameters) DeepCopy() *CustomMetricFilterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMetricFilterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResourcePolicyParameters) DeepCopyInto(out *CustomResourcePolicyParameters) {
 	*out = *in
@@ -105,6 +175,86 @@ func (in *CustomResourcePolicyParameters) DeepCopy() *CustomResourcePolicyParame
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomSubscriptionFilterParameters) DeepCopyInto(out *CustomSubscriptionFilterParameters) {
+	*out = *in
+	if in.LogGroupName != nil {
+		in, out := &in.LogGroupName, &out.LogGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogGroupNameRef != nil {
+		in, out := &in.LogGroupNameRef, &out.LogGroupNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogGroupNameSelector != nil {
+		in, out := &in.LogGroupNameSelector, &out.LogGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationARN != nil {
+		in, out := &in.DestinationARN, &out.DestinationARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationStreamRef != nil {
+		in, out := &in.DestinationStreamRef, &out.DestinationStreamRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationStreamSelector != nil {
+		in, out := &in.DestinationStreamSelector, &out.DestinationStreamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationDeliveryStreamRef != nil {
+		in, out := &in.DestinationDeliveryStreamRef, &out.DestinationDeliveryStreamRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationDeliveryStreamSelector != nil {
+		in, out := &in.DestinationDeliveryStreamSelector, &out.DestinationDeliveryStreamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationFunctionRef != nil {
+		in, out := &in.DestinationFunctionRef, &out.DestinationFunctionRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationFunctionSelector != nil {
+		in, out := &in.DestinationFunctionSelector, &out.DestinationFunctionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSubscriptionFilterParameters.
+func (in *CustomSubscriptionFilterParameters) DeepCopy() *CustomSubscriptionFilterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomSubscriptionFilterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Delivery) DeepCopyInto(out *Delivery) {
 	*out = *in
@@ -435,6 +585,11 @@ func (in *LogGroupObservation) DeepCopyInto(out *LogGroupObservation) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DataProtectionStatus != nil {
+		in, out := &in.DataProtectionStatus, &out.DataProtectionStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.KMSKeyID != nil {
 		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
@@ -611,6 +766,155 @@ func (in *LogGroup_SDK) DeepCopy() *LogGroup_SDK {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogStream) DeepCopyInto(out *LogStream) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStream.
+func (in *LogStream) DeepCopy() *LogStream {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogStream) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamList) DeepCopyInto(out *LogStreamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogStream, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamList.
+func (in *LogStreamList) DeepCopy() *LogStreamList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogStreamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamObservation) DeepCopyInto(out *LogStreamObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FirstEventTimestamp != nil {
+		in, out := &in.FirstEventTimestamp, &out.FirstEventTimestamp
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastEventTimestamp != nil {
+		in, out := &in.LastEventTimestamp, &out.LastEventTimestamp
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastIngestionTime != nil {
+		in, out := &in.LastIngestionTime, &out.LastIngestionTime
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamObservation.
+func (in *LogStreamObservation) DeepCopy() *LogStreamObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamParameters) DeepCopyInto(out *LogStreamParameters) {
+	*out = *in
+	in.CustomLogStreamParameters.DeepCopyInto(&out.CustomLogStreamParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamParameters.
+func (in *LogStreamParameters) DeepCopy() *LogStreamParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamSpec) DeepCopyInto(out *LogStreamSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamSpec.
+func (in *LogStreamSpec) DeepCopy() *LogStreamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamStatus) DeepCopyInto(out *LogStreamStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamStatus.
+func (in *LogStreamStatus) DeepCopy() *LogStreamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStream_SDK) DeepCopyInto(out *LogStream_SDK) {
 	*out = *in
 	if in.ARN != nil {
 		in, out := &in.ARN, &out.ARN
@@ -644,18 +948,163 @@ func (in *LogStream) DeepCopyInto(out *LogStream) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStream.
-func (in *LogStream) DeepCopy() *LogStream {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStream_SDK.
+func (in *LogStream_SDK) DeepCopy() *LogStream_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStream)
+	out := new(LogStream_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricFilter) DeepCopyInto(out *MetricFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilter.
+func (in *MetricFilter) DeepCopy() *MetricFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MetricFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricFilterList) DeepCopyInto(out *MetricFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MetricFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilterList.
+func (in *MetricFilterList) DeepCopy() *MetricFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MetricFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricFilterObservation) DeepCopyInto(out *MetricFilterObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilterObservation.
+func (in *MetricFilterObservation) DeepCopy() *MetricFilterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricFilterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricFilterParameters) DeepCopyInto(out *MetricFilterParameters) {
+	*out = *in
+	if in.FilterPattern != nil {
+		in, out := &in.FilterPattern, &out.FilterPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricTransformations != nil {
+		in, out := &in.MetricTransformations, &out.MetricTransformations
+		*out = make([]*MetricTransformation, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(MetricTransformation)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.CustomMetricFilterParameters.DeepCopyInto(&out.CustomMetricFilterParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilterParameters.
+func (in *MetricFilterParameters) DeepCopy() *MetricFilterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricFilterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricFilterSpec) DeepCopyInto(out *MetricFilterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilterSpec.
+func (in *MetricFilterSpec) DeepCopy() *MetricFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricFilterStatus) DeepCopyInto(out *MetricFilterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilterStatus.
+func (in *MetricFilterStatus) DeepCopy() *MetricFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricFilter_SDK) DeepCopyInto(out *MetricFilter_SDK) {
 	*out = *in
 	if in.CreationTime != nil {
 		in, out := &in.CreationTime, &out.CreationTime
@@ -669,12 +1118,68 @@ func (in *MetricFilter) DeepCopyInto(out *MetricFilter) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilter.
-func (in *MetricFilter) DeepCopy() *MetricFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricFilter_SDK.
+func (in *MetricFilter_SDK) DeepCopy() *MetricFilter_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricFilter)
+	out := new(MetricFilter_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricTransformation) DeepCopyInto(out *MetricTransformation) {
+	*out = *in
+	if in.DefaultValue != nil {
+		in, out := &in.DefaultValue, &out.DefaultValue
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.MetricName != nil {
+		in, out := &in.MetricName, &out.MetricName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricNamespace != nil {
+		in, out := &in.MetricNamespace, &out.MetricNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricValue != nil {
+		in, out := &in.MetricValue, &out.MetricValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricTransformation.
+func (in *MetricTransformation) DeepCopy() *MetricTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricTransformation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -920,6 +1425,145 @@ func (in *ResourcePolicy_SDK) DeepCopy() *ResourcePolicy_SDK {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubscriptionFilter) DeepCopyInto(out *SubscriptionFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilter.
+func (in *SubscriptionFilter) DeepCopy() *SubscriptionFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubscriptionFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionFilterList) DeepCopyInto(out *SubscriptionFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SubscriptionFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilterList.
+func (in *SubscriptionFilterList) DeepCopy() *SubscriptionFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubscriptionFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionFilterObservation) DeepCopyInto(out *SubscriptionFilterObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilterObservation.
+func (in *SubscriptionFilterObservation) DeepCopy() *SubscriptionFilterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionFilterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionFilterParameters) DeepCopyInto(out *SubscriptionFilterParameters) {
+	*out = *in
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(string)
+		**out = **in
+	}
+	if in.FilterPattern != nil {
+		in, out := &in.FilterPattern, &out.FilterPattern
+		*out = new(string)
+		**out = **in
+	}
+	in.CustomSubscriptionFilterParameters.DeepCopyInto(&out.CustomSubscriptionFilterParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilterParameters.
+func (in *SubscriptionFilterParameters) DeepCopy() *SubscriptionFilterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionFilterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionFilterSpec) DeepCopyInto(out *SubscriptionFilterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilterSpec.
+func (in *SubscriptionFilterSpec) DeepCopy() *SubscriptionFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionFilterStatus) DeepCopyInto(out *SubscriptionFilterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilterStatus.
+func (in *SubscriptionFilterStatus) DeepCopy() *SubscriptionFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionFilter_SDK) DeepCopyInto(out *SubscriptionFilter_SDK) {
 	*out = *in
 	if in.CreationTime != nil {
 		in, out := &in.CreationTime, &out.CreationTime
@@ -933,12 +1577,12 @@ func (in *SubscriptionFilter) DeepCopyInto(out *SubscriptionFilter) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilter.
-func (in *SubscriptionFilter) DeepCopy() *SubscriptionFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionFilter_SDK.
+func (in *SubscriptionFilter_SDK) DeepCopy() *SubscriptionFilter_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(SubscriptionFilter)
+	out := new(SubscriptionFilter_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
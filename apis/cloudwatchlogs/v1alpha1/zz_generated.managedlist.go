@@ -29,6 +29,24 @@ func (l *LogGroupList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this LogStreamList.
+func (l *LogStreamList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MetricFilterList.
+func (l *MetricFilterList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ResourcePolicyList.
 func (l *ResourcePolicyList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -37,3 +55,12 @@ func (l *ResourcePolicyList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this SubscriptionFilterList.
+func (l *SubscriptionFilterList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -51,3 +51,55 @@ func (mg *LogGroup) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// ResolveReferences of this LogStream.
+func (mg *LogStream) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomLogStreamParameters.LogGroupName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomLogStreamParameters.LogGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CustomLogStreamParameters.LogGroupNameSelector,
+		To: reference.To{
+			List:    &LogGroupList{},
+			Managed: &LogGroup{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomLogStreamParameters.LogGroupName")
+	}
+	mg.Spec.ForProvider.CustomLogStreamParameters.LogGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomLogStreamParameters.LogGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this MetricFilter.
+func (mg *MetricFilter) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomMetricFilterParameters.LogGroupName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomMetricFilterParameters.LogGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CustomMetricFilterParameters.LogGroupNameSelector,
+		To: reference.To{
+			List:    &LogGroupList{},
+			Managed: &LogGroup{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomMetricFilterParameters.LogGroupName")
+	}
+	mg.Spec.ForProvider.CustomMetricFilterParameters.LogGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomMetricFilterParameters.LogGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -59,6 +59,9 @@ type LogGroupObservation struct {
 	// The creation time of the log group, expressed as the number of milliseconds
 	// after Jan 1, 1970 00:00:00 UTC.
 	CreationTime *int64 `json:"creationTime,omitempty"`
+	// Displays whether this log group has a protection policy, or whether it had
+	// one in the past. For more information, see PutDataProtectionPolicy (https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutDataProtectionPolicy.html).
+	DataProtectionStatus *string `json:"dataProtectionStatus,omitempty"`
 	// The Amazon Resource Name (ARN) of the KMS key to use when encrypting log
 	// data.
 	KMSKeyID *string `json:"kmsKeyID,omitempty"`
@@ -126,7 +126,7 @@ type LogGroup_SDK struct {
 }
 
 // +kubebuilder:skipversion
-type LogStream struct {
+type LogStream_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
 	CreationTime *int64 `json:"creationTime,omitempty"`
@@ -141,12 +141,27 @@ type LogStream struct {
 }
 
 // +kubebuilder:skipversion
-type MetricFilter struct {
+type MetricFilter_SDK struct {
 	CreationTime *int64 `json:"creationTime,omitempty"`
 
 	LogGroupName *string `json:"logGroupName,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type MetricTransformation struct {
+	DefaultValue *float64 `json:"defaultValue,omitempty"`
+
+	Dimensions map[string]*string `json:"dimensions,omitempty"`
+
+	MetricName *string `json:"metricName,omitempty"`
+
+	MetricNamespace *string `json:"metricNamespace,omitempty"`
+
+	MetricValue *string `json:"metricValue,omitempty"`
+
+	Unit *string `json:"unit,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type OutputLogEvent struct {
 	IngestionTime *int64 `json:"ingestionTime,omitempty"`
@@ -176,7 +191,7 @@ type ResourcePolicy_SDK struct {
 }
 
 // +kubebuilder:skipversion
-type SubscriptionFilter struct {
+type SubscriptionFilter_SDK struct {
 	CreationTime *int64 `json:"creationTime,omitempty"`
 
 	LogGroupName *string `json:"logGroupName,omitempty"`
@@ -37,3 +37,17 @@ func UserPoolName() reference.ExtractValueFn {
 
 	}
 }
+
+// UserPoolARN returns the status.atProvider.arn of a UserPool.
+func UserPoolARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*UserPool)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.ARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.ARN
+	}
+}
@@ -32,7 +32,108 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// TimeToLive configures the expiry of items based on a timestamp
+	// attribute.
+	// +optional
+	TimeToLive *TimeToLive `json:"timeToLive,omitempty"`
+
+	// KinesisStreamingDestinations are the Kinesis data streams item-level
+	// changes of the table are replicated to. The destinations are not managed
+	// if this is unset; set it to an empty list to disable all destinations.
+	// +optional
+	KinesisStreamingDestinations []*KinesisStreamingDestination `json:"kinesisStreamingDestinations,omitempty"`
+
+	// ContributorInsightsEnabled specifies whether CloudWatch Contributor
+	// Insights are enabled for the table.
+	// +optional
+	ContributorInsightsEnabled *bool `json:"contributorInsightsEnabled,omitempty"`
+
+	// ImportFrom creates the table from data exported to S3 instead of
+	// creating an empty table. It is only used when the table is created.
+	// Local secondary indexes, streams and tags are not supported by imports,
+	// they are applied once the import has completed where possible.
+	// +immutable
+	// +optional
+	ImportFrom *TableImportSource `json:"importFrom,omitempty"`
+}
+
+// TimeToLive configures the time to live of the items of a Table.
+type TimeToLive struct {
+	// AttributeName is the name of the attribute holding the expiry time of
+	// an item, in UNIX epoch seconds.
+	AttributeName string `json:"attributeName"`
+
+	// Enabled specifies whether time to live is enabled. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// KinesisStreamingDestination is a Kinesis data stream a Table streams its
+// changes to.
+type KinesisStreamingDestination struct {
+	// StreamARN is the ARN of the Kinesis data stream.
+	// +optional
+	StreamARN *string `json:"streamARN,omitempty"`
+
+	// StreamARNRef is a reference to a Kinesis Stream used to set StreamARN.
+	// +optional
+	StreamARNRef *xpv1.Reference `json:"streamARNRef,omitempty"`
+
+	// StreamARNSelector selects a reference to a Kinesis Stream used to set
+	// StreamARN.
+	// +optional
+	StreamARNSelector *xpv1.Selector `json:"streamARNSelector,omitempty"`
+}
+
+// TableImportSource describes the S3 data a Table is imported from.
+type TableImportSource struct {
+	// InputFormat is the format of the source data.
+	// +kubebuilder:validation:Enum=DYNAMODB_JSON;ION;CSV
+	InputFormat string `json:"inputFormat"`
+
+	// InputCompressionType is the compression of the source data.
+	// +kubebuilder:validation:Enum=GZIP;ZSTD;NONE
+	// +optional
+	InputCompressionType *string `json:"inputCompressionType,omitempty"`
+
+	// CSV configures how CSV source data is parsed.
+	// +optional
+	CSV *TableImportCSVOptions `json:"csv,omitempty"`
+
+	// S3Bucket is the name of the bucket holding the source data.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3BucketRef is a reference to a Bucket used to set S3Bucket.
+	// +optional
+	S3BucketRef *xpv1.Reference `json:"s3BucketRef,omitempty"`
+
+	// S3BucketSelector selects a reference to a Bucket used to set S3Bucket.
+	// +optional
+	S3BucketSelector *xpv1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// S3BucketOwner is the account ID of the bucket owner, required if the
+	// bucket is owned by another account.
+	// +optional
+	S3BucketOwner *string `json:"s3BucketOwner,omitempty"`
+
+	// S3KeyPrefix is the key prefix shared by all source objects.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+}
+
+// TableImportCSVOptions configures how CSV data is imported.
+type TableImportCSVOptions struct {
+	// Delimiter is the delimiter of the CSV data. Defaults to a comma.
+	// +optional
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// HeaderList lists the column names if the CSV data has no header line.
+	// +optional
+	HeaderList []*string `json:"headerList,omitempty"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
@@ -20,8 +20,12 @@ import (
 	"context"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kinesis "github.com/crossplane-contrib/provider-aws/apis/kinesis/v1alpha1"
+	s3 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 )
 
 // ResolveReferences of this Backup
@@ -43,3 +47,55 @@ func (mg *Backup) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.TableNameRef = rsp.ResolvedReference
 	return nil
 }
+
+// ResolveReferences of this Table
+func (mg *Table) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.kinesisStreamingDestinations[].streamARN
+	for i, d := range mg.Spec.ForProvider.KinesisStreamingDestinations {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(d.StreamARN),
+			Reference:    d.StreamARNRef,
+			Selector:     d.StreamARNSelector,
+			To:           reference.To{Managed: &kinesis.Stream{}, List: &kinesis.StreamList{}},
+			Extract:      kinesis.StreamARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.kinesisStreamingDestinations[%d].streamARN", i)
+		}
+		d.StreamARN = reference.ToPtrValue(rsp.ResolvedValue)
+		d.StreamARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.importFrom.s3Bucket
+	if src := mg.Spec.ForProvider.ImportFrom; src != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(src.S3Bucket),
+			Reference:    src.S3BucketRef,
+			Selector:     src.S3BucketSelector,
+			To:           reference.To{Managed: &s3.Bucket{}, List: &s3.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.importFrom.s3Bucket")
+		}
+		src.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		src.S3BucketRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// TableARN returns the status.atProvider.tableARN of a Table.
+func TableARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Table)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.TableARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.TableARN
+	}
+}
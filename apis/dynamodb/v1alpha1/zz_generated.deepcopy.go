@@ -761,6 +761,32 @@ func (in *CustomGlobalTableParameters) DeepCopy() *CustomGlobalTableParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTableParameters) DeepCopyInto(out *CustomTableParameters) {
 	*out = *in
+	if in.TimeToLive != nil {
+		in, out := &in.TimeToLive, &out.TimeToLive
+		*out = new(TimeToLive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KinesisStreamingDestinations != nil {
+		in, out := &in.KinesisStreamingDestinations, &out.KinesisStreamingDestinations
+		*out = make([]*KinesisStreamingDestination, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KinesisStreamingDestination)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ContributorInsightsEnabled != nil {
+		in, out := &in.ContributorInsightsEnabled, &out.ContributorInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImportFrom != nil {
+		in, out := &in.ImportFrom, &out.ImportFrom
+		*out = new(TableImportSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableParameters.
@@ -1474,6 +1500,11 @@ func (in *KeySchemaElement) DeepCopy() *KeySchemaElement {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KinesisDataStreamDestination) DeepCopyInto(out *KinesisDataStreamDestination) {
 	*out = *in
+	if in.DestinationStatus != nil {
+		in, out := &in.DestinationStatus, &out.DestinationStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.DestinationStatusDescription != nil {
 		in, out := &in.DestinationStatusDescription, &out.DestinationStatusDescription
 		*out = new(string)
@@ -1496,6 +1527,36 @@ func (in *KinesisDataStreamDestination) DeepCopy() *KinesisDataStreamDestination
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KinesisStreamingDestination) DeepCopyInto(out *KinesisStreamingDestination) {
+	*out = *in
+	if in.StreamARN != nil {
+		in, out := &in.StreamARN, &out.StreamARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.StreamARNRef != nil {
+		in, out := &in.StreamARNRef, &out.StreamARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StreamARNSelector != nil {
+		in, out := &in.StreamARNSelector, &out.StreamARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisStreamingDestination.
+func (in *KinesisStreamingDestination) DeepCopy() *KinesisStreamingDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(KinesisStreamingDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalSecondaryIndex) DeepCopyInto(out *LocalSecondaryIndex) {
 	*out = *in
@@ -2754,6 +2815,87 @@ func (in *TableDescription) DeepCopy() *TableDescription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableImportCSVOptions) DeepCopyInto(out *TableImportCSVOptions) {
+	*out = *in
+	if in.Delimiter != nil {
+		in, out := &in.Delimiter, &out.Delimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.HeaderList != nil {
+		in, out := &in.HeaderList, &out.HeaderList
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableImportCSVOptions.
+func (in *TableImportCSVOptions) DeepCopy() *TableImportCSVOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TableImportCSVOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableImportSource) DeepCopyInto(out *TableImportSource) {
+	*out = *in
+	if in.InputCompressionType != nil {
+		in, out := &in.InputCompressionType, &out.InputCompressionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(TableImportCSVOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketRef != nil {
+		in, out := &in.S3BucketRef, &out.S3BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3BucketSelector != nil {
+		in, out := &in.S3BucketSelector, &out.S3BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3BucketOwner != nil {
+		in, out := &in.S3BucketOwner, &out.S3BucketOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3KeyPrefix != nil {
+		in, out := &in.S3KeyPrefix, &out.S3KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableImportSource.
+func (in *TableImportSource) DeepCopy() *TableImportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TableImportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableList) DeepCopyInto(out *TableList) {
 	*out = *in
@@ -2799,6 +2941,11 @@ func (in *TableObservation) DeepCopyInto(out *TableObservation) {
 		*out = new(BillingModeSummary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ContributorInsightsStatus != nil {
+		in, out := &in.ContributorInsightsStatus, &out.ContributorInsightsStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.CreationDateTime != nil {
 		in, out := &in.CreationDateTime, &out.CreationDateTime
 		*out = (*in).DeepCopy()
@@ -2808,11 +2955,32 @@ func (in *TableObservation) DeepCopyInto(out *TableObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ImportARN != nil {
+		in, out := &in.ImportARN, &out.ImportARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportStatus != nil {
+		in, out := &in.ImportStatus, &out.ImportStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.ItemCount != nil {
 		in, out := &in.ItemCount, &out.ItemCount
 		*out = new(int64)
 		**out = **in
 	}
+	if in.KinesisDataStreamDestinations != nil {
+		in, out := &in.KinesisDataStreamDestinations, &out.KinesisDataStreamDestinations
+		*out = make([]*KinesisDataStreamDestination, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KinesisDataStreamDestination)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.LatestStreamARN != nil {
 		in, out := &in.LatestStreamARN, &out.LatestStreamARN
 		*out = new(string)
@@ -2874,6 +3042,11 @@ func (in *TableObservation) DeepCopyInto(out *TableObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TimeToLiveStatus != nil {
+		in, out := &in.TimeToLiveStatus, &out.TimeToLiveStatus
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableObservation.
@@ -2979,7 +3152,7 @@ func (in *TableParameters) DeepCopyInto(out *TableParameters) {
 			}
 		}
 	}
-	out.CustomTableParameters = in.CustomTableParameters
+	in.CustomTableParameters.DeepCopyInto(&out.CustomTableParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
@@ -3051,6 +3224,26 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeToLive) DeepCopyInto(out *TimeToLive) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeToLive.
+func (in *TimeToLive) DeepCopy() *TimeToLive {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeToLive)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeToLiveDescription) DeepCopyInto(out *TimeToLiveDescription) {
 	*out = *in
@@ -177,15 +177,23 @@ type TableObservation struct {
 	ArchivalSummary *ArchivalSummary `json:"archivalSummary,omitempty"`
 	// Contains the details for the read/write capacity mode.
 	BillingModeSummary *BillingModeSummary `json:"billingModeSummary,omitempty"`
+	// Current status of contributor insights
+	ContributorInsightsStatus *string `json:"contributorInsightsStatus,omitempty"`
 	// The date and time when the table was created, in UNIX epoch time (http://www.epochconverter.com/)
 	// format.
 	CreationDateTime *metav1.Time `json:"creationDateTime,omitempty"`
 	// Represents the version of global tables (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/GlobalTables.html)
 	// in use, if the table is replicated across Amazon Web Services Regions.
 	GlobalTableVersion *string `json:"globalTableVersion,omitempty"`
+	// The Amazon Resource Number (ARN) corresponding to the import request.
+	ImportARN *string `json:"importARN,omitempty"`
+	// The status of the import.
+	ImportStatus *string `json:"importStatus,omitempty"`
 	// The number of items in the specified table. DynamoDB updates this value approximately
 	// every six hours. Recent changes might not be reflected in this value.
 	ItemCount *int64 `json:"itemCount,omitempty"`
+	// The list of Kinesis data stream destinations of the table.
+	KinesisDataStreamDestinations []*KinesisDataStreamDestination `json:"kinesisDataStreamDestinations,omitempty"`
 	// The Amazon Resource Name (ARN) that uniquely identifies the latest stream
 	// for this table.
 	LatestStreamARN *string `json:"latestStreamARN,omitempty"`
@@ -242,6 +250,8 @@ type TableObservation struct {
 	//    * ARCHIVED - The table has been archived. See the ArchivalReason for more
 	//    information.
 	TableStatus *string `json:"tableStatus,omitempty"`
+	// The TTL status for the table.
+	TimeToLiveStatus *string `json:"timeToLiveStatus,omitempty"`
 }
 
 // TableStatus defines the observed state of Table.
@@ -365,6 +365,8 @@ type KeySchemaElement struct {
 
 // +kubebuilder:skipversion
 type KinesisDataStreamDestination struct {
+	DestinationStatus *string `json:"destinationStatus,omitempty"`
+
 	DestinationStatusDescription *string `json:"destinationStatusDescription,omitempty"`
 
 	StreamARN *string `json:"streamARN,omitempty"`
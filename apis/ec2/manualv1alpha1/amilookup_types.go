@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AMILookupParameters define the AMIs that are looked up.
+// +kubebuilder:validation:XValidation:rule="(has(self.owners) && size(self.owners) > 0) || (has(self.filters) && size(self.filters) > 0)",message="at least one of owners or filters is required"
+type AMILookupParameters struct {
+	// Region is the region in which the AMIs are looked up.
+	Region string `json:"region"`
+
+	// Owners scopes the lookup to AMIs of the given owners, i.e. AWS account
+	// IDs, self, amazon or aws-marketplace.
+	// +optional
+	Owners []string `json:"owners,omitempty"`
+
+	// Filters scopes the lookup to AMIs that match all filters, e.g. name,
+	// architecture or virtualization-type.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
+
+	// IncludeDeprecated includes deprecated AMIs in the lookup.
+	// +optional
+	IncludeDeprecated *bool `json:"includeDeprecated,omitempty"`
+}
+
+// An AMILookupSpec defines the desired state of an AMILookup.
+type AMILookupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AMILookupParameters `json:"forProvider"`
+}
+
+// AMILookupObservation is the most recent AMI that matches the lookup.
+type AMILookupObservation struct {
+	// ImageID is the ID of the AMI.
+	ImageID string `json:"imageId,omitempty"`
+
+	// Name is the name of the AMI.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the AMI.
+	Description string `json:"description,omitempty"`
+
+	// Architecture is the architecture of the AMI.
+	Architecture string `json:"architecture,omitempty"`
+
+	// CreationDate is the date and time the AMI was created.
+	CreationDate string `json:"creationDate,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the AMI.
+	OwnerID string `json:"ownerId,omitempty"`
+}
+
+// An AMILookupStatus represents the observed state of an AMILookup.
+type AMILookupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AMILookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AMILookup looks up the most recent AMI that matches the given owners and
+// filters. It never creates, updates or deletes an AMI. The ID of the AMI is
+// published as the imageId connection detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IMAGE-ID",type="string",JSONPath=".status.atProvider.imageId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AMILookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AMILookupSpec   `json:"spec"`
+	Status AMILookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AMILookupList contains a list of AMILookups
+type AMILookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AMILookup `json:"items"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AvailabilityZonesParameters define the availability zones that are looked
+// up.
+type AvailabilityZonesParameters struct {
+	// Region is the region whose availability zones are looked up.
+	Region string `json:"region"`
+
+	// AllAvailabilityZones includes the Local Zones and Wavelength Zones that
+	// the account is not opted in to.
+	// +optional
+	AllAvailabilityZones *bool `json:"allAvailabilityZones,omitempty"`
+
+	// Filters scopes the lookup to zones that match all filters, e.g.
+	// state, zone-type or opt-in-status.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
+}
+
+// An AvailabilityZonesSpec defines the desired state of an AvailabilityZones.
+type AvailabilityZonesSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AvailabilityZonesParameters `json:"forProvider"`
+}
+
+// AvailabilityZonesObservation are the availability zones that match the
+// lookup, sorted by name.
+type AvailabilityZonesObservation struct {
+	// Names are the names of the zones, e.g. us-east-1a.
+	Names []string `json:"names,omitempty"`
+
+	// ZoneIDs are the IDs of the zones, e.g. use1-az1, in the order of
+	// Names.
+	ZoneIDs []string `json:"zoneIds,omitempty"`
+}
+
+// An AvailabilityZonesStatus represents the observed state of an
+// AvailabilityZones.
+type AvailabilityZonesStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AvailabilityZonesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AvailabilityZones looks up the availability zones of a region. It never
+// creates, updates or deletes anything. The comma separated names and IDs of
+// the zones are published as the names and zoneIds connection details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONES",type="string",JSONPath=".status.atProvider.names"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AvailabilityZones struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AvailabilityZonesSpec   `json:"spec"`
+	Status AvailabilityZonesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AvailabilityZonesList contains a list of AvailabilityZones
+type AvailabilityZonesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AvailabilityZones `json:"items"`
+}
@@ -868,3 +868,14 @@ type TagSpecification struct {
 	// The tags to apply to the resource
 	Tags []Tag `json:"tags"`
 }
+
+// Filter filters the resources that are looked up. See the documentation of
+// the corresponding EC2 Describe API for the supported filter names.
+type Filter struct {
+	// Name of the filter, e.g. architecture or tag:Name.
+	Name string `json:"name"`
+
+	// Values of the filter. A resource matches the filter if it matches any
+	// of the values. Values may contain the wildcards * and ?.
+	Values []string `json:"values"`
+}
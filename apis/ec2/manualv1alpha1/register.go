@@ -64,8 +64,35 @@ var (
 	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
 )
 
+// AMILookup type metadata.
+var (
+	AMILookupKind             = reflect.TypeOf(AMILookup{}).Name()
+	AMILookupGroupKind        = schema.GroupKind{Group: Group, Kind: AMILookupKind}.String()
+	AMILookupKindAPIVersion   = AMILookupKind + "." + SchemeGroupVersion.String()
+	AMILookupGroupVersionKind = SchemeGroupVersion.WithKind(AMILookupKind)
+)
+
+// AvailabilityZones type metadata.
+var (
+	AvailabilityZonesKind             = reflect.TypeOf(AvailabilityZones{}).Name()
+	AvailabilityZonesGroupKind        = schema.GroupKind{Group: Group, Kind: AvailabilityZonesKind}.String()
+	AvailabilityZonesKindAPIVersion   = AvailabilityZonesKind + "." + SchemeGroupVersion.String()
+	AvailabilityZonesGroupVersionKind = SchemeGroupVersion.WithKind(AvailabilityZonesKind)
+)
+
+// VPCLookup type metadata.
+var (
+	VPCLookupKind             = reflect.TypeOf(VPCLookup{}).Name()
+	VPCLookupGroupKind        = schema.GroupKind{Group: Group, Kind: VPCLookupKind}.String()
+	VPCLookupKindAPIVersion   = VPCLookupKind + "." + SchemeGroupVersion.String()
+	VPCLookupGroupVersionKind = SchemeGroupVersion.WithKind(VPCLookupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPCCIDRBlock{}, &VPCCIDRBlockList{})
 	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
 	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&AMILookup{}, &AMILookupList{})
+	SchemeBuilder.Register(&AvailabilityZones{}, &AvailabilityZonesList{})
+	SchemeBuilder.Register(&VPCLookup{}, &VPCLookupList{})
 }
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPCLookupParameters define the VPC that is looked up.
+type VPCLookupParameters struct {
+	// Region is the region in which the VPC is looked up.
+	Region string `json:"region"`
+
+	// Tags scopes the lookup to VPCs that have all of the given tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Filters scopes the lookup to VPCs that match all filters, e.g.
+	// cidr-block or is-default.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
+}
+
+// A VPCLookupSpec defines the desired state of a VPCLookup.
+type VPCLookupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCLookupParameters `json:"forProvider"`
+}
+
+// VPCLookupObservation is the VPC that matches the lookup.
+type VPCLookupObservation struct {
+	// VPCID is the ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// CIDRBlock is the primary IPv4 CIDR block of the VPC.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// IsDefault indicates whether the VPC is the default VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the VPC.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// State is the current state of the VPC.
+	State string `json:"state,omitempty"`
+}
+
+// A VPCLookupStatus represents the observed state of a VPCLookup.
+type VPCLookupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCLookup looks up an existing VPC by its tags and filters. Exactly one VPC
+// must match. It never creates, updates or deletes a VPC. The ID and CIDR
+// block of the VPC are published as the vpcId and cidrBlock connection
+// details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VPC-ID",type="string",JSONPath=".status.atProvider.vpcId"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".status.atProvider.cidrBlock"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCLookupSpec   `json:"spec"`
+	Status VPCLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCLookupList contains a list of VPCLookups
+type VPCLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCLookup `json:"items"`
+}
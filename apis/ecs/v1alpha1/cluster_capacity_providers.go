@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterCapacityProvidersParameters defines the desired state of
+// ClusterCapacityProviders
+type ClusterCapacityProvidersParameters struct {
+	// Region is which region the ClusterCapacityProviders will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The short name or full Amazon Resource Name (ARN) of the cluster to modify
+	// the capacity provider settings for. The capacity providers of the cluster
+	// should not be managed through the Cluster resource at the same time.
+	// +immutable
+	// +crossplane:generate:reference:type=Cluster
+	Cluster         *string         `json:"cluster,omitempty"`
+	ClusterRef      *xpv1.Reference `json:"clusterRef,omitempty"`
+	ClusterSelector *xpv1.Selector  `json:"clusterSelector,omitempty"`
+
+	// The name of one or more capacity providers to associate with the cluster.
+	//
+	// If specifying a capacity provider that uses an Auto Scaling group, the
+	// capacity provider must already be created. To use a Fargate capacity
+	// provider, specify either the FARGATE or FARGATE_SPOT capacity providers.
+	// +crossplane:generate:reference:type=CapacityProvider
+	// +crossplane:generate:reference:refFieldName=CapacityProviderRefs
+	// +crossplane:generate:reference:selectorFieldName=CapacityProviderSelector
+	// +optional
+	CapacityProviders []*string `json:"capacityProviders,omitempty"`
+
+	// CapacityProviderRefs are references to CapacityProviders used to set the
+	// CapacityProviders.
+	// +optional
+	CapacityProviderRefs []xpv1.Reference `json:"capacityProviderRefs,omitempty"`
+
+	// CapacityProviderSelector selects references to CapacityProviders used to
+	// set the CapacityProviders.
+	// +optional
+	CapacityProviderSelector *xpv1.Selector `json:"capacityProviderSelector,omitempty"`
+
+	// The capacity provider strategy to use by default for the cluster. Only
+	// capacity providers associated with the cluster can be part of the
+	// strategy.
+	// +optional
+	DefaultCapacityProviderStrategy []*CapacityProviderStrategyItem `json:"defaultCapacityProviderStrategy,omitempty"`
+}
+
+// ClusterCapacityProvidersSpec defines the desired state of
+// ClusterCapacityProviders
+type ClusterCapacityProvidersSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterCapacityProvidersParameters `json:"forProvider"`
+}
+
+// ClusterCapacityProvidersObservation defines the observed state of
+// ClusterCapacityProviders
+type ClusterCapacityProvidersObservation struct {
+	// The Amazon Resource Name (ARN) that identifies the cluster.
+	ClusterARN *string `json:"clusterARN,omitempty"`
+
+	// The status of the capacity providers associated with the cluster.
+	AttachmentsStatus *string `json:"attachmentsStatus,omitempty"`
+}
+
+// ClusterCapacityProvidersStatus defines the observed state of
+// ClusterCapacityProviders.
+type ClusterCapacityProvidersStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterCapacityProvidersObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterCapacityProviders manages the capacity providers and the default
+// capacity provider strategy of an existing cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.cluster"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClusterCapacityProviders struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterCapacityProvidersSpec   `json:"spec"`
+	Status            ClusterCapacityProvidersStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterCapacityProvidersList contains a list of ClusterCapacityProviders
+type ClusterCapacityProvidersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterCapacityProviders `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ClusterCapacityProvidersKind             = "ClusterCapacityProviders"
+	ClusterCapacityProvidersGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ClusterCapacityProvidersKind}.String()
+	ClusterCapacityProvidersKindAPIVersion   = ClusterCapacityProvidersKind + "." + GroupVersion.String()
+	ClusterCapacityProvidersGroupVersionKind = GroupVersion.WithKind(ClusterCapacityProvidersKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterCapacityProviders{}, &ClusterCapacityProvidersList{})
+}
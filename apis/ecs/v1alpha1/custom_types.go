@@ -100,6 +100,13 @@ type CustomServiceParameters struct {
 	// +optional
 	ForceDeletion bool `json:"forceDeletion,omitempty"`
 
+	// WaitForSteadyState keeps the Service unready until it has reached a
+	// steady state: a single completed deployment with all desired tasks
+	// running. By default the Service is ready as soon as the desired number of
+	// tasks is running, even while a deployment is still in progress.
+	// +optional
+	WaitForSteadyState bool `json:"waitForSteadyState,omitempty"`
+
 	// A load balancer object representing the load balancers to use with your service.
 	// For more information, see Service Load Balancing (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/service-load-balancing.html)
 	// in the Amazon Elastic Container Service Developer Guide.
@@ -266,3 +273,37 @@ type CustomTaskDefinitionParameters struct {
 
 	Volumes []*CustomVolume `json:"volumes,omitempty"`
 }
+
+// CustomCapacityProviderParameters provides custom parameters for the
+// CapacityProvider type
+type CustomCapacityProviderParameters struct {
+	// The details of the Auto Scaling group for the capacity provider.
+	// +kubebuilder:validation:Required
+	AutoScalingGroupProvider CustomAutoScalingGroupProvider `json:"autoScalingGroupProvider"`
+}
+
+// CustomAutoScalingGroupProvider provides custom parameters for the
+// AutoScalingGroupProvider type
+type CustomAutoScalingGroupProvider struct {
+	// The Amazon Resource Name (ARN) that identifies the Auto Scaling group, or
+	// the Auto Scaling group name.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1beta1.AutoScalingGroup
+	// +crossplane:generate:reference:extractor=AutoScalingGroupARN()
+	AutoScalingGroupARN         *string         `json:"autoScalingGroupARN,omitempty"`
+	AutoScalingGroupARNRef      *xpv1.Reference `json:"autoScalingGroupARNRef,omitempty"`
+	AutoScalingGroupARNSelector *xpv1.Selector  `json:"autoScalingGroupARNSelector,omitempty"`
+
+	// The managed scaling settings for the Auto Scaling group capacity provider.
+	// +optional
+	ManagedScaling *ManagedScaling `json:"managedScaling,omitempty"`
+
+	// The managed termination protection setting to use for the Auto Scaling
+	// group capacity provider. This determines whether the Auto Scaling group
+	// has managed termination protection. When using managed termination
+	// protection, managed scaling must also be used otherwise managed
+	// termination protection doesn't work.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	ManagedTerminationProtection *string `json:"managedTerminationProtection,omitempty"`
+}
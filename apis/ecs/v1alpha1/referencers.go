@@ -5,6 +5,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"k8s.io/utils/ptr"
 
+	autoscaling "github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1beta1"
 	elbv2 "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
 )
 
@@ -18,3 +19,14 @@ func LoadBalancerName() reference.ExtractValueFn {
 		return ptr.Deref(lb.Status.AtProvider.LoadBalancerName, "")
 	}
 }
+
+// AutoScalingGroupARN returns the ARN of an AutoScalingGroup
+func AutoScalingGroupARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		asg, ok := mg.(*autoscaling.AutoScalingGroup)
+		if !ok {
+			return ""
+		}
+		return ptr.Deref(asg.Status.AtProvider.AutoScalingGroupARN, "")
+	}
+}
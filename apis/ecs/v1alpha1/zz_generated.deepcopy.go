@@ -176,6 +176,16 @@ func (in *AutoScalingGroupProvider) DeepCopyInto(out *AutoScalingGroupProvider)
 		*out = new(string)
 		**out = **in
 	}
+	if in.ManagedScaling != nil {
+		in, out := &in.ManagedScaling, &out.ManagedScaling
+		*out = new(ManagedScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedTerminationProtection != nil {
+		in, out := &in.ManagedTerminationProtection, &out.ManagedTerminationProtection
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupProvider.
@@ -190,6 +200,65 @@ func (in *AutoScalingGroupProvider) DeepCopy() *AutoScalingGroupProvider {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CapacityProvider) DeepCopyInto(out *CapacityProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProvider.
+func (in *CapacityProvider) DeepCopy() *CapacityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CapacityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityProviderList) DeepCopyInto(out *CapacityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CapacityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProviderList.
+func (in *CapacityProviderList) DeepCopy() *CapacityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CapacityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityProviderObservation) DeepCopyInto(out *CapacityProviderObservation) {
 	*out = *in
 	if in.CapacityProviderARN != nil {
 		in, out := &in.CapacityProviderARN, &out.CapacityProviderARN
@@ -201,6 +270,36 @@ func (in *CapacityProvider) DeepCopyInto(out *CapacityProvider) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdateStatus != nil {
+		in, out := &in.UpdateStatus, &out.UpdateStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdateStatusReason != nil {
+		in, out := &in.UpdateStatusReason, &out.UpdateStatusReason
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProviderObservation.
+func (in *CapacityProviderObservation) DeepCopy() *CapacityProviderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityProviderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityProviderParameters) DeepCopyInto(out *CapacityProviderParameters) {
+	*out = *in
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -212,19 +311,49 @@ func (in *CapacityProvider) DeepCopyInto(out *CapacityProvider) {
 			}
 		}
 	}
-	if in.UpdateStatusReason != nil {
-		in, out := &in.UpdateStatusReason, &out.UpdateStatusReason
-		*out = new(string)
-		**out = **in
+	in.CustomCapacityProviderParameters.DeepCopyInto(&out.CustomCapacityProviderParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProviderParameters.
+func (in *CapacityProviderParameters) DeepCopy() *CapacityProviderParameters {
+	if in == nil {
+		return nil
 	}
+	out := new(CapacityProviderParameters)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProvider.
-func (in *CapacityProvider) DeepCopy() *CapacityProvider {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityProviderSpec) DeepCopyInto(out *CapacityProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProviderSpec.
+func (in *CapacityProviderSpec) DeepCopy() *CapacityProviderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CapacityProvider)
+	out := new(CapacityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityProviderStatus) DeepCopyInto(out *CapacityProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProviderStatus.
+func (in *CapacityProviderStatus) DeepCopy() *CapacityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityProviderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -259,6 +388,62 @@ func (in *CapacityProviderStrategyItem) DeepCopy() *CapacityProviderStrategyItem
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityProvider_SDK) DeepCopyInto(out *CapacityProvider_SDK) {
+	*out = *in
+	if in.CapacityProviderARN != nil {
+		in, out := &in.CapacityProviderARN, &out.CapacityProviderARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoScalingGroupProvider != nil {
+		in, out := &in.AutoScalingGroupProvider, &out.AutoScalingGroupProvider
+		*out = new(AutoScalingGroupProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UpdateStatus != nil {
+		in, out := &in.UpdateStatus, &out.UpdateStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdateStatusReason != nil {
+		in, out := &in.UpdateStatusReason, &out.UpdateStatusReason
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityProvider_SDK.
+func (in *CapacityProvider_SDK) DeepCopy() *CapacityProvider_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityProvider_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -286,6 +471,188 @@ func (in *Cluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityProviders) DeepCopyInto(out *ClusterCapacityProviders) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityProviders.
+func (in *ClusterCapacityProviders) DeepCopy() *ClusterCapacityProviders {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityProviders)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCapacityProviders) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityProvidersList) DeepCopyInto(out *ClusterCapacityProvidersList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterCapacityProviders, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityProvidersList.
+func (in *ClusterCapacityProvidersList) DeepCopy() *ClusterCapacityProvidersList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityProvidersList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCapacityProvidersList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityProvidersObservation) DeepCopyInto(out *ClusterCapacityProvidersObservation) {
+	*out = *in
+	if in.ClusterARN != nil {
+		in, out := &in.ClusterARN, &out.ClusterARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AttachmentsStatus != nil {
+		in, out := &in.AttachmentsStatus, &out.AttachmentsStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityProvidersObservation.
+func (in *ClusterCapacityProvidersObservation) DeepCopy() *ClusterCapacityProvidersObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityProvidersObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityProvidersParameters) DeepCopyInto(out *ClusterCapacityProvidersParameters) {
+	*out = *in
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityProviders != nil {
+		in, out := &in.CapacityProviders, &out.CapacityProviders
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.CapacityProviderRefs != nil {
+		in, out := &in.CapacityProviderRefs, &out.CapacityProviderRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CapacityProviderSelector != nil {
+		in, out := &in.CapacityProviderSelector, &out.CapacityProviderSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultCapacityProviderStrategy != nil {
+		in, out := &in.DefaultCapacityProviderStrategy, &out.DefaultCapacityProviderStrategy
+		*out = make([]*CapacityProviderStrategyItem, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CapacityProviderStrategyItem)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityProvidersParameters.
+func (in *ClusterCapacityProvidersParameters) DeepCopy() *ClusterCapacityProvidersParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityProvidersParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityProvidersSpec) DeepCopyInto(out *ClusterCapacityProvidersSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityProvidersSpec.
+func (in *ClusterCapacityProvidersSpec) DeepCopy() *ClusterCapacityProvidersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityProvidersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityProvidersStatus) DeepCopyInto(out *ClusterCapacityProvidersStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityProvidersStatus.
+func (in *ClusterCapacityProvidersStatus) DeepCopy() *ClusterCapacityProvidersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityProvidersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterConfiguration) DeepCopyInto(out *ClusterConfiguration) {
 	*out = *in
@@ -1420,6 +1787,62 @@ func (in *CustomAWSVPCConfiguration) DeepCopy() *CustomAWSVPCConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomAutoScalingGroupProvider) DeepCopyInto(out *CustomAutoScalingGroupProvider) {
+	*out = *in
+	if in.AutoScalingGroupARN != nil {
+		in, out := &in.AutoScalingGroupARN, &out.AutoScalingGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoScalingGroupARNRef != nil {
+		in, out := &in.AutoScalingGroupARNRef, &out.AutoScalingGroupARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoScalingGroupARNSelector != nil {
+		in, out := &in.AutoScalingGroupARNSelector, &out.AutoScalingGroupARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedScaling != nil {
+		in, out := &in.ManagedScaling, &out.ManagedScaling
+		*out = new(ManagedScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedTerminationProtection != nil {
+		in, out := &in.ManagedTerminationProtection, &out.ManagedTerminationProtection
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAutoScalingGroupProvider.
+func (in *CustomAutoScalingGroupProvider) DeepCopy() *CustomAutoScalingGroupProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomAutoScalingGroupProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomCapacityProviderParameters) DeepCopyInto(out *CustomCapacityProviderParameters) {
+	*out = *in
+	in.AutoScalingGroupProvider.DeepCopyInto(&out.AutoScalingGroupProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCapacityProviderParameters.
+func (in *CustomCapacityProviderParameters) DeepCopy() *CustomCapacityProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomCapacityProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomClusterParameters) DeepCopyInto(out *CustomClusterParameters) {
 	*out = *in
@@ -2811,6 +3234,46 @@ func (in *ManagedAgentStateChange) DeepCopy() *ManagedAgentStateChange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedScaling) DeepCopyInto(out *ManagedScaling) {
+	*out = *in
+	if in.InstanceWarmupPeriod != nil {
+		in, out := &in.InstanceWarmupPeriod, &out.InstanceWarmupPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumScalingStepSize != nil {
+		in, out := &in.MaximumScalingStepSize, &out.MaximumScalingStepSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinimumScalingStepSize != nil {
+		in, out := &in.MinimumScalingStepSize, &out.MinimumScalingStepSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetCapacity != nil {
+		in, out := &in.TargetCapacity, &out.TargetCapacity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedScaling.
+func (in *ManagedScaling) DeepCopy() *ManagedScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MountPoint) DeepCopyInto(out *MountPoint) {
 	*out = *in
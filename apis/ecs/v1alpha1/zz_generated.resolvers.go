@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	"context"
+	v1beta12 "github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1beta1"
 	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
 	v1alpha11 "github.com/crossplane-contrib/provider-aws/apis/efs/v1alpha1"
 	v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
@@ -29,6 +30,75 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this CapacityProvider.
+func (mg *CapacityProvider) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomCapacityProviderParameters.AutoScalingGroupProvider.AutoScalingGroupARN),
+		Extract:      AutoScalingGroupARN(),
+		Reference:    mg.Spec.ForProvider.CustomCapacityProviderParameters.AutoScalingGroupProvider.AutoScalingGroupARNRef,
+		Selector:     mg.Spec.ForProvider.CustomCapacityProviderParameters.AutoScalingGroupProvider.AutoScalingGroupARNSelector,
+		To: reference.To{
+			List:    &v1beta12.AutoScalingGroupList{},
+			Managed: &v1beta12.AutoScalingGroup{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomCapacityProviderParameters.AutoScalingGroupProvider.AutoScalingGroupARN")
+	}
+	mg.Spec.ForProvider.CustomCapacityProviderParameters.AutoScalingGroupProvider.AutoScalingGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomCapacityProviderParameters.AutoScalingGroupProvider.AutoScalingGroupARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ClusterCapacityProviders.
+func (mg *ClusterCapacityProviders) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Cluster),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.ClusterRef,
+		Selector:     mg.Spec.ForProvider.ClusterSelector,
+		To: reference.To{
+			List:    &ClusterList{},
+			Managed: &Cluster{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Cluster")
+	}
+	mg.Spec.ForProvider.Cluster = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ClusterRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.CapacityProviders),
+		Extract:       reference.ExternalName(),
+		References:    mg.Spec.ForProvider.CapacityProviderRefs,
+		Selector:      mg.Spec.ForProvider.CapacityProviderSelector,
+		To: reference.To{
+			List:    &CapacityProviderList{},
+			Managed: &CapacityProvider{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CapacityProviders")
+	}
+	mg.Spec.ForProvider.CapacityProviders = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.CapacityProviderRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
 // ResolveReferences of this Service.
 func (mg *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
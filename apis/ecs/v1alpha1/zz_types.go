@@ -68,16 +68,37 @@ type Attribute struct {
 // +kubebuilder:skipversion
 type AutoScalingGroupProvider struct {
 	AutoScalingGroupARN *string `json:"autoScalingGroupARN,omitempty"`
+
+	// The managed scaling settings for the Auto Scaling group capacity provider.
+	//
+	// When managed scaling is turned on, Amazon ECS manages the scale-in and scale-out
+	// actions of the Auto Scaling group. Amazon ECS manages a target tracking scaling
+	// policy using an Amazon ECS managed CloudWatch metric with the specified targetCapacity
+	// value as the target value for the metric. For more information, see Using
+	// managed scaling (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/asg-capacity-providers.html#asg-capacity-providers-managed-scaling)
+	// in the Amazon Elastic Container Service Developer Guide.
+	//
+	// If managed scaling is off, the user must manage the scaling of the Auto Scaling
+	// group.
+	ManagedScaling *ManagedScaling `json:"managedScaling,omitempty"`
+
+	ManagedTerminationProtection *string `json:"managedTerminationProtection,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type CapacityProvider struct {
+type CapacityProvider_SDK struct {
 	CapacityProviderARN *string `json:"capacityProviderARN,omitempty"`
+	// The details of the Auto Scaling group for the capacity provider.
+	AutoScalingGroupProvider *AutoScalingGroupProvider `json:"autoScalingGroupProvider,omitempty"`
 
 	Name *string `json:"name,omitempty"`
 
+	Status *string `json:"status,omitempty"`
+
 	Tags []*Tag `json:"tags,omitempty"`
 
+	UpdateStatus *string `json:"updateStatus,omitempty"`
+
 	UpdateStatusReason *string `json:"updateStatusReason,omitempty"`
 }
 
@@ -820,6 +841,19 @@ type ManagedAgentStateChange struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type ManagedScaling struct {
+	InstanceWarmupPeriod *int64 `json:"instanceWarmupPeriod,omitempty"`
+
+	MaximumScalingStepSize *int64 `json:"maximumScalingStepSize,omitempty"`
+
+	MinimumScalingStepSize *int64 `json:"minimumScalingStepSize,omitempty"`
+
+	Status *string `json:"status,omitempty"`
+
+	TargetCapacity *int64 `json:"targetCapacity,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type MountPoint struct {
 	ContainerPath *string `json:"containerPath,omitempty"`
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -116,3 +117,17 @@ func (mg *MountTarget) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	return nil
 }
+
+// FileSystemARN returns the status.atProvider.fileSystemARN of a FileSystem.
+func FileSystemARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*FileSystem)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.FileSystemARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.FileSystemARN
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessEntryParameters define the desired state of an AWS Elastic Kubernetes
+// Service access entry.
+type AccessEntryParameters struct {
+	// Region is the region you'd like the access entry to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the cluster to create the access entry for.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1.Cluster
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The ARN of the IAM principal for the access entry. You can specify one
+	// ARN for each access entry. You can't specify the same ARN in more than
+	// one access entry.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +crossplane:generate:reference:refFieldName=PrincipalARNRef
+	// +crossplane:generate:reference:selectorFieldName=PrincipalARNSelector
+	PrincipalARN string `json:"principalArn,omitempty"`
+
+	// PrincipalARNRef is a reference to a Role used to set the PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalARNRef *xpv1.Reference `json:"principalArnRef,omitempty"`
+
+	// PrincipalARNSelector selects references to a Role used to set the
+	// PrincipalARN.
+	// +optional
+	PrincipalARNSelector *xpv1.Selector `json:"principalArnSelector,omitempty"`
+
+	// The value for name that you've specified for kind: Group as a subject in
+	// a Kubernetes RoleBinding or ClusterRoleBinding object. Amazon EKS
+	// doesn't confirm that the value for name exists in any bindings on your
+	// cluster.
+	// +optional
+	KubernetesGroups []string `json:"kubernetesGroups,omitempty"`
+
+	// The username to authenticate to Kubernetes with. We recommend not
+	// specifying a username and letting Amazon EKS specify it for you.
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// The type of the new access entry. Valid values are STANDARD,
+	// FARGATE_LINUX, EC2_LINUX, and EC2_WINDOWS. Defaults to STANDARD.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;FARGATE_LINUX;EC2_LINUX;EC2_WINDOWS
+	Type *string `json:"type,omitempty"`
+
+	// The metadata to apply to the access entry to assist with categorization
+	// and organization. Each tag consists of a key and an optional value, both
+	// of which you define.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AccessEntryObservation is the observed state of an access entry.
+type AccessEntryObservation struct {
+	// The ARN of the access entry.
+	AccessEntryARN string `json:"accessEntryArn,omitempty"`
+
+	// The Unix epoch timestamp at object creation.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The Unix epoch timestamp for the last modification to the object.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// An AccessEntrySpec defines the desired state of an EKS access entry.
+type AccessEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessEntryParameters `json:"forProvider"`
+}
+
+// An AccessEntryStatus represents the observed state of an EKS access entry.
+type AccessEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessEntryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessEntry is a managed resource that represents an AWS Elastic
+// Kubernetes Service access entry. The external name of an AccessEntry is
+// the ARN of its IAM principal.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessEntrySpec   `json:"spec"`
+	Status AccessEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessEntryList contains a list of AccessEntry items
+type AccessEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessEntry `json:"items"`
+}
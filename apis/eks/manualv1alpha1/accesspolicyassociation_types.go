@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessScopeType is the scope of an access policy association.
+type AccessScopeType string
+
+// Types of access scope.
+const (
+	AccessScopeTypeCluster   AccessScopeType = "cluster"
+	AccessScopeTypeNamespace AccessScopeType = "namespace"
+)
+
+// AccessScope describes the scope of an access policy association.
+type AccessScope struct {
+	// The scope type of an access policy.
+	// +kubebuilder:validation:Enum=cluster;namespace
+	Type AccessScopeType `json:"type"`
+
+	// A Kubernetes namespace that an access policy is scoped to. A value is
+	// required if you specified namespace for Type.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// AccessPolicyAssociationParameters define the desired state of an AWS
+// Elastic Kubernetes Service access policy association.
+type AccessPolicyAssociationParameters struct {
+	// Region is the region you'd like the access policy to be associated in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the cluster of the access entry.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1.Cluster
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The ARN of the IAM principal of the access entry that the access policy
+	// is associated with.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +crossplane:generate:reference:refFieldName=PrincipalARNRef
+	// +crossplane:generate:reference:selectorFieldName=PrincipalARNSelector
+	PrincipalARN string `json:"principalArn,omitempty"`
+
+	// PrincipalARNRef is a reference to a Role used to set the PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalARNRef *xpv1.Reference `json:"principalArnRef,omitempty"`
+
+	// PrincipalARNSelector selects references to a Role used to set the
+	// PrincipalARN.
+	// +optional
+	PrincipalARNSelector *xpv1.Selector `json:"principalArnSelector,omitempty"`
+
+	// The ARN of the access policy that you're associating, for example
+	// arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy.
+	// +immutable
+	PolicyARN string `json:"policyArn"`
+
+	// The scope for the access policy. You can scope access policies to an
+	// entire cluster or to specific Kubernetes namespaces.
+	AccessScope AccessScope `json:"accessScope"`
+}
+
+// AccessPolicyAssociationObservation is the observed state of an access
+// policy association.
+type AccessPolicyAssociationObservation struct {
+	// The date and time the access policy was associated.
+	AssociatedAt *metav1.Time `json:"associatedAt,omitempty"`
+
+	// The Unix epoch timestamp for the last modification to the object.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// An AccessPolicyAssociationSpec defines the desired state of an EKS access
+// policy association.
+type AccessPolicyAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPolicyAssociationParameters `json:"forProvider"`
+}
+
+// An AccessPolicyAssociationStatus represents the observed state of an EKS
+// access policy association.
+type AccessPolicyAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessPolicyAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPolicyAssociation is a managed resource that associates an EKS
+// access policy with an access entry.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="POLICY",type="string",JSONPath=".spec.forProvider.policyArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPolicyAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPolicyAssociationSpec   `json:"spec"`
+	Status AccessPolicyAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPolicyAssociationList contains a list of AccessPolicyAssociation items
+type AccessPolicyAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPolicyAssociation `json:"items"`
+}
@@ -242,7 +242,9 @@ type LaunchTemplateSpecification struct {
 	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
 
 	// The version of the launch template to use. If no version is specified, then the
-	// template's default version is used.
+	// template's default version is used. Set it to $Latest or $Default to track
+	// the latest or default version of the launch template; the node group is
+	// rolled whenever that version changes.
 	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1.LaunchTemplateVersion
 	Version *string `json:"version,omitempty"`
 
@@ -395,6 +397,45 @@ type NodeGroupObservation struct {
 
 	// The current status of the managed node group.
 	Status NodeGroupStatusType `json:"status,omitempty"`
+
+	// The version of the launch template used by the managed node group.
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+
+	// The most recent update of the managed node group started by this
+	// resource.
+	LastUpdate *NodeGroupUpdateStatus `json:"lastUpdate,omitempty"`
+}
+
+// NodeGroupUpdateStatus is the observed state of an update of a node group.
+type NodeGroupUpdateStatus struct {
+	// The ID of the update.
+	ID string `json:"id,omitempty"`
+
+	// The type of the update, such as VersionUpdate or ConfigUpdate.
+	Type string `json:"type,omitempty"`
+
+	// The current status of the update. One of InProgress, Failed, Cancelled
+	// or Successful.
+	Status string `json:"status,omitempty"`
+
+	// The Unix epoch timestamp in seconds for when the update was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// Any errors associated with a Failed update.
+	Errors []NodeGroupUpdateError `json:"errors,omitempty"`
+}
+
+// NodeGroupUpdateError is an error that occurred during an update of a node
+// group.
+type NodeGroupUpdateError struct {
+	// A brief description of the error.
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// A more complete description of the error.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// The IDs of the resources associated with the error.
+	ResourceIDs []string `json:"resourceIds,omitempty"`
 }
 
 // NodeGroupHealth describes the health of a node group.
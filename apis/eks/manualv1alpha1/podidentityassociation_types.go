@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodIdentityAssociationParameters define the desired state of an AWS Elastic
+// Kubernetes Service Pod Identity association.
+type PodIdentityAssociationParameters struct {
+	// Region is the region you'd like the association to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the cluster to create the association in.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1.Cluster
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The name of the Kubernetes namespace inside the cluster to create the
+	// association in. The service account and the pods that use the service
+	// account must be in this namespace.
+	// +immutable
+	Namespace string `json:"namespace"`
+
+	// The name of the Kubernetes service account inside the cluster to
+	// associate the IAM credentials with.
+	// +immutable
+	ServiceAccount string `json:"serviceAccount"`
+
+	// The ARN of the IAM role to associate with the service account. The EKS
+	// Pod Identity agent manages credentials to assume this role for
+	// applications in the containers in the pods that use this service
+	// account.
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +crossplane:generate:reference:refFieldName=RoleARNRef
+	// +crossplane:generate:reference:selectorFieldName=RoleARNSelector
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// RoleARNRef is a reference to a Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects references to a Role used to set the RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// The metadata to apply to the association to assist with categorization
+	// and organization. Each tag consists of a key and an optional value, both
+	// of which you define.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// PodIdentityAssociationObservation is the observed state of a Pod Identity
+// association.
+type PodIdentityAssociationObservation struct {
+	// The ARN of the association.
+	AssociationARN string `json:"associationArn,omitempty"`
+
+	// The ID of the association.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// If defined, the Pod Identity association is owned by an Amazon EKS
+	// add-on.
+	OwnerARN string `json:"ownerArn,omitempty"`
+
+	// The timestamp that the association was created at.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The most recent timestamp that the association was modified at.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// A PodIdentityAssociationSpec defines the desired state of an EKS Pod
+// Identity association.
+type PodIdentityAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PodIdentityAssociationParameters `json:"forProvider"`
+}
+
+// A PodIdentityAssociationStatus represents the observed state of an EKS Pod
+// Identity association.
+type PodIdentityAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PodIdentityAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PodIdentityAssociation is a managed resource that represents an AWS
+// Elastic Kubernetes Service Pod Identity association. The external name of a
+// PodIdentityAssociation is its association ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.namespace"
+// +kubebuilder:printcolumn:name="SERVICEACCOUNT",type="string",JSONPath=".spec.forProvider.serviceAccount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PodIdentityAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PodIdentityAssociationSpec   `json:"spec"`
+	Status PodIdentityAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PodIdentityAssociationList contains a list of PodIdentityAssociation items
+type PodIdentityAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodIdentityAssociation `json:"items"`
+}
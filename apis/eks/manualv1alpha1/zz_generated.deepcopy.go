@@ -25,6 +25,351 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntry) DeepCopyInto(out *AccessEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntry.
+func (in *AccessEntry) DeepCopy() *AccessEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryList) DeepCopyInto(out *AccessEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryList.
+func (in *AccessEntryList) DeepCopy() *AccessEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryObservation) DeepCopyInto(out *AccessEntryObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryObservation.
+func (in *AccessEntryObservation) DeepCopy() *AccessEntryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryParameters) DeepCopyInto(out *AccessEntryParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrincipalARNRef != nil {
+		in, out := &in.PrincipalARNRef, &out.PrincipalARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrincipalARNSelector != nil {
+		in, out := &in.PrincipalARNSelector, &out.PrincipalARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesGroups != nil {
+		in, out := &in.KubernetesGroups, &out.KubernetesGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryParameters.
+func (in *AccessEntryParameters) DeepCopy() *AccessEntryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntrySpec) DeepCopyInto(out *AccessEntrySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntrySpec.
+func (in *AccessEntrySpec) DeepCopy() *AccessEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryStatus) DeepCopyInto(out *AccessEntryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryStatus.
+func (in *AccessEntryStatus) DeepCopy() *AccessEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociation) DeepCopyInto(out *AccessPolicyAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociation.
+func (in *AccessPolicyAssociation) DeepCopy() *AccessPolicyAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPolicyAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationList) DeepCopyInto(out *AccessPolicyAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPolicyAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationList.
+func (in *AccessPolicyAssociationList) DeepCopy() *AccessPolicyAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPolicyAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationObservation) DeepCopyInto(out *AccessPolicyAssociationObservation) {
+	*out = *in
+	if in.AssociatedAt != nil {
+		in, out := &in.AssociatedAt, &out.AssociatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationObservation.
+func (in *AccessPolicyAssociationObservation) DeepCopy() *AccessPolicyAssociationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationParameters) DeepCopyInto(out *AccessPolicyAssociationParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrincipalARNRef != nil {
+		in, out := &in.PrincipalARNRef, &out.PrincipalARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrincipalARNSelector != nil {
+		in, out := &in.PrincipalARNSelector, &out.PrincipalARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.AccessScope.DeepCopyInto(&out.AccessScope)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationParameters.
+func (in *AccessPolicyAssociationParameters) DeepCopy() *AccessPolicyAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationSpec) DeepCopyInto(out *AccessPolicyAssociationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationSpec.
+func (in *AccessPolicyAssociationSpec) DeepCopy() *AccessPolicyAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationStatus) DeepCopyInto(out *AccessPolicyAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationStatus.
+func (in *AccessPolicyAssociationStatus) DeepCopy() *AccessPolicyAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScope) DeepCopyInto(out *AccessScope) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScope.
+func (in *AccessScope) DeepCopy() *AccessScope {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoScalingGroup) DeepCopyInto(out *AutoScalingGroup) {
 	*out = *in
@@ -556,6 +901,11 @@ func (in *NodeGroupObservation) DeepCopyInto(out *NodeGroupObservation) {
 	in.Resources.DeepCopyInto(&out.Resources)
 	in.ScalingConfig.DeepCopyInto(&out.ScalingConfig)
 	in.UpdateConfig.DeepCopyInto(&out.UpdateConfig)
+	if in.LastUpdate != nil {
+		in, out := &in.LastUpdate, &out.LastUpdate
+		*out = new(NodeGroupUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupObservation.
@@ -850,6 +1200,52 @@ func (in *NodeGroupUpdateConfigStatus) DeepCopy() *NodeGroupUpdateConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupUpdateError) DeepCopyInto(out *NodeGroupUpdateError) {
+	*out = *in
+	if in.ResourceIDs != nil {
+		in, out := &in.ResourceIDs, &out.ResourceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupUpdateError.
+func (in *NodeGroupUpdateError) DeepCopy() *NodeGroupUpdateError {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupUpdateError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupUpdateStatus) DeepCopyInto(out *NodeGroupUpdateStatus) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]NodeGroupUpdateError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupUpdateStatus.
+func (in *NodeGroupUpdateStatus) DeepCopy() *NodeGroupUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCIdentityProvider) DeepCopyInto(out *OIDCIdentityProvider) {
 	*out = *in
@@ -872,6 +1268,164 @@ func (in *OIDCIdentityProvider) DeepCopy() *OIDCIdentityProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociation) DeepCopyInto(out *PodIdentityAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociation.
+func (in *PodIdentityAssociation) DeepCopy() *PodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodIdentityAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationList) DeepCopyInto(out *PodIdentityAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodIdentityAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationList.
+func (in *PodIdentityAssociationList) DeepCopy() *PodIdentityAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodIdentityAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationObservation) DeepCopyInto(out *PodIdentityAssociationObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationObservation.
+func (in *PodIdentityAssociationObservation) DeepCopy() *PodIdentityAssociationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationParameters) DeepCopyInto(out *PodIdentityAssociationParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationParameters.
+func (in *PodIdentityAssociationParameters) DeepCopy() *PodIdentityAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationSpec) DeepCopyInto(out *PodIdentityAssociationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationSpec.
+func (in *PodIdentityAssociationSpec) DeepCopy() *PodIdentityAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationStatus) DeepCopyInto(out *PodIdentityAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationStatus.
+func (in *PodIdentityAssociationStatus) DeepCopy() *PodIdentityAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteAccessConfig) DeepCopyInto(out *RemoteAccessConfig) {
 	*out = *in
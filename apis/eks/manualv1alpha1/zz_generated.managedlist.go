@@ -20,6 +20,24 @@ package manualv1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this AccessEntryList.
+func (l *AccessEntryList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this AccessPolicyAssociationList.
+func (l *AccessPolicyAssociationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this FargateProfileList.
 func (l *FargateProfileList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -46,3 +64,12 @@ func (l *NodeGroupList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this PodIdentityAssociationList.
+func (l *PodIdentityAssociationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
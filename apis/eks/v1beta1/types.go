@@ -61,6 +61,41 @@ type AccessConfig struct {
 	AuthenticationMode *AuthenticationMode `json:"authenticationMode,omitempty"`
 }
 
+// KubeconfigStyle determines how the kubeconfig that is published in the
+// connection secret of a cluster authenticates against it.
+type KubeconfigStyle string
+
+// Kubeconfig styles.
+const (
+	// KubeconfigStylePresignedToken embeds a bearer token that is derived
+	// from a presigned STS GetCallerIdentity request. The token expires after
+	// 15 minutes and is refreshed and re-published before it does.
+	KubeconfigStylePresignedToken KubeconfigStyle = "PresignedToken"
+
+	// KubeconfigStyleExec configures the kubeconfig to obtain a token by
+	// running "aws eks get-token", so that consumers authenticate with their
+	// own AWS credentials.
+	KubeconfigStyleExec KubeconfigStyle = "Exec"
+
+	// KubeconfigStyleEndpointOnly publishes only the endpoint and the
+	// certificate authority data of the cluster, without a kubeconfig.
+	KubeconfigStyleEndpointOnly KubeconfigStyle = "EndpointOnly"
+)
+
+// KubeconfigOptions configure the kubeconfig that is published in the
+// connection secret of a cluster.
+type KubeconfigOptions struct {
+	// Style of the published kubeconfig. Defaults to PresignedToken.
+	// +kubebuilder:validation:Enum=PresignedToken;Exec;EndpointOnly
+	// +optional
+	Style *KubeconfigStyle `json:"style,omitempty"`
+
+	// RoleARN is passed to "aws eks get-token" as the role to assume when
+	// the Exec style is used.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+}
+
 // ClusterParameters define the desired state of an AWS Elastic Kubernetes
 // Service cluster.
 type ClusterParameters struct {
@@ -81,6 +116,11 @@ type ClusterParameters struct {
 	// +optional
 	EncryptionConfig []EncryptionConfig `json:"encryptionConfig,omitempty"`
 
+	// Kubeconfig configures the kubeconfig that is published in the
+	// connection secret of the cluster. It is not sent to AWS.
+	// +optional
+	Kubeconfig *KubeconfigOptions `json:"kubeconfig,omitempty"`
+
 	// The Kubernetes network configuration for the cluster.
 	// +immutable
 	// +optional
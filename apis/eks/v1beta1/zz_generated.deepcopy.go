@@ -163,6 +163,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(KubeconfigOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubernetesNetworkConfig != nil {
 		in, out := &in.KubernetesNetworkConfig, &out.KubernetesNetworkConfig
 		*out = new(KubernetesNetworkConfigRequest)
@@ -489,6 +494,31 @@ func (in *Identity) DeepCopy() *Identity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigOptions) DeepCopyInto(out *KubeconfigOptions) {
+	*out = *in
+	if in.Style != nil {
+		in, out := &in.Style, &out.Style
+		*out = new(KubeconfigStyle)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigOptions.
+func (in *KubeconfigOptions) DeepCopy() *KubeconfigOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesNetworkConfigRequest) DeepCopyInto(out *KubernetesNetworkConfigRequest) {
 	*out = *in
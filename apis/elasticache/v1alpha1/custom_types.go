@@ -16,9 +16,102 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
 // CustomCacheParameterGroupParameters includes the custom fields.
 type CustomCacheParameterGroupParameters struct {
 	// A list of parameters to associate with this DB parameter group
 	// +optional
 	ParameterNameValues []ParameterNameValue `json:"parameters,omitempty"`
 }
+
+// CustomUserParameters includes the custom fields of User.
+type CustomUserParameters struct {
+	// PasswordSecretRefs references the secret keys that contain the passwords
+	// of the user if the authentication type is password. A user can have up to
+	// two passwords. The passwords are published as password and password2 in
+	// the connection secret of the user.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	PasswordSecretRefs []xpv1.SecretKeySelector `json:"passwordSecretRefs,omitempty"`
+}
+
+// CustomUserGroupParameters includes the custom fields of UserGroup.
+type CustomUserGroupParameters struct {
+	// The list of user IDs that belong to the user group. Every user group of
+	// the redis engine has to include a user with the user name default.
+	// +optional
+	// +crossplane:generate:reference:type=User
+	// +crossplane:generate:reference:refFieldName=UserIDRefs
+	// +crossplane:generate:reference:selectorFieldName=UserIDSelector
+	UserIDs []*string `json:"userIDs,omitempty"`
+
+	// UserIDRefs is a list of references to Users used to set the UserIDs.
+	// +optional
+	UserIDRefs []xpv1.Reference `json:"userIDRefs,omitempty"`
+
+	// UserIDSelector selects references to Users used to set the UserIDs.
+	// +optional
+	UserIDSelector *xpv1.Selector `json:"userIDSelector,omitempty"`
+}
+
+// CustomGlobalReplicationGroupParameters includes the custom fields of
+// GlobalReplicationGroup.
+type CustomGlobalReplicationGroupParameters struct {
+	// The ID of the replication group that becomes the primary member of the
+	// Global datastore. It accepts writes and replicates updates to the
+	// secondary replication groups, which join the Global datastore by setting
+	// their globalReplicationGroupID.
+	// +immutable
+	// +kubebuilder:validation:Required
+	PrimaryReplicationGroupID *string `json:"primaryReplicationGroupID"`
+
+	// A valid cache node type that you want to scale this Global datastore to.
+	// +optional
+	CacheNodeType *string `json:"cacheNodeType,omitempty"`
+
+	// The name of the cache parameter group to use with the Global datastore.
+	// It must be compatible with the major engine version used by the Global
+	// datastore and is applied together with an engine version upgrade.
+	// +optional
+	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
+
+	// The upgraded version of the cache engine to be run on the clusters in the
+	// Global datastore.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// RetainPrimaryReplicationGroup keeps the primary replication group as a
+	// standalone replication group when the Global datastore is deleted.
+	// +kubebuilder:default=true
+	// +optional
+	RetainPrimaryReplicationGroup *bool `json:"retainPrimaryReplicationGroup,omitempty"`
+}
+
+// CustomSnapshotParameters includes the custom fields of Snapshot.
+type CustomSnapshotParameters struct {
+	// The identifier of an existing cluster. The snapshot is created from this
+	// cluster.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/cache/v1alpha1.CacheCluster
+	CacheClusterID *string `json:"cacheClusterID,omitempty"`
+
+	// CacheClusterIDRef is a reference to a CacheCluster used to set the
+	// CacheClusterID.
+	// +optional
+	CacheClusterIDRef *xpv1.Reference `json:"cacheClusterIDRef,omitempty"`
+
+	// CacheClusterIDSelector selects a reference to a CacheCluster used to set
+	// the CacheClusterID.
+	// +optional
+	CacheClusterIDSelector *xpv1.Selector `json:"cacheClusterIDSelector,omitempty"`
+
+	// The identifier of an existing replication group. The snapshot is created
+	// from this replication group.
+	// +immutable
+	// +optional
+	ReplicationGroupID *string `json:"replicationGroupID,omitempty"`
+}
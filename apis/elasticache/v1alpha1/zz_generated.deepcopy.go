@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -32,6 +33,11 @@ func (in *Authentication) DeepCopyInto(out *Authentication) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authentication.
@@ -44,6 +50,26 @@ func (in *Authentication) DeepCopy() *Authentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationMode) DeepCopyInto(out *AuthenticationMode) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationMode.
+func (in *AuthenticationMode) DeepCopy() *AuthenticationMode {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
@@ -706,6 +732,139 @@ func (in *CustomCacheParameterGroupParameters) DeepCopy() *CustomCacheParameterG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomGlobalReplicationGroupParameters) DeepCopyInto(out *CustomGlobalReplicationGroupParameters) {
+	*out = *in
+	if in.PrimaryReplicationGroupID != nil {
+		in, out := &in.PrimaryReplicationGroupID, &out.PrimaryReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheParameterGroupName != nil {
+		in, out := &in.CacheParameterGroupName, &out.CacheParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetainPrimaryReplicationGroup != nil {
+		in, out := &in.RetainPrimaryReplicationGroup, &out.RetainPrimaryReplicationGroup
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomGlobalReplicationGroupParameters.
+func (in *CustomGlobalReplicationGroupParameters) DeepCopy() *CustomGlobalReplicationGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomGlobalReplicationGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomSnapshotParameters) DeepCopyInto(out *CustomSnapshotParameters) {
+	*out = *in
+	if in.CacheClusterID != nil {
+		in, out := &in.CacheClusterID, &out.CacheClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheClusterIDRef != nil {
+		in, out := &in.CacheClusterIDRef, &out.CacheClusterIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheClusterIDSelector != nil {
+		in, out := &in.CacheClusterIDSelector, &out.CacheClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationGroupID != nil {
+		in, out := &in.ReplicationGroupID, &out.ReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSnapshotParameters.
+func (in *CustomSnapshotParameters) DeepCopy() *CustomSnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomSnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomUserGroupParameters) DeepCopyInto(out *CustomUserGroupParameters) {
+	*out = *in
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.UserIDRefs != nil {
+		in, out := &in.UserIDRefs, &out.UserIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserIDSelector != nil {
+		in, out := &in.UserIDSelector, &out.UserIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomUserGroupParameters.
+func (in *CustomUserGroupParameters) DeepCopy() *CustomUserGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomUserGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomUserParameters) DeepCopyInto(out *CustomUserParameters) {
+	*out = *in
+	if in.PasswordSecretRefs != nil {
+		in, out := &in.PasswordSecretRefs, &out.PasswordSecretRefs
+		*out = make([]v1.SecretKeySelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomUserParameters.
+func (in *CustomUserParameters) DeepCopy() *CustomUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomerNodeEndpoint) DeepCopyInto(out *CustomerNodeEndpoint) {
 	*out = *in
@@ -859,41 +1018,10 @@ func (in *GlobalNodeGroup) DeepCopy() *GlobalNodeGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroup) DeepCopyInto(out *GlobalReplicationGroup) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CacheNodeType != nil {
-		in, out := &in.CacheNodeType, &out.CacheNodeType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
-		*out = new(string)
-		**out = **in
-	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.GlobalReplicationGroupDescription != nil {
-		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
-		*out = new(string)
-		**out = **in
-	}
-	if in.GlobalReplicationGroupID != nil {
-		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup.
@@ -906,6 +1034,14 @@ func (in *GlobalReplicationGroup) DeepCopy() *GlobalReplicationGroup {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroupInfo) DeepCopyInto(out *GlobalReplicationGroupInfo) {
 	*out = *in
@@ -931,6 +1067,38 @@ func (in *GlobalReplicationGroupInfo) DeepCopy() *GlobalReplicationGroupInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupList) DeepCopyInto(out *GlobalReplicationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalReplicationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupList.
+func (in *GlobalReplicationGroupList) DeepCopy() *GlobalReplicationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroupMember) DeepCopyInto(out *GlobalReplicationGroupMember) {
 	*out = *in
@@ -967,77 +1135,316 @@ func (in *GlobalReplicationGroupMember) DeepCopy() *GlobalReplicationGroupMember
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KinesisFirehoseDestinationDetails) DeepCopyInto(out *KinesisFirehoseDestinationDetails) {
+func (in *GlobalReplicationGroupObservation) DeepCopyInto(out *GlobalReplicationGroupObservation) {
 	*out = *in
-	if in.DeliveryStream != nil {
-		in, out := &in.DeliveryStream, &out.DeliveryStream
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisFirehoseDestinationDetails.
-func (in *KinesisFirehoseDestinationDetails) DeepCopy() *KinesisFirehoseDestinationDetails {
-	if in == nil {
-		return nil
+	if in.AtRestEncryptionEnabled != nil {
+		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(KinesisFirehoseDestinationDetails)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogDeliveryConfiguration) DeepCopyInto(out *LogDeliveryConfiguration) {
-	*out = *in
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
+	if in.AuthTokenEnabled != nil {
+		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeliveryConfiguration.
-func (in *LogDeliveryConfiguration) DeepCopy() *LogDeliveryConfiguration {
-	if in == nil {
-		return nil
+	if in.ClusterEnabled != nil {
+		in, out := &in.ClusterEnabled, &out.ClusterEnabled
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(LogDeliveryConfiguration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
-	*out = *in
-	if in.NodeGroupID != nil {
-		in, out := &in.NodeGroupID, &out.NodeGroupID
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
 		*out = new(string)
 		**out = **in
 	}
-	if in.Slots != nil {
-		in, out := &in.Slots, &out.Slots
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalNodeGroups != nil {
+		in, out := &in.GlobalNodeGroups, &out.GlobalNodeGroups
+		*out = make([]*GlobalNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalNodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
-func (in *NodeGroup) DeepCopy() *NodeGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupObservation.
+func (in *GlobalReplicationGroupObservation) DeepCopy() *GlobalReplicationGroupObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeGroup)
+	out := new(GlobalReplicationGroupObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeGroupConfiguration) DeepCopyInto(out *NodeGroupConfiguration) {
+func (in *GlobalReplicationGroupParameters) DeepCopyInto(out *GlobalReplicationGroupParameters) {
+	*out = *in
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupIDSuffix != nil {
+		in, out := &in.GlobalReplicationGroupIDSuffix, &out.GlobalReplicationGroupIDSuffix
+		*out = new(string)
+		**out = **in
+	}
+	in.CustomGlobalReplicationGroupParameters.DeepCopyInto(&out.CustomGlobalReplicationGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupParameters.
+func (in *GlobalReplicationGroupParameters) DeepCopy() *GlobalReplicationGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupSpec) DeepCopyInto(out *GlobalReplicationGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupSpec.
+func (in *GlobalReplicationGroupSpec) DeepCopy() *GlobalReplicationGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupStatus) DeepCopyInto(out *GlobalReplicationGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupStatus.
+func (in *GlobalReplicationGroupStatus) DeepCopy() *GlobalReplicationGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroup_SDK) DeepCopyInto(out *GlobalReplicationGroup_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AtRestEncryptionEnabled != nil {
+		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthTokenEnabled != nil {
+		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterEnabled != nil {
+		in, out := &in.ClusterEnabled, &out.ClusterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalNodeGroups != nil {
+		in, out := &in.GlobalNodeGroups, &out.GlobalNodeGroups
+		*out = make([]*GlobalNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalNodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup_SDK.
+func (in *GlobalReplicationGroup_SDK) DeepCopy() *GlobalReplicationGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KinesisFirehoseDestinationDetails) DeepCopyInto(out *KinesisFirehoseDestinationDetails) {
+	*out = *in
+	if in.DeliveryStream != nil {
+		in, out := &in.DeliveryStream, &out.DeliveryStream
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisFirehoseDestinationDetails.
+func (in *KinesisFirehoseDestinationDetails) DeepCopy() *KinesisFirehoseDestinationDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(KinesisFirehoseDestinationDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeliveryConfiguration) DeepCopyInto(out *LogDeliveryConfiguration) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeliveryConfiguration.
+func (in *LogDeliveryConfiguration) DeepCopy() *LogDeliveryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDeliveryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
+	*out = *in
+	if in.NodeGroupID != nil {
+		in, out := &in.NodeGroupID, &out.NodeGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Slots != nil {
+		in, out := &in.Slots, &out.Slots
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
+func (in *NodeGroup) DeepCopy() *NodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupConfiguration) DeepCopyInto(out *NodeGroupConfiguration) {
 	*out = *in
 	if in.PrimaryAvailabilityZone != nil {
 		in, out := &in.PrimaryAvailabilityZone, &out.PrimaryAvailabilityZone
@@ -1638,6 +2045,65 @@ func (in *ServiceUpdate) DeepCopy() *ServiceUpdate {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotObservation) DeepCopyInto(out *SnapshotObservation) {
 	*out = *in
 	if in.ARN != nil {
 		in, out := &in.ARN, &out.ARN
@@ -1649,11 +2115,15 @@ func (in *Snapshot) DeepCopyInto(out *Snapshot) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CacheClusterID != nil {
-		in, out := &in.CacheClusterID, &out.CacheClusterID
+	if in.AutomaticFailover != nil {
+		in, out := &in.AutomaticFailover, &out.AutomaticFailover
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheClusterCreateTime != nil {
+		in, out := &in.CacheClusterCreateTime, &out.CacheClusterCreateTime
+		*out = (*in).DeepCopy()
+	}
 	if in.CacheNodeType != nil {
 		in, out := &in.CacheNodeType, &out.CacheNodeType
 		*out = new(string)
@@ -1669,6 +2139,11 @@ func (in *Snapshot) DeepCopyInto(out *Snapshot) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DataTiering != nil {
+		in, out := &in.DataTiering, &out.DataTiering
+		*out = new(string)
+		**out = **in
+	}
 	if in.Engine != nil {
 		in, out := &in.Engine, &out.Engine
 		*out = new(string)
@@ -1679,10 +2154,16 @@ func (in *Snapshot) DeepCopyInto(out *Snapshot) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
-		**out = **in
+	if in.NodeSnapshots != nil {
+		in, out := &in.NodeSnapshots, &out.NodeSnapshots
+		*out = make([]*NodeSnapshot, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NodeSnapshot)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	if in.NumCacheNodes != nil {
 		in, out := &in.NumCacheNodes, &out.NumCacheNodes
@@ -1719,7 +2200,203 @@ func (in *Snapshot) DeepCopyInto(out *Snapshot) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReplicationGroupID != nil {
+	if in.SnapshotName != nil {
+		in, out := &in.SnapshotName, &out.SnapshotName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotRetentionLimit != nil {
+		in, out := &in.SnapshotRetentionLimit, &out.SnapshotRetentionLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotSource != nil {
+		in, out := &in.SnapshotSource, &out.SnapshotSource
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotStatus != nil {
+		in, out := &in.SnapshotStatus, &out.SnapshotStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotWindow != nil {
+		in, out := &in.SnapshotWindow, &out.SnapshotWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopicARN != nil {
+		in, out := &in.TopicARN, &out.TopicARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotObservation.
+func (in *SnapshotObservation) DeepCopy() *SnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotParameters) DeepCopyInto(out *SnapshotParameters) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.CustomSnapshotParameters.DeepCopyInto(&out.CustomSnapshotParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotParameters.
+func (in *SnapshotParameters) DeepCopy() *SnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot_SDK) DeepCopyInto(out *Snapshot_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheClusterID != nil {
+		in, out := &in.CacheClusterID, &out.CacheClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheParameterGroupName != nil {
+		in, out := &in.CacheParameterGroupName, &out.CacheParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheSubnetGroupName != nil {
+		in, out := &in.CacheSubnetGroupName, &out.CacheSubnetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NumCacheNodes != nil {
+		in, out := &in.NumCacheNodes, &out.NumCacheNodes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NumNodeGroups != nil {
+		in, out := &in.NumNodeGroups, &out.NumNodeGroups
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreferredAvailabilityZone != nil {
+		in, out := &in.PreferredAvailabilityZone, &out.PreferredAvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredOutpostARN != nil {
+		in, out := &in.PreferredOutpostARN, &out.PreferredOutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationGroupDescription != nil {
+		in, out := &in.ReplicationGroupDescription, &out.ReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationGroupID != nil {
 		in, out := &in.ReplicationGroupID, &out.ReplicationGroupID
 		*out = new(string)
 		**out = **in
@@ -1761,12 +2438,12 @@ func (in *Snapshot) DeepCopyInto(out *Snapshot) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
-func (in *Snapshot) DeepCopy() *Snapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot_SDK.
+func (in *Snapshot_SDK) DeepCopy() *Snapshot_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(Snapshot)
+	out := new(Snapshot_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1924,36 +2601,10 @@ func (in *UpdateAction) DeepCopy() *UpdateAction {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.AccessString != nil {
-		in, out := &in.AccessString, &out.AccessString
-		*out = new(string)
-		**out = **in
-	}
-	if in.MinimumEngineVersion != nil {
-		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(string)
-		**out = **in
-	}
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
@@ -1966,29 +2617,21 @@ func (in *User) DeepCopy() *User {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserGroup) DeepCopyInto(out *UserGroup) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.MinimumEngineVersion != nil {
-		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.UserGroupID != nil {
-		in, out := &in.UserGroupID, &out.UserGroupID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroup.
@@ -2000,3 +2643,499 @@ func (in *UserGroup) DeepCopy() *UserGroup {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroupList) DeepCopyInto(out *UserGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroupList.
+func (in *UserGroupList) DeepCopy() *UserGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroupObservation) DeepCopyInto(out *UserGroupObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimumEngineVersion != nil {
+		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = new(UserGroupPendingChanges)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationGroups != nil {
+		in, out := &in.ReplicationGroups, &out.ReplicationGroups
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserGroupID != nil {
+		in, out := &in.UserGroupID, &out.UserGroupID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroupObservation.
+func (in *UserGroupObservation) DeepCopy() *UserGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroupParameters) DeepCopyInto(out *UserGroupParameters) {
+	*out = *in
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.CustomUserGroupParameters.DeepCopyInto(&out.CustomUserGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroupParameters.
+func (in *UserGroupParameters) DeepCopy() *UserGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroupPendingChanges) DeepCopyInto(out *UserGroupPendingChanges) {
+	*out = *in
+	if in.UserIDsToAdd != nil {
+		in, out := &in.UserIDsToAdd, &out.UserIDsToAdd
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.UserIDsToRemove != nil {
+		in, out := &in.UserIDsToRemove, &out.UserIDsToRemove
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroupPendingChanges.
+func (in *UserGroupPendingChanges) DeepCopy() *UserGroupPendingChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroupPendingChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroupSpec) DeepCopyInto(out *UserGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroupSpec.
+func (in *UserGroupSpec) DeepCopy() *UserGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroupStatus) DeepCopyInto(out *UserGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroupStatus.
+func (in *UserGroupStatus) DeepCopy() *UserGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserGroup_SDK) DeepCopyInto(out *UserGroup_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimumEngineVersion != nil {
+		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = new(UserGroupPendingChanges)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationGroups != nil {
+		in, out := &in.ReplicationGroups, &out.ReplicationGroups
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserGroupID != nil {
+		in, out := &in.UserGroupID, &out.UserGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserGroup_SDK.
+func (in *UserGroup_SDK) DeepCopy() *UserGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(UserGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]User, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserObservation) DeepCopyInto(out *UserObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(Authentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinimumEngineVersion != nil {
+		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserGroupIDs != nil {
+		in, out := &in.UserGroupIDs, &out.UserGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
+func (in *UserObservation) DeepCopy() *UserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserParameters) DeepCopyInto(out *UserParameters) {
+	*out = *in
+	if in.AccessString != nil {
+		in, out := &in.AccessString, &out.AccessString
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthenticationMode != nil {
+		in, out := &in.AuthenticationMode, &out.AuthenticationMode
+		*out = new(AuthenticationMode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoPasswordRequired != nil {
+		in, out := &in.NoPasswordRequired, &out.NoPasswordRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	in.CustomUserParameters.DeepCopyInto(&out.CustomUserParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
+func (in *UserParameters) DeepCopy() *UserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *User_SDK) DeepCopyInto(out *User_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessString != nil {
+		in, out := &in.AccessString, &out.AccessString
+		*out = new(string)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(Authentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimumEngineVersion != nil {
+		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserGroupIDs != nil {
+		in, out := &in.UserGroupIDs, &out.UserGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User_SDK.
+func (in *User_SDK) DeepCopy() *User_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(User_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,6 +30,13 @@ var (
 // +kubebuilder:skipversion
 type Authentication struct {
 	PasswordCount *int64 `json:"passwordCount,omitempty"`
+
+	Type *string `json:"type_,omitempty"`
+}
+
+// +kubebuilder:skipversion
+type AuthenticationMode struct {
+	Type *string `json:"type_,omitempty"`
 }
 
 // +kubebuilder:skipversion
@@ -228,20 +235,32 @@ type GlobalNodeGroup struct {
 }
 
 // +kubebuilder:skipversion
-type GlobalReplicationGroup struct {
+type GlobalReplicationGroup_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
+	AtRestEncryptionEnabled *bool `json:"atRestEncryptionEnabled,omitempty"`
+
+	AuthTokenEnabled *bool `json:"authTokenEnabled,omitempty"`
+
 	CacheNodeType *string `json:"cacheNodeType,omitempty"`
 
+	ClusterEnabled *bool `json:"clusterEnabled,omitempty"`
+
 	Engine *string `json:"engine,omitempty"`
 
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
+	GlobalNodeGroups []*GlobalNodeGroup `json:"globalNodeGroups,omitempty"`
+
 	GlobalReplicationGroupDescription *string `json:"globalReplicationGroupDescription,omitempty"`
 
 	GlobalReplicationGroupID *string `json:"globalReplicationGroupID,omitempty"`
 
+	Members []*GlobalReplicationGroupMember `json:"members,omitempty"`
+
 	Status *string `json:"status,omitempty"`
+
+	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
 }
 
 // +kubebuilder:skipversion
@@ -468,7 +487,7 @@ type ServiceUpdate struct {
 }
 
 // +kubebuilder:skipversion
-type Snapshot struct {
+type Snapshot_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
 	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
@@ -564,27 +583,48 @@ type UpdateAction struct {
 }
 
 // +kubebuilder:skipversion
-type User struct {
+type User_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
 	AccessString *string `json:"accessString,omitempty"`
 
+	Authentication *Authentication `json:"authentication,omitempty"`
+
+	Engine *string `json:"engine,omitempty"`
+
 	MinimumEngineVersion *string `json:"minimumEngineVersion,omitempty"`
 
 	Status *string `json:"status,omitempty"`
 
+	UserGroupIDs []*string `json:"userGroupIDs,omitempty"`
+
 	UserID *string `json:"userID,omitempty"`
 
 	UserName *string `json:"userName,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type UserGroup struct {
+type UserGroupPendingChanges struct {
+	UserIDsToAdd []*string `json:"userIDsToAdd,omitempty"`
+
+	UserIDsToRemove []*string `json:"userIDsToRemove,omitempty"`
+}
+
+// +kubebuilder:skipversion
+type UserGroup_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
+	Engine *string `json:"engine,omitempty"`
+
 	MinimumEngineVersion *string `json:"minimumEngineVersion,omitempty"`
 
+	PendingChanges *UserGroupPendingChanges `json:"pendingChanges,omitempty"`
+
+	ReplicationGroups []*string `json:"replicationGroups,omitempty"`
+
 	Status *string `json:"status,omitempty"`
 
 	UserGroupID *string `json:"userGroupID,omitempty"`
+
+	UserIDs []*string `json:"userIDs,omitempty"`
 }
@@ -0,0 +1,160 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomEventBusParameters includes custom additional fields for EventBusParameters.
+type CustomEventBusParameters struct {
+	// Policy is the resource-based policy of the event bus, formatted as a
+	// JSON string. It controls which principals are allowed to put events to
+	// the event bus. All permissions are removed from the event bus if this
+	// is not set.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+}
+
+// CustomRuleParameters includes custom additional fields for RuleParameters.
+type CustomRuleParameters struct {
+	// The name or ARN of the event bus to associate with this rule. If you
+	// omit this, the default event bus is used.
+	// +immutable
+	// +crossplane:generate:reference:type=EventBus
+	// +optional
+	EventBusName *string `json:"eventBusName,omitempty"`
+
+	// EventBusNameRef is a reference to an EventBus used to set EventBusName.
+	// +optional
+	EventBusNameRef *xpv1.Reference `json:"eventBusNameRef,omitempty"`
+
+	// EventBusNameSelector selects a reference to an EventBus used to set
+	// EventBusName.
+	// +optional
+	EventBusNameSelector *xpv1.Selector `json:"eventBusNameSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IAM role associated with the rule.
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+}
+
+// CustomArchiveParameters includes custom additional fields for ArchiveParameters.
+type CustomArchiveParameters struct {
+	// The ARN of the event bus that sends events to the archive.
+	// +immutable
+	// +crossplane:generate:reference:type=EventBus
+	// +crossplane:generate:reference:extractor=EventBusARN()
+	// +optional
+	EventSourceARN *string `json:"eventSourceARN,omitempty"`
+
+	// EventSourceARNRef is a reference to an EventBus used to set
+	// EventSourceARN.
+	// +optional
+	EventSourceARNRef *xpv1.Reference `json:"eventSourceARNRef,omitempty"`
+
+	// EventSourceARNSelector selects a reference to an EventBus used to set
+	// EventSourceARN.
+	// +optional
+	EventSourceARNSelector *xpv1.Selector `json:"eventSourceARNSelector,omitempty"`
+}
+
+// RetryPolicy contains the retry policy configuration to use for the
+// dead-letter queue.
+type RetryPolicy struct {
+	// The maximum amount of time, in seconds, to continue to make retry attempts.
+	// +optional
+	MaximumEventAgeInSeconds *int64 `json:"maximumEventAgeInSeconds,omitempty"`
+
+	// The maximum number of retry attempts to make before the request fails.
+	// +optional
+	MaximumRetryAttempts *int64 `json:"maximumRetryAttempts,omitempty"`
+}
+
+// DeadLetterConfig contains the Amazon SQS queue used as dead-letter queue.
+type DeadLetterConfig struct {
+	// The ARN of the SQS queue specified as the target for the dead-letter
+	// queue.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// ARNRef is a reference to an SQS Queue used to set ARN.
+	// +optional
+	ARNRef *xpv1.Reference `json:"arnRef,omitempty"`
+
+	// ARNSelector selects a reference to an SQS Queue used to set ARN.
+	// +optional
+	ARNSelector *xpv1.Selector `json:"arnSelector,omitempty"`
+}
+
+// SQSParameters contains the custom parameters for when an Amazon SQS FIFO
+// queue is used as a target.
+type SQSParameters struct {
+	// The FIFO message group ID to use as the target.
+	// +optional
+	MessageGroupID *string `json:"messageGroupID,omitempty"`
+}
+
+// TargetARNReferences holds the references a target ARN can be resolved
+// from. At most one of them should be set.
+type TargetARNReferences struct {
+	// FunctionRef is a reference to a Lambda Function used to set the target
+	// ARN.
+	// +optional
+	FunctionRef *xpv1.Reference `json:"functionRef,omitempty"`
+
+	// FunctionSelector selects a reference to a Lambda Function used to set
+	// the target ARN.
+	// +optional
+	FunctionSelector *xpv1.Selector `json:"functionSelector,omitempty"`
+
+	// QueueRef is a reference to an SQS Queue used to set the target ARN.
+	// +optional
+	QueueRef *xpv1.Reference `json:"queueRef,omitempty"`
+
+	// QueueSelector selects a reference to an SQS Queue used to set the
+	// target ARN.
+	// +optional
+	QueueSelector *xpv1.Selector `json:"queueSelector,omitempty"`
+
+	// TopicRef is a reference to an SNS Topic used to set the target ARN.
+	// +optional
+	TopicRef *xpv1.Reference `json:"topicRef,omitempty"`
+
+	// TopicSelector selects a reference to an SNS Topic used to set the
+	// target ARN.
+	// +optional
+	TopicSelector *xpv1.Selector `json:"topicSelector,omitempty"`
+
+	// StateMachineRef is a reference to a Step Functions StateMachine used to
+	// set the target ARN.
+	// +optional
+	StateMachineRef *xpv1.Reference `json:"stateMachineRef,omitempty"`
+
+	// StateMachineSelector selects a reference to a Step Functions
+	// StateMachine used to set the target ARN.
+	// +optional
+	StateMachineSelector *xpv1.Selector `json:"stateMachineSelector,omitempty"`
+}
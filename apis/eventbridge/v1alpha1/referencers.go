@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	ecsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ecs/v1alpha1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	lambdav1beta1 "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
+	sfnv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/sfn/v1alpha1"
+	snsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/sns/v1beta1"
+	sqsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/sqs/v1beta1"
+)
+
+// EventBusARN returns the status.atProvider.eventBusARN of an EventBus.
+func EventBusARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*EventBus)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.EventBusARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.EventBusARN
+	}
+}
+
+// ResolveReferences of this Target
+func (mg *Target) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.eventBusName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventBusName),
+		Reference:    mg.Spec.ForProvider.EventBusNameRef,
+		Selector:     mg.Spec.ForProvider.EventBusNameSelector,
+		To:           reference.To{Managed: &EventBus{}, List: &EventBusList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventBusName")
+	}
+	mg.Spec.ForProvider.EventBusName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EventBusNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.rule
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Rule),
+		Reference:    mg.Spec.ForProvider.RuleRef,
+		Selector:     mg.Spec.ForProvider.RuleSelector,
+		To:           reference.To{Managed: &Rule{}, List: &RuleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.rule")
+	}
+	mg.Spec.ForProvider.Rule = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RuleRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.arn
+	if err := resolveTargetARN(ctx, r, &mg.Spec.ForProvider.ARN, &mg.Spec.ForProvider.TargetARNReferences); err != nil {
+		return errors.Wrap(err, "spec.forProvider.arn")
+	}
+
+	// Resolve spec.forProvider.roleARN
+	if err := resolveRoleARN(ctx, r, &mg.Spec.ForProvider.RoleARN, &mg.Spec.ForProvider.RoleARNRef, mg.Spec.ForProvider.RoleARNSelector); err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleARN")
+	}
+
+	// Resolve spec.forProvider.deadLetterConfig.arn
+	if mg.Spec.ForProvider.DeadLetterConfig != nil {
+		if err := resolveDeadLetterConfig(ctx, r, mg.Spec.ForProvider.DeadLetterConfig); err != nil {
+			return errors.Wrap(err, "spec.forProvider.deadLetterConfig.arn")
+		}
+	}
+	return nil
+}
+
+// ResolveReferences of this Schedule
+func (mg *Schedule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.target.arn
+	if err := resolveTargetARN(ctx, r, &mg.Spec.ForProvider.Target.ARN, &mg.Spec.ForProvider.Target.TargetARNReferences); err != nil {
+		return errors.Wrap(err, "spec.forProvider.target.arn")
+	}
+
+	// Resolve spec.forProvider.target.arn from an ECS cluster. The external
+	// name of a Cluster is its ARN.
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Target.ARN),
+		Reference:    mg.Spec.ForProvider.Target.ClusterRef,
+		Selector:     mg.Spec.ForProvider.Target.ClusterSelector,
+		To:           reference.To{Managed: &ecsv1alpha1.Cluster{}, List: &ecsv1alpha1.ClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.target.arn")
+	}
+	mg.Spec.ForProvider.Target.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Target.ClusterRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.target.roleARN
+	if err := resolveRoleARN(ctx, r, &mg.Spec.ForProvider.Target.RoleARN, &mg.Spec.ForProvider.Target.RoleARNRef, mg.Spec.ForProvider.Target.RoleARNSelector); err != nil {
+		return errors.Wrap(err, "spec.forProvider.target.roleARN")
+	}
+
+	// Resolve spec.forProvider.target.deadLetterConfig.arn
+	if mg.Spec.ForProvider.Target.DeadLetterConfig != nil {
+		if err := resolveDeadLetterConfig(ctx, r, mg.Spec.ForProvider.Target.DeadLetterConfig); err != nil {
+			return errors.Wrap(err, "spec.forProvider.target.deadLetterConfig.arn")
+		}
+	}
+
+	// Resolve spec.forProvider.target.ecsParameters
+	if mg.Spec.ForProvider.Target.ECSParameters != nil {
+		if err := resolveECSParameters(ctx, r, mg.Spec.ForProvider.Target.ECSParameters); err != nil {
+			return errors.Wrap(err, "spec.forProvider.target.ecsParameters")
+		}
+	}
+	return nil
+}
+
+// resolveECSParameters resolves the task definition, subnets and security
+// groups of scheduled ECS tasks.
+func resolveECSParameters(ctx context.Context, r *reference.APIResolver, p *ScheduleECSParameters) error {
+	// The external name of a TaskDefinitionFamily is the ARN of the family
+	// without revision, so the tasks always use the latest revision.
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.TaskDefinitionARN),
+		Reference:    p.TaskDefinitionARNRef,
+		Selector:     p.TaskDefinitionARNSelector,
+		To:           reference.To{Managed: &ecsv1alpha1.TaskDefinitionFamily{}, List: &ecsv1alpha1.TaskDefinitionFamilyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "taskDefinitionARN")
+	}
+	p.TaskDefinitionARN = reference.ToPtrValue(rsp.ResolvedValue)
+	p.TaskDefinitionARNRef = rsp.ResolvedReference
+
+	if p.NetworkConfiguration == nil || p.NetworkConfiguration.AWSVPCConfiguration == nil {
+		return nil
+	}
+	vpc := p.NetworkConfiguration.AWSVPCConfiguration
+
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: vpc.Subnets,
+		References:    vpc.SubnetRefs,
+		Selector:      vpc.SubnetSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "networkConfiguration.awsvpcConfiguration.subnets")
+	}
+	vpc.Subnets = mrsp.ResolvedValues
+	vpc.SubnetRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: vpc.SecurityGroups,
+		References:    vpc.SecurityGroupRefs,
+		Selector:      vpc.SecurityGroupSelector,
+		To:            reference.To{Managed: &ec2v1beta1.SecurityGroup{}, List: &ec2v1beta1.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "networkConfiguration.awsvpcConfiguration.securityGroups")
+	}
+	vpc.SecurityGroups = mrsp.ResolvedValues
+	vpc.SecurityGroupRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// resolveTargetARN resolves the target ARN from whichever of the supported
+// target kinds is referenced.
+func resolveTargetARN(ctx context.Context, r *reference.APIResolver, arn **string, refs *TargetARNReferences) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*arn),
+		Reference:    refs.FunctionRef,
+		Selector:     refs.FunctionSelector,
+		To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+		Extract:      lambdav1beta1.FunctionARN(),
+	})
+	if err != nil {
+		return err
+	}
+	*arn = reference.ToPtrValue(rsp.ResolvedValue)
+	refs.FunctionRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*arn),
+		Reference:    refs.QueueRef,
+		Selector:     refs.QueueSelector,
+		To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+		Extract:      sqsv1beta1.QueueARN(),
+	})
+	if err != nil {
+		return err
+	}
+	*arn = reference.ToPtrValue(rsp.ResolvedValue)
+	refs.QueueRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*arn),
+		Reference:    refs.TopicRef,
+		Selector:     refs.TopicSelector,
+		To:           reference.To{Managed: &snsv1beta1.Topic{}, List: &snsv1beta1.TopicList{}},
+		Extract:      snsv1beta1.SNSTopicARN(),
+	})
+	if err != nil {
+		return err
+	}
+	*arn = reference.ToPtrValue(rsp.ResolvedValue)
+	refs.TopicRef = rsp.ResolvedReference
+
+	// The external name of a StateMachine is its ARN.
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*arn),
+		Reference:    refs.StateMachineRef,
+		Selector:     refs.StateMachineSelector,
+		To:           reference.To{Managed: &sfnv1alpha1.StateMachine{}, List: &sfnv1alpha1.StateMachineList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	*arn = reference.ToPtrValue(rsp.ResolvedValue)
+	refs.StateMachineRef = rsp.ResolvedReference
+	return nil
+}
+
+func resolveRoleARN(ctx context.Context, r *reference.APIResolver, arn **string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*arn),
+		Reference:    *ref,
+		Selector:     sel,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	*arn = reference.ToPtrValue(rsp.ResolvedValue)
+	*ref = rsp.ResolvedReference
+	return nil
+}
+
+func resolveDeadLetterConfig(ctx context.Context, r *reference.APIResolver, dlc *DeadLetterConfig) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(dlc.ARN),
+		Reference:    dlc.ARNRef,
+		Selector:     dlc.ARNSelector,
+		To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+		Extract:      sqsv1beta1.QueueARN(),
+	})
+	if err != nil {
+		return err
+	}
+	dlc.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+	dlc.ARNRef = rsp.ResolvedReference
+	return nil
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// FlexibleTimeWindow allows you to configure a time window during which
+// EventBridge Scheduler invokes the schedule.
+type FlexibleTimeWindow struct {
+	// The maximum time window during which a schedule can be invoked.
+	// +optional
+	MaximumWindowInMinutes *int64 `json:"maximumWindowInMinutes,omitempty"`
+
+	// Determines whether the schedule is invoked within a flexible time window.
+	// +kubebuilder:validation:Enum=OFF;FLEXIBLE
+	// +kubebuilder:validation:Required
+	Mode string `json:"mode"`
+}
+
+// ScheduleTarget is the schedule's target.
+type ScheduleTarget struct {
+	// The Amazon Resource Name (ARN) of the target. It has to be given
+	// directly or resolved using one of the references below.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	TargetARNReferences `json:",inline"`
+
+	// ClusterRef is a reference to an ECS Cluster used to set the target ARN
+	// when the schedule runs ECS tasks.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to an ECS Cluster used to set the
+	// target ARN when the schedule runs ECS tasks.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IAM role that EventBridge Scheduler
+	// will use for this target when the schedule is invoked.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// The text, or well-formed JSON, passed to the target.
+	// +optional
+	Input *string `json:"input,omitempty"`
+
+	// A RetryPolicy object that includes information about the retry policy
+	// settings, including the maximum age of an event, and the maximum number
+	// of times EventBridge Scheduler will try to deliver the event to a target.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// An object that contains information about an Amazon SQS queue that
+	// EventBridge Scheduler uses as a dead-letter queue for your schedule.
+	// +optional
+	DeadLetterConfig *DeadLetterConfig `json:"deadLetterConfig,omitempty"`
+
+	// The templated target type for the Amazon SQS SendMessage API operation.
+	// +optional
+	SQSParameters *SQSParameters `json:"sqsParameters,omitempty"`
+
+	// The templated target type for the Amazon ECS RunTask API operation. Set
+	// it together with an ECS cluster as target to run standalone tasks.
+	// +optional
+	ECSParameters *ScheduleECSParameters `json:"ecsParameters,omitempty"`
+}
+
+// ScheduleECSParameters are the parameters of the tasks EventBridge Scheduler
+// runs on an ECS cluster.
+type ScheduleECSParameters struct {
+	// The Amazon Resource Name (ARN) of the task definition to use. If the
+	// revision is omitted, the latest ACTIVE revision of the family is used.
+	// +optional
+	TaskDefinitionARN *string `json:"taskDefinitionARN,omitempty"`
+
+	// TaskDefinitionARNRef is a reference to an ECS TaskDefinitionFamily used
+	// to set TaskDefinitionARN. The tasks always use the latest revision of
+	// the family.
+	// +optional
+	TaskDefinitionARNRef *xpv1.Reference `json:"taskDefinitionARNRef,omitempty"`
+
+	// TaskDefinitionARNSelector selects a reference to an ECS
+	// TaskDefinitionFamily used to set TaskDefinitionARN.
+	// +optional
+	TaskDefinitionARNSelector *xpv1.Selector `json:"taskDefinitionARNSelector,omitempty"`
+
+	// The number of tasks to create based on the task definition.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TaskCount *int64 `json:"taskCount,omitempty"`
+
+	// Specifies the launch type on which your task is running. Must not be
+	// set together with CapacityProviderStrategy.
+	// +kubebuilder:validation:Enum=EC2;FARGATE;EXTERNAL
+	// +optional
+	LaunchType *string `json:"launchType,omitempty"`
+
+	// The capacity provider strategy to use for the task. If neither this
+	// nor LaunchType is set, the default capacity provider strategy of the
+	// cluster is used.
+	// +optional
+	CapacityProviderStrategy []ScheduleCapacityProviderStrategyItem `json:"capacityProviderStrategy,omitempty"`
+
+	// The network configuration of the tasks. It is required for task
+	// definitions using the awsvpc network mode.
+	// +optional
+	NetworkConfiguration *ScheduleNetworkConfiguration `json:"networkConfiguration,omitempty"`
+
+	// The placement constraints of the tasks.
+	// +optional
+	PlacementConstraints []SchedulePlacementConstraint `json:"placementConstraints,omitempty"`
+
+	// The placement strategy of the tasks.
+	// +optional
+	PlacementStrategy []SchedulePlacementStrategy `json:"placementStrategy,omitempty"`
+
+	// The platform version of Fargate tasks, for example 1.4.0.
+	// +optional
+	PlatformVersion *string `json:"platformVersion,omitempty"`
+
+	// The ECS task group of the tasks.
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// Specifies whether to use ECS managed tags for the tasks.
+	// +optional
+	EnableECSManagedTags *bool `json:"enableECSManagedTags,omitempty"`
+
+	// Whether or not to enable the execute command functionality for the
+	// containers in the tasks.
+	// +optional
+	EnableExecuteCommand *bool `json:"enableExecuteCommand,omitempty"`
+
+	// Specifies whether to propagate the tags from the task definition to the
+	// tasks.
+	// +kubebuilder:validation:Enum=TASK_DEFINITION
+	// +optional
+	PropagateTags *string `json:"propagateTags,omitempty"`
+
+	// The reference ID to use for the tasks.
+	// +optional
+	ReferenceID *string `json:"referenceID,omitempty"`
+}
+
+// ScheduleCapacityProviderStrategyItem is a capacity provider used by the
+// tasks of a schedule.
+type ScheduleCapacityProviderStrategyItem struct {
+	// The short name of the capacity provider.
+	// +kubebuilder:validation:Required
+	CapacityProvider string `json:"capacityProvider"`
+
+	// The minimum number of tasks to run on the capacity provider.
+	// +optional
+	Base *int64 `json:"base,omitempty"`
+
+	// The relative percentage of the total number of tasks that should use
+	// the capacity provider.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// ScheduleNetworkConfiguration is the network configuration of the tasks of
+// a schedule.
+type ScheduleNetworkConfiguration struct {
+	// The VPC subnets and security groups of the tasks.
+	// +optional
+	AWSVPCConfiguration *ScheduleAWSVPCConfiguration `json:"awsvpcConfiguration,omitempty"`
+}
+
+// ScheduleAWSVPCConfiguration are the VPC subnets and security groups of the
+// tasks of a schedule.
+type ScheduleAWSVPCConfiguration struct {
+	// Specifies whether the task's elastic network interface receives a public
+	// IP address.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	AssignPublicIP *string `json:"assignPublicIP,omitempty"`
+
+	// The IDs of the security groups of the tasks.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// SecurityGroupRefs are references to SecurityGroups used to set the
+	// SecurityGroups.
+	// +optional
+	SecurityGroupRefs []xpv1.Reference `json:"securityGroupRefs,omitempty"`
+
+	// SecurityGroupSelector selects references to SecurityGroups used to set
+	// the SecurityGroups.
+	// +optional
+	SecurityGroupSelector *xpv1.Selector `json:"securityGroupSelector,omitempty"`
+
+	// The IDs of the subnets of the tasks.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs are references to Subnets used to set the Subnets.
+	// +optional
+	SubnetRefs []xpv1.Reference `json:"subnetRefs,omitempty"`
+
+	// SubnetSelector selects references to Subnets used to set the Subnets.
+	// +optional
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+}
+
+// SchedulePlacementConstraint is a placement constraint of the tasks of a
+// schedule.
+type SchedulePlacementConstraint struct {
+	// The type of constraint.
+	// +kubebuilder:validation:Enum=distinctInstance;memberOf
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// A cluster query language expression to apply to the constraint. It
+	// cannot be set when the type is distinctInstance.
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+}
+
+// SchedulePlacementStrategy is a placement strategy of the tasks of a
+// schedule.
+type SchedulePlacementStrategy struct {
+	// The type of placement strategy.
+	// +kubebuilder:validation:Enum=random;spread;binpack
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// The field to apply the placement strategy against.
+	// +optional
+	Field *string `json:"field,omitempty"`
+}
+
+// ScheduleParameters defines the desired state of Schedule
+type ScheduleParameters struct {
+	// Region is which region the Schedule will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name of the schedule group to associate with this schedule. If you
+	// omit this, the default schedule group is used.
+	// +immutable
+	// +optional
+	GroupName *string `json:"groupName,omitempty"`
+
+	// The description you specify for the schedule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The expression that defines when the schedule runs. For example,
+	// "at(2022-11-20T13:00:00)", "rate(5 minutes)" or "cron(0 20 * * ? *)".
+	// +kubebuilder:validation:Required
+	ScheduleExpression *string `json:"scheduleExpression"`
+
+	// The timezone in which the scheduling expression is evaluated.
+	// +optional
+	ScheduleExpressionTimezone *string `json:"scheduleExpressionTimezone,omitempty"`
+
+	// The date, in UTC, after which the schedule can begin invoking its target.
+	// +optional
+	StartDate *metav1.Time `json:"startDate,omitempty"`
+
+	// The date, in UTC, before which the schedule can invoke its target.
+	// +optional
+	EndDate *metav1.Time `json:"endDate,omitempty"`
+
+	// Specifies whether the schedule is enabled or disabled.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// Specifies the action that EventBridge Scheduler applies to the schedule
+	// after the schedule completes invoking the target.
+	// +kubebuilder:validation:Enum=NONE;DELETE
+	// +optional
+	ActionAfterCompletion *string `json:"actionAfterCompletion,omitempty"`
+
+	// The Amazon Resource Name (ARN) for the customer managed KMS key that
+	// EventBridge Scheduler will use to encrypt and decrypt your data.
+	// +optional
+	KMSKeyARN *string `json:"kmsKeyARN,omitempty"`
+
+	// Allows you to configure a time window during which EventBridge Scheduler
+	// invokes the schedule.
+	// +kubebuilder:validation:Required
+	FlexibleTimeWindow FlexibleTimeWindow `json:"flexibleTimeWindow"`
+
+	// The schedule's target.
+	// +kubebuilder:validation:Required
+	Target ScheduleTarget `json:"target"`
+}
+
+// ScheduleSpec defines the desired state of Schedule
+type ScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScheduleParameters `json:"forProvider"`
+}
+
+// ScheduleObservation defines the observed state of Schedule
+type ScheduleObservation struct {
+	// The Amazon Resource Name (ARN) of the schedule.
+	ARN *string `json:"arn,omitempty"`
+
+	// The time at which the schedule was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+
+	// The time at which the schedule was last modified.
+	LastModificationDate *metav1.Time `json:"lastModificationDate,omitempty"`
+}
+
+// ScheduleStatus defines the observed state of Schedule.
+type ScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Schedule is an EventBridge Scheduler schedule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Schedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ScheduleSpec   `json:"spec"`
+	Status            ScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScheduleList contains a list of Schedules
+type ScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Schedule `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ScheduleKind             = "Schedule"
+	ScheduleGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ScheduleKind}.String()
+	ScheduleKindAPIVersion   = ScheduleKind + "." + GroupVersion.String()
+	ScheduleGroupVersionKind = GroupVersion.WithKind(ScheduleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Schedule{}, &ScheduleList{})
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InputTransformer contains the parameters needed for you to provide custom
+// input to a target based on one or more pieces of data extracted from the
+// event.
+type InputTransformer struct {
+	// Map of JSON paths to be extracted from the event. You can then insert these
+	// in the template in InputTemplate to produce the output you want to be sent
+	// to the target.
+	// +optional
+	InputPathsMap map[string]*string `json:"inputPathsMap,omitempty"`
+
+	// Input template where you specify placeholders that will be filled with
+	// the values of the keys from InputPathsMap to customize the data sent to
+	// the target.
+	// +kubebuilder:validation:Required
+	InputTemplate *string `json:"inputTemplate"`
+}
+
+// TargetParameters defines the desired state of Target
+type TargetParameters struct {
+	// Region is which region the Target will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name or ARN of the event bus associated with the rule. If you omit
+	// this, the default event bus is used.
+	// +immutable
+	// +optional
+	EventBusName *string `json:"eventBusName,omitempty"`
+
+	// EventBusNameRef is a reference to an EventBus used to set EventBusName.
+	// +optional
+	EventBusNameRef *xpv1.Reference `json:"eventBusNameRef,omitempty"`
+
+	// EventBusNameSelector selects a reference to an EventBus used to set
+	// EventBusName.
+	// +optional
+	EventBusNameSelector *xpv1.Selector `json:"eventBusNameSelector,omitempty"`
+
+	// The name of the rule this target belongs to.
+	// +immutable
+	// +optional
+	Rule *string `json:"rule,omitempty"`
+
+	// RuleRef is a reference to a Rule used to set Rule.
+	// +optional
+	RuleRef *xpv1.Reference `json:"ruleRef,omitempty"`
+
+	// RuleSelector selects a reference to a Rule used to set Rule.
+	// +optional
+	RuleSelector *xpv1.Selector `json:"ruleSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the target. It has to be given
+	// directly or resolved using one of the references below.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	TargetARNReferences `json:",inline"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be used for this target
+	// when the rule is triggered.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// Valid JSON text passed to the target. In this case, nothing from the event
+	// itself is passed to the target.
+	// +optional
+	Input *string `json:"input,omitempty"`
+
+	// The value of the JSONPath that is used for extracting part of the matched
+	// event when passing it to the target.
+	// +optional
+	InputPath *string `json:"inputPath,omitempty"`
+
+	// Settings to enable you to provide custom input to a target based on certain
+	// event data.
+	// +optional
+	InputTransformer *InputTransformer `json:"inputTransformer,omitempty"`
+
+	// The retry policy configuration to use for the dead-letter queue.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// The DeadLetterConfig that defines the target queue to send dead-letter
+	// queue events to.
+	// +optional
+	DeadLetterConfig *DeadLetterConfig `json:"deadLetterConfig,omitempty"`
+
+	// Contains the message group ID to use when the target is a FIFO queue.
+	// +optional
+	SQSParameters *SQSParameters `json:"sqsParameters,omitempty"`
+}
+
+// TargetSpec defines the desired state of Target
+type TargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetParameters `json:"forProvider"`
+}
+
+// TargetObservation defines the observed state of Target
+type TargetObservation struct {
+	// The ID of the target within the rule.
+	ID *string `json:"id,omitempty"`
+}
+
+// TargetStatus defines the observed state of Target.
+type TargetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Target is a target of an EventBridge Rule. The external name of the
+// resource is used as the ID of the target within the rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Target struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TargetSpec   `json:"spec"`
+	Status            TargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetList contains a list of Targets
+type TargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Target `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	TargetKind             = "Target"
+	TargetGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TargetKind}.String()
+	TargetKindAPIVersion   = TargetKind + "." + GroupVersion.String()
+	TargetGroupVersionKind = GroupVersion.WithKind(TargetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Target{}, &TargetList{})
+}
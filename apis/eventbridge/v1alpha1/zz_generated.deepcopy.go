@@ -0,0 +1,1645 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Archive) DeepCopyInto(out *Archive) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Archive.
+func (in *Archive) DeepCopy() *Archive {
+	if in == nil {
+		return nil
+	}
+	out := new(Archive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Archive) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveList) DeepCopyInto(out *ArchiveList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Archive, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveList.
+func (in *ArchiveList) DeepCopy() *ArchiveList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArchiveList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveObservation) DeepCopyInto(out *ArchiveObservation) {
+	*out = *in
+	if in.ArchiveARN != nil {
+		in, out := &in.ArchiveARN, &out.ArchiveARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveObservation.
+func (in *ArchiveObservation) DeepCopy() *ArchiveObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveParameters) DeepCopyInto(out *ArchiveParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventPattern != nil {
+		in, out := &in.EventPattern, &out.EventPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int64)
+		**out = **in
+	}
+	in.CustomArchiveParameters.DeepCopyInto(&out.CustomArchiveParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveParameters.
+func (in *ArchiveParameters) DeepCopy() *ArchiveParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveSpec) DeepCopyInto(out *ArchiveSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveSpec.
+func (in *ArchiveSpec) DeepCopy() *ArchiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveStatus) DeepCopyInto(out *ArchiveStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveStatus.
+func (in *ArchiveStatus) DeepCopy() *ArchiveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Archive_SDK) DeepCopyInto(out *Archive_SDK) {
+	*out = *in
+	if in.ArchiveName != nil {
+		in, out := &in.ArchiveName, &out.ArchiveName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EventCount != nil {
+		in, out := &in.EventCount, &out.EventCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EventSourceARN != nil {
+		in, out := &in.EventSourceARN, &out.EventSourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SizeBytes != nil {
+		in, out := &in.SizeBytes, &out.SizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Archive_SDK.
+func (in *Archive_SDK) DeepCopy() *Archive_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(Archive_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomArchiveParameters) DeepCopyInto(out *CustomArchiveParameters) {
+	*out = *in
+	if in.EventSourceARN != nil {
+		in, out := &in.EventSourceARN, &out.EventSourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventSourceARNRef != nil {
+		in, out := &in.EventSourceARNRef, &out.EventSourceARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventSourceARNSelector != nil {
+		in, out := &in.EventSourceARNSelector, &out.EventSourceARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomArchiveParameters.
+func (in *CustomArchiveParameters) DeepCopy() *CustomArchiveParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomArchiveParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEventBusParameters) DeepCopyInto(out *CustomEventBusParameters) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEventBusParameters.
+func (in *CustomEventBusParameters) DeepCopy() *CustomEventBusParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEventBusParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRuleParameters) DeepCopyInto(out *CustomRuleParameters) {
+	*out = *in
+	if in.EventBusName != nil {
+		in, out := &in.EventBusName, &out.EventBusName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventBusNameRef != nil {
+		in, out := &in.EventBusNameRef, &out.EventBusNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventBusNameSelector != nil {
+		in, out := &in.EventBusNameSelector, &out.EventBusNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRuleParameters.
+func (in *CustomRuleParameters) DeepCopy() *CustomRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterConfig) DeepCopyInto(out *DeadLetterConfig) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARNRef != nil {
+		in, out := &in.ARNRef, &out.ARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ARNSelector != nil {
+		in, out := &in.ARNSelector, &out.ARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterConfig.
+func (in *DeadLetterConfig) DeepCopy() *DeadLetterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBus) DeepCopyInto(out *EventBus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBus.
+func (in *EventBus) DeepCopy() *EventBus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventBus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusList) DeepCopyInto(out *EventBusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventBus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusList.
+func (in *EventBusList) DeepCopy() *EventBusList {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventBusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusObservation) DeepCopyInto(out *EventBusObservation) {
+	*out = *in
+	if in.EventBusARN != nil {
+		in, out := &in.EventBusARN, &out.EventBusARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusObservation.
+func (in *EventBusObservation) DeepCopy() *EventBusObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusParameters) DeepCopyInto(out *EventBusParameters) {
+	*out = *in
+	if in.EventSourceName != nil {
+		in, out := &in.EventSourceName, &out.EventSourceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.CustomEventBusParameters.DeepCopyInto(&out.CustomEventBusParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusParameters.
+func (in *EventBusParameters) DeepCopy() *EventBusParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusSpec) DeepCopyInto(out *EventBusSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusSpec.
+func (in *EventBusSpec) DeepCopy() *EventBusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusStatus) DeepCopyInto(out *EventBusStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusStatus.
+func (in *EventBusStatus) DeepCopy() *EventBusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBus_SDK) DeepCopyInto(out *EventBus_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBus_SDK.
+func (in *EventBus_SDK) DeepCopy() *EventBus_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBus_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexibleTimeWindow) DeepCopyInto(out *FlexibleTimeWindow) {
+	*out = *in
+	if in.MaximumWindowInMinutes != nil {
+		in, out := &in.MaximumWindowInMinutes, &out.MaximumWindowInMinutes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexibleTimeWindow.
+func (in *FlexibleTimeWindow) DeepCopy() *FlexibleTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexibleTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputTransformer) DeepCopyInto(out *InputTransformer) {
+	*out = *in
+	if in.InputPathsMap != nil {
+		in, out := &in.InputPathsMap, &out.InputPathsMap
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.InputTemplate != nil {
+		in, out := &in.InputTemplate, &out.InputTemplate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputTransformer.
+func (in *InputTransformer) DeepCopy() *InputTransformer {
+	if in == nil {
+		return nil
+	}
+	out := new(InputTransformer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.MaximumEventAgeInSeconds != nil {
+		in, out := &in.MaximumEventAgeInSeconds, &out.MaximumEventAgeInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumRetryAttempts != nil {
+		in, out := &in.MaximumRetryAttempts, &out.MaximumRetryAttempts
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Rule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleList) DeepCopyInto(out *RuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleList.
+func (in *RuleList) DeepCopy() *RuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleObservation) DeepCopyInto(out *RuleObservation) {
+	*out = *in
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedBy != nil {
+		in, out := &in.ManagedBy, &out.ManagedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuleARN != nil {
+		in, out := &in.RuleARN, &out.RuleARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleObservation.
+func (in *RuleObservation) DeepCopy() *RuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleParameters) DeepCopyInto(out *RuleParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventPattern != nil {
+		in, out := &in.EventPattern, &out.EventPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.CustomRuleParameters.DeepCopyInto(&out.CustomRuleParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleParameters.
+func (in *RuleParameters) DeepCopy() *RuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSpec) DeepCopyInto(out *RuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSpec.
+func (in *RuleSpec) DeepCopy() *RuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleStatus) DeepCopyInto(out *RuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatus.
+func (in *RuleStatus) DeepCopy() *RuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule_SDK) DeepCopyInto(out *Rule_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventBusName != nil {
+		in, out := &in.EventBusName, &out.EventBusName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventPattern != nil {
+		in, out := &in.EventPattern, &out.EventPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedBy != nil {
+		in, out := &in.ManagedBy, &out.ManagedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule_SDK.
+func (in *Rule_SDK) DeepCopy() *Rule_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQSParameters) DeepCopyInto(out *SQSParameters) {
+	*out = *in
+	if in.MessageGroupID != nil {
+		in, out := &in.MessageGroupID, &out.MessageGroupID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQSParameters.
+func (in *SQSParameters) DeepCopy() *SQSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SQSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Schedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleAWSVPCConfiguration) DeepCopyInto(out *ScheduleAWSVPCConfiguration) {
+	*out = *in
+	if in.AssignPublicIP != nil {
+		in, out := &in.AssignPublicIP, &out.AssignPublicIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroupSelector != nil {
+		in, out := &in.SecurityGroupSelector, &out.SecurityGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubnetSelector != nil {
+		in, out := &in.SubnetSelector, &out.SubnetSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleAWSVPCConfiguration.
+func (in *ScheduleAWSVPCConfiguration) DeepCopy() *ScheduleAWSVPCConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleAWSVPCConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleCapacityProviderStrategyItem) DeepCopyInto(out *ScheduleCapacityProviderStrategyItem) {
+	*out = *in
+	if in.Base != nil {
+		in, out := &in.Base, &out.Base
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleCapacityProviderStrategyItem.
+func (in *ScheduleCapacityProviderStrategyItem) DeepCopy() *ScheduleCapacityProviderStrategyItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleCapacityProviderStrategyItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleECSParameters) DeepCopyInto(out *ScheduleECSParameters) {
+	*out = *in
+	if in.TaskDefinitionARN != nil {
+		in, out := &in.TaskDefinitionARN, &out.TaskDefinitionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.TaskDefinitionARNRef != nil {
+		in, out := &in.TaskDefinitionARNRef, &out.TaskDefinitionARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaskDefinitionARNSelector != nil {
+		in, out := &in.TaskDefinitionARNSelector, &out.TaskDefinitionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaskCount != nil {
+		in, out := &in.TaskCount, &out.TaskCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LaunchType != nil {
+		in, out := &in.LaunchType, &out.LaunchType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityProviderStrategy != nil {
+		in, out := &in.CapacityProviderStrategy, &out.CapacityProviderStrategy
+		*out = make([]ScheduleCapacityProviderStrategyItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkConfiguration != nil {
+		in, out := &in.NetworkConfiguration, &out.NetworkConfiguration
+		*out = new(ScheduleNetworkConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementConstraints != nil {
+		in, out := &in.PlacementConstraints, &out.PlacementConstraints
+		*out = make([]SchedulePlacementConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlacementStrategy != nil {
+		in, out := &in.PlacementStrategy, &out.PlacementStrategy
+		*out = make([]SchedulePlacementStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlatformVersion != nil {
+		in, out := &in.PlatformVersion, &out.PlatformVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableECSManagedTags != nil {
+		in, out := &in.EnableECSManagedTags, &out.EnableECSManagedTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableExecuteCommand != nil {
+		in, out := &in.EnableExecuteCommand, &out.EnableExecuteCommand
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PropagateTags != nil {
+		in, out := &in.PropagateTags, &out.PropagateTags
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferenceID != nil {
+		in, out := &in.ReferenceID, &out.ReferenceID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleECSParameters.
+func (in *ScheduleECSParameters) DeepCopy() *ScheduleECSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleECSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleList) DeepCopyInto(out *ScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Schedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleList.
+func (in *ScheduleList) DeepCopy() *ScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleNetworkConfiguration) DeepCopyInto(out *ScheduleNetworkConfiguration) {
+	*out = *in
+	if in.AWSVPCConfiguration != nil {
+		in, out := &in.AWSVPCConfiguration, &out.AWSVPCConfiguration
+		*out = new(ScheduleAWSVPCConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleNetworkConfiguration.
+func (in *ScheduleNetworkConfiguration) DeepCopy() *ScheduleNetworkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleNetworkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleObservation) DeepCopyInto(out *ScheduleObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastModificationDate != nil {
+		in, out := &in.LastModificationDate, &out.LastModificationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleObservation.
+func (in *ScheduleObservation) DeepCopy() *ScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleParameters) DeepCopyInto(out *ScheduleParameters) {
+	*out = *in
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduleExpressionTimezone != nil {
+		in, out := &in.ScheduleExpressionTimezone, &out.ScheduleExpressionTimezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = (*in).DeepCopy()
+	}
+	if in.EndDate != nil {
+		in, out := &in.EndDate, &out.EndDate
+		*out = (*in).DeepCopy()
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionAfterCompletion != nil {
+		in, out := &in.ActionAfterCompletion, &out.ActionAfterCompletion
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyARN != nil {
+		in, out := &in.KMSKeyARN, &out.KMSKeyARN
+		*out = new(string)
+		**out = **in
+	}
+	in.FlexibleTimeWindow.DeepCopyInto(&out.FlexibleTimeWindow)
+	in.Target.DeepCopyInto(&out.Target)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleParameters.
+func (in *ScheduleParameters) DeepCopy() *ScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulePlacementConstraint) DeepCopyInto(out *SchedulePlacementConstraint) {
+	*out = *in
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulePlacementConstraint.
+func (in *SchedulePlacementConstraint) DeepCopy() *SchedulePlacementConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulePlacementConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulePlacementStrategy) DeepCopyInto(out *SchedulePlacementStrategy) {
+	*out = *in
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulePlacementStrategy.
+func (in *SchedulePlacementStrategy) DeepCopy() *SchedulePlacementStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulePlacementStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
+func (in *ScheduleSpec) DeepCopy() *ScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
+func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTarget) DeepCopyInto(out *ScheduleTarget) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	in.TargetARNReferences.DeepCopyInto(&out.TargetARNReferences)
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetterConfig != nil {
+		in, out := &in.DeadLetterConfig, &out.DeadLetterConfig
+		*out = new(DeadLetterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQSParameters != nil {
+		in, out := &in.SQSParameters, &out.SQSParameters
+		*out = new(SQSParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ECSParameters != nil {
+		in, out := &in.ECSParameters, &out.ECSParameters
+		*out = new(ScheduleECSParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTarget.
+func (in *ScheduleTarget) DeepCopy() *ScheduleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Target) DeepCopyInto(out *Target) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Target.
+func (in *Target) DeepCopy() *Target {
+	if in == nil {
+		return nil
+	}
+	out := new(Target)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Target) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetARNReferences) DeepCopyInto(out *TargetARNReferences) {
+	*out = *in
+	if in.FunctionRef != nil {
+		in, out := &in.FunctionRef, &out.FunctionRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionSelector != nil {
+		in, out := &in.FunctionSelector, &out.FunctionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueRef != nil {
+		in, out := &in.QueueRef, &out.QueueRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueSelector != nil {
+		in, out := &in.QueueSelector, &out.QueueSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicRef != nil {
+		in, out := &in.TopicRef, &out.TopicRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicSelector != nil {
+		in, out := &in.TopicSelector, &out.TopicSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateMachineRef != nil {
+		in, out := &in.StateMachineRef, &out.StateMachineRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateMachineSelector != nil {
+		in, out := &in.StateMachineSelector, &out.StateMachineSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetARNReferences.
+func (in *TargetARNReferences) DeepCopy() *TargetARNReferences {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetARNReferences)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetList) DeepCopyInto(out *TargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Target, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetList.
+func (in *TargetList) DeepCopy() *TargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetObservation) DeepCopyInto(out *TargetObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetObservation.
+func (in *TargetObservation) DeepCopy() *TargetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetParameters) DeepCopyInto(out *TargetParameters) {
+	*out = *in
+	if in.EventBusName != nil {
+		in, out := &in.EventBusName, &out.EventBusName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventBusNameRef != nil {
+		in, out := &in.EventBusNameRef, &out.EventBusNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventBusNameSelector != nil {
+		in, out := &in.EventBusNameSelector, &out.EventBusNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuleRef != nil {
+		in, out := &in.RuleRef, &out.RuleRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuleSelector != nil {
+		in, out := &in.RuleSelector, &out.RuleSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	in.TargetARNReferences.DeepCopyInto(&out.TargetARNReferences)
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputPath != nil {
+		in, out := &in.InputPath, &out.InputPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputTransformer != nil {
+		in, out := &in.InputTransformer, &out.InputTransformer
+		*out = new(InputTransformer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetterConfig != nil {
+		in, out := &in.DeadLetterConfig, &out.DeadLetterConfig
+		*out = new(DeadLetterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQSParameters != nil {
+		in, out := &in.SQSParameters, &out.SQSParameters
+		*out = new(SQSParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetParameters.
+func (in *TargetParameters) DeepCopy() *TargetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSpec.
+func (in *TargetSpec) DeepCopy() *TargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
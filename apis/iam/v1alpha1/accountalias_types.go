@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AccountAliasParameters defines the desired state of AccountAlias
+type AccountAliasParameters struct {
+	// The account alias to create. An account has at most one alias, an
+	// existing alias is replaced.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	AccountAlias string `json:"accountAlias"`
+}
+
+// AccountAliasSpec defines the desired state of AccountAlias
+type AccountAliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountAliasParameters `json:"forProvider"`
+}
+
+// AccountAliasStatus defines the observed state of AccountAlias.
+type AccountAliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAlias is the alias of the AWS account, e.g. used in the sign-in URL
+// of the account. The alias is used as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              AccountAliasSpec   `json:"spec"`
+	Status            AccountAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAliasList contains a list of AccountAliases
+type AccountAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountAlias `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	AccountAliasKind             = "AccountAlias"
+	AccountAliasGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountAliasKind}.String()
+	AccountAliasKindAPIVersion   = AccountAliasKind + "." + GroupVersion.String()
+	AccountAliasGroupVersionKind = GroupVersion.WithKind(AccountAliasKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountAlias{}, &AccountAliasList{})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LoginProfileParameters defines the desired state of LoginProfile
+type LoginProfileParameters struct {
+	// The name of the IAM user that the login profile is created for.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.User
+	// +optional
+	UserName *string `json:"userName,omitempty"`
+
+	// UserNameRef is a reference to an User used to set the UserName.
+	// +optional
+	UserNameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UserNameSelector selects a reference to an User used to set the
+	// UserName.
+	// +optional
+	UserNameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// Specifies whether the user is required to set a new password on next
+	// sign-in. It is only applied when the login profile is created, since
+	// IAM clears it once the user has set a new password.
+	// +immutable
+	// +optional
+	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
+
+	// PasswordSecretRef references the secret key that contains the password
+	// of the user. A password is generated if it is not set. The password is
+	// published as password in the connection secret. Changes of the
+	// referenced secret are applied to the login profile.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// LoginProfileSpec defines the desired state of LoginProfile
+type LoginProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoginProfileParameters `json:"forProvider"`
+}
+
+// LoginProfileObservation defines the observed state of LoginProfile
+type LoginProfileObservation struct {
+	// The date when the password for the user was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// LoginProfileStatus defines the observed state of LoginProfile.
+type LoginProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoginProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoginProfile is the password of an IAM user for the AWS Management
+// Console. The name of the user is used as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoginProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LoginProfileSpec   `json:"spec"`
+	Status            LoginProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoginProfileList contains a list of LoginProfiles
+type LoginProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoginProfile `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	LoginProfileKind             = "LoginProfile"
+	LoginProfileGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: LoginProfileKind}.String()
+	LoginProfileKindAPIVersion   = LoginProfileKind + "." + GroupVersion.String()
+	LoginProfileGroupVersionKind = GroupVersion.WithKind(LoginProfileKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LoginProfile{}, &LoginProfileList{})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
+
+// SAMLProviderParameters defines the desired state of SAMLProvider
+type SAMLProviderParameters struct {
+	// The name of the provider. Defaults to the name of the SAMLProvider
+	// object.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An XML document generated by an identity provider (IdP) that supports
+	// SAML 2.0. The document includes the issuer's name, expiration
+	// information, and keys that can be used to validate the SAML
+	// authentication response (assertions) that are received from the IdP.
+	// Either SAMLMetadataDocument or SAMLMetadataDocumentConfigMapRef is
+	// required.
+	// +optional
+	SAMLMetadataDocument *string `json:"samlMetadataDocument,omitempty"`
+
+	// SAMLMetadataDocumentConfigMapRef references the ConfigMap key that
+	// contains the metadata document of the identity provider. It takes
+	// precedence over SAMLMetadataDocument.
+	// +optional
+	SAMLMetadataDocumentConfigMapRef *common.ConfigMapKeySelector `json:"samlMetadataDocumentConfigMapRef,omitempty"`
+
+	// A list of tags that you want to attach to the SAML provider.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// SAMLProviderSpec defines the desired state of SAMLProvider
+type SAMLProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SAMLProviderParameters `json:"forProvider"`
+}
+
+// SAMLProviderObservation defines the observed state of SAMLProvider
+type SAMLProviderObservation struct {
+	// The Amazon Resource Name (ARN) of the SAML provider.
+	ARN *string `json:"arn,omitempty"`
+
+	// The date and time when the SAML provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// The expiration date and time for the SAML provider.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+}
+
+// SAMLProviderStatus defines the observed state of SAMLProvider.
+type SAMLProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SAMLProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProvider is an IAM identity provider that supports SAML 2.0. The ARN of
+// the provider is used as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SAMLProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SAMLProviderSpec   `json:"spec"`
+	Status            SAMLProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProviderList contains a list of SAMLProviders
+type SAMLProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLProvider `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SAMLProviderKind             = "SAMLProvider"
+	SAMLProviderGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SAMLProviderKind}.String()
+	SAMLProviderKindAPIVersion   = SAMLProviderKind + "." + GroupVersion.String()
+	SAMLProviderGroupVersionKind = GroupVersion.WithKind(SAMLProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SAMLProvider{}, &SAMLProviderList{})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceSpecificCredentialParameters defines the desired state of
+// ServiceSpecificCredential
+type ServiceSpecificCredentialParameters struct {
+	// The name of the IAM user that is to be associated with the credentials.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.User
+	// +optional
+	UserName *string `json:"userName,omitempty"`
+
+	// UserNameRef is a reference to an User used to set the UserName.
+	// +optional
+	UserNameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UserNameSelector selects a reference to an User used to set the
+	// UserName.
+	// +optional
+	UserNameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// The name of the Amazon Web Services service that is to be associated
+	// with the credentials, e.g. codecommit.amazonaws.com.
+	// +immutable
+	// +kubebuilder:validation:Required
+	ServiceName string `json:"serviceName"`
+
+	// The status of the credentials. Inactive credentials can not be used to
+	// authenticate to the service.
+	// +kubebuilder:validation:Enum=Active;Inactive
+	// +kubebuilder:default=Active
+	// +optional
+	Status *string `json:"status,omitempty"`
+}
+
+// ServiceSpecificCredentialSpec defines the desired state of
+// ServiceSpecificCredential
+type ServiceSpecificCredentialSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceSpecificCredentialParameters `json:"forProvider"`
+}
+
+// ServiceSpecificCredentialObservation defines the observed state of
+// ServiceSpecificCredential
+type ServiceSpecificCredentialObservation struct {
+	// The unique identifier for the service-specific credential.
+	ServiceSpecificCredentialID *string `json:"serviceSpecificCredentialID,omitempty"`
+
+	// The generated user name for the service-specific credential.
+	ServiceUserName *string `json:"serviceUserName,omitempty"`
+
+	// The date and time when the service-specific credential were created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// ServiceSpecificCredentialStatus defines the observed state of
+// ServiceSpecificCredential.
+type ServiceSpecificCredentialStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceSpecificCredentialObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceSpecificCredential is a set of credentials of an IAM user for a
+// single service like CodeCommit. The ID of the credential is used as
+// external name. The generated user name and password are published as
+// username and password in the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ServiceSpecificCredential struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServiceSpecificCredentialSpec   `json:"spec"`
+	Status            ServiceSpecificCredentialStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceSpecificCredentialList contains a list of ServiceSpecificCredentials
+type ServiceSpecificCredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceSpecificCredential `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceSpecificCredentialKind             = "ServiceSpecificCredential"
+	ServiceSpecificCredentialGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceSpecificCredentialKind}.String()
+	ServiceSpecificCredentialKindAPIVersion   = ServiceSpecificCredentialKind + "." + GroupVersion.String()
+	ServiceSpecificCredentialGroupVersionKind = GroupVersion.WithKind(ServiceSpecificCredentialKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceSpecificCredential{}, &ServiceSpecificCredentialList{})
+}
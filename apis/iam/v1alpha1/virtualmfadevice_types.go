@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VirtualMFADeviceParameters defines the desired state of VirtualMFADevice
+type VirtualMFADeviceParameters struct {
+	// The name of the virtual MFA device. Defaults to the name of the
+	// VirtualMFADevice object.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The path for the virtual MFA device.
+	// +immutable
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// A list of tags that you want to attach to the virtual MFA device.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// VirtualMFADeviceSpec defines the desired state of VirtualMFADevice
+type VirtualMFADeviceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VirtualMFADeviceParameters `json:"forProvider"`
+}
+
+// VirtualMFADeviceObservation defines the observed state of VirtualMFADevice
+type VirtualMFADeviceObservation struct {
+	// The serial number associated with the virtual MFA device.
+	SerialNumber *string `json:"serialNumber,omitempty"`
+
+	// The name of the IAM user the device is assigned to.
+	UserName *string `json:"userName,omitempty"`
+
+	// The date and time on which the virtual MFA device was enabled.
+	EnableDate *metav1.Time `json:"enableDate,omitempty"`
+}
+
+// VirtualMFADeviceStatus defines the observed state of VirtualMFADevice.
+type VirtualMFADeviceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VirtualMFADeviceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMFADevice is a virtual MFA device. The serial number of the device
+// is used as external name. Its seed is published as base32StringSeed and as
+// QR code PNG in qrCodePNG in the connection secret. Assigning the device to
+// an user requires codes generated from the seed and is not managed.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".status.atProvider.userName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VirtualMFADevice struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualMFADeviceSpec   `json:"spec"`
+	Status            VirtualMFADeviceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMFADeviceList contains a list of VirtualMFADevices
+type VirtualMFADeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMFADevice `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	VirtualMFADeviceKind             = "VirtualMFADevice"
+	VirtualMFADeviceGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: VirtualMFADeviceKind}.String()
+	VirtualMFADeviceKindAPIVersion   = VirtualMFADeviceKind + "." + GroupVersion.String()
+	VirtualMFADeviceGroupVersionKind = GroupVersion.WithKind(VirtualMFADeviceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualMFADevice{}, &VirtualMFADeviceList{})
+}
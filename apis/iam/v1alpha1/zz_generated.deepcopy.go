@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -116,6 +117,113 @@ func (in *AccessKeyMetadata) DeepCopy() *AccessKeyMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAlias) DeepCopyInto(out *AccountAlias) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAlias.
+func (in *AccountAlias) DeepCopy() *AccountAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountAlias) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasList) DeepCopyInto(out *AccountAliasList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasList.
+func (in *AccountAliasList) DeepCopy() *AccountAliasList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountAliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasParameters) DeepCopyInto(out *AccountAliasParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasParameters.
+func (in *AccountAliasParameters) DeepCopy() *AccountAliasParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasSpec) DeepCopyInto(out *AccountAliasSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasSpec.
+func (in *AccountAliasSpec) DeepCopy() *AccountAliasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasStatus) DeepCopyInto(out *AccountAliasStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasStatus.
+func (in *AccountAliasStatus) DeepCopy() *AccountAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachedPermissionsBoundary) DeepCopyInto(out *AttachedPermissionsBoundary) {
 	*out = *in
@@ -591,24 +699,152 @@ func (in *InstanceProfile_SDK) DeepCopy() *InstanceProfile_SDK {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoginProfile) DeepCopyInto(out *LoginProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfile.
+func (in *LoginProfile) DeepCopy() *LoginProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoginProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileList) DeepCopyInto(out *LoginProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoginProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileList.
+func (in *LoginProfileList) DeepCopy() *LoginProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoginProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileObservation) DeepCopyInto(out *LoginProfileObservation) {
 	*out = *in
 	if in.CreateDate != nil {
 		in, out := &in.CreateDate, &out.CreateDate
 		*out = (*in).DeepCopy()
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileObservation.
+func (in *LoginProfileObservation) DeepCopy() *LoginProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileParameters) DeepCopyInto(out *LoginProfileParameters) {
+	*out = *in
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PasswordResetRequired != nil {
 		in, out := &in.PasswordResetRequired, &out.PasswordResetRequired
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfile.
-func (in *LoginProfile) DeepCopy() *LoginProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileParameters.
+func (in *LoginProfileParameters) DeepCopy() *LoginProfileParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(LoginProfile)
+	out := new(LoginProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileSpec) DeepCopyInto(out *LoginProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileSpec.
+func (in *LoginProfileSpec) DeepCopy() *LoginProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileStatus) DeepCopyInto(out *LoginProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileStatus.
+func (in *LoginProfileStatus) DeepCopy() *LoginProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1138,6 +1374,65 @@ func (in *RoleLastUsed) DeepCopy() *RoleLastUsed {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProvider) DeepCopyInto(out *SAMLProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProvider.
+func (in *SAMLProvider) DeepCopy() *SAMLProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SAMLProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderList) DeepCopyInto(out *SAMLProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SAMLProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderList.
+func (in *SAMLProviderList) DeepCopy() *SAMLProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SAMLProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SAMLProviderListEntry) DeepCopyInto(out *SAMLProviderListEntry) {
 	*out = *in
@@ -1167,30 +1462,129 @@ func (in *SAMLProviderListEntry) DeepCopy() *SAMLProviderListEntry {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHPublicKey) DeepCopyInto(out *SSHPublicKey) {
+func (in *SAMLProviderObservation) DeepCopyInto(out *SAMLProviderObservation) {
 	*out = *in
-	if in.UploadDate != nil {
-		in, out := &in.UploadDate, &out.UploadDate
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHPublicKey.
-func (in *SSHPublicKey) DeepCopy() *SSHPublicKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderObservation.
+func (in *SAMLProviderObservation) DeepCopy() *SAMLProviderObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHPublicKey)
+	out := new(SAMLProviderObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHPublicKeyMetadata) DeepCopyInto(out *SSHPublicKeyMetadata) {
+func (in *SAMLProviderParameters) DeepCopyInto(out *SAMLProviderParameters) {
 	*out = *in
-	if in.UploadDate != nil {
-		in, out := &in.UploadDate, &out.UploadDate
-		*out = (*in).DeepCopy()
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SAMLMetadataDocument != nil {
+		in, out := &in.SAMLMetadataDocument, &out.SAMLMetadataDocument
+		*out = new(string)
+		**out = **in
+	}
+	if in.SAMLMetadataDocumentConfigMapRef != nil {
+		in, out := &in.SAMLMetadataDocumentConfigMapRef, &out.SAMLMetadataDocumentConfigMapRef
+		*out = new(common.ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderParameters.
+func (in *SAMLProviderParameters) DeepCopy() *SAMLProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderSpec) DeepCopyInto(out *SAMLProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderSpec.
+func (in *SAMLProviderSpec) DeepCopy() *SAMLProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderStatus) DeepCopyInto(out *SAMLProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderStatus.
+func (in *SAMLProviderStatus) DeepCopy() *SAMLProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHPublicKey) DeepCopyInto(out *SSHPublicKey) {
+	*out = *in
+	if in.UploadDate != nil {
+		in, out := &in.UploadDate, &out.UploadDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHPublicKey.
+func (in *SSHPublicKey) DeepCopy() *SSHPublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHPublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHPublicKeyMetadata) DeepCopyInto(out *SSHPublicKeyMetadata) {
+	*out = *in
+	if in.UploadDate != nil {
+		in, out := &in.UploadDate, &out.UploadDate
+		*out = (*in).DeepCopy()
 	}
 }
 
@@ -1494,10 +1888,10 @@ func (in *ServiceLinkedRoleStatus) DeepCopy() *ServiceLinkedRoleStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSpecificCredential) DeepCopyInto(out *ServiceSpecificCredential) {
 	*out = *in
-	if in.CreateDate != nil {
-		in, out := &in.CreateDate, &out.CreateDate
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredential.
@@ -1510,6 +1904,46 @@ func (in *ServiceSpecificCredential) DeepCopy() *ServiceSpecificCredential {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceSpecificCredential) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSpecificCredentialList) DeepCopyInto(out *ServiceSpecificCredentialList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceSpecificCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredentialList.
+func (in *ServiceSpecificCredentialList) DeepCopy() *ServiceSpecificCredentialList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSpecificCredentialList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceSpecificCredentialList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSpecificCredentialMetadata) DeepCopyInto(out *ServiceSpecificCredentialMetadata) {
 	*out = *in
@@ -1529,6 +1963,104 @@ func (in *ServiceSpecificCredentialMetadata) DeepCopy() *ServiceSpecificCredenti
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSpecificCredentialObservation) DeepCopyInto(out *ServiceSpecificCredentialObservation) {
+	*out = *in
+	if in.ServiceSpecificCredentialID != nil {
+		in, out := &in.ServiceSpecificCredentialID, &out.ServiceSpecificCredentialID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceUserName != nil {
+		in, out := &in.ServiceUserName, &out.ServiceUserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredentialObservation.
+func (in *ServiceSpecificCredentialObservation) DeepCopy() *ServiceSpecificCredentialObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSpecificCredentialObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSpecificCredentialParameters) DeepCopyInto(out *ServiceSpecificCredentialParameters) {
+	*out = *in
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredentialParameters.
+func (in *ServiceSpecificCredentialParameters) DeepCopy() *ServiceSpecificCredentialParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSpecificCredentialParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSpecificCredentialSpec) DeepCopyInto(out *ServiceSpecificCredentialSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredentialSpec.
+func (in *ServiceSpecificCredentialSpec) DeepCopy() *ServiceSpecificCredentialSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSpecificCredentialSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSpecificCredentialStatus) DeepCopyInto(out *ServiceSpecificCredentialStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredentialStatus.
+func (in *ServiceSpecificCredentialStatus) DeepCopy() *ServiceSpecificCredentialStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSpecificCredentialStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SigningCertificate) DeepCopyInto(out *SigningCertificate) {
 	*out = *in
@@ -1714,29 +2246,153 @@ func (in *UserDetail) DeepCopy() *UserDetail {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMFADevice) DeepCopyInto(out *VirtualMFADevice) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADevice.
+func (in *VirtualMFADevice) DeepCopy() *VirtualMFADevice {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMFADevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMFADevice) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMFADeviceList) DeepCopyInto(out *VirtualMFADeviceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMFADevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADeviceList.
+func (in *VirtualMFADeviceList) DeepCopy() *VirtualMFADeviceList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMFADeviceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMFADeviceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMFADeviceObservation) DeepCopyInto(out *VirtualMFADeviceObservation) {
+	*out = *in
+	if in.SerialNumber != nil {
+		in, out := &in.SerialNumber, &out.SerialNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
 	if in.EnableDate != nil {
 		in, out := &in.EnableDate, &out.EnableDate
 		*out = (*in).DeepCopy()
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADeviceObservation.
+func (in *VirtualMFADeviceObservation) DeepCopy() *VirtualMFADeviceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMFADeviceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMFADeviceParameters) DeepCopyInto(out *VirtualMFADeviceParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
+		*out = make([]Tag, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADevice.
-func (in *VirtualMFADevice) DeepCopy() *VirtualMFADevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADeviceParameters.
+func (in *VirtualMFADeviceParameters) DeepCopy() *VirtualMFADeviceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMFADevice)
+	out := new(VirtualMFADeviceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMFADeviceSpec) DeepCopyInto(out *VirtualMFADeviceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADeviceSpec.
+func (in *VirtualMFADeviceSpec) DeepCopy() *VirtualMFADeviceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMFADeviceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMFADeviceStatus) DeepCopyInto(out *VirtualMFADeviceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADeviceStatus.
+func (in *VirtualMFADeviceStatus) DeepCopy() *VirtualMFADeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMFADeviceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
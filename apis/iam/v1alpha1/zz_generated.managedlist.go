@@ -20,6 +20,15 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this AccountAliasList.
+func (l *AccountAliasList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this InstanceProfileList.
 func (l *InstanceProfileList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -29,6 +38,24 @@ func (l *InstanceProfileList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this LoginProfileList.
+func (l *LoginProfileList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this SAMLProviderList.
+func (l *SAMLProviderList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ServiceLinkedRoleList.
 func (l *ServiceLinkedRoleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -37,3 +64,21 @@ func (l *ServiceLinkedRoleList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this ServiceSpecificCredentialList.
+func (l *ServiceSpecificCredentialList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this VirtualMFADeviceList.
+func (l *VirtualMFADeviceList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
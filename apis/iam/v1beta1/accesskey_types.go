@@ -41,6 +41,26 @@ type AccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// Rotation configures the periodic replacement of the access key. If it
+	// is not set, the access key is never rotated.
+	// +optional
+	Rotation *AccessKeyRotation `json:"rotation,omitempty"`
+}
+
+// AccessKeyRotation configures the rotation of an AWS IAM Access Key.
+type AccessKeyRotation struct {
+	// RotateAfter is the age after which the access key is replaced by a new
+	// one, e.g. 2160h for 90 days. The new access key is published to the
+	// connection secret as soon as it is created.
+	RotateAfter metav1.Duration `json:"rotateAfter"`
+
+	// OverlapPeriod is the time the replaced access key stays active after a
+	// rotation so that its consumers can pick up the new one. The replaced
+	// access key is deactivated and deleted once the overlap period is over.
+	// Defaults to 24h.
+	// +optional
+	OverlapPeriod *metav1.Duration `json:"overlapPeriod,omitempty"`
 }
 
 // An AccessKeySpec defines the desired state of an IAM Access Key.
@@ -49,9 +69,30 @@ type AccessKeySpec struct {
 	ForProvider       AccessKeyParameters `json:"forProvider"`
 }
 
+// AccessKeyObservation keeps the state for the external resource
+type AccessKeyObservation struct {
+	// AccessKeyID is the ID of the current access key.
+	AccessKeyID string `json:"accessKeyID,omitempty"`
+
+	// CreateDate is the time the current access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// PreviousAccessKeyID is the ID of the access key that was replaced by the
+	// current one during the last rotation. It is set until the replaced
+	// access key is deleted at the end of the overlap period.
+	PreviousAccessKeyID string `json:"previousAccessKeyID,omitempty"`
+
+	// PreviousCreateDate is the time the replaced access key was created.
+	PreviousCreateDate *metav1.Time `json:"previousCreateDate,omitempty"`
+
+	// LastRotationTime is the time the access key was last rotated.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
 // AccessKeyStatus represents the observed state of an IAM Access Key.
 type AccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -43,6 +43,38 @@ type PolicyParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// PolicyValidation validates Document before it is applied. The
+	// document is not applied if the validation fails.
+	// +optional
+	PolicyValidation *PolicyValidation `json:"policyValidation,omitempty"`
+}
+
+// PolicyValidation configures the validation of policy documents with the IAM
+// Access Analyzer before they are applied. Documents with findings of type
+// ERROR are not applied, all other findings are only reported.
+type PolicyValidation struct {
+	// Region of the IAM Access Analyzer that validates the policy documents.
+	// +kubebuilder:default=us-east-1
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// ExpectedAllowedActions are actions, e.g. s3:GetObject, that the
+	// identity policy documents are expected to allow. The documents are not
+	// applied if the IAM policy simulator denies any of them.
+	// +optional
+	ExpectedAllowedActions []string `json:"expectedAllowedActions,omitempty"`
+
+	// ExpectedDeniedActions are actions that the identity policy documents
+	// are expected to deny. The documents are not applied if the IAM policy
+	// simulator allows any of them.
+	// +optional
+	ExpectedDeniedActions []string `json:"expectedDeniedActions,omitempty"`
+
+	// ResourceARNs are the resources the expected actions are simulated
+	// against. Defaults to all resources.
+	// +optional
+	ResourceARNs []string `json:"resourceArns,omitempty"`
 }
 
 // A PolicySpec defines the desired state of a Policy.
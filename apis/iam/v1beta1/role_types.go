@@ -44,6 +44,15 @@ type Tag struct {
 	Value string `json:"value,omitempty"`
 }
 
+// InlinePolicy is a policy document that is embedded in an IAM role or user.
+type InlinePolicy struct {
+	// PolicyName is the name of the inline policy.
+	PolicyName string `json:"policyName"`
+
+	// PolicyDocument is the JSON policy document of the inline policy.
+	PolicyDocument string `json:"policyDocument"`
+}
+
 // Contains information about the last time that an IAM role was used. This
 // includes the date and time and the Region in which the role was last used.
 // Activity is only reported for the trailing 400 days. This period can be shorter
@@ -102,6 +111,45 @@ type RoleParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// ManagedPolicyARNs are the ARNs of the managed policies that are
+	// attached to the role. Managed policies that are attached to the role
+	// but not listed here are only detached if Exclusive is set.
+	// +optional
+	// +crossplane:generate:reference:type=Policy
+	// +crossplane:generate:reference:extractor=PolicyARN()
+	// +crossplane:generate:reference:refFieldName=ManagedPolicyARNRefs
+	// +crossplane:generate:reference:selectorFieldName=ManagedPolicyARNSelector
+	ManagedPolicyARNs []string `json:"managedPolicyArns,omitempty"`
+
+	// ManagedPolicyARNRefs is a list of references to Policies used to set
+	// the ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNRefs []xpv1.Reference `json:"managedPolicyArnRefs,omitempty"`
+
+	// ManagedPolicyARNSelector selects references to Policies used to set
+	// the ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNSelector *xpv1.Selector `json:"managedPolicyArnSelector,omitempty"`
+
+	// InlinePolicies are the inline policies that are embedded in the role.
+	// Inline policies of the role that are not listed here are only deleted
+	// if Exclusive is set.
+	// +optional
+	InlinePolicies []InlinePolicy `json:"inlinePolicies,omitempty"`
+
+	// Exclusive makes ManagedPolicyARNs and InlinePolicies the only policies
+	// of the role. Managed policies that are not listed are detached and
+	// inline policies that are not listed are deleted, including those
+	// managed by RolePolicyAttachment and RolePolicy resources.
+	// +optional
+	Exclusive *bool `json:"exclusive,omitempty"`
+
+	// PolicyValidation validates AssumeRolePolicyDocument and InlinePolicies
+	// before they are applied. Policies are not applied if the validation
+	// fails.
+	// +optional
+	PolicyValidation *PolicyValidation `json:"policyValidation,omitempty"`
 }
 
 // A RoleSpec defines the desired state of a Role.
@@ -35,6 +35,39 @@ type UserParameters struct {
 	// A list of tags that you want to attach to the newly created user.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// ManagedPolicyARNs are the ARNs of the managed policies that are
+	// attached to the user. Managed policies that are attached to the user
+	// but not listed here are only detached if Exclusive is set.
+	// +optional
+	// +crossplane:generate:reference:type=Policy
+	// +crossplane:generate:reference:extractor=PolicyARN()
+	// +crossplane:generate:reference:refFieldName=ManagedPolicyARNRefs
+	// +crossplane:generate:reference:selectorFieldName=ManagedPolicyARNSelector
+	ManagedPolicyARNs []string `json:"managedPolicyArns,omitempty"`
+
+	// ManagedPolicyARNRefs is a list of references to Policies used to set
+	// the ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNRefs []xpv1.Reference `json:"managedPolicyArnRefs,omitempty"`
+
+	// ManagedPolicyARNSelector selects references to Policies used to set
+	// the ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNSelector *xpv1.Selector `json:"managedPolicyArnSelector,omitempty"`
+
+	// InlinePolicies are the inline policies that are embedded in the user.
+	// Inline policies of the user that are not listed here are only deleted
+	// if Exclusive is set.
+	// +optional
+	InlinePolicies []InlinePolicy `json:"inlinePolicies,omitempty"`
+
+	// Exclusive makes ManagedPolicyARNs and InlinePolicies the only policies
+	// of the user. Managed policies that are not listed are detached and
+	// inline policies that are not listed are deleted, including those
+	// managed by UserPolicyAttachment resources.
+	// +optional
+	Exclusive *bool `json:"exclusive,omitempty"`
 }
 
 // UserSpec defines the desired state of an IAM User.
@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -84,6 +85,33 @@ func (in *AccessKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyObservation) DeepCopyInto(out *AccessKeyObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousCreateDate != nil {
+		in, out := &in.PreviousCreateDate, &out.PreviousCreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyObservation.
+func (in *AccessKeyObservation) DeepCopy() *AccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 	*out = *in
@@ -97,6 +125,11 @@ func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AccessKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyParameters.
@@ -109,6 +142,27 @@ func (in *AccessKeyParameters) DeepCopy() *AccessKeyParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyRotation) DeepCopyInto(out *AccessKeyRotation) {
+	*out = *in
+	out.RotateAfter = in.RotateAfter
+	if in.OverlapPeriod != nil {
+		in, out := &in.OverlapPeriod, &out.OverlapPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyRotation.
+func (in *AccessKeyRotation) DeepCopy() *AccessKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeySpec) DeepCopyInto(out *AccessKeySpec) {
 	*out = *in
@@ -130,6 +184,7 @@ func (in *AccessKeySpec) DeepCopy() *AccessKeySpec {
 func (in *AccessKeyStatus) DeepCopyInto(out *AccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyStatus.
@@ -556,6 +611,21 @@ func (in *GroupUserMembershipStatus) DeepCopy() *GroupUserMembershipStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InlinePolicy) DeepCopyInto(out *InlinePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InlinePolicy.
+func (in *InlinePolicy) DeepCopy() *InlinePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InlinePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenIDConnectProvider) DeepCopyInto(out *OpenIDConnectProvider) {
 	*out = *in
@@ -790,6 +860,11 @@ func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyValidation != nil {
+		in, out := &in.PolicyValidation, &out.PolicyValidation
+		*out = new(PolicyValidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
@@ -836,6 +911,41 @@ func (in *PolicyStatus) DeepCopy() *PolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyValidation) DeepCopyInto(out *PolicyValidation) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpectedAllowedActions != nil {
+		in, out := &in.ExpectedAllowedActions, &out.ExpectedAllowedActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpectedDeniedActions != nil {
+		in, out := &in.ExpectedDeniedActions, &out.ExpectedDeniedActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceARNs != nil {
+		in, out := &in.ResourceARNs, &out.ResourceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyValidation.
+func (in *PolicyValidation) DeepCopy() *PolicyValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Role) DeepCopyInto(out *Role) {
 	*out = *in
@@ -971,6 +1081,38 @@ func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.ManagedPolicyARNs != nil {
+		in, out := &in.ManagedPolicyARNs, &out.ManagedPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPolicyARNRefs != nil {
+		in, out := &in.ManagedPolicyARNRefs, &out.ManagedPolicyARNRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedPolicyARNSelector != nil {
+		in, out := &in.ManagedPolicyARNSelector, &out.ManagedPolicyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make([]InlinePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusive != nil {
+		in, out := &in.Exclusive, &out.Exclusive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PolicyValidation != nil {
+		in, out := &in.PolicyValidation, &out.PolicyValidation
+		*out = new(PolicyValidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
@@ -1401,6 +1543,33 @@ func (in *UserParameters) DeepCopyInto(out *UserParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.ManagedPolicyARNs != nil {
+		in, out := &in.ManagedPolicyARNs, &out.ManagedPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPolicyARNRefs != nil {
+		in, out := &in.ManagedPolicyARNRefs, &out.ManagedPolicyARNRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedPolicyARNSelector != nil {
+		in, out := &in.ManagedPolicyARNSelector, &out.ManagedPolicyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make([]InlinePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusive != nil {
+		in, out := &in.Exclusive, &out.Exclusive
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
@@ -135,6 +135,32 @@ func (mg *GroupUserMembership) ResolveReferences(ctx context.Context, c client.R
 	return nil
 }
 
+// ResolveReferences of this Role.
+func (mg *Role) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ManagedPolicyARNs,
+		Extract:       PolicyARN(),
+		References:    mg.Spec.ForProvider.ManagedPolicyARNRefs,
+		Selector:      mg.Spec.ForProvider.ManagedPolicyARNSelector,
+		To: reference.To{
+			List:    &PolicyList{},
+			Managed: &Policy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ManagedPolicyARNs")
+	}
+	mg.Spec.ForProvider.ManagedPolicyARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ManagedPolicyARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
 // ResolveReferences of this RolePolicy.
 func (mg *RolePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -203,6 +229,32 @@ func (mg *RolePolicyAttachment) ResolveReferences(ctx context.Context, c client.
 	return nil
 }
 
+// ResolveReferences of this User.
+func (mg *User) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ManagedPolicyARNs,
+		Extract:       PolicyARN(),
+		References:    mg.Spec.ForProvider.ManagedPolicyARNRefs,
+		Selector:      mg.Spec.ForProvider.ManagedPolicyARNSelector,
+		To: reference.To{
+			List:    &PolicyList{},
+			Managed: &Policy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ManagedPolicyARNs")
+	}
+	mg.Spec.ForProvider.ManagedPolicyARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ManagedPolicyARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
 // ResolveReferences of this UserPolicyAttachment.
 func (mg *UserPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CustomKeyStoreParameters defines the desired state of CustomKeyStore
+type CustomKeyStoreParameters struct {
+	// Region is which region the CustomKeyStore will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Specifies the type of custom key store. AWS_CLOUDHSM creates a key store
+	// backed by an CloudHSM cluster, EXTERNAL_KEY_STORE one backed by an external
+	// key manager that is reached through an external key store proxy.
+	// +immutable
+	// +kubebuilder:validation:Enum=AWS_CLOUDHSM;EXTERNAL_KEY_STORE
+	// +kubebuilder:default=AWS_CLOUDHSM
+	// +optional
+	CustomKeyStoreType *string `json:"customKeyStoreType,omitempty"`
+
+	// Identifies the CloudHSM cluster backing an AWS_CLOUDHSM key store. The
+	// cluster must be active, have at least two active HSMs in different
+	// availability zones and must not be associated with another key store.
+	// +optional
+	CloudHSMClusterID *string `json:"cloudHSMClusterID,omitempty"`
+
+	// The content of the trust anchor certificate of the CloudHSM cluster,
+	// that is the customerCA.crt file created when the cluster was
+	// initialized. Required for AWS_CLOUDHSM key stores.
+	// +immutable
+	// +optional
+	TrustAnchorCertificate *string `json:"trustAnchorCertificate,omitempty"`
+
+	// KeyStorePasswordSecretRef references the password of the kmsuser crypto
+	// user of the CloudHSM cluster. Required for AWS_CLOUDHSM key stores.
+	// The password can only be written, changes of the secret are not
+	// detected.
+	// +optional
+	KeyStorePasswordSecretRef *xpv1.SecretKeySelector `json:"keyStorePasswordSecretRef,omitempty"`
+
+	// Indicates how the external key store proxy communicates with KMS.
+	// Required for EXTERNAL_KEY_STORE key stores.
+	// +kubebuilder:validation:Enum=PUBLIC_ENDPOINT;VPC_ENDPOINT_SERVICE
+	// +optional
+	XksProxyConnectivity *string `json:"xksProxyConnectivity,omitempty"`
+
+	// The protocol (always https) and DNS hostname of the external key store
+	// proxy, e.g. https://myproxy.example.com. Required for EXTERNAL_KEY_STORE
+	// key stores.
+	// +optional
+	XksProxyURIEndpoint *string `json:"xksProxyURIEndpoint,omitempty"`
+
+	// The base path of the external key store proxy API, e.g.
+	// /example/prefix/kms/xks/v1. Required for EXTERNAL_KEY_STORE key stores.
+	// +optional
+	XksProxyURIPath *string `json:"xksProxyURIPath,omitempty"`
+
+	// The name of the Amazon VPC endpoint service of the external key store
+	// proxy. Required when XksProxyConnectivity is VPC_ENDPOINT_SERVICE.
+	// +optional
+	XksProxyVPCEndpointServiceName *string `json:"xksProxyVPCEndpointServiceName,omitempty"`
+
+	// XksProxyAuthenticationCredential references the credential KMS uses to
+	// authenticate to the external key store proxy. Required for
+	// EXTERNAL_KEY_STORE key stores.
+	// +optional
+	XksProxyAuthenticationCredential *XksProxyAuthenticationCredential `json:"xksProxyAuthenticationCredential,omitempty"`
+
+	// Connected specifies whether the key store should be connected to its
+	// backing key store. Most settings of a key store can only be changed
+	// while it is disconnected. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	Connected *bool `json:"connected,omitempty"`
+}
+
+// XksProxyAuthenticationCredential references the secrets containing the
+// credential KMS uses to authenticate to an external key store proxy.
+type XksProxyAuthenticationCredential struct {
+	// AccessKeyIDSecretRef references the access key ID of the credential.
+	AccessKeyIDSecretRef xpv1.SecretKeySelector `json:"accessKeyIDSecretRef"`
+
+	// RawSecretAccessKeySecretRef references the raw secret access key of the
+	// credential.
+	RawSecretAccessKeySecretRef xpv1.SecretKeySelector `json:"rawSecretAccessKeySecretRef"`
+}
+
+// CustomKeyStoreSpec defines the desired state of CustomKeyStore
+type CustomKeyStoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomKeyStoreParameters `json:"forProvider"`
+}
+
+// CustomKeyStoreObservation defines the observed state of CustomKeyStore
+type CustomKeyStoreObservation struct {
+	// The ID of the custom key store.
+	CustomKeyStoreID *string `json:"customKeyStoreID,omitempty"`
+
+	// Indicates whether the custom key store is connected to its backing key
+	// store.
+	ConnectionState *string `json:"connectionState,omitempty"`
+
+	// Describes the connection error if ConnectionState is FAILED.
+	ConnectionErrorCode *string `json:"connectionErrorCode,omitempty"`
+
+	// The date and time when the custom key store was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// CustomKeyStoreStatus defines the observed state of CustomKeyStore.
+type CustomKeyStoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomKeyStoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomKeyStore is a KMS key store backed by an CloudHSM cluster or an
+// external key manager. The key store is named after the CustomKeyStore
+// object, its ID is used as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.connectionState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CustomKeyStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CustomKeyStoreSpec   `json:"spec"`
+	Status            CustomKeyStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomKeyStoreList contains a list of CustomKeyStores
+type CustomKeyStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomKeyStore `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	CustomKeyStoreKind             = "CustomKeyStore"
+	CustomKeyStoreGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: CustomKeyStoreKind}.String()
+	CustomKeyStoreKindAPIVersion   = CustomKeyStoreKind + "." + GroupVersion.String()
+	CustomKeyStoreGroupVersionKind = GroupVersion.WithKind(CustomKeyStoreKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CustomKeyStore{}, &CustomKeyStoreList{})
+}
@@ -14,6 +14,32 @@ type CustomKeyParameters struct {
 
 	// Specifies if key rotation is enabled for the corresponding key
 	EnableKeyRotation *bool `json:"enableKeyRotation,omitempty"`
+
+	// Creates the KMS key in the specified custom key store (https://docs.aws.amazon.com/kms/latest/developerguide/custom-key-store-overview.html).
+	// The ConnectionState of the custom key store must be CONNECTED.
+	//
+	// This parameter is valid only for symmetric encryption KMS keys in a single
+	// Region. You cannot create any other type of KMS key in a custom key store.
+	//
+	// When you create a KMS key in an CloudHSM key store, KMS generates a non-exportable
+	// 256-bit symmetric key in its associated CloudHSM cluster and associates it
+	// with the KMS key. When you create a KMS key in an external key store, you
+	// must use the XksKeyId parameter to specify an external key that serves as
+	// key material for the KMS key.
+	// +immutable
+	// +crossplane:generate:reference:type=CustomKeyStore
+	// +optional
+	CustomKeyStoreID *string `json:"customKeyStoreID,omitempty"`
+
+	// CustomKeyStoreIDRef is a reference to a CustomKeyStore used to set
+	// CustomKeyStoreID.
+	// +optional
+	CustomKeyStoreIDRef *xpv1.Reference `json:"customKeyStoreIDRef,omitempty"`
+
+	// CustomKeyStoreIDSelector selects a reference to a CustomKeyStore used to
+	// set CustomKeyStoreID.
+	// +optional
+	CustomKeyStoreIDSelector *xpv1.Selector `json:"customKeyStoreIDSelector,omitempty"`
 }
 
 // CustomGrantParameters are custom parameters for Grant.
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ReplicaKeyParameters defines the desired state of ReplicaKey
+type ReplicaKeyParameters struct {
+	// Region is the region the replica key is created in. It must differ from
+	// the region of the primary key.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// PrimaryKeyARN is the ARN of the multi-Region primary key to replicate.
+	// The primary key can be in any region other than Region.
+	//
+	// For example:
+	//
+	//    * arn:aws:kms:us-east-1:111122223333:key/mrk-1234abcd12ab34cd56ef1234567890ab
+	//
+	// +immutable
+	// +crossplane:generate:reference:type=Key
+	// +crossplane:generate:reference:extractor=KMSKeyARN()
+	// +optional
+	PrimaryKeyARN *string `json:"primaryKeyArn,omitempty"`
+
+	// PrimaryKeyARNRef is a reference to a multi-Region KMS Key used to set
+	// PrimaryKeyARN.
+	// +optional
+	PrimaryKeyARNRef *xpv1.Reference `json:"primaryKeyArnRef,omitempty"`
+
+	// PrimaryKeyARNSelector selects a reference to a multi-Region KMS Key used
+	// to set PrimaryKeyARN.
+	// +optional
+	PrimaryKeyARNSelector *xpv1.Selector `json:"primaryKeyArnSelector,omitempty"`
+
+	// A description of the replica key.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The key policy of the replica key. If omitted, the default key policy is
+	// applied. The key policy is not shared with the primary key or the other
+	// replicas.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// Skips the key policy lockout safety check when creating the replica or
+	// updating its policy.
+	// +optional
+	BypassPolicyLockoutSafetyCheck *bool `json:"bypassPolicyLockoutSafetyCheck,omitempty"`
+
+	// Specifies whether the replica key is enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Specifies how many days the replica key is retained when scheduled for
+	// deletion. Defaults to 30 days.
+	// +optional
+	PendingWindowInDays *int64 `json:"pendingWindowInDays,omitempty"`
+
+	// Tags of the replica key. Tags are not shared with the primary key or the
+	// other replicas.
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// ReplicaKeySpec defines the desired state of ReplicaKey
+type ReplicaKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReplicaKeyParameters `json:"forProvider"`
+}
+
+// ReplicaKeyObservation defines the observed state of ReplicaKey
+type ReplicaKeyObservation struct {
+	// The ARN of the replica key.
+	ARN *string `json:"arn,omitempty"`
+
+	// The key ID of the replica key. Related multi-Region keys share the same
+	// key ID.
+	KeyID *string `json:"keyID,omitempty"`
+
+	// The current state of the replica key.
+	KeyState *string `json:"keyState,omitempty"`
+
+	// Specifies whether the replica key is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The ARN of the primary key the replica currently belongs to. It changes
+	// when another replica is promoted to be the primary key.
+	PrimaryKeyARN *string `json:"primaryKeyArn,omitempty"`
+
+	// The date and time after which KMS deletes the replica key.
+	DeletionDate *metav1.Time `json:"deletionDate,omitempty"`
+}
+
+// ReplicaKeyStatus defines the observed state of ReplicaKey.
+type ReplicaKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReplicaKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicaKey is a replica of a multi-Region KMS key in another region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReplicaKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ReplicaKeySpec   `json:"spec"`
+	Status            ReplicaKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicaKeyList contains a list of ReplicaKeys
+type ReplicaKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicaKey `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ReplicaKeyKind             = "ReplicaKey"
+	ReplicaKeyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ReplicaKeyKind}.String()
+	ReplicaKeyKindAPIVersion   = ReplicaKeyKind + "." + GroupVersion.String()
+	ReplicaKeyGroupVersionKind = GroupVersion.WithKind(ReplicaKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ReplicaKey{}, &ReplicaKeyList{})
+}
@@ -244,6 +244,21 @@ func (in *CustomKeyParameters) DeepCopyInto(out *CustomKeyParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CustomKeyStoreID != nil {
+		in, out := &in.CustomKeyStoreID, &out.CustomKeyStoreID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomKeyStoreIDRef != nil {
+		in, out := &in.CustomKeyStoreIDRef, &out.CustomKeyStoreIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomKeyStoreIDSelector != nil {
+		in, out := &in.CustomKeyStoreIDSelector, &out.CustomKeyStoreIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyParameters.
@@ -256,6 +271,198 @@ func (in *CustomKeyParameters) DeepCopy() *CustomKeyParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyStore) DeepCopyInto(out *CustomKeyStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyStore.
+func (in *CustomKeyStore) DeepCopy() *CustomKeyStore {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomKeyStore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyStoreList) DeepCopyInto(out *CustomKeyStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomKeyStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyStoreList.
+func (in *CustomKeyStoreList) DeepCopy() *CustomKeyStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomKeyStoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyStoreObservation) DeepCopyInto(out *CustomKeyStoreObservation) {
+	*out = *in
+	if in.CustomKeyStoreID != nil {
+		in, out := &in.CustomKeyStoreID, &out.CustomKeyStoreID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionState != nil {
+		in, out := &in.ConnectionState, &out.ConnectionState
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionErrorCode != nil {
+		in, out := &in.ConnectionErrorCode, &out.ConnectionErrorCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyStoreObservation.
+func (in *CustomKeyStoreObservation) DeepCopy() *CustomKeyStoreObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyStoreObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyStoreParameters) DeepCopyInto(out *CustomKeyStoreParameters) {
+	*out = *in
+	if in.CustomKeyStoreType != nil {
+		in, out := &in.CustomKeyStoreType, &out.CustomKeyStoreType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CloudHSMClusterID != nil {
+		in, out := &in.CloudHSMClusterID, &out.CloudHSMClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustAnchorCertificate != nil {
+		in, out := &in.TrustAnchorCertificate, &out.TrustAnchorCertificate
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyStorePasswordSecretRef != nil {
+		in, out := &in.KeyStorePasswordSecretRef, &out.KeyStorePasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.XksProxyConnectivity != nil {
+		in, out := &in.XksProxyConnectivity, &out.XksProxyConnectivity
+		*out = new(string)
+		**out = **in
+	}
+	if in.XksProxyURIEndpoint != nil {
+		in, out := &in.XksProxyURIEndpoint, &out.XksProxyURIEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.XksProxyURIPath != nil {
+		in, out := &in.XksProxyURIPath, &out.XksProxyURIPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.XksProxyVPCEndpointServiceName != nil {
+		in, out := &in.XksProxyVPCEndpointServiceName, &out.XksProxyVPCEndpointServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.XksProxyAuthenticationCredential != nil {
+		in, out := &in.XksProxyAuthenticationCredential, &out.XksProxyAuthenticationCredential
+		*out = new(XksProxyAuthenticationCredential)
+		**out = **in
+	}
+	if in.Connected != nil {
+		in, out := &in.Connected, &out.Connected
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyStoreParameters.
+func (in *CustomKeyStoreParameters) DeepCopy() *CustomKeyStoreParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyStoreParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyStoreSpec) DeepCopyInto(out *CustomKeyStoreSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyStoreSpec.
+func (in *CustomKeyStoreSpec) DeepCopy() *CustomKeyStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyStoreStatus) DeepCopyInto(out *CustomKeyStoreStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyStoreStatus.
+func (in *CustomKeyStoreStatus) DeepCopy() *CustomKeyStoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomKeyStoresListEntry) DeepCopyInto(out *CustomKeyStoresListEntry) {
 	*out = *in
@@ -930,11 +1137,6 @@ func (in *KeyParameters) DeepCopyInto(out *KeyParameters) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CustomKeyStoreID != nil {
-		in, out := &in.CustomKeyStoreID, &out.CustomKeyStoreID
-		*out = new(string)
-		**out = **in
-	}
 	if in.CustomerMasterKeySpec != nil {
 		in, out := &in.CustomerMasterKeySpec, &out.CustomerMasterKeySpec
 		*out = new(string)
@@ -1094,6 +1296,209 @@ func (in *MultiRegionKey) DeepCopy() *MultiRegionKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaKey) DeepCopyInto(out *ReplicaKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaKey.
+func (in *ReplicaKey) DeepCopy() *ReplicaKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicaKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaKeyList) DeepCopyInto(out *ReplicaKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicaKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaKeyList.
+func (in *ReplicaKeyList) DeepCopy() *ReplicaKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicaKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaKeyObservation) DeepCopyInto(out *ReplicaKeyObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyID != nil {
+		in, out := &in.KeyID, &out.KeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyState != nil {
+		in, out := &in.KeyState, &out.KeyState
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrimaryKeyARN != nil {
+		in, out := &in.PrimaryKeyARN, &out.PrimaryKeyARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionDate != nil {
+		in, out := &in.DeletionDate, &out.DeletionDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaKeyObservation.
+func (in *ReplicaKeyObservation) DeepCopy() *ReplicaKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaKeyParameters) DeepCopyInto(out *ReplicaKeyParameters) {
+	*out = *in
+	if in.PrimaryKeyARN != nil {
+		in, out := &in.PrimaryKeyARN, &out.PrimaryKeyARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryKeyARNRef != nil {
+		in, out := &in.PrimaryKeyARNRef, &out.PrimaryKeyARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrimaryKeyARNSelector != nil {
+		in, out := &in.PrimaryKeyARNSelector, &out.PrimaryKeyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BypassPolicyLockoutSafetyCheck != nil {
+		in, out := &in.BypassPolicyLockoutSafetyCheck, &out.BypassPolicyLockoutSafetyCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PendingWindowInDays != nil {
+		in, out := &in.PendingWindowInDays, &out.PendingWindowInDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaKeyParameters.
+func (in *ReplicaKeyParameters) DeepCopy() *ReplicaKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaKeySpec) DeepCopyInto(out *ReplicaKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaKeySpec.
+func (in *ReplicaKeySpec) DeepCopy() *ReplicaKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaKeyStatus) DeepCopyInto(out *ReplicaKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaKeyStatus.
+func (in *ReplicaKeyStatus) DeepCopy() *ReplicaKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -1138,3 +1543,20 @@ func (in *XksKeyConfigurationType) DeepCopy() *XksKeyConfigurationType {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XksProxyAuthenticationCredential) DeepCopyInto(out *XksProxyAuthenticationCredential) {
+	*out = *in
+	out.AccessKeyIDSecretRef = in.AccessKeyIDSecretRef
+	out.RawSecretAccessKeySecretRef = in.RawSecretAccessKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XksProxyAuthenticationCredential.
+func (in *XksProxyAuthenticationCredential) DeepCopy() *XksProxyAuthenticationCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(XksProxyAuthenticationCredential)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -76,3 +76,55 @@ func (mg *Grant) ResolveReferences(ctx context.Context, c client.Reader) error {
 
 	return nil
 }
+
+// ResolveReferences of this Key.
+func (mg *Key) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomKeyParameters.CustomKeyStoreID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomKeyParameters.CustomKeyStoreIDRef,
+		Selector:     mg.Spec.ForProvider.CustomKeyParameters.CustomKeyStoreIDSelector,
+		To: reference.To{
+			List:    &CustomKeyStoreList{},
+			Managed: &CustomKeyStore{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomKeyParameters.CustomKeyStoreID")
+	}
+	mg.Spec.ForProvider.CustomKeyParameters.CustomKeyStoreID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomKeyParameters.CustomKeyStoreIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ReplicaKey.
+func (mg *ReplicaKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PrimaryKeyARN),
+		Extract:      KMSKeyARN(),
+		Reference:    mg.Spec.ForProvider.PrimaryKeyARNRef,
+		Selector:     mg.Spec.ForProvider.PrimaryKeyARNSelector,
+		To: reference.To{
+			List:    &KeyList{},
+			Managed: &Key{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PrimaryKeyARN")
+	}
+	mg.Spec.ForProvider.PrimaryKeyARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PrimaryKeyARNRef = rsp.ResolvedReference
+
+	return nil
+}
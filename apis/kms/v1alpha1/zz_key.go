@@ -42,19 +42,6 @@ type KeyParameters struct {
 	// making the request from making a subsequent PutKeyPolicy request on the KMS
 	// key.
 	BypassPolicyLockoutSafetyCheck *bool `json:"bypassPolicyLockoutSafetyCheck,omitempty"`
-	// Creates the KMS key in the specified custom key store (https://docs.aws.amazon.com/kms/latest/developerguide/custom-key-store-overview.html).
-	// The ConnectionState of the custom key store must be CONNECTED. To find the
-	// CustomKeyStoreID and ConnectionState use the DescribeCustomKeyStores operation.
-	//
-	// This parameter is valid only for symmetric encryption KMS keys in a single
-	// Region. You cannot create any other type of KMS key in a custom key store.
-	//
-	// When you create a KMS key in an CloudHSM key store, KMS generates a non-exportable
-	// 256-bit symmetric key in its associated CloudHSM cluster and associates it
-	// with the KMS key. When you create a KMS key in an external key store, you
-	// must use the XksKeyId parameter to specify an external key that serves as
-	// key material for the KMS key.
-	CustomKeyStoreID *string `json:"customKeyStoreID,omitempty"`
 	// Instead, use the KeySpec parameter.
 	//
 	// The KeySpec and CustomerMasterKeySpec parameters work the same way. Only
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+)
+
+// ObjectLockRetention is the object lock retention of an object.
+type ObjectLockRetention struct {
+	// Mode is the object lock retention mode.
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// RetainUntilDate is the date until which the object is locked.
+	RetainUntilDate metav1.Time `json:"retainUntilDate"`
+}
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+type ObjectParameters struct {
+	// Region is where the Bucket of the Object resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// Bucket is the name of the bucket that contains the Object.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references to a Bucket to retrieve its name
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Key is the object key.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the inline content of the Object. Exactly one of content,
+	// contentConfigMapRef or contentSecretRef must be set.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentConfigMapRef references a key of a ConfigMap that holds the
+	// content of the Object. Both data and binaryData keys are supported.
+	// +optional
+	ContentConfigMapRef *common.ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// ContentSecretRef references a key of a Secret that holds the content
+	// of the Object.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentType is a standard MIME type describing the format of the
+	// content. S3 uses binary/octet-stream if it is not set.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Metadata is the user-defined metadata of the Object. S3 stores the
+	// keys in lower case.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption algorithm used
+	// to store the Object. The default encryption of the bucket is used if
+	// it is not set.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms";"aws:kms:dsse"
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ID or ARN of the KMS key that encrypts the Object
+	// if serverSideEncryption is aws:kms or aws:kms:dsse.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+
+	// SSEKMSKeyIDRef is a reference to a KMS Key used to set SSEKMSKeyID.
+	// +optional
+	SSEKMSKeyIDRef *xpv1.Reference `json:"sseKmsKeyIdRef,omitempty"`
+
+	// SSEKMSKeyIDSelector selects a reference to a KMS Key used to set
+	// SSEKMSKeyID.
+	// +optional
+	SSEKMSKeyIDSelector *xpv1.Selector `json:"sseKmsKeyIdSelector,omitempty"`
+
+	// Tags is the set of tags of the Object.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+
+	// ObjectLockRetention is the object lock retention of the Object. The
+	// bucket must have object lock enabled.
+	// +optional
+	ObjectLockRetention *ObjectLockRetention `json:"objectLockRetention,omitempty"`
+}
+
+// An ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// ObjectObservation is the observed state of an Object.
+type ObjectObservation struct {
+	// ETag is the entity tag of the Object.
+	ETag *string `json:"eTag,omitempty"`
+
+	// ChecksumSHA256 is the base64 encoded SHA-256 checksum of the Object.
+	ChecksumSHA256 *string `json:"checksumSHA256,omitempty"`
+
+	// VersionID is the version of the Object if the bucket is versioned.
+	VersionID *string `json:"versionId,omitempty"`
+
+	// LastModified is the time the Object was last modified.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+}
+
+// An ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents a small AWS S3 object whose
+// content is declared inline or read from a ConfigMap or Secret.
+//
+// Deleting an Object does not remove its versions in a versioned bucket:
+// S3 adds a delete marker and the previous versions remain recoverable. With
+// deletionPolicy Orphan the object is left untouched.
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	kms "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 )
@@ -64,6 +65,41 @@ func (mg *BucketPolicy) ResolveReferences(ctx context.Context, c client.Reader)
 	return nil
 }
 
+// ResolveReferences of this Object
+func (mg *Object) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Bucket),
+		Reference:    mg.Spec.ForProvider.BucketRef,
+		Selector:     mg.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	mg.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sseKmsKeyId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SSEKMSKeyID),
+		Reference:    mg.Spec.ForProvider.SSEKMSKeyIDRef,
+		Selector:     mg.Spec.ForProvider.SSEKMSKeyIDSelector,
+		To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sseKmsKeyId")
+	}
+	mg.Spec.ForProvider.SSEKMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SSEKMSKeyIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolvePrincipal resolves all the User and Role references in a BucketPrincipal
 func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *common.BucketPrincipal, statementIndex int) error {
 	if principal == nil {
@@ -21,7 +21,9 @@ limitations under the License.
 package v1alpha3
 
 import (
+	apiscommon "github.com/crossplane-contrib/provider-aws/apis/common"
 	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -157,3 +159,233 @@ func (in *BucketPolicyStatus) DeepCopy() *BucketPolicyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Object) DeepCopyInto(out *Object) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Object.
+func (in *Object) DeepCopy() *Object {
+	if in == nil {
+		return nil
+	}
+	out := new(Object)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Object) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectList) DeepCopyInto(out *ObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Object, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectList.
+func (in *ObjectList) DeepCopy() *ObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockRetention) DeepCopyInto(out *ObjectLockRetention) {
+	*out = *in
+	in.RetainUntilDate.DeepCopyInto(&out.RetainUntilDate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockRetention.
+func (in *ObjectLockRetention) DeepCopy() *ObjectLockRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChecksumSHA256 != nil {
+		in, out := &in.ChecksumSHA256, &out.ChecksumSHA256
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectObservation.
+func (in *ObjectObservation) DeepCopy() *ObjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectParameters) DeepCopyInto(out *ObjectParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentConfigMapRef != nil {
+		in, out := &in.ContentConfigMapRef, &out.ContentConfigMapRef
+		*out = new(apiscommon.ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.ContentSecretRef != nil {
+		in, out := &in.ContentSecretRef, &out.ContentSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServerSideEncryption != nil {
+		in, out := &in.ServerSideEncryption, &out.ServerSideEncryption
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEKMSKeyID != nil {
+		in, out := &in.SSEKMSKeyID, &out.SSEKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEKMSKeyIDRef != nil {
+		in, out := &in.SSEKMSKeyIDRef, &out.SSEKMSKeyIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSEKMSKeyIDSelector != nil {
+		in, out := &in.SSEKMSKeyIDSelector, &out.SSEKMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectLockRetention != nil {
+		in, out := &in.ObjectLockRetention, &out.ObjectLockRetention
+		*out = new(ObjectLockRetention)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectParameters.
+func (in *ObjectParameters) DeepCopy() *ObjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSpec) DeepCopyInto(out *ObjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSpec.
+func (in *ObjectSpec) DeepCopy() *ObjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStatus) DeepCopyInto(out *ObjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStatus.
+func (in *ObjectStatus) DeepCopy() *ObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
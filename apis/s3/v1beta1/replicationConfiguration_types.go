@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ReplicationConfiguration contains replication rules. You can add up to 1,000 rules. The maximum
@@ -47,6 +48,149 @@ type ReplicationConfiguration struct {
 	//
 	// Rules is a required field
 	Rules []ReplicationRule `json:"rules"`
+
+	// BatchReplication replicates the existing objects of the bucket with an
+	// S3 Batch Replication job whenever an enabled rule is added. Only rules
+	// with an ID are tracked. No job is created for the rules a new bucket is
+	// created with, since it has no objects yet. The jobs are reported in
+	// status.atProvider.replication.batchReplicationJobs.
+	// +optional
+	BatchReplication *BatchReplication `json:"batchReplication,omitempty"`
+}
+
+// BatchReplication configures the S3 Batch Replication jobs that replicate
+// existing objects. For more information, see Replicating existing objects
+// with S3 Batch Replication (https://docs.aws.amazon.com/AmazonS3/latest/userguide/s3-batch-replication-batch.html)
+type BatchReplication struct {
+	// AccountID is the ID of the account that owns the bucket. The jobs are
+	// created in this account.
+	AccountID string `json:"accountID"`
+
+	// The Amazon Resource Name (ARN) of the IAM role that S3 Batch Operations
+	// assumes to replicate the objects.
+	//
+	// At least one of roleARN, roleARNRef or roleARNSelector fields is required.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// ObjectReplicationStatuses are the replication statuses of the objects
+	// that are replicated. Valid values are "NONE", "FAILED", "COMPLETED" and
+	// "REPLICA". Defaults to objects that were never replicated or failed to
+	// replicate.
+	// +optional
+	ObjectReplicationStatuses []string `json:"objectReplicationStatuses,omitempty"`
+
+	// Priority is the priority of the jobs. Higher numbers indicate higher
+	// priority. Defaults to 10.
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+
+	// ReportBucketARN is the ARN of the bucket that the completion reports of
+	// the jobs are written to. No report is written if it is not set.
+	// +optional
+	ReportBucketARN *string `json:"reportBucketARN,omitempty"`
+
+	// ReportPrefix is the prefix of the completion reports.
+	// +optional
+	ReportPrefix *string `json:"reportPrefix,omitempty"`
+
+	// ReportScope specifies whether the completion reports contain all tasks
+	// or only failed tasks.
+	// +kubebuilder:validation:Enum=AllTasks;FailedTasksOnly
+	// +kubebuilder:default=FailedTasksOnly
+	// +optional
+	ReportScope string `json:"reportScope,omitempty"`
+}
+
+// ReplicationObservation is the observed state of the replication of a
+// bucket.
+type ReplicationObservation struct {
+	// RuleSettings are the replication metrics and S3 Replication Time
+	// Control (S3 RTC) settings of the rules as configured on the bucket.
+	// They do not tell whether objects are replicated successfully, which is
+	// reported by the replication metrics in CloudWatch if they are enabled.
+	RuleSettings []ReplicationRuleSettings `json:"ruleSettings,omitempty"`
+
+	// InitialRuleIDs are the IDs of the enabled rules the bucket was created
+	// with. The bucket had no objects yet, so no S3 Batch Replication job is
+	// created for them.
+	InitialRuleIDs []string `json:"initialRuleIDs,omitempty"`
+
+	// BatchReplicationJobs are the S3 Batch Replication jobs that replicate
+	// the existing objects for added rules.
+	BatchReplicationJobs []BatchReplicationJob `json:"batchReplicationJobs,omitempty"`
+}
+
+// ReplicationRuleSettings are the configured settings of a replication rule.
+type ReplicationRuleSettings struct {
+	// ID is the ID of the rule.
+	ID *string `json:"id,omitempty"`
+
+	// Status is the status of the rule, i.e. Enabled or Disabled.
+	Status string `json:"status,omitempty"`
+
+	// DestinationBucket is the ARN of the destination bucket.
+	DestinationBucket *string `json:"destinationBucket,omitempty"`
+
+	// MetricsStatus is the status of the replication metrics, i.e. Enabled
+	// or Disabled.
+	MetricsStatus *string `json:"metricsStatus,omitempty"`
+
+	// EventThresholdMinutes is the threshold in minutes after which the
+	// s3:Replication:OperationMissedThreshold event is emitted.
+	EventThresholdMinutes *int32 `json:"eventThresholdMinutes,omitempty"`
+
+	// ReplicationTimeStatus is the status of S3 Replication Time Control,
+	// i.e. Enabled or Disabled.
+	ReplicationTimeStatus *string `json:"replicationTimeStatus,omitempty"`
+
+	// ReplicationTimeMinutes is the time in minutes in which S3 Replication
+	// Time Control replicates objects.
+	ReplicationTimeMinutes *int32 `json:"replicationTimeMinutes,omitempty"`
+}
+
+// BatchReplicationJob is an S3 Batch Replication job of a bucket.
+type BatchReplicationJob struct {
+	// JobID is the ID of the S3 Control job.
+	JobID string `json:"jobID"`
+
+	// RuleIDs are the IDs of the added rules that the job was created for.
+	// The IDs of rules that were removed since are dropped.
+	RuleIDs []string `json:"ruleIDs,omitempty"`
+
+	// Status is the status of the job, e.g. Active, Complete or Failed.
+	Status *string `json:"status,omitempty"`
+
+	// StatusUpdateReason is the reason of the last status update.
+	StatusUpdateReason *string `json:"statusUpdateReason,omitempty"`
+
+	// CreationTime is the time the job was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// TerminationDate is the time the job finished.
+	TerminationDate *metav1.Time `json:"terminationDate,omitempty"`
+
+	// TotalNumberOfTasks is the number of objects the job replicates.
+	TotalNumberOfTasks *int64 `json:"totalNumberOfTasks,omitempty"`
+
+	// NumberOfTasksSucceeded is the number of replicated objects.
+	NumberOfTasksSucceeded *int64 `json:"numberOfTasksSucceeded,omitempty"`
+
+	// NumberOfTasksFailed is the number of objects that failed to replicate.
+	NumberOfTasksFailed *int64 `json:"numberOfTasksFailed,omitempty"`
+
+	// FailureReasons are the reasons why the job failed.
+	FailureReasons []string `json:"failureReasons,omitempty"`
 }
 
 // ReplicationRule specifies which Amazon S3 objects to replicate and where to store the replicas.
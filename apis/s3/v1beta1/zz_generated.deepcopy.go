@@ -71,6 +71,114 @@ func (in *AccessControlTranslation) DeepCopy() *AccessControlTranslation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchReplication) DeepCopyInto(out *BatchReplication) {
+	*out = *in
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectReplicationStatuses != nil {
+		in, out := &in.ObjectReplicationStatuses, &out.ObjectReplicationStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReportBucketARN != nil {
+		in, out := &in.ReportBucketARN, &out.ReportBucketARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReportPrefix != nil {
+		in, out := &in.ReportPrefix, &out.ReportPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchReplication.
+func (in *BatchReplication) DeepCopy() *BatchReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchReplicationJob) DeepCopyInto(out *BatchReplicationJob) {
+	*out = *in
+	if in.RuleIDs != nil {
+		in, out := &in.RuleIDs, &out.RuleIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusUpdateReason != nil {
+		in, out := &in.StatusUpdateReason, &out.StatusUpdateReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TerminationDate != nil {
+		in, out := &in.TerminationDate, &out.TerminationDate
+		*out = (*in).DeepCopy()
+	}
+	if in.TotalNumberOfTasks != nil {
+		in, out := &in.TotalNumberOfTasks, &out.TotalNumberOfTasks
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NumberOfTasksSucceeded != nil {
+		in, out := &in.NumberOfTasksSucceeded, &out.NumberOfTasksSucceeded
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NumberOfTasksFailed != nil {
+		in, out := &in.NumberOfTasksFailed, &out.NumberOfTasksFailed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailureReasons != nil {
+		in, out := &in.FailureReasons, &out.FailureReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchReplicationJob.
+func (in *BatchReplicationJob) DeepCopy() *BatchReplicationJob {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchReplicationJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bucket) DeepCopyInto(out *Bucket) {
 	*out = *in
@@ -101,6 +209,11 @@ func (in *Bucket) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketExternalStatus) DeepCopyInto(out *BucketExternalStatus) {
 	*out = *in
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationObservation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketExternalStatus.
@@ -328,7 +441,7 @@ func (in *BucketSpec) DeepCopy() *BucketSpec {
 func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
@@ -1062,6 +1175,11 @@ func (in *ReplicationConfiguration) DeepCopyInto(out *ReplicationConfiguration)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BatchReplication != nil {
+		in, out := &in.BatchReplication, &out.BatchReplication
+		*out = new(BatchReplication)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfiguration.
@@ -1074,6 +1192,40 @@ func (in *ReplicationConfiguration) DeepCopy() *ReplicationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationObservation) DeepCopyInto(out *ReplicationObservation) {
+	*out = *in
+	if in.RuleSettings != nil {
+		in, out := &in.RuleSettings, &out.RuleSettings
+		*out = make([]ReplicationRuleSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitialRuleIDs != nil {
+		in, out := &in.InitialRuleIDs, &out.InitialRuleIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchReplicationJobs != nil {
+		in, out := &in.BatchReplicationJobs, &out.BatchReplicationJobs
+		*out = make([]BatchReplicationJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationObservation.
+func (in *ReplicationObservation) DeepCopy() *ReplicationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationRule) DeepCopyInto(out *ReplicationRule) {
 	*out = *in
@@ -1170,6 +1322,51 @@ func (in *ReplicationRuleFilter) DeepCopy() *ReplicationRuleFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRuleSettings) DeepCopyInto(out *ReplicationRuleSettings) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationBucket != nil {
+		in, out := &in.DestinationBucket, &out.DestinationBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricsStatus != nil {
+		in, out := &in.MetricsStatus, &out.MetricsStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventThresholdMinutes != nil {
+		in, out := &in.EventThresholdMinutes, &out.EventThresholdMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicationTimeStatus != nil {
+		in, out := &in.ReplicationTimeStatus, &out.ReplicationTimeStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationTimeMinutes != nil {
+		in, out := &in.ReplicationTimeMinutes, &out.ReplicationTimeMinutes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRuleSettings.
+func (in *ReplicationRuleSettings) DeepCopy() *ReplicationRuleSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRuleSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationTime) DeepCopyInto(out *ReplicationTime) {
 	*out = *in
@@ -92,6 +92,26 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 		mg.Spec.ForProvider.ReplicationConfiguration.RoleRef = rsp.ResolvedReference
 
 	}
+	if mg.Spec.ForProvider.ReplicationConfiguration != nil {
+		if mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication != nil {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication.RoleARN),
+				Extract:      v1beta1.RoleARN(),
+				Reference:    mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication.RoleARNRef,
+				Selector:     mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication.RoleARNSelector,
+				To: reference.To{
+					List:    &v1beta1.RoleList{},
+					Managed: &v1beta1.Role{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication.RoleARN")
+			}
+			mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.ReplicationConfiguration.BatchReplication.RoleARNRef = rsp.ResolvedReference
+
+		}
+	}
 	if mg.Spec.ForProvider.ReplicationConfiguration != nil {
 		for i4 := 0; i4 < len(mg.Spec.ForProvider.ReplicationConfiguration.Rules); i4++ {
 			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+)
+
+// AnnotationKeyRequestTokenARN is the annotation that holds the ARN of the
+// request token of the asynchronous creation of a MultiRegionAccessPoint.
+const AnnotationKeyRequestTokenARN = "s3control.aws.crossplane.io/request-token-arn"
+
+// MultiRegionAccessPointRegion is a bucket that a MultiRegionAccessPoint
+// routes requests to.
+type MultiRegionAccessPointRegion struct {
+	// Bucket is the name of the bucket.
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef is a reference to a Bucket used to set Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket used to set Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// BucketAccountID is the ID of the account that owns the bucket.
+	// +optional
+	BucketAccountID *string `json:"bucketAccountID,omitempty"`
+}
+
+// MultiRegionAccessPointParameters defines the desired state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointParameters struct {
+	// Region is the region of the S3 Control endpoint that the requests are
+	// sent to. Requests for Multi-Region Access Points are routed through
+	// us-west-2.
+	// +kubebuilder:default=us-west-2
+	// +optional
+	Region string `json:"region"`
+
+	// AccountID is the ID of the account that owns the Multi-Region Access
+	// Point.
+	// +immutable
+	AccountID *string `json:"accountID"`
+
+	// Regions are the buckets that the Multi-Region Access Point routes
+	// requests to. At most one bucket per region is supported.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Regions []MultiRegionAccessPointRegion `json:"regions"`
+
+	// PublicAccessBlock is the public access block configuration of the
+	// Multi-Region Access Point.
+	// +immutable
+	// +optional
+	PublicAccessBlock *PublicAccessBlockConfiguration `json:"publicAccessBlock,omitempty"`
+
+	// Policy is the access control policy of the Multi-Region Access Point.
+	// It is applied once the Multi-Region Access Point is ready.
+	// +optional
+	Policy *common.BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// MultiRegionAccessPointSpec defines the desired state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MultiRegionAccessPointParameters `json:"forProvider"`
+}
+
+// MultiRegionAccessPointRegionObservation is a bucket that a
+// MultiRegionAccessPoint routes requests to.
+type MultiRegionAccessPointRegionObservation struct {
+	Bucket *string `json:"bucket,omitempty"`
+
+	BucketAccountID *string `json:"bucketAccountID,omitempty"`
+
+	Region *string `json:"region,omitempty"`
+}
+
+// MultiRegionAccessPointRequest is an asynchronous request that creates or
+// deletes a MultiRegionAccessPoint or puts its policy.
+type MultiRegionAccessPointRequest struct {
+	// TokenARN is the ARN of the request token.
+	TokenARN *string `json:"tokenARN,omitempty"`
+
+	// Operation is the requested operation, e.g.
+	// CreateMultiRegionAccessPoint.
+	Operation *string `json:"operation,omitempty"`
+
+	// Status is the status of the request, i.e. SUCCEEDED, FAILED or a
+	// status of a request in progress.
+	Status *string `json:"status,omitempty"`
+
+	// CreationTime is the time the request was sent.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// ErrorCode is the error code of a failed request.
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// ErrorMessage is the error message of a failed request.
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+}
+
+// MultiRegionAccessPointObservation defines the observed state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointObservation struct {
+	// Alias is the alias of the Multi-Region Access Point.
+	Alias *string `json:"alias,omitempty"`
+
+	// ARN is the ARN of the Multi-Region Access Point.
+	ARN *string `json:"arn,omitempty"`
+
+	// CreatedAt is the time the Multi-Region Access Point was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// Status is the status of the Multi-Region Access Point, e.g. READY or
+	// INCONSISTENT_ACROSS_REGIONS.
+	Status *string `json:"status,omitempty"`
+
+	// Regions are the buckets of the Multi-Region Access Point.
+	Regions []MultiRegionAccessPointRegionObservation `json:"regions,omitempty"`
+
+	// LastRequest is the latest asynchronous request of the Multi-Region
+	// Access Point.
+	LastRequest *MultiRegionAccessPointRequest `json:"lastRequest,omitempty"`
+}
+
+// MultiRegionAccessPointStatus defines the observed state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MultiRegionAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPoint is an S3 Multi-Region Access Point that routes
+// requests to buckets in several regions. Its name is used as external name.
+// Creations, deletions and policy updates are asynchronous and tracked in
+// status.atProvider.lastRequest.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MultiRegionAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MultiRegionAccessPointSpec   `json:"spec"`
+	Status            MultiRegionAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPointList contains a list of MultiRegionAccessPoints
+type MultiRegionAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiRegionAccessPoint `json:"items"`
+}
+
+// MultiRegionAccessPoint type metadata.
+var (
+	MultiRegionAccessPointKind             = "MultiRegionAccessPoint"
+	MultiRegionAccessPointGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: MultiRegionAccessPointKind}.String()
+	MultiRegionAccessPointKindAPIVersion   = MultiRegionAccessPointKind + "." + GroupVersion.String()
+	MultiRegionAccessPointGroupVersionKind = GroupVersion.WithKind(MultiRegionAccessPointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MultiRegionAccessPoint{}, &MultiRegionAccessPointList{})
+}
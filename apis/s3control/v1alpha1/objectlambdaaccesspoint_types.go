@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+)
+
+// AWSLambdaTransformation is a Lambda function that transforms the objects
+// of an ObjectLambdaAccessPoint.
+type AWSLambdaTransformation struct {
+	// FunctionARN is the ARN of the Lambda function.
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	// +optional
+	FunctionARN *string `json:"functionARN,omitempty"`
+
+	// FunctionARNRef is a reference to a Function used to set FunctionARN.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionARNRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a Function used to set
+	// FunctionARN.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionARNSelector,omitempty"`
+
+	// FunctionPayload is additional JSON that is passed to the Lambda
+	// function.
+	// +optional
+	FunctionPayload *string `json:"functionPayload,omitempty"`
+}
+
+// ObjectLambdaContentTransformation is the content transformation of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaContentTransformation struct {
+	// AWSLambda is the Lambda function that transforms the content.
+	AWSLambda AWSLambdaTransformation `json:"awsLambda"`
+}
+
+// ObjectLambdaTransformationConfiguration configures the transformation of
+// the objects of an ObjectLambdaAccessPoint.
+type ObjectLambdaTransformationConfiguration struct {
+	// Actions are the S3 actions that invoke the transformation.
+	// +kubebuilder:validation:MinItems=1
+	Actions []string `json:"actions"`
+
+	// ContentTransformation is the transformation of the content.
+	ContentTransformation ObjectLambdaContentTransformation `json:"contentTransformation"`
+}
+
+// ObjectLambdaConfiguration is the configuration of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaConfiguration struct {
+	// SupportingAccessPoint is the ARN of the standard access point that
+	// the Object Lambda access point uses to access the objects.
+	// +crossplane:generate:reference:type=AccessPoint
+	// +crossplane:generate:reference:extractor=AccessPointARN()
+	// +optional
+	SupportingAccessPoint *string `json:"supportingAccessPoint,omitempty"`
+
+	// SupportingAccessPointRef is a reference to an AccessPoint used to set
+	// SupportingAccessPoint.
+	// +optional
+	SupportingAccessPointRef *xpv1.Reference `json:"supportingAccessPointRef,omitempty"`
+
+	// SupportingAccessPointSelector selects a reference to an AccessPoint
+	// used to set SupportingAccessPoint.
+	// +optional
+	SupportingAccessPointSelector *xpv1.Selector `json:"supportingAccessPointSelector,omitempty"`
+
+	// AllowedFeatures are the features that the Lambda functions support,
+	// e.g. GetObject-Range.
+	// +optional
+	AllowedFeatures []string `json:"allowedFeatures,omitempty"`
+
+	// CloudWatchMetricsEnabled enables the CloudWatch request metrics.
+	// +optional
+	CloudWatchMetricsEnabled *bool `json:"cloudWatchMetricsEnabled,omitempty"`
+
+	// TransformationConfigurations configure the transformations of the
+	// objects.
+	// +kubebuilder:validation:MinItems=1
+	TransformationConfigurations []ObjectLambdaTransformationConfiguration `json:"transformationConfigurations"`
+}
+
+// ObjectLambdaAccessPointParameters defines the desired state of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointParameters struct {
+	// Region is which region the ObjectLambdaAccessPoint will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// AccountID is the ID of the account that owns the Object Lambda access
+	// point.
+	// +immutable
+	AccountID *string `json:"accountID"`
+
+	// Configuration is the configuration of the Object Lambda access point.
+	Configuration ObjectLambdaConfiguration `json:"configuration"`
+
+	// Policy is the resource policy of the Object Lambda access point.
+	// +optional
+	Policy *common.BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// ObjectLambdaAccessPointSpec defines the desired state of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectLambdaAccessPointParameters `json:"forProvider"`
+}
+
+// ObjectLambdaAccessPointObservation defines the observed state of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointObservation struct {
+	// ARN is the ARN of the Object Lambda access point.
+	ARN *string `json:"arn,omitempty"`
+
+	// Alias is the alias of the Object Lambda access point.
+	Alias *string `json:"alias,omitempty"`
+
+	// AliasStatus is the status of the alias, i.e. PROVISIONING or READY.
+	AliasStatus *string `json:"aliasStatus,omitempty"`
+
+	// CreationDate is the time the Object Lambda access point was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// ObjectLambdaAccessPointStatus defines the observed state of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectLambdaAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectLambdaAccessPoint is an S3 Object Lambda access point that transforms
+// the objects of a supporting access point with Lambda functions. Its name is
+// used as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ObjectLambdaAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ObjectLambdaAccessPointSpec   `json:"spec"`
+	Status            ObjectLambdaAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectLambdaAccessPointList contains a list of ObjectLambdaAccessPoints
+type ObjectLambdaAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectLambdaAccessPoint `json:"items"`
+}
+
+// ObjectLambdaAccessPoint type metadata.
+var (
+	ObjectLambdaAccessPointKind             = "ObjectLambdaAccessPoint"
+	ObjectLambdaAccessPointGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ObjectLambdaAccessPointKind}.String()
+	ObjectLambdaAccessPointKindAPIVersion   = ObjectLambdaAccessPointKind + "." + GroupVersion.String()
+	ObjectLambdaAccessPointGroupVersionKind = GroupVersion.WithKind(ObjectLambdaAccessPointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ObjectLambdaAccessPoint{}, &ObjectLambdaAccessPointList{})
+}
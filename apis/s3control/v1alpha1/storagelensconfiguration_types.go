@@ -0,0 +1,266 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// StorageLensMetrics enables a group of Storage Lens metrics.
+type StorageLensMetrics struct {
+	// IsEnabled enables the metrics.
+	IsEnabled bool `json:"isEnabled"`
+}
+
+// StorageLensSelectionCriteria selects the prefixes that prefix-level
+// metrics are collected for.
+type StorageLensSelectionCriteria struct {
+	// Delimiter separates the prefixes.
+	// +optional
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// MaxDepth is the maximum depth of the prefixes.
+	// +optional
+	MaxDepth *int64 `json:"maxDepth,omitempty"`
+
+	// MinStorageBytesPercentage is the minimum share of storage bytes of a
+	// prefix.
+	// +optional
+	MinStorageBytesPercentage *float64 `json:"minStorageBytesPercentage,omitempty"`
+}
+
+// StorageLensPrefixLevelStorageMetrics configures the prefix-level storage
+// metrics.
+type StorageLensPrefixLevelStorageMetrics struct {
+	// IsEnabled enables the prefix-level storage metrics.
+	IsEnabled bool `json:"isEnabled"`
+
+	// SelectionCriteria selects the prefixes.
+	// +optional
+	SelectionCriteria *StorageLensSelectionCriteria `json:"selectionCriteria,omitempty"`
+}
+
+// StorageLensPrefixLevel configures the prefix-level metrics.
+type StorageLensPrefixLevel struct {
+	StorageMetrics StorageLensPrefixLevelStorageMetrics `json:"storageMetrics"`
+}
+
+// StorageLensBucketLevel configures the bucket-level metrics.
+type StorageLensBucketLevel struct {
+	// +optional
+	ActivityMetrics *StorageLensMetrics `json:"activityMetrics,omitempty"`
+
+	// +optional
+	AdvancedCostOptimizationMetrics *StorageLensMetrics `json:"advancedCostOptimizationMetrics,omitempty"`
+
+	// +optional
+	AdvancedDataProtectionMetrics *StorageLensMetrics `json:"advancedDataProtectionMetrics,omitempty"`
+
+	// +optional
+	DetailedStatusCodesMetrics *StorageLensMetrics `json:"detailedStatusCodesMetrics,omitempty"`
+
+	// +optional
+	PrefixLevel *StorageLensPrefixLevel `json:"prefixLevel,omitempty"`
+}
+
+// StorageLensAccountLevel configures the account-level metrics.
+type StorageLensAccountLevel struct {
+	// +optional
+	ActivityMetrics *StorageLensMetrics `json:"activityMetrics,omitempty"`
+
+	// +optional
+	AdvancedCostOptimizationMetrics *StorageLensMetrics `json:"advancedCostOptimizationMetrics,omitempty"`
+
+	// +optional
+	AdvancedDataProtectionMetrics *StorageLensMetrics `json:"advancedDataProtectionMetrics,omitempty"`
+
+	// +optional
+	DetailedStatusCodesMetrics *StorageLensMetrics `json:"detailedStatusCodesMetrics,omitempty"`
+
+	// BucketLevel configures the bucket-level metrics.
+	BucketLevel StorageLensBucketLevel `json:"bucketLevel"`
+}
+
+// StorageLensScope lists buckets and regions that are included in or
+// excluded from a Storage Lens configuration.
+type StorageLensScope struct {
+	// Buckets are the ARNs of the buckets.
+	// +optional
+	Buckets []string `json:"buckets,omitempty"`
+
+	// Regions are the names of the regions.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+}
+
+// StorageLensEncryption is the encryption of the metrics export.
+type StorageLensEncryption struct {
+	// Type is the encryption type of the metrics export.
+	// +kubebuilder:validation:Enum=SSE-S3;SSE-KMS
+	Type string `json:"type"`
+
+	// KMSKeyID is the ARN of the KMS key if the type is SSE-KMS.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
+
+// StorageLensS3BucketDestination is the bucket that the metrics are
+// exported to.
+type StorageLensS3BucketDestination struct {
+	// AccountID is the ID of the account that owns the bucket.
+	AccountID string `json:"accountID"`
+
+	// ARN is the ARN of the bucket.
+	ARN string `json:"arn"`
+
+	// Format is the format of the export.
+	// +kubebuilder:validation:Enum=CSV;Parquet
+	Format string `json:"format"`
+
+	// OutputSchemaVersion is the schema version of the export.
+	// +kubebuilder:default=V_1
+	// +optional
+	OutputSchemaVersion string `json:"outputSchemaVersion"`
+
+	// Prefix is the prefix of the exported objects.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Encryption is the encryption of the export.
+	// +optional
+	Encryption *StorageLensEncryption `json:"encryption,omitempty"`
+}
+
+// StorageLensDataExport configures the export of the metrics.
+type StorageLensDataExport struct {
+	// CloudWatchMetrics publishes the metrics to CloudWatch.
+	// +optional
+	CloudWatchMetrics *StorageLensMetrics `json:"cloudWatchMetrics,omitempty"`
+
+	// S3BucketDestination exports the metrics to a bucket.
+	// +optional
+	S3BucketDestination *StorageLensS3BucketDestination `json:"s3BucketDestination,omitempty"`
+}
+
+// StorageLensTag is a tag of a Storage Lens configuration.
+type StorageLensTag struct {
+	Key string `json:"key"`
+
+	Value string `json:"value"`
+}
+
+// StorageLensConfigurationParameters defines the desired state of a
+// StorageLensConfiguration.
+type StorageLensConfigurationParameters struct {
+	// Region is the home region of the Storage Lens configuration.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// AccountID is the ID of the account that owns the Storage Lens
+	// configuration.
+	// +immutable
+	AccountID *string `json:"accountID"`
+
+	// IsEnabled enables the Storage Lens configuration.
+	IsEnabled bool `json:"isEnabled"`
+
+	// AccountLevel configures the account-level metrics.
+	AccountLevel StorageLensAccountLevel `json:"accountLevel"`
+
+	// Include lists the buckets and regions that are included. Only one of
+	// include and exclude can be set.
+	// +optional
+	Include *StorageLensScope `json:"include,omitempty"`
+
+	// Exclude lists the buckets and regions that are excluded.
+	// +optional
+	Exclude *StorageLensScope `json:"exclude,omitempty"`
+
+	// DataExport configures the export of the metrics.
+	// +optional
+	DataExport *StorageLensDataExport `json:"dataExport,omitempty"`
+
+	// AWSOrgARN is the ARN of the AWS organization of an organization-level
+	// configuration.
+	// +optional
+	AWSOrgARN *string `json:"awsOrgARN,omitempty"`
+
+	// Tags are the tags of the Storage Lens configuration.
+	// +optional
+	Tags []StorageLensTag `json:"tags,omitempty"`
+}
+
+// StorageLensConfigurationSpec defines the desired state of a
+// StorageLensConfiguration.
+type StorageLensConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StorageLensConfigurationParameters `json:"forProvider"`
+}
+
+// StorageLensConfigurationObservation defines the observed state of a
+// StorageLensConfiguration.
+type StorageLensConfigurationObservation struct {
+	// ARN is the ARN of the Storage Lens configuration.
+	ARN *string `json:"arn,omitempty"`
+}
+
+// StorageLensConfigurationStatus defines the observed state of a
+// StorageLensConfiguration.
+type StorageLensConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StorageLensConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageLensConfiguration is an S3 Storage Lens configuration of an account.
+// Its ID is used as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type StorageLensConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              StorageLensConfigurationSpec   `json:"spec"`
+	Status            StorageLensConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageLensConfigurationList contains a list of StorageLensConfigurations
+type StorageLensConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageLensConfiguration `json:"items"`
+}
+
+// StorageLensConfiguration type metadata.
+var (
+	StorageLensConfigurationKind             = "StorageLensConfiguration"
+	StorageLensConfigurationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: StorageLensConfigurationKind}.String()
+	StorageLensConfigurationKindAPIVersion   = StorageLensConfigurationKind + "." + GroupVersion.String()
+	StorageLensConfigurationGroupVersionKind = GroupVersion.WithKind(StorageLensConfigurationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StorageLensConfiguration{}, &StorageLensConfigurationList{})
+}
@@ -160,17 +160,6 @@ const (
 	MetricsStatus_Disabled MetricsStatus = "Disabled"
 )
 
-type MultiRegionAccessPointStatus string
-
-const (
-	MultiRegionAccessPointStatus_READY                       MultiRegionAccessPointStatus = "READY"
-	MultiRegionAccessPointStatus_INCONSISTENT_ACROSS_REGIONS MultiRegionAccessPointStatus = "INCONSISTENT_ACROSS_REGIONS"
-	MultiRegionAccessPointStatus_CREATING                    MultiRegionAccessPointStatus = "CREATING"
-	MultiRegionAccessPointStatus_PARTIALLY_CREATED           MultiRegionAccessPointStatus = "PARTIALLY_CREATED"
-	MultiRegionAccessPointStatus_PARTIALLY_DELETED           MultiRegionAccessPointStatus = "PARTIALLY_DELETED"
-	MultiRegionAccessPointStatus_DELETING                    MultiRegionAccessPointStatus = "DELETING"
-)
-
 type NetworkOrigin string
 
 const (
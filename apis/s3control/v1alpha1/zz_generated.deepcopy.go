@@ -26,6 +26,41 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLambdaTransformation) DeepCopyInto(out *AWSLambdaTransformation) {
+	*out = *in
+	if in.FunctionARN != nil {
+		in, out := &in.FunctionARN, &out.FunctionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionARNRef != nil {
+		in, out := &in.FunctionARNRef, &out.FunctionARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionARNSelector != nil {
+		in, out := &in.FunctionARNSelector, &out.FunctionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionPayload != nil {
+		in, out := &in.FunctionPayload, &out.FunctionPayload
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLambdaTransformation.
+func (in *AWSLambdaTransformation) DeepCopy() *AWSLambdaTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLambdaTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessPoint) DeepCopyInto(out *AccessPoint) {
 	*out = *in
@@ -326,113 +361,165 @@ func (in *JobManifestLocation) DeepCopy() *JobManifestLocation {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MultiRegionAccessPointReport) DeepCopyInto(out *MultiRegionAccessPointReport) {
+func (in *MultiRegionAccessPoint) DeepCopyInto(out *MultiRegionAccessPoint) {
 	*out = *in
-	if in.PublicAccessBlock != nil {
-		in, out := &in.PublicAccessBlock, &out.PublicAccessBlock
-		*out = new(PublicAccessBlockConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointReport.
-func (in *MultiRegionAccessPointReport) DeepCopy() *MultiRegionAccessPointReport {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPoint.
+func (in *MultiRegionAccessPoint) DeepCopy() *MultiRegionAccessPoint {
 	if in == nil {
 		return nil
 	}
-	out := new(MultiRegionAccessPointReport)
+	out := new(MultiRegionAccessPoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiRegionAccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MultiRegionAccessPointRoute) DeepCopyInto(out *MultiRegionAccessPointRoute) {
+func (in *MultiRegionAccessPointList) DeepCopyInto(out *MultiRegionAccessPointList) {
 	*out = *in
-	if in.Bucket != nil {
-		in, out := &in.Bucket, &out.Bucket
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MultiRegionAccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRoute.
-func (in *MultiRegionAccessPointRoute) DeepCopy() *MultiRegionAccessPointRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointList.
+func (in *MultiRegionAccessPointList) DeepCopy() *MultiRegionAccessPointList {
 	if in == nil {
 		return nil
 	}
-	out := new(MultiRegionAccessPointRoute)
+	out := new(MultiRegionAccessPointList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiRegionAccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
+func (in *MultiRegionAccessPointObservation) DeepCopyInto(out *MultiRegionAccessPointObservation) {
 	*out = *in
-	if in.BlockPublicACLs != nil {
-		in, out := &in.BlockPublicACLs, &out.BlockPublicACLs
-		*out = new(bool)
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
 		**out = **in
 	}
-	if in.BlockPublicPolicy != nil {
-		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
-		*out = new(bool)
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.IgnorePublicACLs != nil {
-		in, out := &in.IgnorePublicACLs, &out.IgnorePublicACLs
-		*out = new(bool)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.RestrictPublicBuckets != nil {
-		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
-		*out = new(bool)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]MultiRegionAccessPointRegionObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRequest != nil {
+		in, out := &in.LastRequest, &out.LastRequest
+		*out = new(MultiRegionAccessPointRequest)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessBlockConfiguration.
-func (in *PublicAccessBlockConfiguration) DeepCopy() *PublicAccessBlockConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointObservation.
+func (in *MultiRegionAccessPointObservation) DeepCopy() *MultiRegionAccessPointObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicAccessBlockConfiguration)
+	out := new(MultiRegionAccessPointObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Region) DeepCopyInto(out *Region) {
+func (in *MultiRegionAccessPointParameters) DeepCopyInto(out *MultiRegionAccessPointParameters) {
 	*out = *in
-	if in.Bucket != nil {
-		in, out := &in.Bucket, &out.Bucket
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
 		*out = new(string)
 		**out = **in
 	}
-	if in.BucketAccountID != nil {
-		in, out := &in.BucketAccountID, &out.BucketAccountID
-		*out = new(string)
-		**out = **in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]MultiRegionAccessPointRegion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicAccessBlock != nil {
+		in, out := &in.PublicAccessBlock, &out.PublicAccessBlock
+		*out = new(PublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(common.BucketPolicyBody)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
-func (in *Region) DeepCopy() *Region {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointParameters.
+func (in *MultiRegionAccessPointParameters) DeepCopy() *MultiRegionAccessPointParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(Region)
+	out := new(MultiRegionAccessPointParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegionReport) DeepCopyInto(out *RegionReport) {
+func (in *MultiRegionAccessPointRegion) DeepCopyInto(out *MultiRegionAccessPointRegion) {
 	*out = *in
 	if in.Bucket != nil {
 		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BucketAccountID != nil {
 		in, out := &in.BucketAccountID, &out.BucketAccountID
 		*out = new(string)
@@ -440,211 +527,1107 @@ func (in *RegionReport) DeepCopyInto(out *RegionReport) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionReport.
-func (in *RegionReport) DeepCopy() *RegionReport {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRegion.
+func (in *MultiRegionAccessPointRegion) DeepCopy() *MultiRegionAccessPointRegion {
 	if in == nil {
 		return nil
 	}
-	out := new(RegionReport)
+	out := new(MultiRegionAccessPointRegion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegionalBucket) DeepCopyInto(out *RegionalBucket) {
+func (in *MultiRegionAccessPointRegionObservation) DeepCopyInto(out *MultiRegionAccessPointRegionObservation) {
 	*out = *in
 	if in.Bucket != nil {
 		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
-	if in.CreationDate != nil {
-		in, out := &in.CreationDate, &out.CreationDate
-		*out = (*in).DeepCopy()
+	if in.BucketAccountID != nil {
+		in, out := &in.BucketAccountID, &out.BucketAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionalBucket.
-func (in *RegionalBucket) DeepCopy() *RegionalBucket {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRegionObservation.
+func (in *MultiRegionAccessPointRegionObservation) DeepCopy() *MultiRegionAccessPointRegionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RegionalBucket)
+	out := new(MultiRegionAccessPointRegionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3BucketDestination) DeepCopyInto(out *S3BucketDestination) {
+func (in *MultiRegionAccessPointReport) DeepCopyInto(out *MultiRegionAccessPointReport) {
 	*out = *in
-	if in.AccountID != nil {
-		in, out := &in.AccountID, &out.AccountID
-		*out = new(string)
-		**out = **in
+	if in.PublicAccessBlock != nil {
+		in, out := &in.PublicAccessBlock, &out.PublicAccessBlock
+		*out = new(PublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketDestination.
-func (in *S3BucketDestination) DeepCopy() *S3BucketDestination {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointReport.
+func (in *MultiRegionAccessPointReport) DeepCopy() *MultiRegionAccessPointReport {
 	if in == nil {
 		return nil
 	}
-	out := new(S3BucketDestination)
+	out := new(MultiRegionAccessPointReport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3CopyObjectOperation) DeepCopyInto(out *S3CopyObjectOperation) {
+func (in *MultiRegionAccessPointRequest) DeepCopyInto(out *MultiRegionAccessPointRequest) {
 	*out = *in
-	if in.TargetKeyPrefix != nil {
-		in, out := &in.TargetKeyPrefix, &out.TargetKeyPrefix
+	if in.TokenARN != nil {
+		in, out := &in.TokenARN, &out.TokenARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ErrorCode != nil {
+		in, out := &in.ErrorCode, &out.ErrorCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorMessage != nil {
+		in, out := &in.ErrorMessage, &out.ErrorMessage
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3CopyObjectOperation.
-func (in *S3CopyObjectOperation) DeepCopy() *S3CopyObjectOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRequest.
+func (in *MultiRegionAccessPointRequest) DeepCopy() *MultiRegionAccessPointRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(S3CopyObjectOperation)
+	out := new(MultiRegionAccessPointRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3Grantee) DeepCopyInto(out *S3Grantee) {
+func (in *MultiRegionAccessPointRoute) DeepCopyInto(out *MultiRegionAccessPointRoute) {
 	*out = *in
-	if in.DisplayName != nil {
-		in, out := &in.DisplayName, &out.DisplayName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Identifier != nil {
-		in, out := &in.Identifier, &out.Identifier
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Grantee.
-func (in *S3Grantee) DeepCopy() *S3Grantee {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRoute.
+func (in *MultiRegionAccessPointRoute) DeepCopy() *MultiRegionAccessPointRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(S3Grantee)
+	out := new(MultiRegionAccessPointRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3JobManifestGenerator) DeepCopyInto(out *S3JobManifestGenerator) {
+func (in *MultiRegionAccessPointSpec) DeepCopyInto(out *MultiRegionAccessPointSpec) {
 	*out = *in
-	if in.ExpectedBucketOwner != nil {
-		in, out := &in.ExpectedBucketOwner, &out.ExpectedBucketOwner
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3JobManifestGenerator.
-func (in *S3JobManifestGenerator) DeepCopy() *S3JobManifestGenerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointSpec.
+func (in *MultiRegionAccessPointSpec) DeepCopy() *MultiRegionAccessPointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(S3JobManifestGenerator)
+	out := new(MultiRegionAccessPointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3ManifestOutputLocation) DeepCopyInto(out *S3ManifestOutputLocation) {
+func (in *MultiRegionAccessPointStatus) DeepCopyInto(out *MultiRegionAccessPointStatus) {
 	*out = *in
-	if in.ExpectedManifestBucketOwner != nil {
-		in, out := &in.ExpectedManifestBucketOwner, &out.ExpectedManifestBucketOwner
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ManifestOutputLocation.
-func (in *S3ManifestOutputLocation) DeepCopy() *S3ManifestOutputLocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointStatus.
+func (in *MultiRegionAccessPointStatus) DeepCopy() *MultiRegionAccessPointStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(S3ManifestOutputLocation)
+	out := new(MultiRegionAccessPointStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3ObjectMetadata) DeepCopyInto(out *S3ObjectMetadata) {
+func (in *ObjectLambdaAccessPoint) DeepCopyInto(out *ObjectLambdaAccessPoint) {
 	*out = *in
-	if in.CacheControl != nil {
-		in, out := &in.CacheControl, &out.CacheControl
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentDisposition != nil {
-		in, out := &in.ContentDisposition, &out.ContentDisposition
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentEncoding != nil {
-		in, out := &in.ContentEncoding, &out.ContentEncoding
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentLanguage != nil {
-		in, out := &in.ContentLanguage, &out.ContentLanguage
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentMD5 != nil {
-		in, out := &in.ContentMD5, &out.ContentMD5
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentType != nil {
-		in, out := &in.ContentType, &out.ContentType
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ObjectMetadata.
-func (in *S3ObjectMetadata) DeepCopy() *S3ObjectMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPoint.
+func (in *ObjectLambdaAccessPoint) DeepCopy() *ObjectLambdaAccessPoint {
 	if in == nil {
 		return nil
 	}
-	out := new(S3ObjectMetadata)
+	out := new(ObjectLambdaAccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectLambdaAccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointList) DeepCopyInto(out *ObjectLambdaAccessPointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectLambdaAccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointList.
+func (in *ObjectLambdaAccessPointList) DeepCopy() *ObjectLambdaAccessPointList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectLambdaAccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointObservation) DeepCopyInto(out *ObjectLambdaAccessPointObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
+		**out = **in
+	}
+	if in.AliasStatus != nil {
+		in, out := &in.AliasStatus, &out.AliasStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointObservation.
+func (in *ObjectLambdaAccessPointObservation) DeepCopy() *ObjectLambdaAccessPointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointParameters) DeepCopyInto(out *ObjectLambdaAccessPointParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	in.Configuration.DeepCopyInto(&out.Configuration)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(common.BucketPolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointParameters.
+func (in *ObjectLambdaAccessPointParameters) DeepCopy() *ObjectLambdaAccessPointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointSpec) DeepCopyInto(out *ObjectLambdaAccessPointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointSpec.
+func (in *ObjectLambdaAccessPointSpec) DeepCopy() *ObjectLambdaAccessPointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointStatus) DeepCopyInto(out *ObjectLambdaAccessPointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointStatus.
+func (in *ObjectLambdaAccessPointStatus) DeepCopy() *ObjectLambdaAccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaConfiguration) DeepCopyInto(out *ObjectLambdaConfiguration) {
+	*out = *in
+	if in.SupportingAccessPoint != nil {
+		in, out := &in.SupportingAccessPoint, &out.SupportingAccessPoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportingAccessPointRef != nil {
+		in, out := &in.SupportingAccessPointRef, &out.SupportingAccessPointRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SupportingAccessPointSelector != nil {
+		in, out := &in.SupportingAccessPointSelector, &out.SupportingAccessPointSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedFeatures != nil {
+		in, out := &in.AllowedFeatures, &out.AllowedFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CloudWatchMetricsEnabled != nil {
+		in, out := &in.CloudWatchMetricsEnabled, &out.CloudWatchMetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransformationConfigurations != nil {
+		in, out := &in.TransformationConfigurations, &out.TransformationConfigurations
+		*out = make([]ObjectLambdaTransformationConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaConfiguration.
+func (in *ObjectLambdaConfiguration) DeepCopy() *ObjectLambdaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaContentTransformation) DeepCopyInto(out *ObjectLambdaContentTransformation) {
+	*out = *in
+	in.AWSLambda.DeepCopyInto(&out.AWSLambda)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaContentTransformation.
+func (in *ObjectLambdaContentTransformation) DeepCopy() *ObjectLambdaContentTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaContentTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaTransformationConfiguration) DeepCopyInto(out *ObjectLambdaTransformationConfiguration) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ContentTransformation.DeepCopyInto(&out.ContentTransformation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaTransformationConfiguration.
+func (in *ObjectLambdaTransformationConfiguration) DeepCopy() *ObjectLambdaTransformationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaTransformationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
+	*out = *in
+	if in.BlockPublicACLs != nil {
+		in, out := &in.BlockPublicACLs, &out.BlockPublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicPolicy != nil {
+		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnorePublicACLs != nil {
+		in, out := &in.IgnorePublicACLs, &out.IgnorePublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictPublicBuckets != nil {
+		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessBlockConfiguration.
+func (in *PublicAccessBlockConfiguration) DeepCopy() *PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAccessBlockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Region) DeepCopyInto(out *Region) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketAccountID != nil {
+		in, out := &in.BucketAccountID, &out.BucketAccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
+func (in *Region) DeepCopy() *Region {
+	if in == nil {
+		return nil
+	}
+	out := new(Region)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionReport) DeepCopyInto(out *RegionReport) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketAccountID != nil {
+		in, out := &in.BucketAccountID, &out.BucketAccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionReport.
+func (in *RegionReport) DeepCopy() *RegionReport {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionalBucket) DeepCopyInto(out *RegionalBucket) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionalBucket.
+func (in *RegionalBucket) DeepCopy() *RegionalBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionalBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BucketDestination) DeepCopyInto(out *S3BucketDestination) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketDestination.
+func (in *S3BucketDestination) DeepCopy() *S3BucketDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BucketDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3CopyObjectOperation) DeepCopyInto(out *S3CopyObjectOperation) {
+	*out = *in
+	if in.TargetKeyPrefix != nil {
+		in, out := &in.TargetKeyPrefix, &out.TargetKeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3CopyObjectOperation.
+func (in *S3CopyObjectOperation) DeepCopy() *S3CopyObjectOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(S3CopyObjectOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Grantee) DeepCopyInto(out *S3Grantee) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Identifier != nil {
+		in, out := &in.Identifier, &out.Identifier
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Grantee.
+func (in *S3Grantee) DeepCopy() *S3Grantee {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Grantee)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3JobManifestGenerator) DeepCopyInto(out *S3JobManifestGenerator) {
+	*out = *in
+	if in.ExpectedBucketOwner != nil {
+		in, out := &in.ExpectedBucketOwner, &out.ExpectedBucketOwner
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3JobManifestGenerator.
+func (in *S3JobManifestGenerator) DeepCopy() *S3JobManifestGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(S3JobManifestGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ManifestOutputLocation) DeepCopyInto(out *S3ManifestOutputLocation) {
+	*out = *in
+	if in.ExpectedManifestBucketOwner != nil {
+		in, out := &in.ExpectedManifestBucketOwner, &out.ExpectedManifestBucketOwner
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ManifestOutputLocation.
+func (in *S3ManifestOutputLocation) DeepCopy() *S3ManifestOutputLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ManifestOutputLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ObjectMetadata) DeepCopyInto(out *S3ObjectMetadata) {
+	*out = *in
+	if in.CacheControl != nil {
+		in, out := &in.CacheControl, &out.CacheControl
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentDisposition != nil {
+		in, out := &in.ContentDisposition, &out.ContentDisposition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentEncoding != nil {
+		in, out := &in.ContentEncoding, &out.ContentEncoding
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentLanguage != nil {
+		in, out := &in.ContentLanguage, &out.ContentLanguage
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentMD5 != nil {
+		in, out := &in.ContentMD5, &out.ContentMD5
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ObjectMetadata.
+func (in *S3ObjectMetadata) DeepCopy() *S3ObjectMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ObjectMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ObjectOwner) DeepCopyInto(out *S3ObjectOwner) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ObjectOwner.
+func (in *S3ObjectOwner) DeepCopy() *S3ObjectOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ObjectOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensAccountLevel) DeepCopyInto(out *StorageLensAccountLevel) {
+	*out = *in
+	if in.ActivityMetrics != nil {
+		in, out := &in.ActivityMetrics, &out.ActivityMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.AdvancedCostOptimizationMetrics != nil {
+		in, out := &in.AdvancedCostOptimizationMetrics, &out.AdvancedCostOptimizationMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.AdvancedDataProtectionMetrics != nil {
+		in, out := &in.AdvancedDataProtectionMetrics, &out.AdvancedDataProtectionMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.DetailedStatusCodesMetrics != nil {
+		in, out := &in.DetailedStatusCodesMetrics, &out.DetailedStatusCodesMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	in.BucketLevel.DeepCopyInto(&out.BucketLevel)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensAccountLevel.
+func (in *StorageLensAccountLevel) DeepCopy() *StorageLensAccountLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensAccountLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3ObjectOwner) DeepCopyInto(out *S3ObjectOwner) {
+func (in *StorageLensBucketLevel) DeepCopyInto(out *StorageLensBucketLevel) {
 	*out = *in
-	if in.DisplayName != nil {
-		in, out := &in.DisplayName, &out.DisplayName
+	if in.ActivityMetrics != nil {
+		in, out := &in.ActivityMetrics, &out.ActivityMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.AdvancedCostOptimizationMetrics != nil {
+		in, out := &in.AdvancedCostOptimizationMetrics, &out.AdvancedCostOptimizationMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.AdvancedDataProtectionMetrics != nil {
+		in, out := &in.AdvancedDataProtectionMetrics, &out.AdvancedDataProtectionMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.DetailedStatusCodesMetrics != nil {
+		in, out := &in.DetailedStatusCodesMetrics, &out.DetailedStatusCodesMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.PrefixLevel != nil {
+		in, out := &in.PrefixLevel, &out.PrefixLevel
+		*out = new(StorageLensPrefixLevel)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensBucketLevel.
+func (in *StorageLensBucketLevel) DeepCopy() *StorageLensBucketLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensBucketLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensConfiguration) DeepCopyInto(out *StorageLensConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensConfiguration.
+func (in *StorageLensConfiguration) DeepCopy() *StorageLensConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageLensConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensConfigurationList) DeepCopyInto(out *StorageLensConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StorageLensConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensConfigurationList.
+func (in *StorageLensConfigurationList) DeepCopy() *StorageLensConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageLensConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensConfigurationObservation) DeepCopyInto(out *StorageLensConfigurationObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensConfigurationObservation.
+func (in *StorageLensConfigurationObservation) DeepCopy() *StorageLensConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensConfigurationParameters) DeepCopyInto(out *StorageLensConfigurationParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	in.AccountLevel.DeepCopyInto(&out.AccountLevel)
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = new(StorageLensScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = new(StorageLensScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataExport != nil {
+		in, out := &in.DataExport, &out.DataExport
+		*out = new(StorageLensDataExport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSOrgARN != nil {
+		in, out := &in.AWSOrgARN, &out.AWSOrgARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]StorageLensTag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ObjectOwner.
-func (in *S3ObjectOwner) DeepCopy() *S3ObjectOwner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensConfigurationParameters.
+func (in *StorageLensConfigurationParameters) DeepCopy() *StorageLensConfigurationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(S3ObjectOwner)
+	out := new(StorageLensConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensConfigurationSpec) DeepCopyInto(out *StorageLensConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensConfigurationSpec.
+func (in *StorageLensConfigurationSpec) DeepCopy() *StorageLensConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensConfigurationStatus) DeepCopyInto(out *StorageLensConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensConfigurationStatus.
+func (in *StorageLensConfigurationStatus) DeepCopy() *StorageLensConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensDataExport) DeepCopyInto(out *StorageLensDataExport) {
+	*out = *in
+	if in.CloudWatchMetrics != nil {
+		in, out := &in.CloudWatchMetrics, &out.CloudWatchMetrics
+		*out = new(StorageLensMetrics)
+		**out = **in
+	}
+	if in.S3BucketDestination != nil {
+		in, out := &in.S3BucketDestination, &out.S3BucketDestination
+		*out = new(StorageLensS3BucketDestination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensDataExport.
+func (in *StorageLensDataExport) DeepCopy() *StorageLensDataExport {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensDataExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensEncryption) DeepCopyInto(out *StorageLensEncryption) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensEncryption.
+func (in *StorageLensEncryption) DeepCopy() *StorageLensEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensMetrics) DeepCopyInto(out *StorageLensMetrics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensMetrics.
+func (in *StorageLensMetrics) DeepCopy() *StorageLensMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensPrefixLevel) DeepCopyInto(out *StorageLensPrefixLevel) {
+	*out = *in
+	in.StorageMetrics.DeepCopyInto(&out.StorageMetrics)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensPrefixLevel.
+func (in *StorageLensPrefixLevel) DeepCopy() *StorageLensPrefixLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensPrefixLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensPrefixLevelStorageMetrics) DeepCopyInto(out *StorageLensPrefixLevelStorageMetrics) {
+	*out = *in
+	if in.SelectionCriteria != nil {
+		in, out := &in.SelectionCriteria, &out.SelectionCriteria
+		*out = new(StorageLensSelectionCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensPrefixLevelStorageMetrics.
+func (in *StorageLensPrefixLevelStorageMetrics) DeepCopy() *StorageLensPrefixLevelStorageMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensPrefixLevelStorageMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensS3BucketDestination) DeepCopyInto(out *StorageLensS3BucketDestination) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(StorageLensEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensS3BucketDestination.
+func (in *StorageLensS3BucketDestination) DeepCopy() *StorageLensS3BucketDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensS3BucketDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensScope) DeepCopyInto(out *StorageLensScope) {
+	*out = *in
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensScope.
+func (in *StorageLensScope) DeepCopy() *StorageLensScope {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensSelectionCriteria) DeepCopyInto(out *StorageLensSelectionCriteria) {
+	*out = *in
+	if in.Delimiter != nil {
+		in, out := &in.Delimiter, &out.Delimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxDepth != nil {
+		in, out := &in.MaxDepth, &out.MaxDepth
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinStorageBytesPercentage != nil {
+		in, out := &in.MinStorageBytesPercentage, &out.MinStorageBytesPercentage
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensSelectionCriteria.
+func (in *StorageLensSelectionCriteria) DeepCopy() *StorageLensSelectionCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensSelectionCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLensTag) DeepCopyInto(out *StorageLensTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLensTag.
+func (in *StorageLensTag) DeepCopy() *StorageLensTag {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLensTag)
 	in.DeepCopyInto(out)
 	return out
 }
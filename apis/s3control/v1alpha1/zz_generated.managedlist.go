@@ -28,3 +28,30 @@ func (l *AccessPointList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this MultiRegionAccessPointList.
+func (l *MultiRegionAccessPointList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ObjectLambdaAccessPointList.
+func (l *ObjectLambdaAccessPointList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this StorageLensConfigurationList.
+func (l *StorageLensConfigurationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -20,7 +20,8 @@ package v1alpha1
 
 import (
 	"context"
-	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
+	v1beta11 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,8 +40,8 @@ func (mg *AccessPoint) ResolveReferences(ctx context.Context, c client.Reader) e
 		Reference:    mg.Spec.ForProvider.CustomAccessPointParameters.BucketNameRef,
 		Selector:     mg.Spec.ForProvider.CustomAccessPointParameters.BucketNameSelector,
 		To: reference.To{
-			List:    &v1beta1.BucketList{},
-			Managed: &v1beta1.Bucket{},
+			List:    &v1beta11.BucketList{},
+			Managed: &v1beta11.Bucket{},
 		},
 	})
 	if err != nil {
@@ -51,3 +52,77 @@ func (mg *AccessPoint) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	return nil
 }
+
+// ResolveReferences of this MultiRegionAccessPoint.
+func (mg *MultiRegionAccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Regions); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Regions[i3].Bucket),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.Regions[i3].BucketRef,
+			Selector:     mg.Spec.ForProvider.Regions[i3].BucketSelector,
+			To: reference.To{
+				List:    &v1beta11.BucketList{},
+				Managed: &v1beta11.Bucket{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Regions[i3].Bucket")
+		}
+		mg.Spec.ForProvider.Regions[i3].Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Regions[i3].BucketRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ObjectLambdaAccessPoint.
+func (mg *ObjectLambdaAccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Configuration.SupportingAccessPoint),
+		Extract:      AccessPointARN(),
+		Reference:    mg.Spec.ForProvider.Configuration.SupportingAccessPointRef,
+		Selector:     mg.Spec.ForProvider.Configuration.SupportingAccessPointSelector,
+		To: reference.To{
+			List:    &AccessPointList{},
+			Managed: &AccessPoint{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Configuration.SupportingAccessPoint")
+	}
+	mg.Spec.ForProvider.Configuration.SupportingAccessPoint = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Configuration.SupportingAccessPointRef = rsp.ResolvedReference
+
+	for i4 := 0; i4 < len(mg.Spec.ForProvider.Configuration.TransformationConfigurations); i4++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Configuration.TransformationConfigurations[i4].ContentTransformation.AWSLambda.FunctionARN),
+			Extract:      v1beta1.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.Configuration.TransformationConfigurations[i4].ContentTransformation.AWSLambda.FunctionARNRef,
+			Selector:     mg.Spec.ForProvider.Configuration.TransformationConfigurations[i4].ContentTransformation.AWSLambda.FunctionARNSelector,
+			To: reference.To{
+				List:    &v1beta1.FunctionList{},
+				Managed: &v1beta1.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Configuration.TransformationConfigurations[i4].ContentTransformation.AWSLambda.FunctionARN")
+		}
+		mg.Spec.ForProvider.Configuration.TransformationConfigurations[i4].ContentTransformation.AWSLambda.FunctionARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Configuration.TransformationConfigurations[i4].ContentTransformation.AWSLambda.FunctionARNRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
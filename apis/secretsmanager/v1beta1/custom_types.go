@@ -77,6 +77,61 @@ type CustomSecretParameters struct {
 	// ResourcePolicy is a required field
 	// +optional
 	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+
+	// Rotation configures the automatic rotation of the secret by a Lambda
+	// function. The rotation settings of the secret are left untouched if
+	// this is not set. While the rotation is enabled, the data referenced by
+	// StringSecretRef or BinarySecretRef is only used as the initial value of
+	// the secret.
+	// +optional
+	Rotation *SecretRotation `json:"rotation,omitempty"`
+}
+
+// SecretRotation is the rotation configuration of a secret.
+type SecretRotation struct {
+	// Enabled turns the rotation of the secret on or off. Turning it off
+	// cancels the rotation but keeps the configuration in AWS.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The ARN of the Lambda rotation function that rotates the secret.
+	// +optional
+	RotationLambdaARN *string `json:"rotationLambdaARN,omitempty"`
+
+	// RotationLambdaARNRef is a reference to a lambda/v1beta1.Function used
+	// to set the RotationLambdaARN field.
+	// +optional
+	RotationLambdaARNRef *xpv1.Reference `json:"rotationLambdaARNRef,omitempty"`
+
+	// RotationLambdaARNSelector selects references to lambda/v1beta1.Function
+	// used to set the RotationLambdaARN.
+	// +optional
+	RotationLambdaARNSelector *xpv1.Selector `json:"rotationLambdaARNSelector,omitempty"`
+
+	// The number of days between rotations of the secret. You can use this
+	// value to check that your secret meets your compliance guidelines for how
+	// often secrets must be rotated. You can't set both this and
+	// ScheduleExpression.
+	// +optional
+	AutomaticallyAfterDays *int64 `json:"automaticallyAfterDays,omitempty"`
+
+	// A cron() or rate() expression that defines the schedule for rotating
+	// the secret, for example "rate(10 days)" or "cron(0 8 1 * ? *)".
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// The length of the rotation window in hours, for example "3h". The
+	// window starts at the time set by ScheduleExpression and defaults to the
+	// end of the day for rate() expressions.
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// Specifies whether to rotate the secret immediately when the rotation is
+	// enabled or its configuration changes. AWS rotates immediately if this is
+	// not set.
+	// +optional
+	RotateImmediately *bool `json:"rotateImmediately,omitempty"`
 }
 
 // A SecretReference is a reference to a secret in an arbitrary namespace.
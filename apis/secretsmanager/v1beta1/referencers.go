@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kms "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	lambda "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
 )
 
 // ResolveReferences of this Secret
@@ -44,5 +45,21 @@ func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	mg.Spec.ForProvider.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.KMSKeyIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.rotation.rotationLambdaARN
+	if rot := mg.Spec.ForProvider.Rotation; rot != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(rot.RotationLambdaARN),
+			Reference:    rot.RotationLambdaARNRef,
+			Selector:     rot.RotationLambdaARNSelector,
+			To:           reference.To{Managed: &lambda.Function{}, List: &lambda.FunctionList{}},
+			Extract:      lambda.FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.rotation.rotationLambdaARN")
+		}
+		rot.RotationLambdaARN = reference.ToPtrValue(rsp.ResolvedValue)
+		rot.RotationLambdaARNRef = rsp.ResolvedReference
+	}
 	return nil
 }
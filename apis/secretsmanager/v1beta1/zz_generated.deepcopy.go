@@ -63,6 +63,11 @@ func (in *CustomSecretParameters) DeepCopyInto(out *CustomSecretParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(SecretRotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSecretParameters.
@@ -384,6 +389,14 @@ func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastRotatedDate != nil {
+		in, out := &in.LastRotatedDate, &out.LastRotatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationDate != nil {
+		in, out := &in.NextRotationDate, &out.NextRotationDate
+		*out = (*in).DeepCopy()
+	}
 	if in.ReplicationStatus != nil {
 		in, out := &in.ReplicationStatus, &out.ReplicationStatus
 		*out = make([]*ReplicationStatusType, len(*in))
@@ -395,6 +408,11 @@ func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 			}
 		}
 	}
+	if in.RotationEnabled != nil {
+		in, out := &in.RotationEnabled, &out.RotationEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretObservation.
@@ -485,6 +503,61 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotation) DeepCopyInto(out *SecretRotation) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RotationLambdaARN != nil {
+		in, out := &in.RotationLambdaARN, &out.RotationLambdaARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationLambdaARNRef != nil {
+		in, out := &in.RotationLambdaARNRef, &out.RotationLambdaARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationLambdaARNSelector != nil {
+		in, out := &in.RotationLambdaARNSelector, &out.RotationLambdaARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomaticallyAfterDays != nil {
+		in, out := &in.AutomaticallyAfterDays, &out.AutomaticallyAfterDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotateImmediately != nil {
+		in, out := &in.RotateImmediately, &out.RotateImmediately
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotation.
+func (in *SecretRotation) DeepCopy() *SecretRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretSpec) DeepCopyInto(out *SecretSpec) {
 	*out = *in
@@ -91,6 +91,14 @@ type SecretObservation struct {
 	// the same name as a deleted secret, then users with access to the old secret
 	// don't get access to the new secret because the ARNs are different.
 	ARN *string `json:"arn,omitempty"`
+	// The last date and time that Secrets Manager rotated the secret. If the secret
+	// isn't configured for rotation or rotation has been disabled, Secrets Manager
+	// returns null.
+	LastRotatedDate *metav1.Time `json:"lastRotatedDate,omitempty"`
+	// The next rotation is scheduled to occur on or before this date. If the secret
+	// isn't configured for rotation or rotation has been disabled, Secrets Manager
+	// returns null.
+	NextRotationDate *metav1.Time `json:"nextRotationDate,omitempty"`
 	// A list of the replicas of this secret and their status:
 	//
 	//    * Failed, which indicates that the replica was not created.
@@ -100,6 +108,10 @@ type SecretObservation struct {
 	//
 	//    * InSync, which indicates that the replica was created.
 	ReplicationStatus []*ReplicationStatusType `json:"replicationStatus,omitempty"`
+	// Specifies whether automatic rotation is turned on for this secret.
+	//
+	// To turn on rotation, use RotateSecret. To turn off rotation, use CancelRotateSecret.
+	RotationEnabled *bool `json:"rotationEnabled,omitempty"`
 }
 
 // SecretStatus defines the observed state of Secret.
@@ -0,0 +1,45 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomParameterParameters contains the additional fields for
+// ParameterParameters.
+type CustomParameterParameters struct {
+	// Value of the parameter. For SecureString parameters prefer
+	// ValueSecretRef so that the value is not stored in the managed resource.
+	// Either Value or ValueSecretRef must be set, but not both.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueSecretRef points to the key of a Kubernetes Secret whose value is
+	// sent to AWS as the parameter value.
+	// Either Value or ValueSecretRef must be set, but not both.
+	// +optional
+	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+
+	// KeyIDRef is a reference to a kms/v1alpha1.Key used to set the KeyID
+	// field.
+	// +optional
+	KeyIDRef *xpv1.Reference `json:"keyIDRef,omitempty"`
+
+	// KeyIDSelector selects references to a kms/v1alpha1.Key used to set the
+	// KeyID field.
+	// +optional
+	KeyIDSelector *xpv1.Selector `json:"keyIDSelector,omitempty"`
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CallerIdentityParameters define how the caller identity is looked up.
+type CallerIdentityParameters struct {
+	// Region is the region of the STS endpoint that is called. The global
+	// endpoint is called if no region is given.
+	// +optional
+	Region *string `json:"region,omitempty"`
+}
+
+// A CallerIdentitySpec defines the desired state of a CallerIdentity.
+type CallerIdentitySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CallerIdentityParameters `json:"forProvider,omitempty"`
+}
+
+// CallerIdentityObservation is the identity of the credentials of the
+// ProviderConfig.
+type CallerIdentityObservation struct {
+	// AccountID is the ID of the AWS account.
+	AccountID string `json:"accountId,omitempty"`
+
+	// ARN is the ARN of the calling identity.
+	ARN string `json:"arn,omitempty"`
+
+	// UserID is the unique identifier of the calling identity.
+	UserID string `json:"userId,omitempty"`
+}
+
+// A CallerIdentityStatus represents the observed state of a CallerIdentity.
+type CallerIdentityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CallerIdentityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CallerIdentity looks up the identity whose credentials the ProviderConfig
+// uses. It never creates, updates or deletes anything. The account ID, ARN and
+// user ID are published as the accountId, arn and userId connection details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".status.atProvider.accountId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CallerIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CallerIdentitySpec   `json:"spec"`
+	Status CallerIdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CallerIdentityList contains a list of CallerIdentities
+type CallerIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CallerIdentity `json:"items"`
+}
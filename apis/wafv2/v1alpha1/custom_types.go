@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// CustomIPSetParameters contains the additional fields for IPSetParameters.
+type CustomIPSetParameters struct{}
+
+// CustomRegexPatternSetParameters contains the additional fields for
+// RegexPatternSetParameters.
+type CustomRegexPatternSetParameters struct{}
+
+// CustomRuleGroupParameters contains the additional fields for
+// RuleGroupParameters.
+type CustomRuleGroupParameters struct {
+	// The rule statements used to identify the web requests that you want to
+	// allow, block, or count.
+	// +optional
+	Rules []*Rule `json:"rules,omitempty"`
+
+	// Defines and enables Amazon CloudWatch metrics and web request sample
+	// collection.
+	// +kubebuilder:validation:Required
+	VisibilityConfig *VisibilityConfig `json:"visibilityConfig"`
+
+	// A map of custom response keys and content bodies. When you create a rule
+	// with a block action, you can send a custom response to the web request.
+	// You define these for the rule group, and then use them in the rules that
+	// you define in the rule group.
+	// +optional
+	CustomResponseBodies map[string]*CustomResponseBody `json:"customResponseBodies,omitempty"`
+}
+
+// CustomWebACLParameters contains the additional fields for
+// WebACLParameters.
+type CustomWebACLParameters struct {
+	// The action to perform if none of the Rules contained in the WebACL match.
+	// +kubebuilder:validation:Required
+	DefaultAction *DefaultAction `json:"defaultAction"`
+
+	// The rule statements used to identify the web requests that you want to
+	// allow, block, or count.
+	// +optional
+	Rules []*Rule `json:"rules,omitempty"`
+
+	// Defines and enables Amazon CloudWatch metrics and web request sample
+	// collection.
+	// +kubebuilder:validation:Required
+	VisibilityConfig *VisibilityConfig `json:"visibilityConfig"`
+
+	// A map of custom response keys and content bodies. When you create a rule
+	// with a block action, you can send a custom response to the web request.
+	// You define these for the web ACL, and then use them in the rules and default
+	// actions that you define in the web ACL.
+	// +optional
+	CustomResponseBodies map[string]*CustomResponseBody `json:"customResponseBodies,omitempty"`
+
+	// Specifies how WAF should handle CAPTCHA evaluations for rules that don't
+	// have their own CaptchaConfig settings.
+	// +optional
+	CaptchaConfig *CaptchaConfig `json:"captchaConfig,omitempty"`
+
+	// Specifies how WAF should handle challenge evaluations for rules that don't
+	// have their own ChallengeConfig settings.
+	// +optional
+	ChallengeConfig *ChallengeConfig `json:"challengeConfig,omitempty"`
+}
+
+// Rule is a single rule of a WebACL or a RuleGroup. It defines web requests
+// that you want to allow, block, or count.
+type Rule struct {
+	// The name of the rule. You can't change the name of a Rule after you
+	// create it.
+	Name string `json:"name"`
+
+	// If you define more than one Rule in a WebACL, WAF evaluates each request
+	// against the Rules in order based on the value of Priority. WAF processes
+	// rules with lower priority first. The priorities don't need to be consecutive,
+	// but they must all be different.
+	Priority int64 `json:"priority"`
+
+	// Statement is the WAF rule statement in JSON format, exactly as it is
+	// accepted by the AWS WAFv2 API, for example
+	// {"ipSetReferenceStatement":{"arn":"arn:aws:wafv2:..."}}. Statements can
+	// be nested arbitrarily deep using and, or and not statements, which is why
+	// they are given as a JSON document. Binary fields such as SearchString
+	// must be base64 encoded.
+	Statement string `json:"statement"`
+
+	// The action that WAF should take on a web request when it matches the rule
+	// statement. Settings at the web ACL level can override the rule action
+	// setting.
+	//
+	// This is used only for rules whose statements do not reference a rule group.
+	// +optional
+	Action *RuleAction `json:"action,omitempty"`
+
+	// The action to use in the place of the action that results from the rule
+	// group evaluation. Set the override action to none to leave the result of
+	// the rule group alone. Set it to count to override the result to count only.
+	//
+	// This is used only for rules whose statements reference a rule group.
+	// +optional
+	OverrideAction *OverrideAction `json:"overrideAction,omitempty"`
+
+	// Labels to apply to web requests that match the rule match statement.
+	// +optional
+	RuleLabels []*Label `json:"ruleLabels,omitempty"`
+
+	// Defines and enables Amazon CloudWatch metrics and web request sample
+	// collection.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+
+	// Specifies how WAF should handle CAPTCHA evaluations. If you don't specify
+	// this, WAF uses the CAPTCHA configuration that's defined for the web ACL.
+	// +optional
+	CaptchaConfig *CaptchaConfig `json:"captchaConfig,omitempty"`
+
+	// Specifies how WAF should handle Challenge evaluations. If you don't specify
+	// this, WAF uses the challenge configuration that's defined for the web ACL.
+	// +optional
+	ChallengeConfig *ChallengeConfig `json:"challengeConfig,omitempty"`
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apigatewayv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/apigateway/v1alpha1"
+	cognitoidentityproviderv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	elbv2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// WebACLARN returns the status.atProvider.arn of a WebACL.
+func WebACLARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*WebACL)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.ARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this WebACLAssociation
+func (mg *WebACLAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.webACLARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.WebACLARN),
+		Reference:    mg.Spec.ForProvider.WebACLARNRef,
+		Selector:     mg.Spec.ForProvider.WebACLARNSelector,
+		To:           reference.To{Managed: &WebACL{}, List: &WebACLList{}},
+		Extract:      WebACLARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.webACLARN")
+	}
+	mg.Spec.ForProvider.WebACLARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.WebACLARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.resourceARN from whichever kind of resource is
+	// referenced.
+	p := &mg.Spec.ForProvider
+	switch {
+	case p.LoadBalancerRef != nil || p.LoadBalancerSelector != nil:
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.ResourceARN),
+			Reference:    p.LoadBalancerRef,
+			Selector:     p.LoadBalancerSelector,
+			To:           reference.To{Managed: &elbv2v1alpha1.LoadBalancer{}, List: &elbv2v1alpha1.LoadBalancerList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.resourceARN")
+		}
+		p.ResourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.LoadBalancerRef = rsp.ResolvedReference
+	case p.StageRef != nil || p.StageSelector != nil:
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.ResourceARN),
+			Reference:    p.StageRef,
+			Selector:     p.StageSelector,
+			To:           reference.To{Managed: &apigatewayv1alpha1.Stage{}, List: &apigatewayv1alpha1.StageList{}},
+			Extract:      apigatewayv1alpha1.StageARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.resourceARN")
+		}
+		p.ResourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.StageRef = rsp.ResolvedReference
+	case p.UserPoolRef != nil || p.UserPoolSelector != nil:
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.ResourceARN),
+			Reference:    p.UserPoolRef,
+			Selector:     p.UserPoolSelector,
+			To:           reference.To{Managed: &cognitoidentityproviderv1alpha1.UserPool{}, List: &cognitoidentityproviderv1alpha1.UserPoolList{}},
+			Extract:      cognitoidentityproviderv1alpha1.UserPoolARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.resourceARN")
+		}
+		p.ResourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.UserPoolRef = rsp.ResolvedReference
+	}
+	return nil
+}
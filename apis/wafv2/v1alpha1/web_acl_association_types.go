@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WebACLAssociationParameters defines the desired state of WebACLAssociation
+type WebACLAssociationParameters struct {
+	// Region is which region the WebACLAssociation will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The Amazon Resource Name (ARN) of the web ACL that you want to associate
+	// with the resource. The web ACL must have the REGIONAL scope.
+	// +optional
+	WebACLARN *string `json:"webACLARN,omitempty"`
+
+	// WebACLARNRef is a reference to a WebACL used to set WebACLARN.
+	// +optional
+	WebACLARNRef *xpv1.Reference `json:"webACLARNRef,omitempty"`
+
+	// WebACLARNSelector selects a reference to a WebACL used to set WebACLARN.
+	// +optional
+	WebACLARNSelector *xpv1.Selector `json:"webACLARNSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the resource to associate with the web
+	// ACL, for example an Application Load Balancer, an Amazon API Gateway REST
+	// API stage or an Amazon Cognito user pool. CloudFront distributions are
+	// associated through the webACLID of their distribution config instead.
+	// +immutable
+	// +optional
+	ResourceARN *string `json:"resourceARN,omitempty"`
+
+	// LoadBalancerRef is a reference to an elbv2 LoadBalancer used to set
+	// ResourceARN.
+	// +optional
+	LoadBalancerRef *xpv1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects a reference to an elbv2 LoadBalancer used
+	// to set ResourceARN.
+	// +optional
+	LoadBalancerSelector *xpv1.Selector `json:"loadBalancerSelector,omitempty"`
+
+	// StageRef is a reference to an API Gateway Stage used to set ResourceARN.
+	// +optional
+	StageRef *xpv1.Reference `json:"stageRef,omitempty"`
+
+	// StageSelector selects a reference to an API Gateway Stage used to set
+	// ResourceARN.
+	// +optional
+	StageSelector *xpv1.Selector `json:"stageSelector,omitempty"`
+
+	// UserPoolRef is a reference to a Cognito UserPool used to set ResourceARN.
+	// +optional
+	UserPoolRef *xpv1.Reference `json:"userPoolRef,omitempty"`
+
+	// UserPoolSelector selects a reference to a Cognito UserPool used to set
+	// ResourceARN.
+	// +optional
+	UserPoolSelector *xpv1.Selector `json:"userPoolSelector,omitempty"`
+}
+
+// WebACLAssociationSpec defines the desired state of WebACLAssociation
+type WebACLAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebACLAssociationParameters `json:"forProvider"`
+}
+
+// WebACLAssociationObservation defines the observed state of
+// WebACLAssociation
+type WebACLAssociationObservation struct{}
+
+// WebACLAssociationStatus defines the observed state of WebACLAssociation.
+type WebACLAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebACLAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLAssociation associates a regional WebACL with a resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WebACLAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              WebACLAssociationSpec   `json:"spec"`
+	Status            WebACLAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLAssociationList contains a list of WebACLAssociations
+type WebACLAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACLAssociation `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	WebACLAssociationKind             = "WebACLAssociation"
+	WebACLAssociationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: WebACLAssociationKind}.String()
+	WebACLAssociationKindAPIVersion   = WebACLAssociationKind + "." + GroupVersion.String()
+	WebACLAssociationGroupVersionKind = GroupVersion.WithKind(WebACLAssociationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WebACLAssociation{}, &WebACLAssociationList{})
+}
@@ -0,0 +1,1771 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowAction) DeepCopyInto(out *AllowAction) {
+	*out = *in
+	if in.CustomRequestHandling != nil {
+		in, out := &in.CustomRequestHandling, &out.CustomRequestHandling
+		*out = new(CustomRequestHandling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowAction.
+func (in *AllowAction) DeepCopy() *AllowAction {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockAction) DeepCopyInto(out *BlockAction) {
+	*out = *in
+	if in.CustomResponse != nil {
+		in, out := &in.CustomResponse, &out.CustomResponse
+		*out = new(CustomResponse)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockAction.
+func (in *BlockAction) DeepCopy() *BlockAction {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptchaAction) DeepCopyInto(out *CaptchaAction) {
+	*out = *in
+	if in.CustomRequestHandling != nil {
+		in, out := &in.CustomRequestHandling, &out.CustomRequestHandling
+		*out = new(CustomRequestHandling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptchaAction.
+func (in *CaptchaAction) DeepCopy() *CaptchaAction {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptchaAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptchaConfig) DeepCopyInto(out *CaptchaConfig) {
+	*out = *in
+	if in.ImmunityTimeProperty != nil {
+		in, out := &in.ImmunityTimeProperty, &out.ImmunityTimeProperty
+		*out = new(ImmunityTimeProperty)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptchaConfig.
+func (in *CaptchaConfig) DeepCopy() *CaptchaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptchaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChallengeAction) DeepCopyInto(out *ChallengeAction) {
+	*out = *in
+	if in.CustomRequestHandling != nil {
+		in, out := &in.CustomRequestHandling, &out.CustomRequestHandling
+		*out = new(CustomRequestHandling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChallengeAction.
+func (in *ChallengeAction) DeepCopy() *ChallengeAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ChallengeAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChallengeConfig) DeepCopyInto(out *ChallengeConfig) {
+	*out = *in
+	if in.ImmunityTimeProperty != nil {
+		in, out := &in.ImmunityTimeProperty, &out.ImmunityTimeProperty
+		*out = new(ImmunityTimeProperty)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChallengeConfig.
+func (in *ChallengeConfig) DeepCopy() *ChallengeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ChallengeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CountAction) DeepCopyInto(out *CountAction) {
+	*out = *in
+	if in.CustomRequestHandling != nil {
+		in, out := &in.CustomRequestHandling, &out.CustomRequestHandling
+		*out = new(CustomRequestHandling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CountAction.
+func (in *CountAction) DeepCopy() *CountAction {
+	if in == nil {
+		return nil
+	}
+	out := new(CountAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomHTTPHeader) DeepCopyInto(out *CustomHTTPHeader) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHTTPHeader.
+func (in *CustomHTTPHeader) DeepCopy() *CustomHTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomHTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomIPSetParameters) DeepCopyInto(out *CustomIPSetParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomIPSetParameters.
+func (in *CustomIPSetParameters) DeepCopy() *CustomIPSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomIPSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRegexPatternSetParameters) DeepCopyInto(out *CustomRegexPatternSetParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRegexPatternSetParameters.
+func (in *CustomRegexPatternSetParameters) DeepCopy() *CustomRegexPatternSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRegexPatternSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRequestHandling) DeepCopyInto(out *CustomRequestHandling) {
+	*out = *in
+	if in.InsertHeaders != nil {
+		in, out := &in.InsertHeaders, &out.InsertHeaders
+		*out = make([]*CustomHTTPHeader, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CustomHTTPHeader)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRequestHandling.
+func (in *CustomRequestHandling) DeepCopy() *CustomRequestHandling {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRequestHandling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResponse) DeepCopyInto(out *CustomResponse) {
+	*out = *in
+	if in.CustomResponseBodyKey != nil {
+		in, out := &in.CustomResponseBodyKey, &out.CustomResponseBodyKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResponseCode != nil {
+		in, out := &in.ResponseCode, &out.ResponseCode
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]*CustomHTTPHeader, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CustomHTTPHeader)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponse.
+func (in *CustomResponse) DeepCopy() *CustomResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResponseBody) DeepCopyInto(out *CustomResponseBody) {
+	*out = *in
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponseBody.
+func (in *CustomResponseBody) DeepCopy() *CustomResponseBody {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResponseBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRuleGroupParameters) DeepCopyInto(out *CustomRuleGroupParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*Rule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Rule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VisibilityConfig != nil {
+		in, out := &in.VisibilityConfig, &out.VisibilityConfig
+		*out = new(VisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomResponseBodies != nil {
+		in, out := &in.CustomResponseBodies, &out.CustomResponseBodies
+		*out = make(map[string]*CustomResponseBody, len(*in))
+		for key, val := range *in {
+			var outVal *CustomResponseBody
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(CustomResponseBody)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRuleGroupParameters.
+func (in *CustomRuleGroupParameters) DeepCopy() *CustomRuleGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRuleGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomWebACLParameters) DeepCopyInto(out *CustomWebACLParameters) {
+	*out = *in
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(DefaultAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*Rule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Rule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VisibilityConfig != nil {
+		in, out := &in.VisibilityConfig, &out.VisibilityConfig
+		*out = new(VisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomResponseBodies != nil {
+		in, out := &in.CustomResponseBodies, &out.CustomResponseBodies
+		*out = make(map[string]*CustomResponseBody, len(*in))
+		for key, val := range *in {
+			var outVal *CustomResponseBody
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(CustomResponseBody)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.CaptchaConfig != nil {
+		in, out := &in.CaptchaConfig, &out.CaptchaConfig
+		*out = new(CaptchaConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChallengeConfig != nil {
+		in, out := &in.ChallengeConfig, &out.ChallengeConfig
+		*out = new(ChallengeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomWebACLParameters.
+func (in *CustomWebACLParameters) DeepCopy() *CustomWebACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomWebACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultAction) DeepCopyInto(out *DefaultAction) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = new(AllowAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(BlockAction)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultAction.
+func (in *DefaultAction) DeepCopy() *DefaultAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSet) DeepCopyInto(out *IPSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSet.
+func (in *IPSet) DeepCopy() *IPSet {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetList) DeepCopyInto(out *IPSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetList.
+func (in *IPSetList) DeepCopy() *IPSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetObservation) DeepCopyInto(out *IPSetObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetObservation.
+func (in *IPSetObservation) DeepCopy() *IPSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetParameters) DeepCopyInto(out *IPSetParameters) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddressVersion != nil {
+		in, out := &in.IPAddressVersion, &out.IPAddressVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	out.CustomIPSetParameters = in.CustomIPSetParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetParameters.
+func (in *IPSetParameters) DeepCopy() *IPSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetSpec) DeepCopyInto(out *IPSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetSpec.
+func (in *IPSetSpec) DeepCopy() *IPSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetStatus) DeepCopyInto(out *IPSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetStatus.
+func (in *IPSetStatus) DeepCopy() *IPSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetSummary) DeepCopyInto(out *IPSetSummary) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetSummary.
+func (in *IPSetSummary) DeepCopy() *IPSetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSet_SDK) DeepCopyInto(out *IPSet_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddressVersion != nil {
+		in, out := &in.IPAddressVersion, &out.IPAddressVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSet_SDK.
+func (in *IPSet_SDK) DeepCopy() *IPSet_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSet_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmunityTimeProperty) DeepCopyInto(out *ImmunityTimeProperty) {
+	*out = *in
+	if in.ImmunityTime != nil {
+		in, out := &in.ImmunityTime, &out.ImmunityTime
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmunityTimeProperty.
+func (in *ImmunityTimeProperty) DeepCopy() *ImmunityTimeProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmunityTimeProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Label) DeepCopyInto(out *Label) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
+func (in *Label) DeepCopy() *Label {
+	if in == nil {
+		return nil
+	}
+	out := new(Label)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoneAction) DeepCopyInto(out *NoneAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoneAction.
+func (in *NoneAction) DeepCopy() *NoneAction {
+	if in == nil {
+		return nil
+	}
+	out := new(NoneAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideAction) DeepCopyInto(out *OverrideAction) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(CountAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.None != nil {
+		in, out := &in.None, &out.None
+		*out = new(NoneAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideAction.
+func (in *OverrideAction) DeepCopy() *OverrideAction {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Regex) DeepCopyInto(out *Regex) {
+	*out = *in
+	if in.RegexString != nil {
+		in, out := &in.RegexString, &out.RegexString
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Regex.
+func (in *Regex) DeepCopy() *Regex {
+	if in == nil {
+		return nil
+	}
+	out := new(Regex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSet) DeepCopyInto(out *RegexPatternSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSet.
+func (in *RegexPatternSet) DeepCopy() *RegexPatternSet {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegexPatternSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSetList) DeepCopyInto(out *RegexPatternSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RegexPatternSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSetList.
+func (in *RegexPatternSetList) DeepCopy() *RegexPatternSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegexPatternSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSetObservation) DeepCopyInto(out *RegexPatternSetObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSetObservation.
+func (in *RegexPatternSetObservation) DeepCopy() *RegexPatternSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSetParameters) DeepCopyInto(out *RegexPatternSetParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegularExpressionList != nil {
+		in, out := &in.RegularExpressionList, &out.RegularExpressionList
+		*out = make([]*Regex, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Regex)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	out.CustomRegexPatternSetParameters = in.CustomRegexPatternSetParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSetParameters.
+func (in *RegexPatternSetParameters) DeepCopy() *RegexPatternSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSetSpec) DeepCopyInto(out *RegexPatternSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSetSpec.
+func (in *RegexPatternSetSpec) DeepCopy() *RegexPatternSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSetStatus) DeepCopyInto(out *RegexPatternSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSetStatus.
+func (in *RegexPatternSetStatus) DeepCopy() *RegexPatternSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPatternSetSummary) DeepCopyInto(out *RegexPatternSetSummary) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPatternSetSummary.
+func (in *RegexPatternSetSummary) DeepCopy() *RegexPatternSetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPatternSetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(RuleAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OverrideAction != nil {
+		in, out := &in.OverrideAction, &out.OverrideAction
+		*out = new(OverrideAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuleLabels != nil {
+		in, out := &in.RuleLabels, &out.RuleLabels
+		*out = make([]*Label, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Label)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.VisibilityConfig.DeepCopyInto(&out.VisibilityConfig)
+	if in.CaptchaConfig != nil {
+		in, out := &in.CaptchaConfig, &out.CaptchaConfig
+		*out = new(CaptchaConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChallengeConfig != nil {
+		in, out := &in.ChallengeConfig, &out.ChallengeConfig
+		*out = new(ChallengeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleAction) DeepCopyInto(out *RuleAction) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = new(AllowAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(BlockAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Captcha != nil {
+		in, out := &in.Captcha, &out.Captcha
+		*out = new(CaptchaAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Challenge != nil {
+		in, out := &in.Challenge, &out.Challenge
+		*out = new(ChallengeAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(CountAction)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleAction.
+func (in *RuleAction) DeepCopy() *RuleAction {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroup) DeepCopyInto(out *RuleGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroup.
+func (in *RuleGroup) DeepCopy() *RuleGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuleGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupList) DeepCopyInto(out *RuleGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupList.
+func (in *RuleGroupList) DeepCopy() *RuleGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuleGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupObservation) DeepCopyInto(out *RuleGroupObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelNamespace != nil {
+		in, out := &in.LabelNamespace, &out.LabelNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupObservation.
+func (in *RuleGroupObservation) DeepCopy() *RuleGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupParameters) DeepCopyInto(out *RuleGroupParameters) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.CustomRuleGroupParameters.DeepCopyInto(&out.CustomRuleGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupParameters.
+func (in *RuleGroupParameters) DeepCopy() *RuleGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupSpec) DeepCopyInto(out *RuleGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupSpec.
+func (in *RuleGroupSpec) DeepCopy() *RuleGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupStatus) DeepCopyInto(out *RuleGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupStatus.
+func (in *RuleGroupStatus) DeepCopy() *RuleGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupSummary) DeepCopyInto(out *RuleGroupSummary) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupSummary.
+func (in *RuleGroupSummary) DeepCopy() *RuleGroupSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VisibilityConfig) DeepCopyInto(out *VisibilityConfig) {
+	*out = *in
+	if in.CloudWatchMetricsEnabled != nil {
+		in, out := &in.CloudWatchMetricsEnabled, &out.CloudWatchMetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetricName != nil {
+		in, out := &in.MetricName, &out.MetricName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SampledRequestsEnabled != nil {
+		in, out := &in.SampledRequestsEnabled, &out.SampledRequestsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VisibilityConfig.
+func (in *VisibilityConfig) DeepCopy() *VisibilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VisibilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACL) DeepCopyInto(out *WebACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACL.
+func (in *WebACL) DeepCopy() *WebACL {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociation) DeepCopyInto(out *WebACLAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociation.
+func (in *WebACLAssociation) DeepCopy() *WebACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationList) DeepCopyInto(out *WebACLAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebACLAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationList.
+func (in *WebACLAssociationList) DeepCopy() *WebACLAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationObservation) DeepCopyInto(out *WebACLAssociationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationObservation.
+func (in *WebACLAssociationObservation) DeepCopy() *WebACLAssociationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationParameters) DeepCopyInto(out *WebACLAssociationParameters) {
+	*out = *in
+	if in.WebACLARN != nil {
+		in, out := &in.WebACLARN, &out.WebACLARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebACLARNRef != nil {
+		in, out := &in.WebACLARNRef, &out.WebACLARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebACLARNSelector != nil {
+		in, out := &in.WebACLARNSelector, &out.WebACLARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceARN != nil {
+		in, out := &in.ResourceARN, &out.ResourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancerRef != nil {
+		in, out := &in.LoadBalancerRef, &out.LoadBalancerRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerSelector != nil {
+		in, out := &in.LoadBalancerSelector, &out.LoadBalancerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StageRef != nil {
+		in, out := &in.StageRef, &out.StageRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StageSelector != nil {
+		in, out := &in.StageSelector, &out.StageSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserPoolRef != nil {
+		in, out := &in.UserPoolRef, &out.UserPoolRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserPoolSelector != nil {
+		in, out := &in.UserPoolSelector, &out.UserPoolSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationParameters.
+func (in *WebACLAssociationParameters) DeepCopy() *WebACLAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationSpec) DeepCopyInto(out *WebACLAssociationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationSpec.
+func (in *WebACLAssociationSpec) DeepCopy() *WebACLAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationStatus) DeepCopyInto(out *WebACLAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationStatus.
+func (in *WebACLAssociationStatus) DeepCopy() *WebACLAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLList) DeepCopyInto(out *WebACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLList.
+func (in *WebACLList) DeepCopy() *WebACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLObservation) DeepCopyInto(out *WebACLObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelNamespace != nil {
+		in, out := &in.LabelNamespace, &out.LabelNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedByFirewallManager != nil {
+		in, out := &in.ManagedByFirewallManager, &out.ManagedByFirewallManager
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLObservation.
+func (in *WebACLObservation) DeepCopy() *WebACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLParameters) DeepCopyInto(out *WebACLParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TokenDomains != nil {
+		in, out := &in.TokenDomains, &out.TokenDomains
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	in.CustomWebACLParameters.DeepCopyInto(&out.CustomWebACLParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLParameters.
+func (in *WebACLParameters) DeepCopy() *WebACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLSpec) DeepCopyInto(out *WebACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLSpec.
+func (in *WebACLSpec) DeepCopy() *WebACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLStatus) DeepCopyInto(out *WebACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLStatus.
+func (in *WebACLStatus) DeepCopy() *WebACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLSummary) DeepCopyInto(out *WebACLSummary) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockToken != nil {
+		in, out := &in.LockToken, &out.LockToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLSummary.
+func (in *WebACLSummary) DeepCopy() *WebACLSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLSummary)
+	in.DeepCopyInto(out)
+	return out
+}
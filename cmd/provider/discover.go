@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"io"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis"
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/discovery"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+)
+
+const (
+	errGetRestConfig  = "cannot get API server rest config"
+	errAddToScheme    = "cannot add APIs to scheme"
+	errNewClient      = "cannot create API server client"
+	errGetPC          = "cannot get ProviderConfig"
+	errGetCredentials = "cannot get credentials of ProviderConfig"
+)
+
+// discoveryOptions returns the options of the discovery.
+func discoveryOptions(providerConfig, region string, managementPolicies []string) discovery.Options {
+	o := discovery.Options{ProviderConfig: providerConfig, Region: region}
+	for _, p := range managementPolicies {
+		o.ManagementPolicies = append(o.ManagementPolicies, xpv1.ManagementAction(p))
+	}
+	return o
+}
+
+// discover writes the existing resources of the given kinds as managed
+// resources to w. It uses the credentials of the ProviderConfig, but does not
+// track its usage. Resources that are skipped are logged to log.
+func discover(ctx context.Context, o discovery.Options, kinds []string, w io.Writer, log logging.Logger) error {
+	rc, err := ctrl.GetConfig()
+	if err != nil {
+		return errors.Wrap(err, errGetRestConfig)
+	}
+	// The client-go scheme is needed to read the credentials secrets.
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		return errors.Wrap(err, errAddToScheme)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		return errors.Wrap(err, errAddToScheme)
+	}
+	kube, err := client.New(rc, client.Options{Scheme: s})
+	if err != nil {
+		return errors.Wrap(err, errNewClient)
+	}
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: o.ProviderConfig}, pc); err != nil {
+		return errors.Wrap(err, errGetPC)
+	}
+	cfg, err := connectaws.UseProviderConfigCredentials(ctx, kube, pc, o.Region)
+	if err != nil {
+		return errors.Wrap(err, errGetCredentials)
+	}
+
+	discoverers, err := discovery.New(*cfg, log, kinds)
+	if err != nil {
+		return err
+	}
+	mgs, err := discovery.Discover(ctx, o, discoverers...)
+	if err != nil {
+		return err
+	}
+	return discovery.Write(w, mgs)
+}
@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -41,6 +42,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller"
+	"github.com/crossplane-contrib/provider-aws/pkg/discovery"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/metrics"
 )
@@ -57,8 +59,22 @@ func main() {
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+
+		_ = app.Command("run", "Run the controller manager.").Default()
+
+		discoverCmd                = app.Command("discover", "Print existing AWS resources as managed resources that observe them.")
+		discoverProviderConfig     = discoverCmd.Flag("provider-config", "Name of the ProviderConfig whose credentials are used and that the managed resources reference.").Default("default").String()
+		discoverRegion             = discoverCmd.Flag("region", "Region in which resources are discovered.").Required().String()
+		discoverKinds              = discoverCmd.Flag("kind", "Kind of the resources to discover. Can be repeated. Supported kinds are "+strings.Join(discovery.Kinds(), ", ")+".").Required().Strings()
+		discoverManagementPolicies = discoverCmd.Flag("management-policy", "Management policy of the managed resources. Can be repeated.").Default(string(xpv1.ManagementActionObserve)).Strings()
 	)
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	if kingpin.MustParse(app.Parse(os.Args[1:])) == discoverCmd.FullCommand() {
+		// The managed resources are written to stdout, so the log is
+		// written to stderr.
+		log := logging.NewLogrLogger(zap.New(zap.UseDevMode(*debug), zap.WriteTo(os.Stderr)).WithName("discover"))
+		kingpin.FatalIfError(discover(context.Background(), discoveryOptions(*discoverProviderConfig, *discoverRegion, *discoverManagementPolicies), *discoverKinds, os.Stdout, log), "Cannot discover resources")
+		return
+	}
 
 	zl := zap.New(zap.UseDevMode(*debug), UseISO8601())
 	log := logging.NewLogrLogger(zl.WithName("provider-aws"))
@@ -8,6 +8,7 @@ require (
 	github.com/aws/aws-sdk-go-v2 v1.31.0
 	github.com/aws/aws-sdk-go-v2/config v1.27.39
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.37
+	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.33.4
 	github.com/aws/aws-sdk-go-v2/service/acm v1.29.3
 	github.com/aws/aws-sdk-go-v2/service/acmpca v1.36.3
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.54.3
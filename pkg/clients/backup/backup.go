@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/backup/backupiface"
+	"github.com/pkg/errors"
+
+	tagutils "github.com/crossplane-contrib/provider-aws/pkg/utils/tags"
+)
+
+const (
+	errListTags    = "cannot list tags"
+	errTagResource = "cannot tag resource"
+	errUntag       = "cannot untag resource"
+)
+
+// ListTags returns all tags of the Backup resource with the given ARN.
+func ListTags(ctx context.Context, client backupiface.BackupAPI, arn *string) (map[string]*string, error) {
+	tags := map[string]*string{}
+	input := &svcsdk.ListTagsInput{ResourceArn: arn}
+	for {
+		resp, err := client.ListTagsWithContext(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, errListTags)
+		}
+		for k, v := range resp.Tags {
+			tags[k] = v
+		}
+		if aws.StringValue(resp.NextToken) == "" {
+			return tags, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+// UpdateTags brings the tags of the Backup resource with the given ARN in
+// line with the desired tags.
+func UpdateTags(ctx context.Context, client backupiface.BackupAPI, arn *string, spec map[string]*string) error {
+	current, err := ListTags(ctx, client, arn)
+	if err != nil {
+		return err
+	}
+	add, remove := tagutils.DiffTagsMapPtr(spec, current)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceArn: arn, TagKeyList: remove}); err != nil {
+			return errors.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceArn: arn, Tags: add}); err != nil {
+			return errors.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+// AreTagsUpToDate returns whether the current tags of the Backup resource
+// with the given ARN match the desired tags.
+func AreTagsUpToDate(ctx context.Context, client backupiface.BackupAPI, arn *string, spec map[string]*string) (bool, error) {
+	current, err := ListTags(ctx, client, arn)
+	if err != nil {
+		return false, err
+	}
+	add, remove := tagutils.DiffTagsMapPtr(spec, current)
+	return len(add) == 0 && len(remove) == 0, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/backup/backupiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type mockTagClient struct {
+	backupiface.BackupAPI
+	tags   map[string]*string
+	tagged map[string]*string
+	untag  []*string
+}
+
+func (m *mockTagClient) ListTagsWithContext(_ context.Context, _ *svcsdk.ListTagsInput, _ ...request.Option) (*svcsdk.ListTagsOutput, error) {
+	return &svcsdk.ListTagsOutput{Tags: m.tags}, nil
+}
+
+func (m *mockTagClient) TagResourceWithContext(_ context.Context, in *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	m.tagged = in.Tags
+	return &svcsdk.TagResourceOutput{}, nil
+}
+
+func (m *mockTagClient) UntagResourceWithContext(_ context.Context, in *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	m.untag = in.TagKeyList
+	return &svcsdk.UntagResourceOutput{}, nil
+}
+
+func TestUpdateTags(t *testing.T) {
+	type want struct {
+		upToDate bool
+		tagged   map[string]*string
+		untag    []*string
+	}
+	cases := map[string]struct {
+		spec    map[string]*string
+		current map[string]*string
+		want    want
+	}{
+		"UpToDate": {
+			spec:    map[string]*string{"k": aws.String("v")},
+			current: map[string]*string{"k": aws.String("v")},
+			want: want{
+				upToDate: true,
+			},
+		},
+		"AddChangeAndRemove": {
+			spec: map[string]*string{
+				"added":   aws.String("v"),
+				"changed": aws.String("new"),
+			},
+			current: map[string]*string{
+				"changed": aws.String("old"),
+				"removed": aws.String("v"),
+			},
+			want: want{
+				tagged: map[string]*string{
+					"added":   aws.String("v"),
+					"changed": aws.String("new"),
+				},
+				untag: []*string{aws.String("changed"), aws.String("removed")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockTagClient{tags: tc.current}
+			upToDate, err := AreTagsUpToDate(context.Background(), client, aws.String("arn"), tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			if err := UpdateTags(context.Background(), client, aws.String("arn"), tc.spec); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.tagged, client.tagged, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untag, client.untag, cmpopts.SortSlices(func(a, b *string) bool { return aws.StringValue(a) < aws.StringValue(b) })); diff != "" {
+				t.Errorf("untag: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+// AMILookupClient is the external client used for AMILookup Custom Resource
+type AMILookupClient interface {
+	DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+}
+
+// NewAMILookupClient returns a new client using AWS credentials as JSON encoded data.
+func NewAMILookupClient(cfg aws.Config) AMILookupClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// GenerateDescribeImagesInput returns the input that looks up the AMIs of an
+// AMILookup.
+func GenerateDescribeImagesInput(p manualv1alpha1.AMILookupParameters) *ec2.DescribeImagesInput {
+	return &ec2.DescribeImagesInput{
+		Owners:            p.Owners,
+		Filters:           BuildLookupFilters(p.Filters),
+		IncludeDeprecated: p.IncludeDeprecated,
+	}
+}
+
+// LatestImage returns the most recently created image, or nil if there are no
+// images. Images that are created at the same time are ordered by their ID.
+func LatestImage(images []ec2types.Image) *ec2types.Image {
+	var latest *ec2types.Image
+	for i := range images {
+		img := &images[i]
+		if latest == nil {
+			latest = img
+			continue
+		}
+		// Creation dates are ISO 8601 timestamps, i.e. they sort
+		// lexicographically.
+		created, latestCreated := aws.ToString(img.CreationDate), aws.ToString(latest.CreationDate)
+		if created > latestCreated || (created == latestCreated && aws.ToString(img.ImageId) > aws.ToString(latest.ImageId)) {
+			latest = img
+		}
+	}
+	return latest
+}
+
+// GenerateAMILookupObservation returns the observation of the given image.
+func GenerateAMILookupObservation(img ec2types.Image) manualv1alpha1.AMILookupObservation {
+	return manualv1alpha1.AMILookupObservation{
+		ImageID:      aws.ToString(img.ImageId),
+		Name:         aws.ToString(img.Name),
+		Description:  aws.ToString(img.Description),
+		Architecture: string(img.Architecture),
+		CreationDate: aws.ToString(img.CreationDate),
+		OwnerID:      aws.ToString(img.OwnerId),
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+// AvailabilityZonesClient is the external client used for AvailabilityZones Custom Resource
+type AvailabilityZonesClient interface {
+	DescribeAvailabilityZones(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput, opts ...func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput, error)
+}
+
+// NewAvailabilityZonesClient returns a new client using AWS credentials as JSON encoded data.
+func NewAvailabilityZonesClient(cfg aws.Config) AvailabilityZonesClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// GenerateDescribeAvailabilityZonesInput returns the input that looks up the
+// zones of an AvailabilityZones.
+func GenerateDescribeAvailabilityZonesInput(p manualv1alpha1.AvailabilityZonesParameters) *ec2.DescribeAvailabilityZonesInput {
+	return &ec2.DescribeAvailabilityZonesInput{
+		AllAvailabilityZones: p.AllAvailabilityZones,
+		Filters:              BuildLookupFilters(p.Filters),
+	}
+}
+
+// GenerateAvailabilityZonesObservation returns the observation of the given
+// zones, sorted by name.
+func GenerateAvailabilityZonesObservation(zones []ec2types.AvailabilityZone) manualv1alpha1.AvailabilityZonesObservation {
+	sorted := make([]ec2types.AvailabilityZone, len(zones))
+	copy(sorted, zones)
+	sort.Slice(sorted, func(i, j int) bool {
+		return aws.ToString(sorted[i].ZoneName) < aws.ToString(sorted[j].ZoneName)
+	})
+
+	o := manualv1alpha1.AvailabilityZonesObservation{}
+	for _, z := range sorted {
+		o.Names = append(o.Names, aws.ToString(z.ZoneName))
+		o.ZoneIDs = append(o.ZoneIDs, aws.ToString(z.ZoneId))
+	}
+	return o
+}
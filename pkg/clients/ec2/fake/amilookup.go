@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AMILookupClient = (*MockAMILookupClient)(nil)
+
+// MockAMILookupClient is a type that implements all the methods for AMILookupClient interface
+type MockAMILookupClient struct {
+	MockDescribeImages func(ctx context.Context, input *ec2.DescribeImagesInput, opts []func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+}
+
+// DescribeImages mocks DescribeImages method
+func (m *MockAMILookupClient) DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	return m.MockDescribeImages(ctx, input, opts)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AvailabilityZonesClient = (*MockAvailabilityZonesClient)(nil)
+
+// MockAvailabilityZonesClient is a type that implements all the methods for AvailabilityZonesClient interface
+type MockAvailabilityZonesClient struct {
+	MockDescribeAvailabilityZones func(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput, opts []func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput, error)
+}
+
+// DescribeAvailabilityZones mocks DescribeAvailabilityZones method
+func (m *MockAvailabilityZonesClient) DescribeAvailabilityZones(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput, opts ...func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	return m.MockDescribeAvailabilityZones(ctx, input, opts)
+}
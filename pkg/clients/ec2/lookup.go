@@ -0,0 +1,36 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+// BuildLookupFilters returns the EC2 filters of a lookup.
+func BuildLookupFilters(filters []manualv1alpha1.Filter) []ec2types.Filter {
+	if len(filters) == 0 {
+		return nil
+	}
+	res := make([]ec2types.Filter, len(filters))
+	for i, f := range filters {
+		res[i] = ec2types.Filter{Name: aws.String(f.Name), Values: f.Values}
+	}
+	return res
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestLatestImage(t *testing.T) {
+	cases := map[string]struct {
+		images []ec2types.Image
+		want   *ec2types.Image
+	}{
+		"NoImages": {},
+		"MostRecent": {
+			images: []ec2types.Image{
+				{ImageId: aws.String("ami-1"), CreationDate: aws.String("2024-01-01T00:00:00.000Z")},
+				{ImageId: aws.String("ami-2"), CreationDate: aws.String("2024-03-01T00:00:00.000Z")},
+				{ImageId: aws.String("ami-3"), CreationDate: aws.String("2024-02-01T00:00:00.000Z")},
+			},
+			want: &ec2types.Image{ImageId: aws.String("ami-2"), CreationDate: aws.String("2024-03-01T00:00:00.000Z")},
+		},
+		"SameCreationDate": {
+			images: []ec2types.Image{
+				{ImageId: aws.String("ami-b"), CreationDate: aws.String("2024-01-01T00:00:00.000Z")},
+				{ImageId: aws.String("ami-a"), CreationDate: aws.String("2024-01-01T00:00:00.000Z")},
+			},
+			want: &ec2types.Image{ImageId: aws.String("ami-b"), CreationDate: aws.String("2024-01-01T00:00:00.000Z")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LatestImage(tc.images)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2types.Image{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAvailabilityZonesObservation(t *testing.T) {
+	zones := []ec2types.AvailabilityZone{
+		{ZoneName: aws.String("eu-central-1b"), ZoneId: aws.String("euc1-az3")},
+		{ZoneName: aws.String("eu-central-1a"), ZoneId: aws.String("euc1-az2")},
+	}
+	want := manualv1alpha1.AvailabilityZonesObservation{
+		Names:   []string{"eu-central-1a", "eu-central-1b"},
+		ZoneIDs: []string{"euc1-az2", "euc1-az3"},
+	}
+	if diff := cmp.Diff(want, GenerateAvailabilityZonesObservation(zones)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateVPCLookupInput(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.VPCLookupParameters
+		want *ec2.DescribeVpcsInput
+	}{
+		"NoFilters": {
+			p:    manualv1alpha1.VPCLookupParameters{Region: "eu-central-1"},
+			want: &ec2.DescribeVpcsInput{},
+		},
+		"TagsAndFilters": {
+			p: manualv1alpha1.VPCLookupParameters{
+				Region:  "eu-central-1",
+				Tags:    []manualv1alpha1.Tag{{Key: "Name", Value: "shared"}},
+				Filters: []manualv1alpha1.Filter{{Name: "cidr-block", Values: []string{"10.0.0.0/16"}}},
+			},
+			want: &ec2.DescribeVpcsInput{
+				Filters: []ec2types.Filter{
+					{Name: aws.String("tag:Name"), Values: []string{"shared"}},
+					{Name: aws.String("cidr-block"), Values: []string{"10.0.0.0/16"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVPCLookupInput(tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.DescribeVpcsInput{}, ec2types.Filter{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
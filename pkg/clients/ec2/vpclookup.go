@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+// VPCLookupClient is the external client used for VPCLookup Custom Resource
+type VPCLookupClient interface {
+	DescribeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+}
+
+// NewVPCLookupClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCLookupClient(cfg aws.Config) VPCLookupClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// GenerateVPCLookupInput returns the input that looks up the VPCs of a
+// VPCLookup. Every tag is a filter on the value of the tag key.
+func GenerateVPCLookupInput(p manualv1alpha1.VPCLookupParameters) *ec2.DescribeVpcsInput {
+	filters := make([]ec2types.Filter, 0, len(p.Tags)+len(p.Filters))
+	for _, t := range p.Tags {
+		filters = append(filters, ec2types.Filter{Name: aws.String("tag:" + t.Key), Values: []string{t.Value}})
+	}
+	filters = append(filters, BuildLookupFilters(p.Filters)...)
+	if len(filters) == 0 {
+		filters = nil
+	}
+	return &ec2.DescribeVpcsInput{Filters: filters}
+}
+
+// GenerateVPCLookupObservation returns the observation of the given VPC.
+func GenerateVPCLookupObservation(vpc ec2types.Vpc) manualv1alpha1.VPCLookupObservation {
+	return manualv1alpha1.VPCLookupObservation{
+		VPCID:     aws.ToString(vpc.VpcId),
+		CIDRBlock: aws.ToString(vpc.CidrBlock),
+		IsDefault: aws.ToBool(vpc.IsDefault),
+		OwnerID:   aws.ToString(vpc.OwnerId),
+		State:     string(vpc.State),
+	}
+}
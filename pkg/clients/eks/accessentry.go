@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// GenerateCreateAccessEntryInput from AccessEntryParameters.
+func GenerateCreateAccessEntryInput(p *manualv1alpha1.AccessEntryParameters) *eks.CreateAccessEntryInput {
+	return &eks.CreateAccessEntryInput{
+		ClusterName:      &p.ClusterName,
+		PrincipalArn:     &p.PrincipalARN,
+		KubernetesGroups: p.KubernetesGroups,
+		Username:         p.Username,
+		Type:             p.Type,
+		Tags:             p.Tags,
+	}
+}
+
+// GenerateUpdateAccessEntryInput from AccessEntryParameters.
+func GenerateUpdateAccessEntryInput(principalARN string, p *manualv1alpha1.AccessEntryParameters) *eks.UpdateAccessEntryInput {
+	u := &eks.UpdateAccessEntryInput{
+		ClusterName:      &p.ClusterName,
+		PrincipalArn:     aws.String(principalARN),
+		KubernetesGroups: p.KubernetesGroups,
+		Username:         p.Username,
+	}
+	// A nil list leaves the groups of the access entry untouched, so send an
+	// empty one to remove all of them.
+	if u.KubernetesGroups == nil {
+		u.KubernetesGroups = []string{}
+	}
+	return u
+}
+
+// GenerateAccessEntryObservation is used to produce
+// manualv1alpha1.AccessEntryObservation from types.AccessEntry.
+func GenerateAccessEntryObservation(ae *types.AccessEntry) manualv1alpha1.AccessEntryObservation {
+	if ae == nil {
+		return manualv1alpha1.AccessEntryObservation{}
+	}
+	o := manualv1alpha1.AccessEntryObservation{
+		AccessEntryARN: pointer.StringValue(ae.AccessEntryArn),
+	}
+	if ae.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *ae.CreatedAt}
+	}
+	if ae.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *ae.ModifiedAt}
+	}
+	return o
+}
+
+// LateInitializeAccessEntry fills the empty fields in
+// *manualv1alpha1.AccessEntryParameters with the values seen in
+// types.AccessEntry.
+func LateInitializeAccessEntry(in *manualv1alpha1.AccessEntryParameters, ae *types.AccessEntry) {
+	if ae == nil {
+		return
+	}
+	in.Username = pointer.LateInitialize(in.Username, ae.Username)
+	in.Type = pointer.LateInitialize(in.Type, ae.Type)
+	if len(in.Tags) == 0 && len(ae.Tags) != 0 {
+		in.Tags = ae.Tags
+	}
+}
+
+// IsAccessEntryUpToDate checks whether the Kubernetes groups, the username or
+// the tags of the access entry differ from the desired state. Any other field
+// is immutable.
+func IsAccessEntryUpToDate(p *manualv1alpha1.AccessEntryParameters, ae *types.AccessEntry) bool {
+	if ae == nil {
+		return true
+	}
+	if p.Username != nil && pointer.StringValue(p.Username) != pointer.StringValue(ae.Username) {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if !cmp.Equal(p.KubernetesGroups, ae.KubernetesGroups, cmpopts.EquateEmpty(), sortStrings) {
+		return false
+	}
+	return cmp.Equal(p.Tags, ae.Tags, cmpopts.EquateEmpty())
+}
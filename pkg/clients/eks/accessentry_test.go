@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+)
+
+var (
+	aePrincipalARN = "arn:aws:iam::123456789012:role/team"
+	aeUsername     = "team"
+	aeType         = "STANDARD"
+)
+
+func TestGenerateUpdateAccessEntryInput(t *testing.T) {
+	cases := map[string]struct {
+		p    *manualv1alpha1.AccessEntryParameters
+		want *eks.UpdateAccessEntryInput
+	}{
+		"WithGroups": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				ClusterName:      clusterName,
+				KubernetesGroups: []string{"viewers"},
+				Username:         &aeUsername,
+			},
+			want: &eks.UpdateAccessEntryInput{
+				ClusterName:      &clusterName,
+				PrincipalArn:     &aePrincipalARN,
+				KubernetesGroups: []string{"viewers"},
+				Username:         &aeUsername,
+			},
+		},
+		"WithoutGroups": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				ClusterName: clusterName,
+			},
+			want: &eks.UpdateAccessEntryInput{
+				ClusterName:      &clusterName,
+				PrincipalArn:     &aePrincipalARN,
+				KubernetesGroups: []string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateAccessEntryInput(aePrincipalARN, tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(eks.UpdateAccessEntryInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAccessEntry(t *testing.T) {
+	cases := map[string]struct {
+		p    *manualv1alpha1.AccessEntryParameters
+		ae   *types.AccessEntry
+		want *manualv1alpha1.AccessEntryParameters
+	}{
+		"AllFieldsEmpty": {
+			p: &manualv1alpha1.AccessEntryParameters{},
+			ae: &types.AccessEntry{
+				Username: &aeUsername,
+				Type:     &aeType,
+				Tags:     map[string]string{"cool": "tag"},
+			},
+			want: &manualv1alpha1.AccessEntryParameters{
+				Username: &aeUsername,
+				Type:     &aeType,
+				Tags:     map[string]string{"cool": "tag"},
+			},
+		},
+		"NoOverride": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				Username: aws.String("other"),
+			},
+			ae: &types.AccessEntry{
+				Username: &aeUsername,
+			},
+			want: &manualv1alpha1.AccessEntryParameters{
+				Username: aws.String("other"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccessEntry(tc.p, tc.ae)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessEntryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *manualv1alpha1.AccessEntryParameters
+		ae   *types.AccessEntry
+		want bool
+	}{
+		"UpToDate": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"b", "a"},
+				Username:         &aeUsername,
+				Tags:             map[string]string{"cool": "tag"},
+			},
+			ae: &types.AccessEntry{
+				KubernetesGroups: []string{"a", "b"},
+				Username:         &aeUsername,
+				Tags:             map[string]string{"cool": "tag"},
+			},
+			want: true,
+		},
+		"UnsetUsername": {
+			p: &manualv1alpha1.AccessEntryParameters{},
+			ae: &types.AccessEntry{
+				Username: &aeUsername,
+			},
+			want: true,
+		},
+		"DifferentGroups": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"a"},
+			},
+			ae: &types.AccessEntry{
+				KubernetesGroups: []string{"a", "b"},
+			},
+			want: false,
+		},
+		"DifferentUsername": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				Username: aws.String("other"),
+			},
+			ae: &types.AccessEntry{
+				Username: &aeUsername,
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				Tags: map[string]string{"cool": "tag"},
+			},
+			ae:   &types.AccessEntry{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessEntryUpToDate(tc.p, tc.ae)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// GenerateAssociateAccessPolicyInput from AccessPolicyAssociationParameters.
+func GenerateAssociateAccessPolicyInput(policyARN string, p *manualv1alpha1.AccessPolicyAssociationParameters) *eks.AssociateAccessPolicyInput {
+	return &eks.AssociateAccessPolicyInput{
+		ClusterName:  &p.ClusterName,
+		PrincipalArn: &p.PrincipalARN,
+		PolicyArn:    aws.String(policyARN),
+		AccessScope: &types.AccessScope{
+			Type:       types.AccessScopeType(p.AccessScope.Type),
+			Namespaces: p.AccessScope.Namespaces,
+		},
+	}
+}
+
+// GenerateDisassociateAccessPolicyInput from AccessPolicyAssociationParameters.
+func GenerateDisassociateAccessPolicyInput(policyARN string, p *manualv1alpha1.AccessPolicyAssociationParameters) *eks.DisassociateAccessPolicyInput {
+	return &eks.DisassociateAccessPolicyInput{
+		ClusterName:  &p.ClusterName,
+		PrincipalArn: &p.PrincipalARN,
+		PolicyArn:    aws.String(policyARN),
+	}
+}
+
+// FindAssociatedAccessPolicy returns the associated access policy with the
+// given policy ARN, or nil if there is none.
+func FindAssociatedAccessPolicy(policyARN string, policies []types.AssociatedAccessPolicy) *types.AssociatedAccessPolicy {
+	for i := range policies {
+		if pointer.StringValue(policies[i].PolicyArn) == policyARN {
+			return &policies[i]
+		}
+	}
+	return nil
+}
+
+// GenerateAccessPolicyAssociationObservation is used to produce
+// manualv1alpha1.AccessPolicyAssociationObservation from
+// types.AssociatedAccessPolicy.
+func GenerateAccessPolicyAssociationObservation(ap *types.AssociatedAccessPolicy) manualv1alpha1.AccessPolicyAssociationObservation {
+	if ap == nil {
+		return manualv1alpha1.AccessPolicyAssociationObservation{}
+	}
+	o := manualv1alpha1.AccessPolicyAssociationObservation{}
+	if ap.AssociatedAt != nil {
+		o.AssociatedAt = &metav1.Time{Time: *ap.AssociatedAt}
+	}
+	if ap.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *ap.ModifiedAt}
+	}
+	return o
+}
+
+// IsAccessPolicyAssociationUpToDate checks whether the access scope of the
+// associated access policy differs from the desired state. Any other field is
+// immutable.
+func IsAccessPolicyAssociationUpToDate(p *manualv1alpha1.AccessPolicyAssociationParameters, ap *types.AssociatedAccessPolicy) bool {
+	if ap == nil || ap.AccessScope == nil {
+		return true
+	}
+	if string(ap.AccessScope.Type) != string(p.AccessScope.Type) {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return cmp.Equal(p.AccessScope.Namespaces, ap.AccessScope.Namespaces, cmpopts.EquateEmpty(), sortStrings)
+}
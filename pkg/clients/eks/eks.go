@@ -23,6 +23,7 @@ import (
 	"errors"
 	"net"
 	"slices"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
@@ -48,6 +49,11 @@ const (
 	expireHeader     = "X-Amz-Expires"
 	expireHeaderTime = "60"
 	v1Prefix         = "k8s-aws-v1."
+	execAPIVersion   = "client.authentication.k8s.io/v1beta1"
+
+	// TokenRefreshInterval is the interval after which a presigned token has
+	// to be refreshed. EKS accepts presigned tokens for 15 minutes.
+	TokenRefreshInterval = 10 * time.Minute
 )
 
 // Client defines EKS Client operations
@@ -66,6 +72,7 @@ type Client interface {
 	UpdateNodegroupVersion(ctx context.Context, input *eks.UpdateNodegroupVersionInput, opts ...func(*eks.Options)) (*eks.UpdateNodegroupVersionOutput, error)
 	UpdateNodegroupConfig(ctx context.Context, input *eks.UpdateNodegroupConfigInput, opts ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error)
 	DeleteNodegroup(ctx context.Context, input *eks.DeleteNodegroupInput, opts ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error)
+	DescribeUpdate(ctx context.Context, input *eks.DescribeUpdateInput, opts ...func(*eks.Options)) (*eks.DescribeUpdateOutput, error)
 
 	DescribeFargateProfile(ctx context.Context, input *eks.DescribeFargateProfileInput, opts ...func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
 	CreateFargateProfile(ctx context.Context, input *eks.CreateFargateProfileInput, opts ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
@@ -74,6 +81,20 @@ type Client interface {
 	DescribeIdentityProviderConfig(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	AssociateIdentityProviderConfig(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
 	DisassociateIdentityProviderConfig(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error)
+
+	DescribeAccessEntry(ctx context.Context, input *eks.DescribeAccessEntryInput, opts ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	CreateAccessEntry(ctx context.Context, input *eks.CreateAccessEntryInput, opts ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	UpdateAccessEntry(ctx context.Context, input *eks.UpdateAccessEntryInput, opts ...func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error)
+	DeleteAccessEntry(ctx context.Context, input *eks.DeleteAccessEntryInput, opts ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+
+	ListAssociatedAccessPolicies(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error)
+	AssociateAccessPolicy(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	DisassociateAccessPolicy(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error)
+
+	DescribePodIdentityAssociation(ctx context.Context, input *eks.DescribePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.DescribePodIdentityAssociationOutput, error)
+	CreatePodIdentityAssociation(ctx context.Context, input *eks.CreatePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.CreatePodIdentityAssociationOutput, error)
+	UpdatePodIdentityAssociation(ctx context.Context, input *eks.UpdatePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.UpdatePodIdentityAssociationOutput, error)
+	DeletePodIdentityAssociation(ctx context.Context, input *eks.DeletePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.DeletePodIdentityAssociationOutput, error)
 }
 
 // STSClient STS presigner
@@ -426,37 +447,28 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 	}
 	res := cmp.Equal(&v1beta1.ClusterParameters{}, patch, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region", "Kubeconfig"),
 		cmpopts.IgnoreFields(v1beta1.VpcConfigRequest{}, "PublicAccessCidrs"))
 	return res, nil
 }
 
+// GetKubeconfigStyle returns the kubeconfig style of the supplied
+// ClusterParameters, defaulting to KubeconfigStylePresignedToken.
+func GetKubeconfigStyle(p *v1beta1.ClusterParameters) v1beta1.KubeconfigStyle {
+	if p == nil || p.Kubeconfig == nil || p.Kubeconfig.Style == nil {
+		return v1beta1.KubeconfigStylePresignedToken
+	}
+	return *p.Kubeconfig.Style
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of ekstypes.Cluster.
-func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
+// The kubeconfig is generated according to the kubeconfig style of the
+// supplied ClusterParameters.
+func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, p *v1beta1.ClusterParameters, stsClient STSClient) managed.ConnectionDetails {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
 		return managed.ConnectionDetails{}
 	}
 
-	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
-		func(po *sts.PresignOptions) {
-			po.ClientOptions = []func(*sts.Options){
-				sts.WithAPIOptions(
-					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
-					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
-				),
-			}
-		},
-	)
-
-	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
-	// EKS cluster resource. Signing the URL means that anyone in possession of
-	// this Kubeconfig will now be able to access the EKS cluster until this URL
-	// expires. This is necessary for other systems, such as core Crossplane, to
-	// be able to schedule workloads to the cluster for now, but is not the most
-	// secure way of accessing the cluster.
-	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
-
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
 	// written as a Secret.
@@ -464,6 +476,25 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 	if err != nil {
 		return managed.ConnectionDetails{}
 	}
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(*cluster.Endpoint),
+		xpv1.ResourceCredentialsSecretCAKey:       caData,
+	}
+
+	var authInfo *clientcmdapi.AuthInfo
+	switch GetKubeconfigStyle(p) {
+	case v1beta1.KubeconfigStyleEndpointOnly:
+		return cd
+	case v1beta1.KubeconfigStyleExec:
+		authInfo = &clientcmdapi.AuthInfo{Exec: generateExecConfig(cluster, p)}
+	default:
+		token, err := generatePresignedToken(ctx, cluster, stsClient)
+		if err != nil {
+			return managed.ConnectionDetails{}
+		}
+		authInfo = &clientcmdapi.AuthInfo{Token: token}
+	}
+
 	kc := clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			*cluster.Name: {
@@ -478,9 +509,7 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: authInfo,
 		},
 		CurrentContext: *cluster.Name,
 	}
@@ -489,9 +518,52 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 	if err != nil {
 		return managed.ConnectionDetails{}
 	}
-	return managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
-		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
-		xpv1.ResourceCredentialsSecretCAKey:         caData,
+	cd[xpv1.ResourceCredentialsSecretKubeconfigKey] = rawConfig
+	return cd
+}
+
+// generatePresignedToken returns a bearer token for the supplied cluster
+// that is derived from a presigned STS GetCallerIdentity request.
+func generatePresignedToken(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) (string, error) {
+	getCallerIdentity, err := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(po *sts.PresignOptions) {
+			po.ClientOptions = []func(*sts.Options){
+				sts.WithAPIOptions(
+					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
+					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
+				),
+			}
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
+	// EKS cluster resource. Signing the URL means that anyone in possession of
+	// this Kubeconfig will now be able to access the EKS cluster until this URL
+	// expires. This is necessary for other systems, such as core Crossplane, to
+	// be able to schedule workloads to the cluster for now, but is not the most
+	// secure way of accessing the cluster. The Exec and EndpointOnly kubeconfig
+	// styles avoid publishing such a token.
+	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+	return v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL)), nil
+}
+
+// generateExecConfig returns an exec plugin configuration that obtains a
+// token for the supplied cluster by running "aws eks get-token".
+func generateExecConfig(cluster *ekstypes.Cluster, p *v1beta1.ClusterParameters) *clientcmdapi.ExecConfig {
+	args := []string{"eks", "get-token", "--cluster-name", *cluster.Name}
+	if p.Region != nil {
+		args = append(args, "--region", *p.Region)
+	}
+	if p.Kubeconfig != nil && p.Kubeconfig.RoleARN != nil {
+		args = append(args, "--role-arn", *p.Kubeconfig.RoleARN)
+	}
+	return &clientcmdapi.ExecConfig{
+		APIVersion:      execAPIVersion,
+		Command:         "aws",
+		Args:            args,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
 	}
 }
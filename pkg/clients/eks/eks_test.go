@@ -17,19 +17,28 @@ limitations under the License.
 package eks
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
 	"testing"
 	"time"
 
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go/document"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
 )
 
 var (
@@ -696,6 +705,17 @@ func TestIsUpToDate(t *testing.T) {
 		args args
 		want bool
 	}{
+		"IgnoresKubeconfig": {
+			args: args{
+				p: &v1beta1.ClusterParameters{
+					Kubeconfig: &v1beta1.KubeconfigOptions{
+						Style: ptr.To(v1beta1.KubeconfigStyleExec),
+					},
+				},
+				cluster: &ekstypes.Cluster{},
+			},
+			want: true,
+		},
 		"SameFields": {
 			args: args{
 				p: &v1beta1.ClusterParameters{
@@ -952,3 +972,104 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConnectionDetails(t *testing.T) {
+	endpoint := "https://my-cool-cluster.eks.amazonaws.com"
+	caData := []byte("ca-data")
+	region := "us-east-1"
+	cluster := &ekstypes.Cluster{
+		Name:     &clusterName,
+		Endpoint: &endpoint,
+		CertificateAuthority: &ekstypes.Certificate{
+			Data: ptr.To(base64.StdEncoding.EncodeToString(caData)),
+		},
+	}
+	stsClient := &fake.MockSTSClient{
+		MockPresignGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+			return &v4.PresignedHTTPRequest{URL: "https://sts.amazonaws.com/"}, nil
+		},
+	}
+	kubeconfig := func(authInfo *clientcmdapi.AuthInfo) []byte {
+		raw, _ := clientcmd.Write(clientcmdapi.Config{
+			Clusters:       map[string]*clientcmdapi.Cluster{clusterName: {Server: endpoint, CertificateAuthorityData: caData}},
+			Contexts:       map[string]*clientcmdapi.Context{clusterName: {Cluster: clusterName, AuthInfo: clusterName}},
+			AuthInfos:      map[string]*clientcmdapi.AuthInfo{clusterName: authInfo},
+			CurrentContext: clusterName,
+		})
+		return raw
+	}
+
+	cases := map[string]struct {
+		cluster *ekstypes.Cluster
+		p       *v1beta1.ClusterParameters
+		sts     STSClient
+		want    managed.ConnectionDetails
+	}{
+		"IncompleteCluster": {
+			cluster: &ekstypes.Cluster{Name: &clusterName},
+			p:       &v1beta1.ClusterParameters{},
+			want:    managed.ConnectionDetails{},
+		},
+		"DefaultPresignedToken": {
+			cluster: cluster,
+			p:       &v1beta1.ClusterParameters{},
+			sts:     stsClient,
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey:   []byte(endpoint),
+				xpv1.ResourceCredentialsSecretCAKey:         caData,
+				xpv1.ResourceCredentialsSecretKubeconfigKey: kubeconfig(&clientcmdapi.AuthInfo{Token: v1Prefix + base64.RawURLEncoding.EncodeToString([]byte("https://sts.amazonaws.com/"))}),
+			},
+		},
+		"PresignFailed": {
+			cluster: cluster,
+			p:       &v1beta1.ClusterParameters{},
+			sts: &fake.MockSTSClient{
+				MockPresignGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			want: managed.ConnectionDetails{},
+		},
+		"Exec": {
+			cluster: cluster,
+			p: &v1beta1.ClusterParameters{
+				Region: &region,
+				Kubeconfig: &v1beta1.KubeconfigOptions{
+					Style:   ptr.To(v1beta1.KubeconfigStyleExec),
+					RoleARN: &roleArn,
+				},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+				xpv1.ResourceCredentialsSecretCAKey:       caData,
+				xpv1.ResourceCredentialsSecretKubeconfigKey: kubeconfig(&clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{
+					APIVersion:      execAPIVersion,
+					Command:         "aws",
+					Args:            []string{"eks", "get-token", "--cluster-name", clusterName, "--region", region, "--role-arn", roleArn},
+					InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+				}}),
+			},
+		},
+		"EndpointOnly": {
+			cluster: cluster,
+			p: &v1beta1.ClusterParameters{
+				Kubeconfig: &v1beta1.KubeconfigOptions{
+					Style: ptr.To(v1beta1.KubeconfigStyleEndpointOnly),
+				},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+				xpv1.ResourceCredentialsSecretCAKey:       caData,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(context.Background(), tc.cluster, tc.p, tc.sts)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
@@ -40,6 +41,7 @@ type MockClient struct {
 	MockUpdateNodegroupVersion func(ctx context.Context, input *eks.UpdateNodegroupVersionInput, opts []func(*eks.Options)) (*eks.UpdateNodegroupVersionOutput, error)
 	MockUpdateNodegroupConfig  func(ctx context.Context, input *eks.UpdateNodegroupConfigInput, opts []func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error)
 	MockDeleteNodegroup        func(ctx context.Context, input *eks.DeleteNodegroupInput, opts []func(*eks.Options)) (*eks.DeleteNodegroupOutput, error)
+	MockDescribeUpdate         func(ctx context.Context, input *eks.DescribeUpdateInput, opts []func(*eks.Options)) (*eks.DescribeUpdateOutput, error)
 
 	MockDescribeFargateProfile func(ctx context.Context, input *eks.DescribeFargateProfileInput, opts []func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
 	MockCreateFargateProfile   func(ctx context.Context, input *eks.CreateFargateProfileInput, opts []func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
@@ -48,6 +50,20 @@ type MockClient struct {
 	MockDescribeIdentityProviderConfig     func(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	MockAssociateIdentityProviderConfig    func(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
 	MockDisassociateIdentityProviderConfig func(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error)
+
+	MockDescribeAccessEntry func(ctx context.Context, input *eks.DescribeAccessEntryInput, opts []func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	MockCreateAccessEntry   func(ctx context.Context, input *eks.CreateAccessEntryInput, opts []func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	MockUpdateAccessEntry   func(ctx context.Context, input *eks.UpdateAccessEntryInput, opts []func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error)
+	MockDeleteAccessEntry   func(ctx context.Context, input *eks.DeleteAccessEntryInput, opts []func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+
+	MockListAssociatedAccessPolicies func(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts []func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error)
+	MockAssociateAccessPolicy        func(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts []func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	MockDisassociateAccessPolicy     func(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts []func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error)
+
+	MockDescribePodIdentityAssociation func(ctx context.Context, input *eks.DescribePodIdentityAssociationInput, opts []func(*eks.Options)) (*eks.DescribePodIdentityAssociationOutput, error)
+	MockCreatePodIdentityAssociation   func(ctx context.Context, input *eks.CreatePodIdentityAssociationInput, opts []func(*eks.Options)) (*eks.CreatePodIdentityAssociationOutput, error)
+	MockUpdatePodIdentityAssociation   func(ctx context.Context, input *eks.UpdatePodIdentityAssociationInput, opts []func(*eks.Options)) (*eks.UpdatePodIdentityAssociationOutput, error)
+	MockDeletePodIdentityAssociation   func(ctx context.Context, input *eks.DeletePodIdentityAssociationInput, opts []func(*eks.Options)) (*eks.DeletePodIdentityAssociationOutput, error)
 }
 
 // MockSTSClient mock sts client
@@ -134,6 +150,11 @@ func (c *MockClient) DeleteNodegroup(ctx context.Context, input *eks.DeleteNodeg
 	return c.MockDeleteNodegroup(ctx, input, opts)
 }
 
+// DescribeUpdate calls the underlying MockDescribeUpdate method.
+func (c *MockClient) DescribeUpdate(ctx context.Context, input *eks.DescribeUpdateInput, opts ...func(*eks.Options)) (*eks.DescribeUpdateOutput, error) {
+	return c.MockDescribeUpdate(ctx, input, opts)
+}
+
 // DescribeFargateProfile calls the underlying MockDescribeFargateProfile
 // method.
 func (c *MockClient) DescribeFargateProfile(ctx context.Context, input *eks.DescribeFargateProfileInput, opts ...func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error) {
@@ -169,3 +190,80 @@ func (c *MockClient) AssociateIdentityProviderConfig(ctx context.Context, input
 func (c *MockClient) DisassociateIdentityProviderConfig(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error) {
 	return c.MockDisassociateIdentityProviderConfig(ctx, input, opts)
 }
+
+// DescribeAccessEntry calls the underlying MockDescribeAccessEntry
+// method.
+func (c *MockClient) DescribeAccessEntry(ctx context.Context, input *eks.DescribeAccessEntryInput, opts ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error) {
+	return c.MockDescribeAccessEntry(ctx, input, opts)
+}
+
+// CreateAccessEntry calls the underlying MockCreateAccessEntry
+// method.
+func (c *MockClient) CreateAccessEntry(ctx context.Context, input *eks.CreateAccessEntryInput, opts ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error) {
+	return c.MockCreateAccessEntry(ctx, input, opts)
+}
+
+// UpdateAccessEntry calls the underlying MockUpdateAccessEntry
+// method.
+func (c *MockClient) UpdateAccessEntry(ctx context.Context, input *eks.UpdateAccessEntryInput, opts ...func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error) {
+	return c.MockUpdateAccessEntry(ctx, input, opts)
+}
+
+// DeleteAccessEntry calls the underlying MockDeleteAccessEntry
+// method.
+func (c *MockClient) DeleteAccessEntry(ctx context.Context, input *eks.DeleteAccessEntryInput, opts ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error) {
+	return c.MockDeleteAccessEntry(ctx, input, opts)
+}
+
+// ListAssociatedAccessPolicies calls the underlying MockListAssociatedAccessPolicies
+// method.
+func (c *MockClient) ListAssociatedAccessPolicies(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+	return c.MockListAssociatedAccessPolicies(ctx, input, opts)
+}
+
+// AssociateAccessPolicy calls the underlying MockAssociateAccessPolicy
+// method.
+func (c *MockClient) AssociateAccessPolicy(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error) {
+	return c.MockAssociateAccessPolicy(ctx, input, opts)
+}
+
+// DisassociateAccessPolicy calls the underlying MockDisassociateAccessPolicy
+// method.
+func (c *MockClient) DisassociateAccessPolicy(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error) {
+	return c.MockDisassociateAccessPolicy(ctx, input, opts)
+}
+
+// DescribePodIdentityAssociation calls the underlying MockDescribePodIdentityAssociation
+// method.
+func (c *MockClient) DescribePodIdentityAssociation(ctx context.Context, input *eks.DescribePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.DescribePodIdentityAssociationOutput, error) {
+	return c.MockDescribePodIdentityAssociation(ctx, input, opts)
+}
+
+// CreatePodIdentityAssociation calls the underlying MockCreatePodIdentityAssociation
+// method.
+func (c *MockClient) CreatePodIdentityAssociation(ctx context.Context, input *eks.CreatePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.CreatePodIdentityAssociationOutput, error) {
+	return c.MockCreatePodIdentityAssociation(ctx, input, opts)
+}
+
+// UpdatePodIdentityAssociation calls the underlying MockUpdatePodIdentityAssociation
+// method.
+func (c *MockClient) UpdatePodIdentityAssociation(ctx context.Context, input *eks.UpdatePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.UpdatePodIdentityAssociationOutput, error) {
+	return c.MockUpdatePodIdentityAssociation(ctx, input, opts)
+}
+
+// DeletePodIdentityAssociation calls the underlying MockDeletePodIdentityAssociation
+// method.
+func (c *MockClient) DeletePodIdentityAssociation(ctx context.Context, input *eks.DeletePodIdentityAssociationInput, opts ...func(*eks.Options)) (*eks.DeletePodIdentityAssociationOutput, error) {
+	return c.MockDeletePodIdentityAssociation(ctx, input, opts)
+}
+
+// MockLaunchTemplateClient is a fake implementation of eks.LaunchTemplateClient.
+type MockLaunchTemplateClient struct {
+	MockDescribeLaunchTemplates func(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput, opts []func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error)
+}
+
+// DescribeLaunchTemplates calls the underlying MockDescribeLaunchTemplates
+// method.
+func (c *MockLaunchTemplateClient) DescribeLaunchTemplates(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput, opts ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+	return c.MockDescribeLaunchTemplates(ctx, input, opts)
+}
@@ -17,13 +17,17 @@ limitations under the License.
 package eks
 
 import (
+	"context"
 	"reflect"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
@@ -31,6 +35,82 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 )
 
+const (
+	// LaunchTemplateVersionLatest tracks the latest version of a launch
+	// template.
+	LaunchTemplateVersionLatest = "$Latest"
+	// LaunchTemplateVersionDefault tracks the default version of a launch
+	// template.
+	LaunchTemplateVersionDefault = "$Default"
+
+	errDescribeLaunchTemplate = "cannot describe launch template"
+	errLaunchTemplateNotFound = "launch template not found"
+)
+
+// LaunchTemplateClient is the EC2 client used to resolve the launch template
+// version tracked by a node group.
+type LaunchTemplateClient interface {
+	DescribeLaunchTemplates(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput, opts ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error)
+}
+
+// NewLaunchTemplateClient creates a new LaunchTemplateClient.
+func NewLaunchTemplateClient(cfg aws.Config) LaunchTemplateClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsTrackedLaunchTemplateVersion returns true if the version refers to the
+// latest or default version of a launch template instead of a fixed version.
+func IsTrackedLaunchTemplateVersion(v *string) bool {
+	return notNilAndEquals(v, LaunchTemplateVersionLatest) || notNilAndEquals(v, LaunchTemplateVersionDefault)
+}
+
+// ResolveLaunchTemplateVersion returns the version of the launch template a
+// node group should use. $Latest and $Default are resolved to the version
+// number they currently point to, any other version is returned as is.
+func ResolveLaunchTemplateVersion(ctx context.Context, c LaunchTemplateClient, lt *manualv1alpha1.LaunchTemplateSpecification) (*string, error) {
+	if lt == nil || !IsTrackedLaunchTemplateVersion(lt.Version) {
+		return nil, nil
+	}
+	in := &ec2.DescribeLaunchTemplatesInput{}
+	switch {
+	case pointer.StringValue(lt.Name) != "":
+		in.LaunchTemplateNames = []string{*lt.Name}
+	case pointer.StringValue(lt.ID) != "":
+		in.LaunchTemplateIds = []string{*lt.ID}
+	default:
+		// Without a name or ID there is nothing to look up, EKS rejects
+		// the launch template itself.
+		return nil, nil
+	}
+	out, err := c.DescribeLaunchTemplates(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(err, errDescribeLaunchTemplate)
+	}
+	if len(out.LaunchTemplates) == 0 {
+		return nil, errors.New(errLaunchTemplateNotFound)
+	}
+	v := out.LaunchTemplates[0].LatestVersionNumber
+	if *lt.Version == LaunchTemplateVersionDefault {
+		v = out.LaunchTemplates[0].DefaultVersionNumber
+	}
+	if v == nil {
+		return nil, errors.New(errLaunchTemplateNotFound)
+	}
+	return aws.String(strconv.FormatInt(*v, 10)), nil
+}
+
+// WithResolvedLaunchTemplateVersion returns a copy of the NodeGroupParameters
+// in which the launch template version is replaced by the given resolved
+// version. If version is nil, p is returned unchanged.
+func WithResolvedLaunchTemplateVersion(p *manualv1alpha1.NodeGroupParameters, version *string) *manualv1alpha1.NodeGroupParameters {
+	if version == nil || p.LaunchTemplate == nil {
+		return p
+	}
+	r := p.DeepCopy()
+	r.LaunchTemplate.Version = version
+	return r
+}
+
 // GenerateCreateNodeGroupInput from NodeGroupParameters.
 func GenerateCreateNodeGroupInput(name string, p *manualv1alpha1.NodeGroupParameters) *eks.CreateNodegroupInput {
 	c := &eks.CreateNodegroupInput{
@@ -130,8 +210,8 @@ func GenerateUpdateNodeGroupVersionInput(name string, p *manualv1alpha1.NodeGrou
 			}
 		}
 	}
-	if p.UpdateConfig != nil && *p.UpdateConfig.Force {
-		i.Force = true
+	if p.UpdateConfig != nil {
+		i.Force = aws.ToBool(p.UpdateConfig.Force)
 	}
 
 	return u, i
@@ -285,9 +365,53 @@ func GenerateNodeGroupObservation(ng *ekstypes.Nodegroup) manualv1alpha1.NodeGro
 			MaxUnavailablePercentage: ng.UpdateConfig.MaxUnavailablePercentage,
 		}
 	}
+	if ng.LaunchTemplate != nil {
+		o.LaunchTemplateVersion = pointer.StringValue(ng.LaunchTemplate.Version)
+	}
 	return o
 }
 
+// GenerateNodeGroupUpdateStatus is used to produce
+// manualv1alpha1.NodeGroupUpdateStatus from eks.Update.
+func GenerateNodeGroupUpdateStatus(u *ekstypes.Update) *manualv1alpha1.NodeGroupUpdateStatus {
+	if u == nil {
+		return nil
+	}
+	s := &manualv1alpha1.NodeGroupUpdateStatus{
+		ID:     pointer.StringValue(u.Id),
+		Type:   string(u.Type),
+		Status: string(u.Status),
+	}
+	if u.CreatedAt != nil {
+		s.CreatedAt = &metav1.Time{Time: *u.CreatedAt}
+	}
+	for _, e := range u.Errors {
+		s.Errors = append(s.Errors, manualv1alpha1.NodeGroupUpdateError{
+			ErrorCode:    string(e.ErrorCode),
+			ErrorMessage: pointer.StringValue(e.ErrorMessage),
+			ResourceIDs:  e.ResourceIds,
+		})
+	}
+	return s
+}
+
+// IsNodeGroupUpdateInProgress returns true if the given update of a node group
+// has not finished yet.
+func IsNodeGroupUpdateInProgress(s *manualv1alpha1.NodeGroupUpdateStatus) bool {
+	return s != nil && s.ID != "" && s.Status == string(ekstypes.UpdateStatusInProgress)
+}
+
+// IsNodeGroupUpdateConfigUpToDate checks whether the update configuration of
+// the node group matches the desired one. It must be up to date before a
+// version update is started so that the rollout honours it.
+func IsNodeGroupUpdateConfigUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool {
+	if p.UpdateConfig == nil || ng.UpdateConfig == nil {
+		return true
+	}
+	return cmp.Equal(p.UpdateConfig.MaxUnavailable, ng.UpdateConfig.MaxUnavailable) &&
+		cmp.Equal(p.UpdateConfig.MaxUnavailablePercentage, ng.UpdateConfig.MaxUnavailablePercentage)
+}
+
 // LateInitializeNodeGroup fills the empty fields in *manualv1alpha1.NodeGroupParameters with the
 // values seen in eks.Nodegroup.
 func LateInitializeNodeGroup(in *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) { //nolint:gocyclo
@@ -372,13 +496,8 @@ func IsNodeGroupUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nod
 			return false
 		}
 	}
-	if p.UpdateConfig != nil && ng.UpdateConfig != nil {
-		if !cmp.Equal(p.UpdateConfig.MaxUnavailable, ng.UpdateConfig.MaxUnavailable) {
-			return false
-		}
-		if !cmp.Equal(p.UpdateConfig.MaxUnavailablePercentage, ng.UpdateConfig.MaxUnavailablePercentage) {
-			return false
-		}
+	if !IsNodeGroupUpdateConfigUpToDate(p, ng) {
+		return false
 	}
 	if p.ScalingConfig == nil && ng.ScalingConfig == nil {
 		return true
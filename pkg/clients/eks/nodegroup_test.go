@@ -17,9 +17,12 @@ limitations under the License.
 package eks
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/smithy-go/document"
@@ -29,6 +32,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 )
 
@@ -412,6 +416,29 @@ func TestGenerateUpdateNodeGroupVersionInput(t *testing.T) {
 				Force:         true,
 			},
 		},
+		"ForceUnset": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &otherVersion,
+					UpdateConfig: &manualv1alpha1.NodeGroupUpdateConfig{
+						MaxUnavailable: &maxUnavailable,
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					ClusterName:   &clusterName,
+					NodegroupName: &ngName,
+					Version:       &version,
+				},
+			},
+			wantUpdate: true,
+			wantInput: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &otherVersion,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -1249,3 +1276,114 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveLaunchTemplateVersion(t *testing.T) {
+	type want struct {
+		input   *ec2.DescribeLaunchTemplatesInput
+		version *string
+		err     error
+	}
+
+	cases := map[string]struct {
+		lt *manualv1alpha1.LaunchTemplateSpecification
+		want
+	}{
+		"NoLaunchTemplate": {},
+		"FixedVersion": {
+			lt: &manualv1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: &ltVersion},
+		},
+		"Latest": {
+			lt: &manualv1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: ptr.To(LaunchTemplateVersionLatest)},
+			want: want{
+				input:   &ec2.DescribeLaunchTemplatesInput{LaunchTemplateNames: []string{ltName}},
+				version: ptr.To("2"),
+			},
+		},
+		"DefaultByID": {
+			lt: &manualv1alpha1.LaunchTemplateSpecification{ID: &ltID, Version: ptr.To(LaunchTemplateVersionDefault)},
+			want: want{
+				input:   &ec2.DescribeLaunchTemplatesInput{LaunchTemplateIds: []string{ltID}},
+				version: ptr.To("1"),
+			},
+		},
+		"EmptyNameFallsBackToID": {
+			lt: &manualv1alpha1.LaunchTemplateSpecification{Name: ptr.To(""), ID: &ltID, Version: ptr.To(LaunchTemplateVersionLatest)},
+			want: want{
+				input:   &ec2.DescribeLaunchTemplatesInput{LaunchTemplateIds: []string{ltID}},
+				version: ptr.To("2"),
+			},
+		},
+		"EmptyNameAndID": {
+			lt: &manualv1alpha1.LaunchTemplateSpecification{Name: ptr.To(""), ID: ptr.To(""), Version: ptr.To(LaunchTemplateVersionLatest)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *ec2.DescribeLaunchTemplatesInput
+			c := &fake.MockLaunchTemplateClient{MockDescribeLaunchTemplates: func(_ context.Context, in *ec2.DescribeLaunchTemplatesInput, _ []func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+				input = in
+				return &ec2.DescribeLaunchTemplatesOutput{
+					LaunchTemplates: []ec2types.LaunchTemplate{{
+						DefaultVersionNumber: ptr.To[int64](1),
+						LatestVersionNumber:  ptr.To[int64](2),
+					}},
+				}, nil
+			}}
+			got, err := ResolveLaunchTemplateVersion(context.Background(), c, tc.lt)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input, cmpopts.IgnoreUnexported(ec2.DescribeLaunchTemplatesInput{})); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNodeGroupUpdateStatus(t *testing.T) {
+	createdAt := time.Now()
+
+	cases := map[string]struct {
+		u    *ekstypes.Update
+		want *manualv1alpha1.NodeGroupUpdateStatus
+	}{
+		"Nil": {},
+		"Failed": {
+			u: &ekstypes.Update{
+				Id:        ptr.To("update-id"),
+				Type:      ekstypes.UpdateTypeVersionUpdate,
+				Status:    ekstypes.UpdateStatusFailed,
+				CreatedAt: &createdAt,
+				Errors: []ekstypes.ErrorDetail{{
+					ErrorCode:    ekstypes.ErrorCodePodEvictionFailure,
+					ErrorMessage: ptr.To("cannot evict pod"),
+					ResourceIds:  []string{"i-1"},
+				}},
+			},
+			want: &manualv1alpha1.NodeGroupUpdateStatus{
+				ID:        "update-id",
+				Type:      string(ekstypes.UpdateTypeVersionUpdate),
+				Status:    string(ekstypes.UpdateStatusFailed),
+				CreatedAt: &v1.Time{Time: createdAt},
+				Errors: []manualv1alpha1.NodeGroupUpdateError{{
+					ErrorCode:    string(ekstypes.ErrorCodePodEvictionFailure),
+					ErrorMessage: "cannot evict pod",
+					ResourceIDs:  []string{"i-1"},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNodeGroupUpdateStatus(tc.u)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
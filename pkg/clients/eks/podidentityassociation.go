@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// GenerateCreatePodIdentityAssociationInput from
+// PodIdentityAssociationParameters.
+func GenerateCreatePodIdentityAssociationInput(p *manualv1alpha1.PodIdentityAssociationParameters) *eks.CreatePodIdentityAssociationInput {
+	return &eks.CreatePodIdentityAssociationInput{
+		ClusterName:    &p.ClusterName,
+		Namespace:      &p.Namespace,
+		ServiceAccount: &p.ServiceAccount,
+		RoleArn:        &p.RoleARN,
+		Tags:           p.Tags,
+	}
+}
+
+// GenerateUpdatePodIdentityAssociationInput from
+// PodIdentityAssociationParameters.
+func GenerateUpdatePodIdentityAssociationInput(id string, p *manualv1alpha1.PodIdentityAssociationParameters) *eks.UpdatePodIdentityAssociationInput {
+	return &eks.UpdatePodIdentityAssociationInput{
+		AssociationId: aws.String(id),
+		ClusterName:   &p.ClusterName,
+		RoleArn:       &p.RoleARN,
+	}
+}
+
+// GeneratePodIdentityAssociationObservation is used to produce
+// manualv1alpha1.PodIdentityAssociationObservation from
+// types.PodIdentityAssociation.
+func GeneratePodIdentityAssociationObservation(a *types.PodIdentityAssociation) manualv1alpha1.PodIdentityAssociationObservation {
+	if a == nil {
+		return manualv1alpha1.PodIdentityAssociationObservation{}
+	}
+	o := manualv1alpha1.PodIdentityAssociationObservation{
+		AssociationARN: pointer.StringValue(a.AssociationArn),
+		AssociationID:  pointer.StringValue(a.AssociationId),
+		OwnerARN:       pointer.StringValue(a.OwnerArn),
+	}
+	if a.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *a.CreatedAt}
+	}
+	if a.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *a.ModifiedAt}
+	}
+	return o
+}
+
+// LateInitializePodIdentityAssociation fills the empty fields in
+// *manualv1alpha1.PodIdentityAssociationParameters with the values seen in
+// types.PodIdentityAssociation.
+func LateInitializePodIdentityAssociation(in *manualv1alpha1.PodIdentityAssociationParameters, a *types.PodIdentityAssociation) {
+	if a == nil {
+		return
+	}
+	if len(in.Tags) == 0 && len(a.Tags) != 0 {
+		in.Tags = a.Tags
+	}
+}
+
+// IsPodIdentityAssociationRoleUpToDate checks whether the associated IAM role
+// differs from the desired one.
+func IsPodIdentityAssociationRoleUpToDate(p *manualv1alpha1.PodIdentityAssociationParameters, a *types.PodIdentityAssociation) bool {
+	return a == nil || p.RoleARN == pointer.StringValue(a.RoleArn)
+}
+
+// IsPodIdentityAssociationUpToDate checks whether the IAM role or the tags of
+// the association differ from the desired state. Any other field is
+// immutable.
+func IsPodIdentityAssociationUpToDate(p *manualv1alpha1.PodIdentityAssociationParameters, a *types.PodIdentityAssociation) bool {
+	if a == nil {
+		return true
+	}
+	return IsPodIdentityAssociationRoleUpToDate(p, a) && cmp.Equal(p.Tags, a.Tags, cmpopts.EquateEmpty())
+}
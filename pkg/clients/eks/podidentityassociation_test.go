@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+)
+
+var (
+	piaRoleARN = "arn:aws:iam::123456789012:role/workload"
+)
+
+func TestIsPodIdentityAssociationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *manualv1alpha1.PodIdentityAssociationParameters
+		a    *types.PodIdentityAssociation
+		want bool
+	}{
+		"UpToDate": {
+			p: &manualv1alpha1.PodIdentityAssociationParameters{
+				RoleARN: piaRoleARN,
+				Tags:    map[string]string{"cool": "tag"},
+			},
+			a: &types.PodIdentityAssociation{
+				RoleArn: &piaRoleARN,
+				Tags:    map[string]string{"cool": "tag"},
+			},
+			want: true,
+		},
+		"DifferentRole": {
+			p: &manualv1alpha1.PodIdentityAssociationParameters{
+				RoleARN: piaRoleARN,
+			},
+			a: &types.PodIdentityAssociation{
+				RoleArn: aws.String("arn:aws:iam::123456789012:role/other"),
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			p: &manualv1alpha1.PodIdentityAssociationParameters{
+				RoleARN: piaRoleARN,
+			},
+			a: &types.PodIdentityAssociation{
+				RoleArn: &piaRoleARN,
+				Tags:    map[string]string{"cool": "tag"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPodIdentityAssociationUpToDate(tc.p, tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePodIdentityAssociationObservation(t *testing.T) {
+	cases := map[string]struct {
+		a    *types.PodIdentityAssociation
+		want manualv1alpha1.PodIdentityAssociationObservation
+	}{
+		"Nil": {},
+		"AllFields": {
+			a: &types.PodIdentityAssociation{
+				AssociationArn: aws.String("arn:aws:eks:us-east-1:123456789012:podidentityassociation/my-cluster/a-1"),
+				AssociationId:  aws.String("a-1"),
+				OwnerArn:       aws.String("arn:aws:eks:us-east-1:123456789012:addon/my-cluster/vpc-cni/1"),
+			},
+			want: manualv1alpha1.PodIdentityAssociationObservation{
+				AssociationARN: "arn:aws:eks:us-east-1:123456789012:podidentityassociation/my-cluster/a-1",
+				AssociationID:  "a-1",
+				OwnerARN:       "arn:aws:eks:us-east-1:123456789012:addon/my-cluster/vpc-cni/1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePodIdentityAssociationObservation(tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
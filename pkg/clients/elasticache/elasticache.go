@@ -97,6 +97,7 @@ func NewCreateReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 		CacheSecurityGroupNames:    g.CacheSecurityGroupNames,
 		CacheSubnetGroupName:       g.CacheSubnetGroupName,
 		EngineVersion:              g.EngineVersion,
+		GlobalReplicationGroupId:   g.GlobalReplicationGroupID,
 		MultiAZEnabled:             g.MultiAZEnabled,
 		NotificationTopicArn:       g.NotificationTopicARN,
 		NumCacheClusters:           pointer.ToIntAsInt32Ptr(g.NumCacheClusters),
@@ -112,6 +113,7 @@ func NewCreateReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 		SnapshotRetentionLimit:     pointer.ToIntAsInt32Ptr(g.SnapshotRetentionLimit),
 		SnapshotWindow:             g.SnapshotWindow,
 		TransitEncryptionEnabled:   g.TransitEncryptionEnabled,
+		UserGroupIds:               g.UserGroupIDs,
 	}
 	if len(g.Tags) != 0 {
 		c.Tags = make([]elasticachetypes.Tag, len(g.Tags))
@@ -160,6 +162,28 @@ func NewModifyReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 	}
 }
 
+// DiffUserGroupIDs returns the user groups that have to be added to and
+// removed from the replication group.
+func DiffUserGroupIDs(kube []string, rg elasticachetypes.ReplicationGroup) (add, remove []string) {
+	existing := make(map[string]bool, len(rg.UserGroupIds))
+	for _, id := range rg.UserGroupIds {
+		existing[id] = true
+	}
+	desired := make(map[string]bool, len(kube))
+	for _, id := range kube {
+		desired[id] = true
+		if !existing[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range rg.UserGroupIds {
+		if !desired[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
 // NewModifyReplicationGroupShardConfigurationInput returns ElastiCache replication group
 // shard configuration modification input suitable for use with the AWS API.
 func NewModifyReplicationGroupShardConfigurationInput(g v1beta1.ReplicationGroupParameters, id string, rg elasticachetypes.ReplicationGroup) *elasticache.ModifyReplicationGroupShardConfigurationInput {
@@ -267,6 +291,13 @@ func LateInitialize(s *v1beta1.ReplicationGroupParameters, rg elasticachetypes.R
 			s.CacheSecurityGroupNames[i] = aws.ToString(val.CacheSecurityGroupName)
 		}
 	}
+	if len(s.UserGroupIDs) == 0 && len(rg.UserGroupIds) != 0 {
+		s.UserGroupIDs = rg.UserGroupIds
+	}
+	if rg.GlobalReplicationGroupInfo != nil &&
+		aws.ToString(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupMemberRole) == "SECONDARY" {
+		s.GlobalReplicationGroupID = pointer.LateInitialize(s.GlobalReplicationGroupID, rg.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+	}
 }
 
 // ReplicationGroupShardConfigurationNeedsUpdate returns true if the supplied ReplicationGroup and
@@ -291,6 +322,8 @@ func ReplicationGroupNeedsUpdate(kube v1beta1.ReplicationGroupParameters, rg ela
 		return "MultiAZ"
 	case ReplicationGroupNumCacheClustersNeedsUpdate(kube, ccList):
 		return "NumCacheClusters"
+	case userGroupIDsNeedUpdate(kube.UserGroupIDs, rg):
+		return "UserGroupIDs"
 	}
 
 	for _, cc := range ccList {
@@ -435,6 +468,11 @@ func cacheClusterNeedsUpdate(kube v1beta1.ReplicationGroupParameters, cc elastic
 	return ""
 }
 
+func userGroupIDsNeedUpdate(kube []string, rg elasticachetypes.ReplicationGroup) bool {
+	add, remove := DiffUserGroupIDs(kube, rg)
+	return len(add) != 0 || len(remove) != 0
+}
+
 func sgIDsNeedUpdate(kube []string, cc []elasticachetypes.SecurityGroupMembership) bool {
 	if len(kube) != len(cc) {
 		return true
@@ -509,6 +547,13 @@ func GenerateObservation(rg elasticachetypes.ReplicationGroup) v1beta1.Replicati
 		ConfigurationEndpoint: newEndpoint(rg),
 		MemberClusters:        rg.MemberClusters,
 		Status:                pointer.StringValue(rg.Status),
+		UserGroupIDs:          rg.UserGroupIds,
+	}
+	if rg.GlobalReplicationGroupInfo != nil {
+		o.GlobalReplicationGroupInfo = &v1beta1.GlobalReplicationGroupInfo{
+			GlobalReplicationGroupID:         aws.ToString(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupId),
+			GlobalReplicationGroupMemberRole: aws.ToString(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupMemberRole),
+		}
 	}
 	if len(rg.NodeGroups) != 0 {
 		o.NodeGroups = make([]v1beta1.NodeGroup, len(rg.NodeGroups))
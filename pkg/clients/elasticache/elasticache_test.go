@@ -1522,6 +1522,58 @@ func TestDiffTags(t *testing.T) {
 	}
 }
 
+func TestDiffUserGroupIDs(t *testing.T) {
+	type args struct {
+		kube []string
+		rg   elasticachetypes.ReplicationGroup
+	}
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChange": {
+			args: args{
+				kube: []string{"group1", "group2"},
+				rg:   elasticachetypes.ReplicationGroup{UserGroupIds: []string{"group2", "group1"}},
+			},
+			want: want{},
+		},
+		"AddAndRemove": {
+			args: args{
+				kube: []string{"group1", "group3"},
+				rg:   elasticachetypes.ReplicationGroup{UserGroupIds: []string{"group1", "group2"}},
+			},
+			want: want{
+				add:    []string{"group3"},
+				remove: []string{"group2"},
+			},
+		},
+		"RemoveAll": {
+			args: args{
+				rg: elasticachetypes.ReplicationGroup{UserGroupIds: []string{"group1"}},
+			},
+			want: want{
+				remove: []string{"group1"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffUserGroupIDs(tc.args.kube, tc.args.rg)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestReplicationGroupTagsNeedsUpdate(t *testing.T) {
 	type args struct {
 		local  []v1beta1.Tag
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+)
+
+// AreEventPatternsEqual determines if the two event patterns can be
+// considered equal. Event patterns are JSON documents whose arrays are
+// unordered sets of matchers, so formatting, key order and the order of
+// matchers within an array are ignored. If either pattern cannot be parsed,
+// the raw strings are compared.
+func AreEventPatternsEqual(a, b *string) (equal bool, diff string) {
+	rawA, rawB := aws.StringValue(a), aws.StringValue(b)
+	if rawA == "" || rawB == "" {
+		diff = cmp.Diff(rawA, rawB)
+		return diff == "", diff
+	}
+	var pa, pb any
+	if err := json.Unmarshal([]byte(rawA), &pa); err != nil {
+		diff = cmp.Diff(rawA, rawB)
+		return diff == "", diff
+	}
+	if err := json.Unmarshal([]byte(rawB), &pb); err != nil {
+		diff = cmp.Diff(rawA, rawB)
+		return diff == "", diff
+	}
+	diff = cmp.Diff(normalizeEventPattern(pa), normalizeEventPattern(pb))
+	return diff == "", diff
+}
+
+// normalizeEventPattern sorts every array of the given pattern so that
+// patterns that only differ in the order of their matchers are equal.
+func normalizeEventPattern(in any) any {
+	switch v := in.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = normalizeEventPattern(e)
+		}
+		return v
+	case []any:
+		keys := make([]string, len(v))
+		for i := range v {
+			v[i] = normalizeEventPattern(v[i])
+			b, _ := json.Marshal(v[i])
+			keys[i] = string(b)
+		}
+		sort.Sort(byKey{keys: keys, values: v})
+		return v
+	default:
+		return v
+	}
+}
+
+type byKey struct {
+	keys   []string
+	values []any
+}
+
+func (s byKey) Len() int           { return len(s.keys) }
+func (s byKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s byKey) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+// DiffTags returns the tags that need to be added to and removed from the
+// resource so that its tags match the desired ones.
+func DiffTags(desired []*svcapitypes.Tag, current []*svcsdk.Tag) (add []*svcsdk.Tag, remove []*string) {
+	d := make(map[string]string, len(desired))
+	for _, t := range desired {
+		d[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	c := make(map[string]string, len(current))
+	for _, t := range current {
+		c[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	for k, v := range d {
+		if cv, ok := c[k]; !ok || cv != v {
+			add = append(add, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+	for k := range c {
+		if _, ok := d[k]; !ok {
+			remove = append(remove, aws.String(k))
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+	sort.Slice(remove, func(i, j int) bool { return aws.StringValue(remove[i]) < aws.StringValue(remove[j]) })
+	return add, remove
+}
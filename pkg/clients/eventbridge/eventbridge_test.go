@@ -0,0 +1,135 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+)
+
+func TestAreEventPatternsEqual(t *testing.T) {
+	type args struct {
+		a *string
+		b *string
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"BothNil": {
+			args: args{},
+			want: true,
+		},
+		"OneNil": {
+			args: args{
+				a: aws.String(`{"source":["aws.ec2"]}`),
+			},
+			want: false,
+		},
+		"DifferentFormatting": {
+			args: args{
+				a: aws.String(`{"source":["aws.ec2"],"detail-type":["EC2 Instance State-change Notification"]}`),
+				b: aws.String(`{
+  "detail-type": ["EC2 Instance State-change Notification"],
+  "source": ["aws.ec2"]
+}`),
+			},
+			want: true,
+		},
+		"DifferentArrayOrder": {
+			args: args{
+				a: aws.String(`{"source":["aws.ec2","aws.s3"],"detail":{"state":[{"prefix":"run"},"stopped"]}}`),
+				b: aws.String(`{"source":["aws.s3","aws.ec2"],"detail":{"state":["stopped",{"prefix":"run"}]}}`),
+			},
+			want: true,
+		},
+		"DifferentValues": {
+			args: args{
+				a: aws.String(`{"source":["aws.ec2"]}`),
+				b: aws.String(`{"source":["aws.s3"]}`),
+			},
+			want: false,
+		},
+		"InvalidJSON": {
+			args: args{
+				a: aws.String(`{"source":`),
+				b: aws.String(`{"source":`),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := AreEventPatternsEqual(tc.args.a, tc.args.b)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*svcsdk.Tag
+		remove []*string
+	}
+	cases := map[string]struct {
+		desired []*svcapitypes.Tag
+		current []*svcsdk.Tag
+		want    want
+	}{
+		"NoChange": {
+			desired: []*svcapitypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			current: []*svcsdk.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddUpdateRemove": {
+			desired: []*svcapitypes.Tag{
+				{Key: aws.String("a"), Value: aws.String("1")},
+				{Key: aws.String("b"), Value: aws.String("2")},
+			},
+			current: []*svcsdk.Tag{
+				{Key: aws.String("b"), Value: aws.String("old")},
+				{Key: aws.String("c"), Value: aws.String("3")},
+			},
+			want: want{
+				add: []*svcsdk.Tag{
+					{Key: aws.String("a"), Value: aws.String("1")},
+					{Key: aws.String("b"), Value: aws.String("2")},
+				},
+				remove: []*string{aws.String("c")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.AccessAnalyzerClient  = (*MockAccessAnalyzerClient)(nil)
+	_ clientset.PolicySimulatorClient = (*MockPolicySimulatorClient)(nil)
+)
+
+// MockAccessAnalyzerClient is a type that implements all the methods for AccessAnalyzerClient interface
+type MockAccessAnalyzerClient struct {
+	MockValidatePolicy func(ctx context.Context, input *accessanalyzer.ValidatePolicyInput, opts []func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error)
+}
+
+// ValidatePolicy mocks ValidatePolicy method
+func (m *MockAccessAnalyzerClient) ValidatePolicy(ctx context.Context, input *accessanalyzer.ValidatePolicyInput, opts ...func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+	return m.MockValidatePolicy(ctx, input, opts)
+}
+
+// MockPolicySimulatorClient is a type that implements all the methods for PolicySimulatorClient interface
+type MockPolicySimulatorClient struct {
+	MockSimulateCustomPolicy func(ctx context.Context, input *iam.SimulateCustomPolicyInput, opts []func(*iam.Options)) (*iam.SimulateCustomPolicyOutput, error)
+}
+
+// SimulateCustomPolicy mocks SimulateCustomPolicy method
+func (m *MockPolicySimulatorClient) SimulateCustomPolicy(ctx context.Context, input *iam.SimulateCustomPolicyInput, opts ...func(*iam.Options)) (*iam.SimulateCustomPolicyOutput, error) {
+	return m.MockSimulateCustomPolicy(ctx, input, opts)
+}
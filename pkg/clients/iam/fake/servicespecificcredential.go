@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ServiceSpecificCredentialClient = (*MockServiceSpecificCredentialClient)(nil)
+
+// MockServiceSpecificCredentialClient is a type that implements all the methods for ServiceSpecificCredentialClient interface
+type MockServiceSpecificCredentialClient struct {
+	MockCreateServiceSpecificCredential func(ctx context.Context, input *iam.CreateServiceSpecificCredentialInput, opts []func(*iam.Options)) (*iam.CreateServiceSpecificCredentialOutput, error)
+	MockListServiceSpecificCredentials  func(ctx context.Context, input *iam.ListServiceSpecificCredentialsInput, opts []func(*iam.Options)) (*iam.ListServiceSpecificCredentialsOutput, error)
+	MockUpdateServiceSpecificCredential func(ctx context.Context, input *iam.UpdateServiceSpecificCredentialInput, opts []func(*iam.Options)) (*iam.UpdateServiceSpecificCredentialOutput, error)
+	MockDeleteServiceSpecificCredential func(ctx context.Context, input *iam.DeleteServiceSpecificCredentialInput, opts []func(*iam.Options)) (*iam.DeleteServiceSpecificCredentialOutput, error)
+}
+
+// CreateServiceSpecificCredential mocks CreateServiceSpecificCredential method
+func (m *MockServiceSpecificCredentialClient) CreateServiceSpecificCredential(ctx context.Context, input *iam.CreateServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.CreateServiceSpecificCredentialOutput, error) {
+	return m.MockCreateServiceSpecificCredential(ctx, input, opts)
+}
+
+// ListServiceSpecificCredentials mocks ListServiceSpecificCredentials method
+func (m *MockServiceSpecificCredentialClient) ListServiceSpecificCredentials(ctx context.Context, input *iam.ListServiceSpecificCredentialsInput, opts ...func(*iam.Options)) (*iam.ListServiceSpecificCredentialsOutput, error) {
+	return m.MockListServiceSpecificCredentials(ctx, input, opts)
+}
+
+// UpdateServiceSpecificCredential mocks UpdateServiceSpecificCredential method
+func (m *MockServiceSpecificCredentialClient) UpdateServiceSpecificCredential(ctx context.Context, input *iam.UpdateServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.UpdateServiceSpecificCredentialOutput, error) {
+	return m.MockUpdateServiceSpecificCredential(ctx, input, opts)
+}
+
+// DeleteServiceSpecificCredential mocks DeleteServiceSpecificCredential method
+func (m *MockServiceSpecificCredentialClient) DeleteServiceSpecificCredential(ctx context.Context, input *iam.DeleteServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.DeleteServiceSpecificCredentialOutput, error) {
+	return m.MockDeleteServiceSpecificCredential(ctx, input, opts)
+}
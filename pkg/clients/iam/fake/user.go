@@ -44,6 +44,13 @@ type MockUserClient struct {
 	MockDeleteUserPermissionsBoundary func(ctx context.Context, input *iam.DeleteUserPermissionsBoundaryInput, opts []func(*iam.Options)) (*iam.DeleteUserPermissionsBoundaryOutput, error)
 	MockTagUser                       func(ctx context.Context, input *iam.TagUserInput, opt []func(*iam.Options)) (*iam.TagUserOutput, error)
 	MockUntagUser                     func(ctx context.Context, input *iam.UntagUserInput, opts []func(*iam.Options)) (*iam.UntagUserOutput, error)
+	MockListAttachedUserPolicies      func(ctx context.Context, input *iam.ListAttachedUserPoliciesInput, opts []func(*iam.Options)) (*iam.ListAttachedUserPoliciesOutput, error)
+	MockAttachUserPolicy              func(ctx context.Context, input *iam.AttachUserPolicyInput, opts []func(*iam.Options)) (*iam.AttachUserPolicyOutput, error)
+	MockDetachUserPolicy              func(ctx context.Context, input *iam.DetachUserPolicyInput, opts []func(*iam.Options)) (*iam.DetachUserPolicyOutput, error)
+	MockListUserPolicies              func(ctx context.Context, input *iam.ListUserPoliciesInput, opts []func(*iam.Options)) (*iam.ListUserPoliciesOutput, error)
+	MockGetUserPolicy                 func(ctx context.Context, input *iam.GetUserPolicyInput, opts []func(*iam.Options)) (*iam.GetUserPolicyOutput, error)
+	MockPutUserPolicy                 func(ctx context.Context, input *iam.PutUserPolicyInput, opts []func(*iam.Options)) (*iam.PutUserPolicyOutput, error)
+	MockDeleteUserPolicy              func(ctx context.Context, input *iam.DeleteUserPolicyInput, opts []func(*iam.Options)) (*iam.DeleteUserPolicyOutput, error)
 }
 
 // GetUser mocks GetUser method
@@ -87,3 +94,38 @@ func (m *MockUserClient) UntagUser(ctx context.Context, input *iam.UntagUserInpu
 	m.MockUserInput.UntagUserInput = input
 	return m.MockUntagUser(ctx, input, opts)
 }
+
+// ListAttachedUserPolicies mocks ListAttachedUserPolicies method
+func (m *MockUserClient) ListAttachedUserPolicies(ctx context.Context, input *iam.ListAttachedUserPoliciesInput, opts ...func(*iam.Options)) (*iam.ListAttachedUserPoliciesOutput, error) {
+	return m.MockListAttachedUserPolicies(ctx, input, opts)
+}
+
+// AttachUserPolicy mocks AttachUserPolicy method
+func (m *MockUserClient) AttachUserPolicy(ctx context.Context, input *iam.AttachUserPolicyInput, opts ...func(*iam.Options)) (*iam.AttachUserPolicyOutput, error) {
+	return m.MockAttachUserPolicy(ctx, input, opts)
+}
+
+// DetachUserPolicy mocks DetachUserPolicy method
+func (m *MockUserClient) DetachUserPolicy(ctx context.Context, input *iam.DetachUserPolicyInput, opts ...func(*iam.Options)) (*iam.DetachUserPolicyOutput, error) {
+	return m.MockDetachUserPolicy(ctx, input, opts)
+}
+
+// ListUserPolicies mocks ListUserPolicies method
+func (m *MockUserClient) ListUserPolicies(ctx context.Context, input *iam.ListUserPoliciesInput, opts ...func(*iam.Options)) (*iam.ListUserPoliciesOutput, error) {
+	return m.MockListUserPolicies(ctx, input, opts)
+}
+
+// GetUserPolicy mocks GetUserPolicy method
+func (m *MockUserClient) GetUserPolicy(ctx context.Context, input *iam.GetUserPolicyInput, opts ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error) {
+	return m.MockGetUserPolicy(ctx, input, opts)
+}
+
+// PutUserPolicy mocks PutUserPolicy method
+func (m *MockUserClient) PutUserPolicy(ctx context.Context, input *iam.PutUserPolicyInput, opts ...func(*iam.Options)) (*iam.PutUserPolicyOutput, error) {
+	return m.MockPutUserPolicy(ctx, input, opts)
+}
+
+// DeleteUserPolicy mocks DeleteUserPolicy method
+func (m *MockUserClient) DeleteUserPolicy(ctx context.Context, input *iam.DeleteUserPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteUserPolicyOutput, error) {
+	return m.MockDeleteUserPolicy(ctx, input, opts)
+}
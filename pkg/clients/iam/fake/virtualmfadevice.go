@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VirtualMFADeviceClient = (*MockVirtualMFADeviceClient)(nil)
+
+// MockVirtualMFADeviceClient is a type that implements all the methods for VirtualMFADeviceClient interface
+type MockVirtualMFADeviceClient struct {
+	MockCreateVirtualMFADevice func(ctx context.Context, input *iam.CreateVirtualMFADeviceInput, opts []func(*iam.Options)) (*iam.CreateVirtualMFADeviceOutput, error)
+	MockListVirtualMFADevices  func(ctx context.Context, input *iam.ListVirtualMFADevicesInput, opts []func(*iam.Options)) (*iam.ListVirtualMFADevicesOutput, error)
+	MockDeleteVirtualMFADevice func(ctx context.Context, input *iam.DeleteVirtualMFADeviceInput, opts []func(*iam.Options)) (*iam.DeleteVirtualMFADeviceOutput, error)
+	MockDeactivateMFADevice    func(ctx context.Context, input *iam.DeactivateMFADeviceInput, opts []func(*iam.Options)) (*iam.DeactivateMFADeviceOutput, error)
+	MockListMFADeviceTags      func(ctx context.Context, input *iam.ListMFADeviceTagsInput, opts []func(*iam.Options)) (*iam.ListMFADeviceTagsOutput, error)
+	MockTagMFADevice           func(ctx context.Context, input *iam.TagMFADeviceInput, opts []func(*iam.Options)) (*iam.TagMFADeviceOutput, error)
+	MockUntagMFADevice         func(ctx context.Context, input *iam.UntagMFADeviceInput, opts []func(*iam.Options)) (*iam.UntagMFADeviceOutput, error)
+}
+
+// CreateVirtualMFADevice mocks CreateVirtualMFADevice method
+func (m *MockVirtualMFADeviceClient) CreateVirtualMFADevice(ctx context.Context, input *iam.CreateVirtualMFADeviceInput, opts ...func(*iam.Options)) (*iam.CreateVirtualMFADeviceOutput, error) {
+	return m.MockCreateVirtualMFADevice(ctx, input, opts)
+}
+
+// ListVirtualMFADevices mocks ListVirtualMFADevices method
+func (m *MockVirtualMFADeviceClient) ListVirtualMFADevices(ctx context.Context, input *iam.ListVirtualMFADevicesInput, opts ...func(*iam.Options)) (*iam.ListVirtualMFADevicesOutput, error) {
+	return m.MockListVirtualMFADevices(ctx, input, opts)
+}
+
+// DeleteVirtualMFADevice mocks DeleteVirtualMFADevice method
+func (m *MockVirtualMFADeviceClient) DeleteVirtualMFADevice(ctx context.Context, input *iam.DeleteVirtualMFADeviceInput, opts ...func(*iam.Options)) (*iam.DeleteVirtualMFADeviceOutput, error) {
+	return m.MockDeleteVirtualMFADevice(ctx, input, opts)
+}
+
+// DeactivateMFADevice mocks DeactivateMFADevice method
+func (m *MockVirtualMFADeviceClient) DeactivateMFADevice(ctx context.Context, input *iam.DeactivateMFADeviceInput, opts ...func(*iam.Options)) (*iam.DeactivateMFADeviceOutput, error) {
+	return m.MockDeactivateMFADevice(ctx, input, opts)
+}
+
+// ListMFADeviceTags mocks ListMFADeviceTags method
+func (m *MockVirtualMFADeviceClient) ListMFADeviceTags(ctx context.Context, input *iam.ListMFADeviceTagsInput, opts ...func(*iam.Options)) (*iam.ListMFADeviceTagsOutput, error) {
+	return m.MockListMFADeviceTags(ctx, input, opts)
+}
+
+// TagMFADevice mocks TagMFADevice method
+func (m *MockVirtualMFADeviceClient) TagMFADevice(ctx context.Context, input *iam.TagMFADeviceInput, opts ...func(*iam.Options)) (*iam.TagMFADeviceOutput, error) {
+	return m.MockTagMFADevice(ctx, input, opts)
+}
+
+// UntagMFADevice mocks UntagMFADevice method
+func (m *MockVirtualMFADeviceClient) UntagMFADevice(ctx context.Context, input *iam.UntagMFADeviceInput, opts ...func(*iam.Options)) (*iam.UntagMFADeviceOutput, error) {
+	return m.MockUntagMFADevice(ctx, input, opts)
+}
@@ -0,0 +1,303 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
+)
+
+// IdentityPolicies are the managed and inline policies of an IAM role or user.
+type IdentityPolicies struct {
+	// ManagedPolicyARNs are the ARNs of the attached managed policies.
+	ManagedPolicyARNs []string
+
+	// InlinePolicies maps the names of the inline policies to their
+	// documents. Documents are only fetched for the inline policies that are
+	// declared in the spec, all others map to an empty string.
+	InlinePolicies map[string]string
+}
+
+// IdentityPolicyChanges are the changes that are needed to bring the policies
+// of an IAM role or user to the desired state.
+type IdentityPolicyChanges struct {
+	Attach []string
+	Detach []string
+	Put    []v1beta1.InlinePolicy
+	Delete []string
+}
+
+// IsEmpty returns true if no changes are needed.
+func (c IdentityPolicyChanges) IsEmpty() bool {
+	return len(c.Attach) == 0 && len(c.Detach) == 0 && len(c.Put) == 0 && len(c.Delete) == 0
+}
+
+// String returns a human readable description of the changes.
+func (c IdentityPolicyChanges) String() string {
+	var lines []string
+	if len(c.Attach) != 0 {
+		lines = append(lines, fmt.Sprintf("spec.forProvider.managedPolicyArns: policies to attach %v", c.Attach))
+	}
+	if len(c.Detach) != 0 {
+		lines = append(lines, fmt.Sprintf("spec.forProvider.managedPolicyArns: policies to detach %v", c.Detach))
+	}
+	if len(c.Put) != 0 {
+		names := make([]string, len(c.Put))
+		for i, p := range c.Put {
+			names[i] = p.PolicyName
+		}
+		lines = append(lines, fmt.Sprintf("spec.forProvider.inlinePolicies: policies to put %v", names))
+	}
+	if len(c.Delete) != 0 {
+		lines = append(lines, fmt.Sprintf("spec.forProvider.inlinePolicies: policies to delete %v", c.Delete))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ManagesIdentityPolicies returns true if the policies of an IAM role or user
+// are managed through its spec.
+func ManagesIdentityPolicies(managedPolicyARNs []string, inlinePolicies []v1beta1.InlinePolicy, exclusive *bool) bool {
+	return len(managedPolicyARNs) != 0 || len(inlinePolicies) != 0 || pointer.BoolValue(exclusive)
+}
+
+// DiffIdentityPolicies returns the changes that are needed to bring the
+// observed policies to the desired ones. Policies that are not declared are
+// only detached or deleted in exclusive mode.
+func DiffIdentityPolicies(managedPolicyARNs []string, inlinePolicies []v1beta1.InlinePolicy, exclusive bool, observed IdentityPolicies) IdentityPolicyChanges {
+	c := IdentityPolicyChanges{}
+
+	attached := make(map[string]bool, len(observed.ManagedPolicyARNs))
+	for _, arn := range observed.ManagedPolicyARNs {
+		attached[arn] = true
+	}
+	declared := make(map[string]bool, len(managedPolicyARNs))
+	for _, arn := range managedPolicyARNs {
+		if !attached[arn] && !declared[arn] {
+			c.Attach = append(c.Attach, arn)
+		}
+		declared[arn] = true
+	}
+
+	declaredInline := make(map[string]bool, len(inlinePolicies))
+	for _, p := range inlinePolicies {
+		declaredInline[p.PolicyName] = true
+		doc, ok := observed.InlinePolicies[p.PolicyName]
+		if !ok || !policy.ArePolicyDocumentsEqual(doc, p.PolicyDocument) {
+			c.Put = append(c.Put, p)
+		}
+	}
+
+	if exclusive {
+		for _, arn := range observed.ManagedPolicyARNs {
+			if !declared[arn] {
+				c.Detach = append(c.Detach, arn)
+			}
+		}
+		for name := range observed.InlinePolicies {
+			if !declaredInline[name] {
+				c.Delete = append(c.Delete, name)
+			}
+		}
+		sort.Strings(c.Delete)
+	}
+	return c
+}
+
+// RemovableIdentityPolicies returns the changes that remove the declared
+// policies, or all policies in exclusive mode, from an IAM role or user before
+// it is deleted.
+func RemovableIdentityPolicies(managedPolicyARNs []string, inlinePolicies []v1beta1.InlinePolicy, exclusive bool, observed IdentityPolicies) IdentityPolicyChanges {
+	declared := make(map[string]bool, len(managedPolicyARNs))
+	for _, arn := range managedPolicyARNs {
+		declared[arn] = true
+	}
+	declaredInline := make(map[string]bool, len(inlinePolicies))
+	for _, p := range inlinePolicies {
+		declaredInline[p.PolicyName] = true
+	}
+
+	c := IdentityPolicyChanges{}
+	for _, arn := range observed.ManagedPolicyARNs {
+		if exclusive || declared[arn] {
+			c.Detach = append(c.Detach, arn)
+		}
+	}
+	for name := range observed.InlinePolicies {
+		if exclusive || declaredInline[name] {
+			c.Delete = append(c.Delete, name)
+		}
+	}
+	sort.Strings(c.Delete)
+	return c
+}
+
+// decodePolicyDocument decodes the url-encoded policy documents returned by
+// the IAM API.
+func decodePolicyDocument(doc *string) (string, error) {
+	return url.QueryUnescape(aws.ToString(doc))
+}
+
+// GetRolePolicies returns the managed and inline policies of the given role.
+// Documents are only fetched for the inline policies in declared.
+func GetRolePolicies(ctx context.Context, client RoleClient, roleName string, declared []v1beta1.InlinePolicy) (IdentityPolicies, error) {
+	p := IdentityPolicies{InlinePolicies: map[string]string{}}
+
+	var marker *string
+	for {
+		out, err := client.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName), Marker: marker})
+		if err != nil {
+			return p, err
+		}
+		for _, ap := range out.AttachedPolicies {
+			p.ManagedPolicyARNs = append(p.ManagedPolicyARNs, aws.ToString(ap.PolicyArn))
+		}
+		if !out.IsTruncated {
+			break
+		}
+		marker = out.Marker
+	}
+
+	marker = nil
+	for {
+		out, err := client.ListRolePolicies(ctx, &iam.ListRolePoliciesInput{RoleName: aws.String(roleName), Marker: marker})
+		if err != nil {
+			return p, err
+		}
+		for _, name := range out.PolicyNames {
+			p.InlinePolicies[name] = ""
+		}
+		if !out.IsTruncated {
+			break
+		}
+		marker = out.Marker
+	}
+
+	for _, d := range declared {
+		if _, ok := p.InlinePolicies[d.PolicyName]; !ok {
+			continue
+		}
+		out, err := client.GetRolePolicy(ctx, &iam.GetRolePolicyInput{RoleName: aws.String(roleName), PolicyName: aws.String(d.PolicyName)})
+		if err != nil {
+			return p, err
+		}
+		doc, err := decodePolicyDocument(out.PolicyDocument)
+		if err != nil {
+			return p, err
+		}
+		p.InlinePolicies[d.PolicyName] = doc
+	}
+	return p, nil
+}
+
+// ApplyRolePolicyChanges attaches and puts the missing policies of the given
+// role before it detaches and deletes the superfluous ones.
+func ApplyRolePolicyChanges(ctx context.Context, client RoleClient, roleName string, c IdentityPolicyChanges) error {
+	for _, arn := range c.Attach {
+		if _, err := client.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{RoleName: aws.String(roleName), PolicyArn: aws.String(arn)}); err != nil {
+			return err
+		}
+	}
+	for _, p := range c.Put {
+		if _, err := client.PutRolePolicy(ctx, &iam.PutRolePolicyInput{RoleName: aws.String(roleName), PolicyName: aws.String(p.PolicyName), PolicyDocument: aws.String(p.PolicyDocument)}); err != nil {
+			return err
+		}
+	}
+	for _, arn := range c.Detach {
+		if _, err := client.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{RoleName: aws.String(roleName), PolicyArn: aws.String(arn)}); resource.Ignore(IsErrorNotFound, err) != nil {
+			return err
+		}
+	}
+	for _, name := range c.Delete {
+		if _, err := client.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{RoleName: aws.String(roleName), PolicyName: aws.String(name)}); resource.Ignore(IsErrorNotFound, err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetUserPolicies returns the managed and inline policies of the given user.
+// Documents are only fetched for the inline policies in declared.
+func GetUserPolicies(ctx context.Context, client UserClient, userName string, declared []v1beta1.InlinePolicy) (IdentityPolicies, error) {
+	p := IdentityPolicies{InlinePolicies: map[string]string{}}
+
+	var marker *string
+	for {
+		out, err := client.ListAttachedUserPolicies(ctx, &iam.ListAttachedUserPoliciesInput{UserName: aws.String(userName), Marker: marker})
+		if err != nil {
+			return p, err
+		}
+		for _, ap := range out.AttachedPolicies {
+			p.ManagedPolicyARNs = append(p.ManagedPolicyARNs, aws.ToString(ap.PolicyArn))
+		}
+		if !out.IsTruncated {
+			break
+		}
+		marker = out.Marker
+	}
+
+	marker = nil
+	for {
+		out, err := client.ListUserPolicies(ctx, &iam.ListUserPoliciesInput{UserName: aws.String(userName), Marker: marker})
+		if err != nil {
+			return p, err
+		}
+		for _, name := range out.PolicyNames {
+			p.InlinePolicies[name] = ""
+		}
+		if !out.IsTruncated {
+			break
+		}
+		marker = out.Marker
+	}
+
+	for _, d := range declared {
+		if _, ok := p.InlinePolicies[d.PolicyName]; !ok {
+			continue
+		}
+		out, err := client.GetUserPolicy(ctx, &iam.GetUserPolicyInput{UserName: aws.String(userName), PolicyName: aws.String(d.PolicyName)})
+		if err != nil {
+			return p, err
+		}
+		doc, err := decodePolicyDocument(out.PolicyDocument)
+		if err != nil {
+			return p, err
+		}
+		p.InlinePolicies[d.PolicyName] = doc
+	}
+	return p, nil
+}
+
+// ApplyUserPolicyChanges attaches and puts the missing policies of the given
+// user before it detaches and deletes the superfluous ones.
+func ApplyUserPolicyChanges(ctx context.Context, client UserClient, userName string, c IdentityPolicyChanges) error {
+	for _, arn := range c.Attach {
+		if _, err := client.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{UserName: aws.String(userName), PolicyArn: aws.String(arn)}); err != nil {
+			return err
+		}
+	}
+	for _, p := range c.Put {
+		if _, err := client.PutUserPolicy(ctx, &iam.PutUserPolicyInput{UserName: aws.String(userName), PolicyName: aws.String(p.PolicyName), PolicyDocument: aws.String(p.PolicyDocument)}); err != nil {
+			return err
+		}
+	}
+	for _, arn := range c.Detach {
+		if _, err := client.DetachUserPolicy(ctx, &iam.DetachUserPolicyInput{UserName: aws.String(userName), PolicyArn: aws.String(arn)}); resource.Ignore(IsErrorNotFound, err) != nil {
+			return err
+		}
+	}
+	for _, name := range c.Delete {
+		if _, err := client.DeleteUserPolicy(ctx, &iam.DeleteUserPolicyInput{UserName: aws.String(userName), PolicyName: aws.String(name)}); resource.Ignore(IsErrorNotFound, err) != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+var (
+	policyARN      = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+	otherPolicyARN = "arn:aws:iam::aws:policy/AdministratorAccess"
+	inlineName     = "inline"
+	inlineDocument = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	// same document as inlineDocument with a different formatting
+	inlineDocumentFormatted = `{
+		"Version": "2012-10-17",
+		"Statement": {"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": "*"}
+	}`
+	otherDocument = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+)
+
+func TestDiffIdentityPolicies(t *testing.T) {
+	type args struct {
+		managedPolicyARNs []string
+		inlinePolicies    []v1beta1.InlinePolicy
+		exclusive         bool
+		observed          IdentityPolicies
+	}
+
+	cases := map[string]struct {
+		args args
+		want IdentityPolicyChanges
+	}{
+		"UpToDate": {
+			args: args{
+				managedPolicyARNs: []string{policyARN},
+				inlinePolicies:    []v1beta1.InlinePolicy{{PolicyName: inlineName, PolicyDocument: inlineDocumentFormatted}},
+				exclusive:         true,
+				observed: IdentityPolicies{
+					ManagedPolicyARNs: []string{policyARN},
+					InlinePolicies:    map[string]string{inlineName: inlineDocument},
+				},
+			},
+			want: IdentityPolicyChanges{},
+		},
+		"MissingPolicies": {
+			args: args{
+				managedPolicyARNs: []string{policyARN},
+				inlinePolicies:    []v1beta1.InlinePolicy{{PolicyName: inlineName, PolicyDocument: inlineDocument}},
+				observed:          IdentityPolicies{InlinePolicies: map[string]string{}},
+			},
+			want: IdentityPolicyChanges{
+				Attach: []string{policyARN},
+				Put:    []v1beta1.InlinePolicy{{PolicyName: inlineName, PolicyDocument: inlineDocument}},
+			},
+		},
+		"ChangedInlinePolicy": {
+			args: args{
+				inlinePolicies: []v1beta1.InlinePolicy{{PolicyName: inlineName, PolicyDocument: inlineDocument}},
+				observed: IdentityPolicies{
+					InlinePolicies: map[string]string{inlineName: otherDocument},
+				},
+			},
+			want: IdentityPolicyChanges{
+				Put: []v1beta1.InlinePolicy{{PolicyName: inlineName, PolicyDocument: inlineDocument}},
+			},
+		},
+		"UndeclaredPoliciesAreKept": {
+			args: args{
+				managedPolicyARNs: []string{policyARN},
+				observed: IdentityPolicies{
+					ManagedPolicyARNs: []string{policyARN, otherPolicyARN},
+					InlinePolicies:    map[string]string{inlineName: ""},
+				},
+			},
+			want: IdentityPolicyChanges{},
+		},
+		"UndeclaredPoliciesAreRemovedInExclusiveMode": {
+			args: args{
+				managedPolicyARNs: []string{policyARN},
+				exclusive:         true,
+				observed: IdentityPolicies{
+					ManagedPolicyARNs: []string{policyARN, otherPolicyARN},
+					InlinePolicies:    map[string]string{inlineName: ""},
+				},
+			},
+			want: IdentityPolicyChanges{
+				Detach: []string{otherPolicyARN},
+				Delete: []string{inlineName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffIdentityPolicies(tc.args.managedPolicyARNs, tc.args.inlinePolicies, tc.args.exclusive, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemovableIdentityPolicies(t *testing.T) {
+	observed := IdentityPolicies{
+		ManagedPolicyARNs: []string{policyARN, otherPolicyARN},
+		InlinePolicies:    map[string]string{inlineName: "", "other": ""},
+	}
+
+	cases := map[string]struct {
+		exclusive bool
+		want      IdentityPolicyChanges
+	}{
+		"DeclaredOnly": {
+			want: IdentityPolicyChanges{
+				Detach: []string{policyARN},
+				Delete: []string{inlineName},
+			},
+		},
+		"Exclusive": {
+			exclusive: true,
+			want: IdentityPolicyChanges{
+				Detach: []string{policyARN, otherPolicyARN},
+				Delete: []string{inlineName, "other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RemovableIdentityPolicies([]string{policyARN}, []v1beta1.InlinePolicy{{PolicyName: inlineName}}, tc.exclusive, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// LoginProfileClient is the external client used for LoginProfile Custom Resource
+type LoginProfileClient interface {
+	GetLoginProfile(ctx context.Context, input *iam.GetLoginProfileInput, opts ...func(*iam.Options)) (*iam.GetLoginProfileOutput, error)
+	CreateLoginProfile(ctx context.Context, input *iam.CreateLoginProfileInput, opts ...func(*iam.Options)) (*iam.CreateLoginProfileOutput, error)
+	UpdateLoginProfile(ctx context.Context, input *iam.UpdateLoginProfileInput, opts ...func(*iam.Options)) (*iam.UpdateLoginProfileOutput, error)
+	DeleteLoginProfile(ctx context.Context, input *iam.DeleteLoginProfileInput, opts ...func(*iam.Options)) (*iam.DeleteLoginProfileOutput, error)
+}
+
+// NewLoginProfileClient returns a new client using AWS credentials as JSON encoded data.
+func NewLoginProfileClient(cfg aws.Config) LoginProfileClient {
+	return iam.NewFromConfig(cfg)
+}
@@ -53,6 +53,7 @@ func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion
 }
 
 // IsPolicyDocumentUpToDate checks whether there is a change in any of the modifiable fields in policy.
+// If there is, it returns the statement-level diff of the documents.
 func IsPolicyDocumentUpToDate(in string, policy *string) (bool, string, error) {
 
 	unescapedPolicy, err := url.QueryUnescape(aws.ToString(policy))
@@ -68,8 +69,10 @@ func IsPolicyDocumentUpToDate(in string, policy *string) (bool, string, error) {
 		return false, "", err
 	}
 
-	areEqual, diff := policyutils.ArePoliciesEqal(&specPolicy, &externpolicy)
-	return areEqual, diff, nil
+	if areEqual, _ := policyutils.ArePoliciesEqal(&specPolicy, &externpolicy); areEqual {
+		return true, "", nil
+	}
+	return false, policyutils.DiffPolicies(&specPolicy, &externpolicy).String(), nil
 }
 
 // ValidatePolicyObject tries to parse the raw policy into a Policy object.
@@ -0,0 +1,333 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
+)
+
+const (
+	// DefaultPolicyValidationRegion is the region of the IAM Access Analyzer
+	// that validates policy documents if no region is configured.
+	DefaultPolicyValidationRegion = "us-east-1"
+
+	// TypePolicyValidation is the type of the condition that reports the
+	// result of the policy validation.
+	TypePolicyValidation xpv1.ConditionType = "PolicyValidation"
+
+	// ReasonPolicyValid means that the validation has no findings.
+	ReasonPolicyValid xpv1.ConditionReason = "PolicyValid"
+	// ReasonPolicyFindings means that the validation has findings that do
+	// not prevent the policies from being applied.
+	ReasonPolicyFindings xpv1.ConditionReason = "PolicyFindings"
+	// ReasonPolicyInvalid means that the policies are not applied because
+	// of the findings of the validation.
+	ReasonPolicyInvalid xpv1.ConditionReason = "PolicyInvalid"
+
+	// FindingTypeError is the type of findings that prevent policies from
+	// being applied.
+	FindingTypeError = string(accessanalyzertypes.ValidatePolicyFindingTypeError)
+
+	issueCodeInvalidJSON = "INVALID_JSON"
+
+	errValidatePolicy   = "cannot validate policy document"
+	errSimulatePolicy   = "cannot simulate policy documents"
+	errFmtPolicyInvalid = "policy validation failed:\n%s"
+)
+
+// AccessAnalyzerClient is the external client used to validate policy
+// documents.
+type AccessAnalyzerClient interface {
+	ValidatePolicy(ctx context.Context, input *accessanalyzer.ValidatePolicyInput, opts ...func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error)
+}
+
+// NewAccessAnalyzerClient returns a new IAM Access Analyzer client.
+func NewAccessAnalyzerClient(cfg aws.Config) AccessAnalyzerClient {
+	return accessanalyzer.NewFromConfig(cfg)
+}
+
+// PolicySimulatorClient is the external client used to simulate policy
+// documents.
+type PolicySimulatorClient interface {
+	SimulateCustomPolicy(ctx context.Context, input *iam.SimulateCustomPolicyInput, opts ...func(*iam.Options)) (*iam.SimulateCustomPolicyOutput, error)
+}
+
+// NewPolicySimulatorClient returns a new IAM policy simulator client.
+func NewPolicySimulatorClient(cfg aws.Config) PolicySimulatorClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// PolicyValidationRegion returns the region of the IAM Access Analyzer that
+// validates the policy documents.
+func PolicyValidationRegion(p *v1beta1.PolicyValidation) string {
+	if p == nil || aws.ToString(p.Region) == "" {
+		return DefaultPolicyValidationRegion
+	}
+	return *p.Region
+}
+
+// ValidatedPolicyDocument is a policy document that is validated.
+type ValidatedPolicyDocument struct {
+	// Name identifies the document in the findings, e.g. the field that it
+	// is declared in.
+	Name string
+
+	// Document is the JSON policy document.
+	Document string
+
+	// Type is the IAM Access Analyzer policy type of the document, i.e.
+	// IDENTITY_POLICY or RESOURCE_POLICY. Only identity policies are
+	// simulated.
+	Type accessanalyzertypes.PolicyType
+
+	// ResourceType is the IAM Access Analyzer resource type of a resource
+	// policy, e.g. AWS::IAM::AssumeRolePolicyDocument.
+	ResourceType accessanalyzertypes.ValidatePolicyResourceType
+}
+
+// PolicyFinding is a finding of the validation of a policy document.
+type PolicyFinding struct {
+	// Policy is the name of the policy document.
+	Policy string
+
+	// Type is the type of the finding, i.e. ERROR, SECURITY_WARNING, WARNING
+	// or SUGGESTION.
+	Type string
+
+	// IssueCode identifies the issue, e.g. MISSING_VERSION.
+	IssueCode string
+
+	// Details describes the issue.
+	Details string
+}
+
+// PolicyValidationResult is the result of the validation of policy documents.
+type PolicyValidationResult struct {
+	Findings []PolicyFinding
+
+	// DeniedActions are expected allowed actions that are denied.
+	DeniedActions []string
+
+	// AllowedActions are expected denied actions that are allowed.
+	AllowedActions []string
+}
+
+// IsBlocking returns true if the policy documents must not be applied.
+func (r PolicyValidationResult) IsBlocking() bool {
+	if len(r.DeniedActions) != 0 || len(r.AllowedActions) != 0 {
+		return true
+	}
+	for _, f := range r.Findings {
+		if f.Type == FindingTypeError {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a human readable description of the findings.
+func (r PolicyValidationResult) String() string {
+	lines := make([]string, 0, len(r.Findings)+2)
+	for _, f := range r.Findings {
+		lines = append(lines, fmt.Sprintf("%s: %s %s: %s", f.Policy, f.Type, f.IssueCode, f.Details))
+	}
+	if len(r.DeniedActions) != 0 {
+		lines = append(lines, fmt.Sprintf("expected allowed actions are denied %v", r.DeniedActions))
+	}
+	if len(r.AllowedActions) != 0 {
+		lines = append(lines, fmt.Sprintf("expected denied actions are allowed %v", r.AllowedActions))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Condition returns the PolicyValidation condition for the result.
+func (r PolicyValidationResult) Condition() xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               TypePolicyValidation,
+		Status:             "True",
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPolicyValid,
+	}
+	switch {
+	case r.IsBlocking():
+		c.Status = "False"
+		c.Reason = ReasonPolicyInvalid
+		c.Message = r.String()
+	case len(r.Findings) != 0:
+		c.Reason = ReasonPolicyFindings
+		c.Message = r.String()
+	}
+	return c
+}
+
+// A PolicyValidator validates policy documents with the IAM Access Analyzer
+// and simulates them with the IAM policy simulator.
+type PolicyValidator struct {
+	analyzer  AccessAnalyzerClient
+	simulator PolicySimulatorClient
+}
+
+// NewPolicyValidator returns a new PolicyValidator.
+func NewPolicyValidator(analyzer AccessAnalyzerClient, simulator PolicySimulatorClient) *PolicyValidator {
+	return &PolicyValidator{analyzer: analyzer, simulator: simulator}
+}
+
+// Validate validates the given policy documents. The expected actions are
+// only simulated if none of the documents has findings of type ERROR.
+func (v *PolicyValidator) Validate(ctx context.Context, p v1beta1.PolicyValidation, docs []ValidatedPolicyDocument) (PolicyValidationResult, error) {
+	r := PolicyValidationResult{}
+	for _, d := range docs {
+		if _, err := policyutils.ParsePolicyString(d.Document); err != nil {
+			r.Findings = append(r.Findings, PolicyFinding{Policy: d.Name, Type: FindingTypeError, IssueCode: issueCodeInvalidJSON, Details: err.Error()})
+			continue
+		}
+		findings, err := v.findings(ctx, d)
+		if err != nil {
+			return r, errors.Wrap(err, errValidatePolicy)
+		}
+		r.Findings = append(r.Findings, findings...)
+	}
+	if r.IsBlocking() || (len(p.ExpectedAllowedActions) == 0 && len(p.ExpectedDeniedActions) == 0) {
+		return r, nil
+	}
+
+	allowed, err := v.allowedActions(ctx, p, docs)
+	if err != nil {
+		return r, errors.Wrap(err, errSimulatePolicy)
+	}
+	for _, a := range p.ExpectedAllowedActions {
+		if !allowed[a] {
+			r.DeniedActions = append(r.DeniedActions, a)
+		}
+	}
+	for _, a := range p.ExpectedDeniedActions {
+		if allowed[a] {
+			r.AllowedActions = append(r.AllowedActions, a)
+		}
+	}
+	return r, nil
+}
+
+// ValidateResource validates the given policy documents of a managed
+// resource. It sets the PolicyValidation condition, records an event if there
+// are findings and returns an error if the documents must not be applied.
+func (v *PolicyValidator) ValidateResource(ctx context.Context, mg resource.Managed, rec event.Recorder, p v1beta1.PolicyValidation, docs []ValidatedPolicyDocument) error {
+	r, err := v.Validate(ctx, p, docs)
+	if err != nil {
+		return err
+	}
+	mg.SetConditions(r.Condition())
+	switch {
+	case r.IsBlocking():
+		err := errors.Errorf(errFmtPolicyInvalid, r.String())
+		rec.Event(mg, event.Warning(event.Reason(ReasonPolicyInvalid), err))
+		return err
+	case len(r.Findings) != 0:
+		rec.Event(mg, event.Normal(event.Reason(ReasonPolicyFindings), r.String()))
+	}
+	return nil
+}
+
+func (v *PolicyValidator) findings(ctx context.Context, d ValidatedPolicyDocument) ([]PolicyFinding, error) {
+	var findings []PolicyFinding
+	var token *string
+	for {
+		out, err := v.analyzer.ValidatePolicy(ctx, &accessanalyzer.ValidatePolicyInput{
+			PolicyDocument:             aws.String(d.Document),
+			PolicyType:                 d.Type,
+			ValidatePolicyResourceType: d.ResourceType,
+			NextToken:                  token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range out.Findings {
+			findings = append(findings, PolicyFinding{
+				Policy:    d.Name,
+				Type:      string(f.FindingType),
+				IssueCode: aws.ToString(f.IssueCode),
+				Details:   aws.ToString(f.FindingDetails),
+			})
+		}
+		if aws.ToString(out.NextToken) == "" {
+			return findings, nil
+		}
+		token = out.NextToken
+	}
+}
+
+// allowedActions returns the expected actions that the identity policy
+// documents allow.
+func (v *PolicyValidator) allowedActions(ctx context.Context, p v1beta1.PolicyValidation, docs []ValidatedPolicyDocument) (map[string]bool, error) {
+	allowed := map[string]bool{}
+
+	var policies []string
+	for _, d := range docs {
+		if d.Type == accessanalyzertypes.PolicyTypeIdentityPolicy {
+			policies = append(policies, d.Document)
+		}
+	}
+	// Nothing is allowed without identity policies.
+	if len(policies) == 0 {
+		return allowed, nil
+	}
+
+	actions := append(append([]string{}, p.ExpectedAllowedActions...), p.ExpectedDeniedActions...)
+	sort.Strings(actions)
+	resources := p.ResourceARNs
+	if len(resources) == 0 {
+		resources = []string{"*"}
+	}
+
+	var marker *string
+	for {
+		out, err := v.simulator.SimulateCustomPolicy(ctx, &iam.SimulateCustomPolicyInput{
+			PolicyInputList: policies,
+			ActionNames:     actions,
+			ResourceArns:    resources,
+			Marker:          marker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range out.EvaluationResults {
+			action := aws.ToString(r.EvalActionName)
+			if _, ok := allowed[action]; !ok {
+				allowed[action] = true
+			}
+			allowed[action] = allowed[action] && isAllowed(r)
+		}
+		if !out.IsTruncated {
+			return allowed, nil
+		}
+		marker = out.Marker
+	}
+}
+
+// isAllowed returns true if the action is allowed on all simulated resources.
+func isAllowed(r iamtypes.EvaluationResult) bool {
+	if r.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+		return false
+	}
+	for _, rr := range r.ResourceSpecificResults {
+		if rr.EvalResourceDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+			return false
+		}
+	}
+	return true
+}
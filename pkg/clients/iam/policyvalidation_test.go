@@ -0,0 +1,192 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+type mockAnalyzer struct {
+	findings map[string][]accessanalyzertypes.ValidatePolicyFinding
+	err      error
+}
+
+func (m *mockAnalyzer) ValidatePolicy(_ context.Context, input *accessanalyzer.ValidatePolicyInput, _ ...func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+	return &accessanalyzer.ValidatePolicyOutput{Findings: m.findings[*input.PolicyDocument]}, m.err
+}
+
+type mockSimulator struct {
+	decisions map[string]iamtypes.PolicyEvaluationDecisionType
+}
+
+func (m *mockSimulator) SimulateCustomPolicy(_ context.Context, input *iam.SimulateCustomPolicyInput, _ ...func(*iam.Options)) (*iam.SimulateCustomPolicyOutput, error) {
+	out := &iam.SimulateCustomPolicyOutput{}
+	for _, a := range input.ActionNames {
+		out.EvaluationResults = append(out.EvaluationResults, iamtypes.EvaluationResult{
+			EvalActionName: aws.String(a),
+			EvalDecision:   m.decisions[a],
+		})
+	}
+	return out, nil
+}
+
+func TestPolicyValidatorValidate(t *testing.T) {
+	errBoom := errors.New("boom")
+	doc := ValidatedPolicyDocument{Name: "document", Document: inlineDocument, Type: accessanalyzertypes.PolicyTypeIdentityPolicy}
+	warning := accessanalyzertypes.ValidatePolicyFinding{
+		FindingType:    accessanalyzertypes.ValidatePolicyFindingTypeWarning,
+		IssueCode:      aws.String("REDUNDANT_ACTION"),
+		FindingDetails: aws.String("redundant"),
+	}
+	failure := accessanalyzertypes.ValidatePolicyFinding{
+		FindingType:    accessanalyzertypes.ValidatePolicyFindingTypeError,
+		IssueCode:      aws.String("INVALID_ACTION"),
+		FindingDetails: aws.String("invalid"),
+	}
+	decisions := map[string]iamtypes.PolicyEvaluationDecisionType{
+		"s3:GetObject": iamtypes.PolicyEvaluationDecisionTypeAllowed,
+		"s3:PutObject": iamtypes.PolicyEvaluationDecisionTypeImplicitDeny,
+	}
+
+	type want struct {
+		result   PolicyValidationResult
+		blocking bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		analyzer   *mockAnalyzer
+		validation v1beta1.PolicyValidation
+		docs       []ValidatedPolicyDocument
+		want       want
+	}{
+		"Valid": {
+			analyzer: &mockAnalyzer{},
+			docs:     []ValidatedPolicyDocument{doc},
+		},
+		"InvalidJSON": {
+			analyzer: &mockAnalyzer{},
+			docs:     []ValidatedPolicyDocument{{Name: "document", Document: "{", Type: accessanalyzertypes.PolicyTypeIdentityPolicy}},
+			want: want{
+				result: PolicyValidationResult{Findings: []PolicyFinding{{
+					Policy: "document", Type: FindingTypeError, IssueCode: issueCodeInvalidJSON, Details: "unexpected end of JSON input",
+				}}},
+				blocking: true,
+			},
+		},
+		"Warning": {
+			analyzer: &mockAnalyzer{findings: map[string][]accessanalyzertypes.ValidatePolicyFinding{inlineDocument: {warning}}},
+			docs:     []ValidatedPolicyDocument{doc},
+			want: want{
+				result: PolicyValidationResult{Findings: []PolicyFinding{{
+					Policy: "document", Type: string(accessanalyzertypes.ValidatePolicyFindingTypeWarning), IssueCode: "REDUNDANT_ACTION", Details: "redundant",
+				}}},
+			},
+		},
+		"Error": {
+			analyzer:   &mockAnalyzer{findings: map[string][]accessanalyzertypes.ValidatePolicyFinding{inlineDocument: {failure}}},
+			validation: v1beta1.PolicyValidation{ExpectedAllowedActions: []string{"s3:PutObject"}},
+			docs:       []ValidatedPolicyDocument{doc},
+			want: want{
+				result: PolicyValidationResult{Findings: []PolicyFinding{{
+					Policy: "document", Type: FindingTypeError, IssueCode: "INVALID_ACTION", Details: "invalid",
+				}}},
+				blocking: true,
+			},
+		},
+		"ExpectedActions": {
+			analyzer:   &mockAnalyzer{},
+			validation: v1beta1.PolicyValidation{ExpectedAllowedActions: []string{"s3:GetObject"}, ExpectedDeniedActions: []string{"s3:PutObject"}},
+			docs:       []ValidatedPolicyDocument{doc},
+		},
+		"UnexpectedActions": {
+			analyzer:   &mockAnalyzer{},
+			validation: v1beta1.PolicyValidation{ExpectedAllowedActions: []string{"s3:PutObject"}, ExpectedDeniedActions: []string{"s3:GetObject"}},
+			docs:       []ValidatedPolicyDocument{doc},
+			want: want{
+				result: PolicyValidationResult{
+					DeniedActions:  []string{"s3:PutObject"},
+					AllowedActions: []string{"s3:GetObject"},
+				},
+				blocking: true,
+			},
+		},
+		"NoIdentityPolicies": {
+			analyzer:   &mockAnalyzer{},
+			validation: v1beta1.PolicyValidation{ExpectedAllowedActions: []string{"s3:GetObject"}},
+			docs:       []ValidatedPolicyDocument{{Name: "trust", Document: inlineDocument, Type: accessanalyzertypes.PolicyTypeResourcePolicy}},
+			want: want{
+				result:   PolicyValidationResult{DeniedActions: []string{"s3:GetObject"}},
+				blocking: true,
+			},
+		},
+		"AnalyzerError": {
+			analyzer: &mockAnalyzer{err: errBoom},
+			docs:     []ValidatedPolicyDocument{doc},
+			want: want{
+				err: errors.Wrap(errBoom, errValidatePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := NewPolicyValidator(tc.analyzer, &mockSimulator{decisions: decisions})
+			got, err := v.Validate(context.Background(), tc.validation, tc.docs)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.blocking, got.IsBlocking()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyValidationResultCondition(t *testing.T) {
+	cases := map[string]struct {
+		result PolicyValidationResult
+		status string
+		reason string
+	}{
+		"Valid": {
+			status: "True",
+			reason: string(ReasonPolicyValid),
+		},
+		"Findings": {
+			result: PolicyValidationResult{Findings: []PolicyFinding{{Type: string(accessanalyzertypes.ValidatePolicyFindingTypeSuggestion)}}},
+			status: "True",
+			reason: string(ReasonPolicyFindings),
+		},
+		"Invalid": {
+			result: PolicyValidationResult{DeniedActions: []string{"s3:GetObject"}},
+			status: "False",
+			reason: string(ReasonPolicyInvalid),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := tc.result.Condition()
+			if diff := cmp.Diff(tc.status, string(c.Status)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.reason, string(c.Reason)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
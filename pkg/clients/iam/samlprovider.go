@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// SAMLProviderClient is the external client used for SAMLProvider Custom Resource
+type SAMLProviderClient interface {
+	GetSAMLProvider(ctx context.Context, input *iam.GetSAMLProviderInput, opts ...func(*iam.Options)) (*iam.GetSAMLProviderOutput, error)
+	CreateSAMLProvider(ctx context.Context, input *iam.CreateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.CreateSAMLProviderOutput, error)
+	UpdateSAMLProvider(ctx context.Context, input *iam.UpdateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UpdateSAMLProviderOutput, error)
+	DeleteSAMLProvider(ctx context.Context, input *iam.DeleteSAMLProviderInput, opts ...func(*iam.Options)) (*iam.DeleteSAMLProviderOutput, error)
+	TagSAMLProvider(ctx context.Context, input *iam.TagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.TagSAMLProviderOutput, error)
+	UntagSAMLProvider(ctx context.Context, input *iam.UntagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UntagSAMLProviderOutput, error)
+}
+
+// NewSAMLProviderClient returns a new client using AWS credentials as JSON encoded data.
+func NewSAMLProviderClient(cfg aws.Config) SAMLProviderClient {
+	return iam.NewFromConfig(cfg)
+}
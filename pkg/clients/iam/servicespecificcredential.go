@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// ServiceSpecificCredentialClient is the external client used for ServiceSpecificCredential Custom Resource
+type ServiceSpecificCredentialClient interface {
+	CreateServiceSpecificCredential(ctx context.Context, input *iam.CreateServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.CreateServiceSpecificCredentialOutput, error)
+	ListServiceSpecificCredentials(ctx context.Context, input *iam.ListServiceSpecificCredentialsInput, opts ...func(*iam.Options)) (*iam.ListServiceSpecificCredentialsOutput, error)
+	UpdateServiceSpecificCredential(ctx context.Context, input *iam.UpdateServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.UpdateServiceSpecificCredentialOutput, error)
+	DeleteServiceSpecificCredential(ctx context.Context, input *iam.DeleteServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.DeleteServiceSpecificCredentialOutput, error)
+}
+
+// NewServiceSpecificCredentialClient returns a new client using AWS credentials as JSON encoded data.
+func NewServiceSpecificCredentialClient(cfg aws.Config) ServiceSpecificCredentialClient {
+	return iam.NewFromConfig(cfg)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 )
 
@@ -62,3 +63,22 @@ func diffTags(local map[string]string, remote []iamtypes.Tag) (add map[string]st
 
 	return add, remove, areTagsUpToDate
 }
+
+// AlphaTagMap returns the tags of a v1alpha1 IAM resource as map.
+func AlphaTagMap(tags []v1alpha1.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return m
+}
+
+// BuildAlphaIAMTags build a tag array with type that IAM client expects from
+// the tags of a v1alpha1 IAM resource.
+func BuildAlphaIAMTags(tags []v1alpha1.Tag) []iamtypes.Tag {
+	res := make([]iamtypes.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = iamtypes.Tag{Key: t.Key, Value: t.Value}
+	}
+	return res
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// VirtualMFADeviceClient is the external client used for VirtualMFADevice Custom Resource
+type VirtualMFADeviceClient interface {
+	CreateVirtualMFADevice(ctx context.Context, input *iam.CreateVirtualMFADeviceInput, opts ...func(*iam.Options)) (*iam.CreateVirtualMFADeviceOutput, error)
+	ListVirtualMFADevices(ctx context.Context, input *iam.ListVirtualMFADevicesInput, opts ...func(*iam.Options)) (*iam.ListVirtualMFADevicesOutput, error)
+	DeleteVirtualMFADevice(ctx context.Context, input *iam.DeleteVirtualMFADeviceInput, opts ...func(*iam.Options)) (*iam.DeleteVirtualMFADeviceOutput, error)
+	DeactivateMFADevice(ctx context.Context, input *iam.DeactivateMFADeviceInput, opts ...func(*iam.Options)) (*iam.DeactivateMFADeviceOutput, error)
+	ListMFADeviceTags(ctx context.Context, input *iam.ListMFADeviceTagsInput, opts ...func(*iam.Options)) (*iam.ListMFADeviceTagsOutput, error)
+	TagMFADevice(ctx context.Context, input *iam.TagMFADeviceInput, opts ...func(*iam.Options)) (*iam.TagMFADeviceOutput, error)
+	UntagMFADevice(ctx context.Context, input *iam.UntagMFADeviceInput, opts ...func(*iam.Options)) (*iam.UntagMFADeviceOutput, error)
+}
+
+// NewVirtualMFADeviceClient returns a new client using AWS credentials as JSON encoded data.
+func NewVirtualMFADeviceClient(cfg aws.Config) VirtualMFADeviceClient {
+	return iam.NewFromConfig(cfg)
+}
@@ -29,9 +29,11 @@ var _ clientset.BucketClient = (*MockBucketClient)(nil)
 
 // MockBucketClient is a type that implements all the methods for BucketClient interface
 type MockBucketClient struct {
-	MockHeadBucket   func(ctx context.Context, input *s3.HeadBucketInput, opts []func(*s3.Options)) (*s3.HeadBucketOutput, error)
-	MockCreateBucket func(ctx context.Context, input *s3.CreateBucketInput, opts []func(*s3.Options)) (*s3.CreateBucketOutput, error)
-	MockDeleteBucket func(ctx context.Context, input *s3.DeleteBucketInput, opts []func(*s3.Options)) (*s3.DeleteBucketOutput, error)
+	MockHeadBucket        func(ctx context.Context, input *s3.HeadBucketInput, opts []func(*s3.Options)) (*s3.HeadBucketOutput, error)
+	MockListBuckets       func(ctx context.Context, input *s3.ListBucketsInput, opts []func(*s3.Options)) (*s3.ListBucketsOutput, error)
+	MockGetBucketLocation func(ctx context.Context, input *s3.GetBucketLocationInput, opts []func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+	MockCreateBucket      func(ctx context.Context, input *s3.CreateBucketInput, opts []func(*s3.Options)) (*s3.CreateBucketOutput, error)
+	MockDeleteBucket      func(ctx context.Context, input *s3.DeleteBucketInput, opts []func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 
 	MockPutBucketEncryption    func(ctx context.Context, input *s3.PutBucketEncryptionInput, opts []func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
 	MockGetBucketEncryption    func(ctx context.Context, input *s3.GetBucketEncryptionInput, opts []func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
@@ -94,6 +96,16 @@ func (m MockBucketClient) HeadBucket(ctx context.Context, input *s3.HeadBucketIn
 	return m.MockHeadBucket(ctx, input, opts)
 }
 
+// ListBuckets is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBuckets(ctx context.Context, input *s3.ListBucketsInput, opts ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	return m.MockListBuckets(ctx, input, opts)
+}
+
+// GetBucketLocation is the fake method call to invoke the internal mock method
+func (m MockBucketClient) GetBucketLocation(ctx context.Context, input *s3.GetBucketLocationInput, opts ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	return m.MockGetBucketLocation(ctx, input, opts)
+}
+
 // CreateBucket is the fake method call to invoke the internal mock method
 func (m MockBucketClient) CreateBucket(ctx context.Context, input *s3.CreateBucketInput, opts ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
 	return m.MockCreateBucket(ctx, input, opts)
@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // S3 uses MD5 for the ETags of single part uploads.
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// ObjectClient is the external client used for Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+	PutObjectTagging(ctx context.Context, input *s3.PutObjectTaggingInput, opts ...func(*s3.Options)) (*s3.PutObjectTaggingOutput, error)
+	DeleteObjectTagging(ctx context.Context, input *s3.DeleteObjectTaggingInput, opts ...func(*s3.Options)) (*s3.DeleteObjectTaggingOutput, error)
+	PutObjectRetention(ctx context.Context, input *s3.PutObjectRetentionInput, opts ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// IsObjectNotFound returns true if the error code indicates that the object
+// was not found
+func IsObjectNotFound(err error) bool {
+	if IsNotFound(err) {
+		return true
+	}
+	var noSuchKey *s3types.NoSuchKey
+	return errors.As(err, &noSuchKey)
+}
+
+// ContentSHA256 returns the base64 encoded SHA-256 checksum of the content as
+// it is reported by S3.
+func ContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// ContentETag returns the ETag of the content if it is uploaded in a single
+// part and not encrypted with a KMS key.
+func ContentETag(content []byte) string {
+	sum := md5.Sum(content) //nolint:gosec // S3 uses MD5 for the ETags of single part uploads.
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// GeneratePutObjectInput returns the input for the PutObject request that
+// uploads the given content with the desired parameters.
+func GeneratePutObjectInput(p v1alpha3.ObjectParameters, content []byte) *s3.PutObjectInput {
+	in := &s3.PutObjectInput{
+		Bucket:            p.Bucket,
+		Key:               aws.String(p.Key),
+		Body:              bytes.NewReader(content),
+		ContentLength:     aws.Int64(int64(len(content))),
+		ChecksumAlgorithm: s3types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    aws.String(ContentSHA256(content)),
+		ContentType:       p.ContentType,
+		Metadata:          p.Metadata,
+		SSEKMSKeyId:       p.SSEKMSKeyID,
+	}
+	if p.ServerSideEncryption != nil {
+		in.ServerSideEncryption = s3types.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	if len(p.Tags) != 0 {
+		in.Tagging = aws.String(GenerateObjectTagging(p.Tags))
+	}
+	if r := p.ObjectLockRetention; r != nil {
+		in.ObjectLockMode = s3types.ObjectLockMode(r.Mode)
+		in.ObjectLockRetainUntilDate = aws.Time(r.RetainUntilDate.Time)
+	}
+	return in
+}
+
+// GenerateObjectTagging returns the URL encoded tag set of the PutObject
+// request.
+func GenerateObjectTagging(tags []v1beta1.Tag) string {
+	v := url.Values{}
+	for _, t := range tags {
+		v.Set(t.Key, t.Value)
+	}
+	return v.Encode()
+}
+
+// GenerateObjectObservation returns the observation of the given object.
+func GenerateObjectObservation(head *s3.HeadObjectOutput) v1alpha3.ObjectObservation {
+	return v1alpha3.ObjectObservation{
+		ETag:           head.ETag,
+		ChecksumSHA256: head.ChecksumSHA256,
+		VersionID:      head.VersionId,
+		LastModified:   pointer.TimeToMetaTime(head.LastModified),
+	}
+}
+
+// IsObjectContentUpToDate returns true if the observed object has the given
+// content. The SHA-256 checksum is compared if S3 reports one, otherwise the
+// ETag is compared if it is an MD5 digest of the content.
+func IsObjectContentUpToDate(content []byte, head *s3.HeadObjectOutput) bool {
+	if head.ChecksumSHA256 != nil {
+		return aws.ToString(head.ChecksumSHA256) == ContentSHA256(content)
+	}
+	// The ETags of multipart uploads and of objects that are encrypted with
+	// a KMS key are no MD5 digests.
+	etag := aws.ToString(head.ETag)
+	if strings.Contains(etag, "-") || strings.HasPrefix(string(head.ServerSideEncryption), "aws:kms") {
+		return false
+	}
+	return etag == ContentETag(content)
+}
+
+// IsObjectMetadataUpToDate returns true if the content type, the user-defined
+// metadata and the server-side encryption of the observed object are the
+// desired ones. Parameters that are not set are not compared.
+func IsObjectMetadataUpToDate(p v1alpha3.ObjectParameters, head *s3.HeadObjectOutput) bool {
+	if p.ContentType != nil && aws.ToString(p.ContentType) != aws.ToString(head.ContentType) {
+		return false
+	}
+	// S3 stores the keys of the user-defined metadata in lower case.
+	metadata := make(map[string]string, len(p.Metadata))
+	for k, v := range p.Metadata {
+		metadata[strings.ToLower(k)] = v
+	}
+	if !cmp.Equal(metadata, head.Metadata, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && *p.ServerSideEncryption != string(head.ServerSideEncryption) {
+		return false
+	}
+	return p.SSEKMSKeyID == nil || isKMSKeyEqual(*p.SSEKMSKeyID, aws.ToString(head.SSEKMSKeyId))
+}
+
+// isKMSKeyEqual returns true if the desired key ID or ARN identifies the
+// observed key ARN.
+func isKMSKeyEqual(desired, observed string) bool {
+	return desired == observed || strings.HasSuffix(observed, ":key/"+desired)
+}
+
+// IsObjectRetentionUpToDate returns true if the observed object has the
+// desired object lock retention. The retention is not compared if it is not
+// set.
+func IsObjectRetentionUpToDate(p v1alpha3.ObjectParameters, head *s3.HeadObjectOutput) bool {
+	r := p.ObjectLockRetention
+	if r == nil {
+		return true
+	}
+	if r.Mode != string(head.ObjectLockMode) || head.ObjectLockRetainUntilDate == nil {
+		return false
+	}
+	// S3 reports the retain until date with a precision of seconds.
+	return r.RetainUntilDate.UTC().Truncate(time.Second).Equal(head.ObjectLockRetainUntilDate.UTC().Truncate(time.Second))
+}
+
+// AreObjectTagsUpToDate returns true if the observed tags of an object are
+// the desired ones.
+func AreObjectTagsUpToDate(tags []v1beta1.Tag, observed []s3types.Tag) bool {
+	return cmp.Equal(SortS3TagSet(CopyTags(tags)), SortS3TagSet(observed), cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(s3types.Tag{}))
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+)
+
+var (
+	objectContent = []byte("hello world")
+	// sha256 and md5 of objectContent
+	objectSHA256 = "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="
+	objectETag   = `"5eb63bbbe01eeed093cb22bb8f5acdc3"`
+	kmsKeyID     = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	kmsKeyARN    = "arn:aws:kms:us-east-1:123456789012:key/" + kmsKeyID
+)
+
+func TestIsObjectContentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		head *s3.HeadObjectOutput
+		want bool
+	}{
+		"ChecksumEqual": {
+			head: &s3.HeadObjectOutput{ChecksumSHA256: aws.String(objectSHA256), ETag: aws.String(`"other"`)},
+			want: true,
+		},
+		"ChecksumDifferent": {
+			head: &s3.HeadObjectOutput{ChecksumSHA256: aws.String("other"), ETag: aws.String(objectETag)},
+			want: false,
+		},
+		"ETagEqual": {
+			head: &s3.HeadObjectOutput{ETag: aws.String(objectETag)},
+			want: true,
+		},
+		"ETagDifferent": {
+			head: &s3.HeadObjectOutput{ETag: aws.String(`"other"`)},
+			want: false,
+		},
+		"ETagOfKMSEncryptedObject": {
+			head: &s3.HeadObjectOutput{ETag: aws.String(objectETag), ServerSideEncryption: s3types.ServerSideEncryptionAwsKms},
+			want: false,
+		},
+		"ETagOfMultipartUpload": {
+			head: &s3.HeadObjectOutput{ETag: aws.String(`"5eb63bbbe01eeed093cb22bb8f5acdc3-2"`)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsObjectContentUpToDate(objectContent, tc.head); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsObjectMetadataUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.ObjectParameters
+		head *s3.HeadObjectOutput
+		want bool
+	}{
+		"UnsetParameters": {
+			p:    v1alpha3.ObjectParameters{},
+			head: &s3.HeadObjectOutput{ContentType: aws.String("binary/octet-stream"), ServerSideEncryption: s3types.ServerSideEncryptionAes256},
+			want: true,
+		},
+		"UpToDate": {
+			p: v1alpha3.ObjectParameters{
+				ContentType:          aws.String("application/json"),
+				Metadata:             map[string]string{"Owner": "team"},
+				ServerSideEncryption: aws.String("aws:kms"),
+				SSEKMSKeyID:          aws.String(kmsKeyID),
+			},
+			head: &s3.HeadObjectOutput{
+				ContentType:          aws.String("application/json"),
+				Metadata:             map[string]string{"owner": "team"},
+				ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+				SSEKMSKeyId:          aws.String(kmsKeyARN),
+			},
+			want: true,
+		},
+		"ContentTypeChanged": {
+			p:    v1alpha3.ObjectParameters{ContentType: aws.String("application/json")},
+			head: &s3.HeadObjectOutput{ContentType: aws.String("text/plain")},
+			want: false,
+		},
+		"MetadataRemoved": {
+			p:    v1alpha3.ObjectParameters{},
+			head: &s3.HeadObjectOutput{Metadata: map[string]string{"owner": "team"}},
+			want: false,
+		},
+		"KMSKeyChanged": {
+			p:    v1alpha3.ObjectParameters{SSEKMSKeyID: aws.String("other")},
+			head: &s3.HeadObjectOutput{SSEKMSKeyId: aws.String(kmsKeyARN)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsObjectMetadataUpToDate(tc.p, tc.head); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsObjectRetentionUpToDate(t *testing.T) {
+	until := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	retention := &v1alpha3.ObjectLockRetention{Mode: "GOVERNANCE", RetainUntilDate: metav1.NewTime(until)}
+
+	cases := map[string]struct {
+		r    *v1alpha3.ObjectLockRetention
+		head *s3.HeadObjectOutput
+		want bool
+	}{
+		"NotSet": {
+			head: &s3.HeadObjectOutput{ObjectLockMode: s3types.ObjectLockModeCompliance, ObjectLockRetainUntilDate: aws.Time(until)},
+			want: true,
+		},
+		"UpToDate": {
+			r:    retention,
+			head: &s3.HeadObjectOutput{ObjectLockMode: s3types.ObjectLockModeGovernance, ObjectLockRetainUntilDate: aws.Time(until)},
+			want: true,
+		},
+		"NotLocked": {
+			r:    retention,
+			head: &s3.HeadObjectOutput{},
+			want: false,
+		},
+		"DateChanged": {
+			r:    retention,
+			head: &s3.HeadObjectOutput{ObjectLockMode: s3types.ObjectLockModeGovernance, ObjectLockRetainUntilDate: aws.Time(until.Add(time.Hour))},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsObjectRetentionUpToDate(v1alpha3.ObjectParameters{ObjectLockRetention: tc.r}, tc.head); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAreObjectTagsUpToDate(t *testing.T) {
+	tags := []v1beta1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}}
+
+	cases := map[string]struct {
+		tags     []v1beta1.Tag
+		observed []s3types.Tag
+		want     bool
+	}{
+		"Empty": {
+			want: true,
+		},
+		"ReorderedTags": {
+			tags:     tags,
+			observed: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("2")}},
+			want:     true,
+		},
+		"ChangedValue": {
+			tags:     tags,
+			observed: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("3")}},
+			want:     false,
+		},
+		"AddedTag": {
+			observed: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AreObjectTagsUpToDate(tc.tags, tc.observed); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateObjectTagging(t *testing.T) {
+	got := GenerateObjectTagging([]v1beta1.Tag{{Key: "team", Value: "a b"}, {Key: "env", Value: "prod&dev"}})
+	if want := "env=prod%26dev&team=a+b"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+)
+
+// MockJobClient is a mock of the JobClient
+type MockJobClient struct {
+	MockCreateJobWithContext   func(context.Context, *svcsdk.CreateJobInput, ...request.Option) (*svcsdk.CreateJobOutput, error)
+	MockDescribeJobWithContext func(context.Context, *svcsdk.DescribeJobInput, ...request.Option) (*svcsdk.DescribeJobOutput, error)
+}
+
+// CreateJobWithContext calls the underlying MockCreateJobWithContext method.
+func (m *MockJobClient) CreateJobWithContext(ctx context.Context, input *svcsdk.CreateJobInput, opts ...request.Option) (*svcsdk.CreateJobOutput, error) {
+	return m.MockCreateJobWithContext(ctx, input, opts...)
+}
+
+// DescribeJobWithContext calls the underlying MockDescribeJobWithContext method.
+func (m *MockJobClient) DescribeJobWithContext(ctx context.Context, input *svcsdk.DescribeJobInput, opts ...request.Option) (*svcsdk.DescribeJobOutput, error) {
+	return m.MockDescribeJobWithContext(ctx, input, opts...)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+)
+
+// MockMultiRegionAccessPointClient is a mock of the MultiRegionAccessPointClient
+type MockMultiRegionAccessPointClient struct {
+	MockCreateMultiRegionAccessPointWithContext            func(context.Context, *svcsdk.CreateMultiRegionAccessPointInput, ...request.Option) (*svcsdk.CreateMultiRegionAccessPointOutput, error)
+	MockGetMultiRegionAccessPointWithContext               func(context.Context, *svcsdk.GetMultiRegionAccessPointInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error)
+	MockDeleteMultiRegionAccessPointWithContext            func(context.Context, *svcsdk.DeleteMultiRegionAccessPointInput, ...request.Option) (*svcsdk.DeleteMultiRegionAccessPointOutput, error)
+	MockDescribeMultiRegionAccessPointOperationWithContext func(context.Context, *svcsdk.DescribeMultiRegionAccessPointOperationInput, ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error)
+	MockGetMultiRegionAccessPointPolicyWithContext         func(context.Context, *svcsdk.GetMultiRegionAccessPointPolicyInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointPolicyOutput, error)
+	MockPutMultiRegionAccessPointPolicyWithContext         func(context.Context, *svcsdk.PutMultiRegionAccessPointPolicyInput, ...request.Option) (*svcsdk.PutMultiRegionAccessPointPolicyOutput, error)
+}
+
+// CreateMultiRegionAccessPointWithContext calls the underlying MockCreateMultiRegionAccessPointWithContext method.
+func (m *MockMultiRegionAccessPointClient) CreateMultiRegionAccessPointWithContext(ctx context.Context, input *svcsdk.CreateMultiRegionAccessPointInput, opts ...request.Option) (*svcsdk.CreateMultiRegionAccessPointOutput, error) {
+	return m.MockCreateMultiRegionAccessPointWithContext(ctx, input, opts...)
+}
+
+// GetMultiRegionAccessPointWithContext calls the underlying MockGetMultiRegionAccessPointWithContext method.
+func (m *MockMultiRegionAccessPointClient) GetMultiRegionAccessPointWithContext(ctx context.Context, input *svcsdk.GetMultiRegionAccessPointInput, opts ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error) {
+	return m.MockGetMultiRegionAccessPointWithContext(ctx, input, opts...)
+}
+
+// DeleteMultiRegionAccessPointWithContext calls the underlying MockDeleteMultiRegionAccessPointWithContext method.
+func (m *MockMultiRegionAccessPointClient) DeleteMultiRegionAccessPointWithContext(ctx context.Context, input *svcsdk.DeleteMultiRegionAccessPointInput, opts ...request.Option) (*svcsdk.DeleteMultiRegionAccessPointOutput, error) {
+	return m.MockDeleteMultiRegionAccessPointWithContext(ctx, input, opts...)
+}
+
+// DescribeMultiRegionAccessPointOperationWithContext calls the underlying MockDescribeMultiRegionAccessPointOperationWithContext method.
+func (m *MockMultiRegionAccessPointClient) DescribeMultiRegionAccessPointOperationWithContext(ctx context.Context, input *svcsdk.DescribeMultiRegionAccessPointOperationInput, opts ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error) {
+	return m.MockDescribeMultiRegionAccessPointOperationWithContext(ctx, input, opts...)
+}
+
+// GetMultiRegionAccessPointPolicyWithContext calls the underlying MockGetMultiRegionAccessPointPolicyWithContext method.
+func (m *MockMultiRegionAccessPointClient) GetMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *svcsdk.GetMultiRegionAccessPointPolicyInput, opts ...request.Option) (*svcsdk.GetMultiRegionAccessPointPolicyOutput, error) {
+	return m.MockGetMultiRegionAccessPointPolicyWithContext(ctx, input, opts...)
+}
+
+// PutMultiRegionAccessPointPolicyWithContext calls the underlying MockPutMultiRegionAccessPointPolicyWithContext method.
+func (m *MockMultiRegionAccessPointClient) PutMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *svcsdk.PutMultiRegionAccessPointPolicyInput, opts ...request.Option) (*svcsdk.PutMultiRegionAccessPointPolicyOutput, error) {
+	return m.MockPutMultiRegionAccessPointPolicyWithContext(ctx, input, opts...)
+}
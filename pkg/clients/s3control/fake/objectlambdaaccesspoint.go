@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+)
+
+// MockObjectLambdaAccessPointClient is a mock of the ObjectLambdaAccessPointClient
+type MockObjectLambdaAccessPointClient struct {
+	MockCreateAccessPointForObjectLambdaWithContext           func(context.Context, *svcsdk.CreateAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.CreateAccessPointForObjectLambdaOutput, error)
+	MockGetAccessPointForObjectLambdaWithContext              func(context.Context, *svcsdk.GetAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error)
+	MockDeleteAccessPointForObjectLambdaWithContext           func(context.Context, *svcsdk.DeleteAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.DeleteAccessPointForObjectLambdaOutput, error)
+	MockGetAccessPointConfigurationForObjectLambdaWithContext func(context.Context, *svcsdk.GetAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointConfigurationForObjectLambdaOutput, error)
+	MockPutAccessPointConfigurationForObjectLambdaWithContext func(context.Context, *svcsdk.PutAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*svcsdk.PutAccessPointConfigurationForObjectLambdaOutput, error)
+	MockGetAccessPointPolicyForObjectLambdaWithContext        func(context.Context, *svcsdk.GetAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointPolicyForObjectLambdaOutput, error)
+	MockPutAccessPointPolicyForObjectLambdaWithContext        func(context.Context, *svcsdk.PutAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.PutAccessPointPolicyForObjectLambdaOutput, error)
+	MockDeleteAccessPointPolicyForObjectLambdaWithContext     func(context.Context, *svcsdk.DeleteAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.DeleteAccessPointPolicyForObjectLambdaOutput, error)
+}
+
+// CreateAccessPointForObjectLambdaWithContext calls the underlying MockCreateAccessPointForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) CreateAccessPointForObjectLambdaWithContext(ctx context.Context, input *svcsdk.CreateAccessPointForObjectLambdaInput, opts ...request.Option) (*svcsdk.CreateAccessPointForObjectLambdaOutput, error) {
+	return m.MockCreateAccessPointForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// GetAccessPointForObjectLambdaWithContext calls the underlying MockGetAccessPointForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) GetAccessPointForObjectLambdaWithContext(ctx context.Context, input *svcsdk.GetAccessPointForObjectLambdaInput, opts ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error) {
+	return m.MockGetAccessPointForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// DeleteAccessPointForObjectLambdaWithContext calls the underlying MockDeleteAccessPointForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) DeleteAccessPointForObjectLambdaWithContext(ctx context.Context, input *svcsdk.DeleteAccessPointForObjectLambdaInput, opts ...request.Option) (*svcsdk.DeleteAccessPointForObjectLambdaOutput, error) {
+	return m.MockDeleteAccessPointForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// GetAccessPointConfigurationForObjectLambdaWithContext calls the underlying MockGetAccessPointConfigurationForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) GetAccessPointConfigurationForObjectLambdaWithContext(ctx context.Context, input *svcsdk.GetAccessPointConfigurationForObjectLambdaInput, opts ...request.Option) (*svcsdk.GetAccessPointConfigurationForObjectLambdaOutput, error) {
+	return m.MockGetAccessPointConfigurationForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// PutAccessPointConfigurationForObjectLambdaWithContext calls the underlying MockPutAccessPointConfigurationForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) PutAccessPointConfigurationForObjectLambdaWithContext(ctx context.Context, input *svcsdk.PutAccessPointConfigurationForObjectLambdaInput, opts ...request.Option) (*svcsdk.PutAccessPointConfigurationForObjectLambdaOutput, error) {
+	return m.MockPutAccessPointConfigurationForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// GetAccessPointPolicyForObjectLambdaWithContext calls the underlying MockGetAccessPointPolicyForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) GetAccessPointPolicyForObjectLambdaWithContext(ctx context.Context, input *svcsdk.GetAccessPointPolicyForObjectLambdaInput, opts ...request.Option) (*svcsdk.GetAccessPointPolicyForObjectLambdaOutput, error) {
+	return m.MockGetAccessPointPolicyForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// PutAccessPointPolicyForObjectLambdaWithContext calls the underlying MockPutAccessPointPolicyForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) PutAccessPointPolicyForObjectLambdaWithContext(ctx context.Context, input *svcsdk.PutAccessPointPolicyForObjectLambdaInput, opts ...request.Option) (*svcsdk.PutAccessPointPolicyForObjectLambdaOutput, error) {
+	return m.MockPutAccessPointPolicyForObjectLambdaWithContext(ctx, input, opts...)
+}
+
+// DeleteAccessPointPolicyForObjectLambdaWithContext calls the underlying MockDeleteAccessPointPolicyForObjectLambdaWithContext method.
+func (m *MockObjectLambdaAccessPointClient) DeleteAccessPointPolicyForObjectLambdaWithContext(ctx context.Context, input *svcsdk.DeleteAccessPointPolicyForObjectLambdaInput, opts ...request.Option) (*svcsdk.DeleteAccessPointPolicyForObjectLambdaOutput, error) {
+	return m.MockDeleteAccessPointPolicyForObjectLambdaWithContext(ctx, input, opts...)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+)
+
+// MockStorageLensClient is a mock of the StorageLensClient
+type MockStorageLensClient struct {
+	MockPutStorageLensConfigurationWithContext           func(context.Context, *svcsdk.PutStorageLensConfigurationInput, ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error)
+	MockGetStorageLensConfigurationWithContext           func(context.Context, *svcsdk.GetStorageLensConfigurationInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error)
+	MockDeleteStorageLensConfigurationWithContext        func(context.Context, *svcsdk.DeleteStorageLensConfigurationInput, ...request.Option) (*svcsdk.DeleteStorageLensConfigurationOutput, error)
+	MockGetStorageLensConfigurationTaggingWithContext    func(context.Context, *svcsdk.GetStorageLensConfigurationTaggingInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationTaggingOutput, error)
+	MockPutStorageLensConfigurationTaggingWithContext    func(context.Context, *svcsdk.PutStorageLensConfigurationTaggingInput, ...request.Option) (*svcsdk.PutStorageLensConfigurationTaggingOutput, error)
+	MockDeleteStorageLensConfigurationTaggingWithContext func(context.Context, *svcsdk.DeleteStorageLensConfigurationTaggingInput, ...request.Option) (*svcsdk.DeleteStorageLensConfigurationTaggingOutput, error)
+}
+
+// PutStorageLensConfigurationWithContext calls the underlying MockPutStorageLensConfigurationWithContext method.
+func (m *MockStorageLensClient) PutStorageLensConfigurationWithContext(ctx context.Context, input *svcsdk.PutStorageLensConfigurationInput, opts ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error) {
+	return m.MockPutStorageLensConfigurationWithContext(ctx, input, opts...)
+}
+
+// GetStorageLensConfigurationWithContext calls the underlying MockGetStorageLensConfigurationWithContext method.
+func (m *MockStorageLensClient) GetStorageLensConfigurationWithContext(ctx context.Context, input *svcsdk.GetStorageLensConfigurationInput, opts ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error) {
+	return m.MockGetStorageLensConfigurationWithContext(ctx, input, opts...)
+}
+
+// DeleteStorageLensConfigurationWithContext calls the underlying MockDeleteStorageLensConfigurationWithContext method.
+func (m *MockStorageLensClient) DeleteStorageLensConfigurationWithContext(ctx context.Context, input *svcsdk.DeleteStorageLensConfigurationInput, opts ...request.Option) (*svcsdk.DeleteStorageLensConfigurationOutput, error) {
+	return m.MockDeleteStorageLensConfigurationWithContext(ctx, input, opts...)
+}
+
+// GetStorageLensConfigurationTaggingWithContext calls the underlying MockGetStorageLensConfigurationTaggingWithContext method.
+func (m *MockStorageLensClient) GetStorageLensConfigurationTaggingWithContext(ctx context.Context, input *svcsdk.GetStorageLensConfigurationTaggingInput, opts ...request.Option) (*svcsdk.GetStorageLensConfigurationTaggingOutput, error) {
+	return m.MockGetStorageLensConfigurationTaggingWithContext(ctx, input, opts...)
+}
+
+// PutStorageLensConfigurationTaggingWithContext calls the underlying MockPutStorageLensConfigurationTaggingWithContext method.
+func (m *MockStorageLensClient) PutStorageLensConfigurationTaggingWithContext(ctx context.Context, input *svcsdk.PutStorageLensConfigurationTaggingInput, opts ...request.Option) (*svcsdk.PutStorageLensConfigurationTaggingOutput, error) {
+	return m.MockPutStorageLensConfigurationTaggingWithContext(ctx, input, opts...)
+}
+
+// DeleteStorageLensConfigurationTaggingWithContext calls the underlying MockDeleteStorageLensConfigurationTaggingWithContext method.
+func (m *MockStorageLensClient) DeleteStorageLensConfigurationTaggingWithContext(ctx context.Context, input *svcsdk.DeleteStorageLensConfigurationTaggingInput, opts ...request.Option) (*svcsdk.DeleteStorageLensConfigurationTaggingOutput, error) {
+	return m.MockDeleteStorageLensConfigurationTaggingWithContext(ctx, input, opts...)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// DefaultBatchReplicationPriority is the priority of Batch Replication
+	// jobs if none is specified.
+	DefaultBatchReplicationPriority = 10
+)
+
+// JobClient is the external client used for S3 Batch Operations jobs
+type JobClient interface {
+	CreateJobWithContext(ctx context.Context, input *svcsdk.CreateJobInput, opts ...request.Option) (*svcsdk.CreateJobOutput, error)
+	DescribeJobWithContext(ctx context.Context, input *svcsdk.DescribeJobInput, opts ...request.Option) (*svcsdk.DescribeJobOutput, error)
+}
+
+// NewJobClient returns a new client given an aws session
+func NewJobClient(sess *session.Session) JobClient {
+	return svcsdk.New(sess)
+}
+
+// IsJobTerminated returns true if the job is complete, failed or cancelled.
+func IsJobTerminated(j v1beta1.BatchReplicationJob) bool {
+	switch aws.StringValue(j.Status) {
+	case svcsdk.JobStatusComplete, svcsdk.JobStatusFailed, svcsdk.JobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// GenerateBatchReplicationJobInput returns the input for a Batch Replication
+// job that replicates the existing objects of the given bucket for the given
+// rules. The client request token is derived from the bucket, its generation
+// and the rules, so a job is not created twice if its creation could not be
+// recorded, but a rule that is removed and added again gets a new job.
+func GenerateBatchReplicationJobInput(bucketARN string, generation int64, ruleIDs []string, br *v1beta1.BatchReplication) *svcsdk.CreateJobInput {
+	statuses := br.ObjectReplicationStatuses
+	if len(statuses) == 0 {
+		statuses = []string{svcsdk.ReplicationStatusNone, svcsdk.ReplicationStatusFailed}
+	}
+	priority := int64(DefaultBatchReplicationPriority)
+	if br.Priority != nil {
+		priority = *br.Priority
+	}
+	token := sha256.Sum256([]byte(bucketARN + "/" + strconv.FormatInt(generation, 10) + "/" + strings.Join(ruleIDs, ",")))
+
+	input := &svcsdk.CreateJobInput{
+		AccountId:            aws.String(br.AccountID),
+		ClientRequestToken:   aws.String(hex.EncodeToString(token[:])),
+		ConfirmationRequired: aws.Bool(false),
+		Description:          aws.String("Replicate existing objects for rules " + strings.Join(ruleIDs, ", ")),
+		ManifestGenerator: &svcsdk.JobManifestGenerator{
+			S3JobManifestGenerator: &svcsdk.S3JobManifestGenerator{
+				EnableManifestOutput: aws.Bool(false),
+				ExpectedBucketOwner:  aws.String(br.AccountID),
+				SourceBucket:         aws.String(bucketARN),
+				Filter: &svcsdk.JobManifestGeneratorFilter{
+					EligibleForReplication:    aws.Bool(true),
+					ObjectReplicationStatuses: aws.StringSlice(statuses),
+				},
+			},
+		},
+		Operation: &svcsdk.JobOperation{S3ReplicateObject: &svcsdk.S3ReplicateObjectOperation{}},
+		Priority:  aws.Int64(priority),
+		Report:    &svcsdk.JobReport{Enabled: aws.Bool(false)},
+		RoleArn:   br.RoleARN,
+	}
+	if br.ReportBucketARN != nil {
+		scope := br.ReportScope
+		if scope == "" {
+			scope = svcsdk.JobReportScopeFailedTasksOnly
+		}
+		input.Report = &svcsdk.JobReport{
+			Bucket:      br.ReportBucketARN,
+			Enabled:     aws.Bool(true),
+			Format:      aws.String(svcsdk.JobReportFormatReportCsv20180820),
+			Prefix:      br.ReportPrefix,
+			ReportScope: aws.String(scope),
+		}
+	}
+	return input
+}
+
+// GenerateBatchReplicationJob updates the given job with the observed job.
+func GenerateBatchReplicationJob(j v1beta1.BatchReplicationJob, d *svcsdk.JobDescriptor) v1beta1.BatchReplicationJob {
+	j.Status = d.Status
+	j.StatusUpdateReason = d.StatusUpdateReason
+	j.CreationTime = pointer.TimeToMetaTime(d.CreationTime)
+	j.TerminationDate = pointer.TimeToMetaTime(d.TerminationDate)
+	if ps := d.ProgressSummary; ps != nil {
+		j.TotalNumberOfTasks = ps.TotalNumberOfTasks
+		j.NumberOfTasksSucceeded = ps.NumberOfTasksSucceeded
+		j.NumberOfTasksFailed = ps.NumberOfTasksFailed
+	}
+	j.FailureReasons = nil
+	for _, f := range d.FailureReasons {
+		j.FailureReasons = append(j.FailureReasons, aws.StringValue(f.FailureCode)+": "+aws.StringValue(f.FailureReason))
+	}
+	return j
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+)
+
+func TestGenerateBatchReplicationJobInput(t *testing.T) {
+	bucketARN := "arn:aws:s3:::bucket"
+	cases := map[string]struct {
+		br     *v1beta1.BatchReplication
+		report *svcsdk.JobReport
+		prio   int64
+		status []string
+	}{
+		"Defaults": {
+			br:     &v1beta1.BatchReplication{AccountID: "123456789012", RoleARN: aws.String("role")},
+			report: &svcsdk.JobReport{Enabled: aws.Bool(false)},
+			prio:   DefaultBatchReplicationPriority,
+			status: []string{svcsdk.ReplicationStatusNone, svcsdk.ReplicationStatusFailed},
+		},
+		"Report": {
+			br: &v1beta1.BatchReplication{
+				AccountID:                 "123456789012",
+				RoleARN:                   aws.String("role"),
+				ObjectReplicationStatuses: []string{svcsdk.ReplicationStatusCompleted},
+				Priority:                  aws.Int64(1),
+				ReportBucketARN:           aws.String("arn:aws:s3:::reports"),
+				ReportPrefix:              aws.String("batch"),
+			},
+			report: &svcsdk.JobReport{
+				Bucket:      aws.String("arn:aws:s3:::reports"),
+				Enabled:     aws.Bool(true),
+				Format:      aws.String(svcsdk.JobReportFormatReportCsv20180820),
+				Prefix:      aws.String("batch"),
+				ReportScope: aws.String(svcsdk.JobReportScopeFailedTasksOnly),
+			},
+			prio:   1,
+			status: []string{svcsdk.ReplicationStatusCompleted},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateBatchReplicationJobInput(bucketARN, 1, []string{"rule-1", "rule-2"}, tc.br)
+			if diff := cmp.Diff(tc.report, got.Report); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.prio, aws.Int64Value(got.Priority)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.status, aws.StringValueSlice(got.ManifestGenerator.S3JobManifestGenerator.Filter.ObjectReplicationStatuses)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			again := GenerateBatchReplicationJobInput(bucketARN, 1, []string{"rule-1", "rule-2"}, tc.br)
+			if aws.StringValue(got.ClientRequestToken) != aws.StringValue(again.ClientRequestToken) {
+				t.Errorf("client request token is not stable")
+			}
+			next := GenerateBatchReplicationJobInput(bucketARN, 2, []string{"rule-1", "rule-2"}, tc.br)
+			if aws.StringValue(got.ClientRequestToken) == aws.StringValue(next.ClientRequestToken) {
+				t.Errorf("client request token does not change with the generation")
+			}
+		})
+	}
+}
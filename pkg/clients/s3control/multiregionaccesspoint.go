@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+	"github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// RequestStatusSucceeded is the status of a succeeded asynchronous
+	// request.
+	RequestStatusSucceeded = "SUCCEEDED"
+	// RequestStatusFailed is the status of a failed asynchronous request.
+	RequestStatusFailed = "FAILED"
+
+	// OperationCreateMultiRegionAccessPoint is the operation of a request
+	// that creates a Multi-Region Access Point.
+	OperationCreateMultiRegionAccessPoint = svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint
+	// OperationDeleteMultiRegionAccessPoint is the operation of a request
+	// that deletes a Multi-Region Access Point.
+	OperationDeleteMultiRegionAccessPoint = svcsdk.AsyncOperationNameDeleteMultiRegionAccessPoint
+	// OperationPutMultiRegionAccessPointPolicy is the operation of a request
+	// that puts the policy of a Multi-Region Access Point.
+	OperationPutMultiRegionAccessPointPolicy = svcsdk.AsyncOperationNamePutMultiRegionAccessPointPolicy
+
+	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
+)
+
+// MultiRegionAccessPointClient is the external client used for
+// MultiRegionAccessPoint Custom Resource
+type MultiRegionAccessPointClient interface {
+	CreateMultiRegionAccessPointWithContext(ctx context.Context, input *svcsdk.CreateMultiRegionAccessPointInput, opts ...request.Option) (*svcsdk.CreateMultiRegionAccessPointOutput, error)
+	GetMultiRegionAccessPointWithContext(ctx context.Context, input *svcsdk.GetMultiRegionAccessPointInput, opts ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error)
+	DeleteMultiRegionAccessPointWithContext(ctx context.Context, input *svcsdk.DeleteMultiRegionAccessPointInput, opts ...request.Option) (*svcsdk.DeleteMultiRegionAccessPointOutput, error)
+	DescribeMultiRegionAccessPointOperationWithContext(ctx context.Context, input *svcsdk.DescribeMultiRegionAccessPointOperationInput, opts ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error)
+	GetMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *svcsdk.GetMultiRegionAccessPointPolicyInput, opts ...request.Option) (*svcsdk.GetMultiRegionAccessPointPolicyOutput, error)
+	PutMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *svcsdk.PutMultiRegionAccessPointPolicyInput, opts ...request.Option) (*svcsdk.PutMultiRegionAccessPointPolicyOutput, error)
+}
+
+// NewMultiRegionAccessPointClient returns a new client given an aws session
+func NewMultiRegionAccessPointClient(sess *session.Session) MultiRegionAccessPointClient {
+	return svcsdk.New(sess)
+}
+
+// IsMultiRegionAccessPointNotFound returns true if the error code indicates
+// that the Multi-Region Access Point was not found
+func IsMultiRegionAccessPointNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeNoSuchMultiRegionAccessPoint
+}
+
+// IsRequestInProgress returns true if the asynchronous request is neither
+// succeeded nor failed.
+func IsRequestInProgress(r *v1alpha1.MultiRegionAccessPointRequest) bool {
+	if r == nil || r.TokenARN == nil {
+		return false
+	}
+	s := aws.StringValue(r.Status)
+	return s != RequestStatusSucceeded && s != RequestStatusFailed
+}
+
+// GenerateCreateMultiRegionAccessPointInput returns the input for the
+// CreateMultiRegionAccessPoint request.
+func GenerateCreateMultiRegionAccessPointInput(name string, p v1alpha1.MultiRegionAccessPointParameters) *svcsdk.CreateMultiRegionAccessPointInput {
+	details := &svcsdk.CreateMultiRegionAccessPointInput_{
+		Name:    aws.String(name),
+		Regions: make([]*svcsdk.Region, len(p.Regions)),
+	}
+	for i, r := range p.Regions {
+		details.Regions[i] = &svcsdk.Region{Bucket: r.Bucket, BucketAccountId: r.BucketAccountID}
+	}
+	if pab := p.PublicAccessBlock; pab != nil {
+		details.PublicAccessBlock = &svcsdk.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       pab.BlockPublicACLs,
+			BlockPublicPolicy:     pab.BlockPublicPolicy,
+			IgnorePublicAcls:      pab.IgnorePublicACLs,
+			RestrictPublicBuckets: pab.RestrictPublicBuckets,
+		}
+	}
+	return &svcsdk.CreateMultiRegionAccessPointInput{
+		AccountId: p.AccountID,
+		Details:   details,
+	}
+}
+
+// GenerateMultiRegionAccessPointObservation returns the observation of the
+// given Multi-Region Access Point. The last request is kept.
+func GenerateMultiRegionAccessPointObservation(region, accountID string, ap *svcsdk.MultiRegionAccessPointReport, lastRequest *v1alpha1.MultiRegionAccessPointRequest) v1alpha1.MultiRegionAccessPointObservation {
+	o := v1alpha1.MultiRegionAccessPointObservation{
+		Alias:       ap.Alias,
+		CreatedAt:   pointer.TimeToMetaTime(ap.CreatedAt),
+		Status:      ap.Status,
+		LastRequest: lastRequest,
+	}
+	if ap.Alias != nil {
+		o.ARN = aws.String("arn:" + common.Partition(region) + ":s3::" + accountID + ":accesspoint/" + *ap.Alias)
+	}
+	for _, r := range ap.Regions {
+		o.Regions = append(o.Regions, v1alpha1.MultiRegionAccessPointRegionObservation{
+			Bucket:          r.Bucket,
+			BucketAccountID: r.BucketAccountId,
+			Region:          r.Region,
+		})
+	}
+	return o
+}
+
+// GenerateMultiRegionAccessPointRequest returns the status of the given
+// asynchronous request.
+func GenerateMultiRegionAccessPointRequest(op *svcsdk.AsyncOperation) *v1alpha1.MultiRegionAccessPointRequest {
+	r := &v1alpha1.MultiRegionAccessPointRequest{
+		TokenARN:     op.RequestTokenARN,
+		Operation:    op.Operation,
+		Status:       op.RequestStatus,
+		CreationTime: pointer.TimeToMetaTime(op.CreationTime),
+	}
+	if op.ResponseDetails != nil && op.ResponseDetails.ErrorDetails != nil {
+		r.ErrorCode = op.ResponseDetails.ErrorDetails.Code
+		r.ErrorMessage = op.ResponseDetails.ErrorDetails.Message
+	}
+	return r
+}
+
+// MultiRegionAccessPointPolicy returns the proposed policy of a Multi-Region
+// Access Point if there is one and the established policy otherwise.
+func MultiRegionAccessPointPolicy(doc *svcsdk.MultiRegionAccessPointPolicyDocument) string {
+	if doc == nil {
+		return ""
+	}
+	if doc.Proposed != nil && aws.StringValue(doc.Proposed.Policy) != "" {
+		return *doc.Proposed.Policy
+	}
+	if doc.Established != nil {
+		return aws.StringValue(doc.Established.Policy)
+	}
+	return ""
+}
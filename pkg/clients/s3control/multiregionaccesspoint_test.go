@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+)
+
+func TestIsRequestInProgress(t *testing.T) {
+	cases := map[string]struct {
+		req  *v1alpha1.MultiRegionAccessPointRequest
+		want bool
+	}{
+		"NoRequest": {},
+		"NoToken": {
+			req: &v1alpha1.MultiRegionAccessPointRequest{},
+		},
+		"NotDescribed": {
+			req:  &v1alpha1.MultiRegionAccessPointRequest{TokenARN: aws.String("token")},
+			want: true,
+		},
+		"Running": {
+			req:  &v1alpha1.MultiRegionAccessPointRequest{TokenARN: aws.String("token"), Status: aws.String("RUNNING")},
+			want: true,
+		},
+		"Succeeded": {
+			req: &v1alpha1.MultiRegionAccessPointRequest{TokenARN: aws.String("token"), Status: aws.String(RequestStatusSucceeded)},
+		},
+		"Failed": {
+			req: &v1alpha1.MultiRegionAccessPointRequest{TokenARN: aws.String("token"), Status: aws.String(RequestStatusFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsRequestInProgress(tc.req); got != tc.want {
+				t.Errorf("IsRequestInProgress(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMultiRegionAccessPointPolicy(t *testing.T) {
+	cases := map[string]struct {
+		doc  *svcsdk.MultiRegionAccessPointPolicyDocument
+		want string
+	}{
+		"NoPolicy": {},
+		"Established": {
+			doc: &svcsdk.MultiRegionAccessPointPolicyDocument{
+				Established: &svcsdk.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String("established")},
+			},
+			want: "established",
+		},
+		"Proposed": {
+			doc: &svcsdk.MultiRegionAccessPointPolicyDocument{
+				Established: &svcsdk.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String("established")},
+				Proposed:    &svcsdk.ProposedMultiRegionAccessPointPolicy{Policy: aws.String("proposed")},
+			},
+			want: "proposed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, MultiRegionAccessPointPolicy(tc.doc)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMultiRegionAccessPointRequest(t *testing.T) {
+	op := &svcsdk.AsyncOperation{
+		Operation:       aws.String(OperationCreateMultiRegionAccessPoint),
+		RequestStatus:   aws.String(RequestStatusFailed),
+		RequestTokenARN: aws.String("token"),
+		ResponseDetails: &svcsdk.AsyncResponseDetails{ErrorDetails: &svcsdk.AsyncErrorDetails{
+			Code:    aws.String("BucketNotFound"),
+			Message: aws.String("bucket does not exist"),
+		}},
+	}
+	want := &v1alpha1.MultiRegionAccessPointRequest{
+		TokenARN:     aws.String("token"),
+		Operation:    aws.String(OperationCreateMultiRegionAccessPoint),
+		Status:       aws.String(RequestStatusFailed),
+		ErrorCode:    aws.String("BucketNotFound"),
+		ErrorMessage: aws.String("bucket does not exist"),
+	}
+	if diff := cmp.Diff(want, GenerateMultiRegionAccessPointRequest(op)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+	"github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// ObjectLambdaAliasStatusReady is the status of a ready alias of an
+	// Object Lambda access point.
+	ObjectLambdaAliasStatusReady = svcsdk.ObjectLambdaAccessPointAliasStatusReady
+
+	errCodeNoSuchAccessPoint       = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy = "NoSuchAccessPointPolicy"
+)
+
+// ObjectLambdaAccessPointClient is the external client used for
+// ObjectLambdaAccessPoint Custom Resource
+type ObjectLambdaAccessPointClient interface {
+	CreateAccessPointForObjectLambdaWithContext(ctx context.Context, input *svcsdk.CreateAccessPointForObjectLambdaInput, opts ...request.Option) (*svcsdk.CreateAccessPointForObjectLambdaOutput, error)
+	GetAccessPointForObjectLambdaWithContext(ctx context.Context, input *svcsdk.GetAccessPointForObjectLambdaInput, opts ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error)
+	DeleteAccessPointForObjectLambdaWithContext(ctx context.Context, input *svcsdk.DeleteAccessPointForObjectLambdaInput, opts ...request.Option) (*svcsdk.DeleteAccessPointForObjectLambdaOutput, error)
+	GetAccessPointConfigurationForObjectLambdaWithContext(ctx context.Context, input *svcsdk.GetAccessPointConfigurationForObjectLambdaInput, opts ...request.Option) (*svcsdk.GetAccessPointConfigurationForObjectLambdaOutput, error)
+	PutAccessPointConfigurationForObjectLambdaWithContext(ctx context.Context, input *svcsdk.PutAccessPointConfigurationForObjectLambdaInput, opts ...request.Option) (*svcsdk.PutAccessPointConfigurationForObjectLambdaOutput, error)
+	GetAccessPointPolicyForObjectLambdaWithContext(ctx context.Context, input *svcsdk.GetAccessPointPolicyForObjectLambdaInput, opts ...request.Option) (*svcsdk.GetAccessPointPolicyForObjectLambdaOutput, error)
+	PutAccessPointPolicyForObjectLambdaWithContext(ctx context.Context, input *svcsdk.PutAccessPointPolicyForObjectLambdaInput, opts ...request.Option) (*svcsdk.PutAccessPointPolicyForObjectLambdaOutput, error)
+	DeleteAccessPointPolicyForObjectLambdaWithContext(ctx context.Context, input *svcsdk.DeleteAccessPointPolicyForObjectLambdaInput, opts ...request.Option) (*svcsdk.DeleteAccessPointPolicyForObjectLambdaOutput, error)
+}
+
+// NewObjectLambdaAccessPointClient returns a new client given an aws session
+func NewObjectLambdaAccessPointClient(sess *session.Session) ObjectLambdaAccessPointClient {
+	return svcsdk.New(sess)
+}
+
+// IsObjectLambdaAccessPointNotFound returns true if the error code indicates
+// that the Object Lambda access point was not found
+func IsObjectLambdaAccessPointNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeNoSuchAccessPoint
+}
+
+// IsObjectLambdaAccessPointPolicyNotFound returns true if the error code
+// indicates that the Object Lambda access point has no policy
+func IsObjectLambdaAccessPointPolicyNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeNoSuchAccessPointPolicy
+}
+
+// GenerateObjectLambdaConfiguration returns the SDK configuration of an
+// Object Lambda access point.
+func GenerateObjectLambdaConfiguration(c v1alpha1.ObjectLambdaConfiguration) *svcsdk.ObjectLambdaConfiguration {
+	res := &svcsdk.ObjectLambdaConfiguration{
+		AllowedFeatures:              aws.StringSlice(c.AllowedFeatures),
+		CloudWatchMetricsEnabled:     c.CloudWatchMetricsEnabled,
+		SupportingAccessPoint:        c.SupportingAccessPoint,
+		TransformationConfigurations: make([]*svcsdk.ObjectLambdaTransformationConfiguration, len(c.TransformationConfigurations)),
+	}
+	for i, tc := range c.TransformationConfigurations {
+		res.TransformationConfigurations[i] = &svcsdk.ObjectLambdaTransformationConfiguration{
+			Actions: aws.StringSlice(tc.Actions),
+			ContentTransformation: &svcsdk.ObjectLambdaContentTransformation{
+				AwsLambda: &svcsdk.AwsLambdaTransformation{
+					FunctionArn:     tc.ContentTransformation.AWSLambda.FunctionARN,
+					FunctionPayload: tc.ContentTransformation.AWSLambda.FunctionPayload,
+				},
+			},
+		}
+	}
+	return res
+}
+
+// IsObjectLambdaConfigurationUpToDate returns true if the observed
+// configuration matches the desired one. A disabled CloudWatchMetricsEnabled
+// equals an unset one.
+func IsObjectLambdaConfigurationUpToDate(c v1alpha1.ObjectLambdaConfiguration, observed *svcsdk.ObjectLambdaConfiguration) bool {
+	if observed == nil {
+		return false
+	}
+	desired := GenerateObjectLambdaConfiguration(c)
+	if aws.BoolValue(desired.CloudWatchMetricsEnabled) != aws.BoolValue(observed.CloudWatchMetricsEnabled) {
+		return false
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(svcsdk.ObjectLambdaConfiguration{}, svcsdk.ObjectLambdaTransformationConfiguration{},
+			svcsdk.ObjectLambdaContentTransformation{}, svcsdk.AwsLambdaTransformation{}),
+		cmpopts.IgnoreFields(svcsdk.ObjectLambdaConfiguration{}, "CloudWatchMetricsEnabled"),
+		cmpopts.SortSlices(func(a, b *string) bool { return aws.StringValue(a) < aws.StringValue(b) }),
+	)
+}
+
+// GenerateObjectLambdaAccessPointObservation returns the observation of the
+// given Object Lambda access point.
+func GenerateObjectLambdaAccessPointObservation(arn string, o *svcsdk.GetAccessPointForObjectLambdaOutput) v1alpha1.ObjectLambdaAccessPointObservation {
+	res := v1alpha1.ObjectLambdaAccessPointObservation{
+		ARN:          aws.String(arn),
+		CreationDate: pointer.TimeToMetaTime(o.CreationDate),
+	}
+	if o.Alias != nil {
+		res.Alias = o.Alias.Value
+		res.AliasStatus = o.Alias.Status
+	}
+	return res
+}
+
+// ObjectLambdaAccessPointARN returns the ARN of the Object Lambda access
+// point with the given name.
+func ObjectLambdaAccessPointARN(region, accountID, name string) string {
+	return "arn:" + common.Partition(region) + ":s3-object-lambda:" + region + ":" + accountID + ":accesspoint/" + name
+}
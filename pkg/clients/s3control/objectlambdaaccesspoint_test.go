@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"testing"
+)
+
+func TestObjectLambdaAccessPointARN(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"Commercial": {
+			region: "eu-central-1",
+			want:   "arn:aws:s3-object-lambda:eu-central-1:123456789012:accesspoint/olap",
+		},
+		"China": {
+			region: "cn-north-1",
+			want:   "arn:aws-cn:s3-object-lambda:cn-north-1:123456789012:accesspoint/olap",
+		},
+		"GovCloud": {
+			region: "us-gov-west-1",
+			want:   "arn:aws-us-gov:s3-object-lambda:us-gov-west-1:123456789012:accesspoint/olap",
+		},
+		"ISO": {
+			region: "us-iso-east-1",
+			want:   "arn:aws-iso:s3-object-lambda:us-iso-east-1:123456789012:accesspoint/olap",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ObjectLambdaAccessPointARN(tc.region, "123456789012", "olap"); got != tc.want {
+				t.Errorf("ObjectLambdaAccessPointARN(...): want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+)
+
+const (
+	// StorageLensEncryptionSSES3 encrypts a metrics export with S3 managed
+	// keys.
+	StorageLensEncryptionSSES3 = "SSE-S3"
+	// StorageLensEncryptionSSEKMS encrypts a metrics export with a KMS key.
+	StorageLensEncryptionSSEKMS = "SSE-KMS"
+
+	errCodeNoSuchConfiguration = "NoSuchConfiguration"
+)
+
+// StorageLensClient is the external client used for StorageLensConfiguration
+// Custom Resource
+type StorageLensClient interface {
+	PutStorageLensConfigurationWithContext(ctx context.Context, input *svcsdk.PutStorageLensConfigurationInput, opts ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error)
+	GetStorageLensConfigurationWithContext(ctx context.Context, input *svcsdk.GetStorageLensConfigurationInput, opts ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error)
+	DeleteStorageLensConfigurationWithContext(ctx context.Context, input *svcsdk.DeleteStorageLensConfigurationInput, opts ...request.Option) (*svcsdk.DeleteStorageLensConfigurationOutput, error)
+	GetStorageLensConfigurationTaggingWithContext(ctx context.Context, input *svcsdk.GetStorageLensConfigurationTaggingInput, opts ...request.Option) (*svcsdk.GetStorageLensConfigurationTaggingOutput, error)
+	PutStorageLensConfigurationTaggingWithContext(ctx context.Context, input *svcsdk.PutStorageLensConfigurationTaggingInput, opts ...request.Option) (*svcsdk.PutStorageLensConfigurationTaggingOutput, error)
+	DeleteStorageLensConfigurationTaggingWithContext(ctx context.Context, input *svcsdk.DeleteStorageLensConfigurationTaggingInput, opts ...request.Option) (*svcsdk.DeleteStorageLensConfigurationTaggingOutput, error)
+}
+
+// NewStorageLensClient returns a new client given an aws session
+func NewStorageLensClient(sess *session.Session) StorageLensClient {
+	return svcsdk.New(sess)
+}
+
+// IsStorageLensConfigurationNotFound returns true if the error code indicates
+// that the Storage Lens configuration was not found
+func IsStorageLensConfigurationNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeNoSuchConfiguration
+}
+
+func isMetricEnabled(m *v1alpha1.StorageLensMetrics) *bool {
+	if m == nil {
+		return nil
+	}
+	return aws.Bool(m.IsEnabled)
+}
+
+func generateMetrics(enabled *bool) *v1alpha1.StorageLensMetrics {
+	if enabled == nil {
+		return nil
+	}
+	return &v1alpha1.StorageLensMetrics{IsEnabled: *enabled}
+}
+
+func generateBucketLevel(b v1alpha1.StorageLensBucketLevel) *svcsdk.BucketLevel {
+	res := &svcsdk.BucketLevel{}
+	if b.ActivityMetrics != nil {
+		res.ActivityMetrics = &svcsdk.ActivityMetrics{IsEnabled: isMetricEnabled(b.ActivityMetrics)}
+	}
+	if b.AdvancedCostOptimizationMetrics != nil {
+		res.AdvancedCostOptimizationMetrics = &svcsdk.AdvancedCostOptimizationMetrics{IsEnabled: isMetricEnabled(b.AdvancedCostOptimizationMetrics)}
+	}
+	if b.AdvancedDataProtectionMetrics != nil {
+		res.AdvancedDataProtectionMetrics = &svcsdk.AdvancedDataProtectionMetrics{IsEnabled: isMetricEnabled(b.AdvancedDataProtectionMetrics)}
+	}
+	if b.DetailedStatusCodesMetrics != nil {
+		res.DetailedStatusCodesMetrics = &svcsdk.DetailedStatusCodesMetrics{IsEnabled: isMetricEnabled(b.DetailedStatusCodesMetrics)}
+	}
+	if p := b.PrefixLevel; p != nil {
+		res.PrefixLevel = &svcsdk.PrefixLevel{
+			StorageMetrics: &svcsdk.PrefixLevelStorageMetrics{IsEnabled: aws.Bool(p.StorageMetrics.IsEnabled)},
+		}
+		if sc := p.StorageMetrics.SelectionCriteria; sc != nil {
+			res.PrefixLevel.StorageMetrics.SelectionCriteria = &svcsdk.SelectionCriteria{
+				Delimiter:                 sc.Delimiter,
+				MaxDepth:                  sc.MaxDepth,
+				MinStorageBytesPercentage: sc.MinStorageBytesPercentage,
+			}
+		}
+	}
+	return res
+}
+
+// GenerateStorageLensConfiguration returns the SDK representation of the
+// given Storage Lens configuration.
+func GenerateStorageLensConfiguration(id string, p v1alpha1.StorageLensConfigurationParameters) *svcsdk.StorageLensConfiguration { //nolint:gocyclo
+	al := p.AccountLevel
+	res := &svcsdk.StorageLensConfiguration{
+		Id:        aws.String(id),
+		IsEnabled: aws.Bool(p.IsEnabled),
+		AccountLevel: &svcsdk.AccountLevel{
+			BucketLevel: generateBucketLevel(al.BucketLevel),
+		},
+	}
+	if al.ActivityMetrics != nil {
+		res.AccountLevel.ActivityMetrics = &svcsdk.ActivityMetrics{IsEnabled: isMetricEnabled(al.ActivityMetrics)}
+	}
+	if al.AdvancedCostOptimizationMetrics != nil {
+		res.AccountLevel.AdvancedCostOptimizationMetrics = &svcsdk.AdvancedCostOptimizationMetrics{IsEnabled: isMetricEnabled(al.AdvancedCostOptimizationMetrics)}
+	}
+	if al.AdvancedDataProtectionMetrics != nil {
+		res.AccountLevel.AdvancedDataProtectionMetrics = &svcsdk.AdvancedDataProtectionMetrics{IsEnabled: isMetricEnabled(al.AdvancedDataProtectionMetrics)}
+	}
+	if al.DetailedStatusCodesMetrics != nil {
+		res.AccountLevel.DetailedStatusCodesMetrics = &svcsdk.DetailedStatusCodesMetrics{IsEnabled: isMetricEnabled(al.DetailedStatusCodesMetrics)}
+	}
+	if p.Include != nil {
+		res.Include = &svcsdk.Include{Buckets: aws.StringSlice(p.Include.Buckets), Regions: aws.StringSlice(p.Include.Regions)}
+	}
+	if p.Exclude != nil {
+		res.Exclude = &svcsdk.Exclude{Buckets: aws.StringSlice(p.Exclude.Buckets), Regions: aws.StringSlice(p.Exclude.Regions)}
+	}
+	if de := p.DataExport; de != nil {
+		res.DataExport = &svcsdk.StorageLensDataExport{}
+		if de.CloudWatchMetrics != nil {
+			res.DataExport.CloudWatchMetrics = &svcsdk.CloudWatchMetrics{IsEnabled: isMetricEnabled(de.CloudWatchMetrics)}
+		}
+		if d := de.S3BucketDestination; d != nil {
+			res.DataExport.S3BucketDestination = &svcsdk.S3BucketDestination{
+				AccountId:           aws.String(d.AccountID),
+				Arn:                 aws.String(d.ARN),
+				Format:              aws.String(d.Format),
+				OutputSchemaVersion: aws.String(d.OutputSchemaVersion),
+				Prefix:              d.Prefix,
+			}
+			if e := d.Encryption; e != nil {
+				enc := &svcsdk.StorageLensDataExportEncryption{}
+				switch e.Type {
+				case StorageLensEncryptionSSEKMS:
+					enc.SSEKMS = &svcsdk.SSEKMS{KeyId: e.KMSKeyID}
+				case StorageLensEncryptionSSES3:
+					enc.SSES3 = &svcsdk.SSES3{}
+				}
+				res.DataExport.S3BucketDestination.Encryption = enc
+			}
+		}
+	}
+	if p.AWSOrgARN != nil {
+		res.AwsOrg = &svcsdk.StorageLensAwsOrg{Arn: p.AWSOrgARN}
+	}
+	return res
+}
+
+func generateBucketLevelParameters(b *svcsdk.BucketLevel) v1alpha1.StorageLensBucketLevel {
+	res := v1alpha1.StorageLensBucketLevel{}
+	if b == nil {
+		return res
+	}
+	if b.ActivityMetrics != nil {
+		res.ActivityMetrics = generateMetrics(b.ActivityMetrics.IsEnabled)
+	}
+	if b.AdvancedCostOptimizationMetrics != nil {
+		res.AdvancedCostOptimizationMetrics = generateMetrics(b.AdvancedCostOptimizationMetrics.IsEnabled)
+	}
+	if b.AdvancedDataProtectionMetrics != nil {
+		res.AdvancedDataProtectionMetrics = generateMetrics(b.AdvancedDataProtectionMetrics.IsEnabled)
+	}
+	if b.DetailedStatusCodesMetrics != nil {
+		res.DetailedStatusCodesMetrics = generateMetrics(b.DetailedStatusCodesMetrics.IsEnabled)
+	}
+	if b.PrefixLevel != nil && b.PrefixLevel.StorageMetrics != nil {
+		sm := b.PrefixLevel.StorageMetrics
+		res.PrefixLevel = &v1alpha1.StorageLensPrefixLevel{
+			StorageMetrics: v1alpha1.StorageLensPrefixLevelStorageMetrics{IsEnabled: aws.BoolValue(sm.IsEnabled)},
+		}
+		if sc := sm.SelectionCriteria; sc != nil {
+			res.PrefixLevel.StorageMetrics.SelectionCriteria = &v1alpha1.StorageLensSelectionCriteria{
+				Delimiter:                 sc.Delimiter,
+				MaxDepth:                  sc.MaxDepth,
+				MinStorageBytesPercentage: sc.MinStorageBytesPercentage,
+			}
+		}
+	}
+	return res
+}
+
+// GenerateStorageLensConfigurationParameters returns the parameters of the
+// given Storage Lens configuration. Region, AccountID and Tags are not part of
+// the configuration and are left empty.
+func GenerateStorageLensConfigurationParameters(c *svcsdk.StorageLensConfiguration) v1alpha1.StorageLensConfigurationParameters { //nolint:gocyclo
+	res := v1alpha1.StorageLensConfigurationParameters{
+		IsEnabled: aws.BoolValue(c.IsEnabled),
+	}
+	if al := c.AccountLevel; al != nil {
+		res.AccountLevel.BucketLevel = generateBucketLevelParameters(al.BucketLevel)
+		if al.ActivityMetrics != nil {
+			res.AccountLevel.ActivityMetrics = generateMetrics(al.ActivityMetrics.IsEnabled)
+		}
+		if al.AdvancedCostOptimizationMetrics != nil {
+			res.AccountLevel.AdvancedCostOptimizationMetrics = generateMetrics(al.AdvancedCostOptimizationMetrics.IsEnabled)
+		}
+		if al.AdvancedDataProtectionMetrics != nil {
+			res.AccountLevel.AdvancedDataProtectionMetrics = generateMetrics(al.AdvancedDataProtectionMetrics.IsEnabled)
+		}
+		if al.DetailedStatusCodesMetrics != nil {
+			res.AccountLevel.DetailedStatusCodesMetrics = generateMetrics(al.DetailedStatusCodesMetrics.IsEnabled)
+		}
+	}
+	if c.Include != nil {
+		res.Include = &v1alpha1.StorageLensScope{Buckets: aws.StringValueSlice(c.Include.Buckets), Regions: aws.StringValueSlice(c.Include.Regions)}
+	}
+	if c.Exclude != nil {
+		res.Exclude = &v1alpha1.StorageLensScope{Buckets: aws.StringValueSlice(c.Exclude.Buckets), Regions: aws.StringValueSlice(c.Exclude.Regions)}
+	}
+	if de := c.DataExport; de != nil {
+		res.DataExport = &v1alpha1.StorageLensDataExport{}
+		if de.CloudWatchMetrics != nil {
+			res.DataExport.CloudWatchMetrics = generateMetrics(de.CloudWatchMetrics.IsEnabled)
+		}
+		if d := de.S3BucketDestination; d != nil {
+			res.DataExport.S3BucketDestination = &v1alpha1.StorageLensS3BucketDestination{
+				AccountID:           aws.StringValue(d.AccountId),
+				ARN:                 aws.StringValue(d.Arn),
+				Format:              aws.StringValue(d.Format),
+				OutputSchemaVersion: aws.StringValue(d.OutputSchemaVersion),
+				Prefix:              d.Prefix,
+			}
+			if e := d.Encryption; e != nil {
+				switch {
+				case e.SSEKMS != nil:
+					res.DataExport.S3BucketDestination.Encryption = &v1alpha1.StorageLensEncryption{Type: StorageLensEncryptionSSEKMS, KMSKeyID: e.SSEKMS.KeyId}
+				case e.SSES3 != nil:
+					res.DataExport.S3BucketDestination.Encryption = &v1alpha1.StorageLensEncryption{Type: StorageLensEncryptionSSES3}
+				}
+			}
+		}
+	}
+	if c.AwsOrg != nil {
+		res.AWSOrgARN = c.AwsOrg.Arn
+	}
+	return res
+}
+
+// IsStorageLensConfigurationUpToDate returns true if the observed Storage Lens
+// configuration matches the desired parameters. Disabled metrics equal unset
+// metrics.
+func IsStorageLensConfigurationUpToDate(p v1alpha1.StorageLensConfigurationParameters, observed *svcsdk.StorageLensConfiguration) bool {
+	o := GenerateStorageLensConfigurationParameters(observed)
+	return cmp.Equal(p, o,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.StorageLensConfigurationParameters{}, "Region", "AccountID", "Tags"),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmp.Comparer(func(a, b *v1alpha1.StorageLensMetrics) bool {
+			return aws.BoolValue(isMetricEnabled(a)) == aws.BoolValue(isMetricEnabled(b))
+		}),
+	)
+}
+
+// GenerateStorageLensTags returns the SDK representation of the given tags.
+func GenerateStorageLensTags(tags []v1alpha1.StorageLensTag) []*svcsdk.StorageLensTag {
+	res := make([]*svcsdk.StorageLensTag, len(tags))
+	for i, t := range tags {
+		res[i] = &svcsdk.StorageLensTag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// AreStorageLensTagsUpToDate returns true if the observed tags match the
+// desired ones regardless of their order.
+func AreStorageLensTagsUpToDate(tags []v1alpha1.StorageLensTag, observed []*svcsdk.StorageLensTag) bool {
+	if len(tags) != len(observed) {
+		return false
+	}
+	desired := make(map[string]string, len(tags))
+	for _, t := range tags {
+		desired[t.Key] = t.Value
+	}
+	for _, t := range observed {
+		v, ok := desired[aws.StringValue(t.Key)]
+		if !ok || v != aws.StringValue(t.Value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+)
+
+func storageLensParameters() v1alpha1.StorageLensConfigurationParameters {
+	return v1alpha1.StorageLensConfigurationParameters{
+		IsEnabled: true,
+		AccountLevel: v1alpha1.StorageLensAccountLevel{
+			ActivityMetrics: &v1alpha1.StorageLensMetrics{IsEnabled: true},
+			BucketLevel: v1alpha1.StorageLensBucketLevel{
+				PrefixLevel: &v1alpha1.StorageLensPrefixLevel{
+					StorageMetrics: v1alpha1.StorageLensPrefixLevelStorageMetrics{
+						IsEnabled:         true,
+						SelectionCriteria: &v1alpha1.StorageLensSelectionCriteria{Delimiter: aws.String("/"), MaxDepth: aws.Int64(3)},
+					},
+				},
+			},
+		},
+		Include: &v1alpha1.StorageLensScope{Regions: []string{"us-east-1", "eu-west-1"}},
+		DataExport: &v1alpha1.StorageLensDataExport{
+			S3BucketDestination: &v1alpha1.StorageLensS3BucketDestination{
+				AccountID:           "123456789012",
+				ARN:                 "arn:aws:s3:::reports",
+				Format:              "CSV",
+				OutputSchemaVersion: "V_1",
+				Encryption:          &v1alpha1.StorageLensEncryption{Type: StorageLensEncryptionSSEKMS, KMSKeyID: aws.String("key")},
+			},
+		},
+	}
+}
+
+func TestGenerateStorageLensConfigurationParameters(t *testing.T) {
+	p := storageLensParameters()
+	got := GenerateStorageLensConfigurationParameters(GenerateStorageLensConfiguration("dashboard", p))
+	if diff := cmp.Diff(p, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsStorageLensConfigurationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.StorageLensConfigurationParameters
+		observed func(*svcsdk.StorageLensConfiguration)
+		want     bool
+	}{
+		"UpToDate": {
+			p:    storageLensParameters(),
+			want: true,
+		},
+		"DisabledMetricsEqualUnset": {
+			p: storageLensParameters(),
+			observed: func(c *svcsdk.StorageLensConfiguration) {
+				c.AccountLevel.BucketLevel.ActivityMetrics = &svcsdk.ActivityMetrics{IsEnabled: aws.Bool(false)}
+			},
+			want: true,
+		},
+		"RegionsInDifferentOrder": {
+			p: storageLensParameters(),
+			observed: func(c *svcsdk.StorageLensConfiguration) {
+				c.Include.Regions = aws.StringSlice([]string{"eu-west-1", "us-east-1"})
+			},
+			want: true,
+		},
+		"EncryptionChanged": {
+			p: storageLensParameters(),
+			observed: func(c *svcsdk.StorageLensConfiguration) {
+				c.DataExport.S3BucketDestination.Encryption = &svcsdk.StorageLensDataExportEncryption{SSES3: &svcsdk.SSES3{}}
+			},
+		},
+		"Disabled": {
+			p: storageLensParameters(),
+			observed: func(c *svcsdk.StorageLensConfiguration) {
+				c.IsEnabled = aws.Bool(false)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := GenerateStorageLensConfiguration("dashboard", storageLensParameters())
+			if tc.observed != nil {
+				tc.observed(observed)
+			}
+			if got := IsStorageLensConfigurationUpToDate(tc.p, observed); got != tc.want {
+				t.Errorf("IsStorageLensConfigurationUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAreStorageLensTagsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		tags     []v1alpha1.StorageLensTag
+		observed []*svcsdk.StorageLensTag
+		want     bool
+	}{
+		"NoTags": {
+			want: true,
+		},
+		"SameTagsInDifferentOrder": {
+			tags:     []v1alpha1.StorageLensTag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+			observed: GenerateStorageLensTags([]v1alpha1.StorageLensTag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}}),
+			want:     true,
+		},
+		"ValueChanged": {
+			tags:     []v1alpha1.StorageLensTag{{Key: "a", Value: "1"}},
+			observed: GenerateStorageLensTags([]v1alpha1.StorageLensTag{{Key: "a", Value: "2"}}),
+		},
+		"TagRemoved": {
+			observed: GenerateStorageLensTags([]v1alpha1.StorageLensTag{{Key: "a", Value: "1"}}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AreStorageLensTagsUpToDate(tc.tags, tc.observed); got != tc.want {
+				t.Errorf("AreStorageLensTagsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
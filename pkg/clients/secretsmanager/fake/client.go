@@ -27,9 +27,11 @@ import (
 type MockSecretsManagerClient struct {
 	secretsmanageriface.SecretsManagerAPI
 
-	MockDescribeSecretWithContext    func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
-	MockGetSecretValueWithContext    func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
-	MockGetResourcePolicyWithContext func(*secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error)
+	MockDescribeSecretWithContext     func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
+	MockGetSecretValueWithContext     func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	MockGetResourcePolicyWithContext  func(*secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error)
+	MockRotateSecretWithContext       func(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error)
+	MockCancelRotateSecretWithContext func(*secretsmanager.CancelRotateSecretInput) (*secretsmanager.CancelRotateSecretOutput, error)
 }
 
 // DescribeSecretWithContext calls c.MockDescribeSecretWithContext
@@ -46,3 +48,13 @@ func (c *MockSecretsManagerClient) GetSecretValueWithContext(_ aws.Context, in *
 func (c *MockSecretsManagerClient) GetResourcePolicyWithContext(_ aws.Context, in *secretsmanager.GetResourcePolicyInput, _ ...request.Option) (*secretsmanager.GetResourcePolicyOutput, error) {
 	return c.MockGetResourcePolicyWithContext(in)
 }
+
+// RotateSecretWithContext calls c.MockRotateSecretWithContext
+func (c *MockSecretsManagerClient) RotateSecretWithContext(_ aws.Context, in *secretsmanager.RotateSecretInput, _ ...request.Option) (*secretsmanager.RotateSecretOutput, error) {
+	return c.MockRotateSecretWithContext(in)
+}
+
+// CancelRotateSecretWithContext calls c.MockCancelRotateSecretWithContext
+func (c *MockSecretsManagerClient) CancelRotateSecretWithContext(_ aws.Context, in *secretsmanager.CancelRotateSecretInput, _ ...request.Option) (*secretsmanager.CancelRotateSecretOutput, error) {
+	return c.MockCancelRotateSecretWithContext(in)
+}
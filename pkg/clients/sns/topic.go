@@ -177,6 +177,20 @@ func IsSNSTopicUpToDate(p v1beta1.TopicParameters, attr map[string]string) (bool
 		isPolicyUpToDate, nil
 }
 
+// PolicyDiff returns the statement-level diff of the topic policy, or an empty
+// string if the policy is up to date.
+func PolicyDiff(p v1beta1.TopicParameters, attr map[string]string) string {
+	specPolicyStr, currPolicyStr := ptr.Deref(p.Policy, ""), attr[string(TopicPolicy)]
+	if isPolicyUpToDate, err := isSNSPolicyUpToDate(specPolicyStr, currPolicyStr); isPolicyUpToDate || err != nil {
+		return ""
+	}
+	d, err := policyutils.DiffPolicyDocuments(specPolicyStr, currPolicyStr)
+	if err != nil {
+		return ""
+	}
+	return d.String()
+}
+
 // IsSNSPolicyChanged determines whether a SNS topic policy needs to be updated
 func isSNSPolicyUpToDate(specPolicyStr, currPolicyStr string) (bool, error) {
 	if specPolicyStr == "" {
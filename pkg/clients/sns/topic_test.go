@@ -434,3 +434,29 @@ func TestIsSNSTopicUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyDiff(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.TopicParameters
+		attr map[string]string
+		want string
+	}{
+		"SamePolicyButDifferentFormat": {
+			p:    v1beta1.TopicParameters{Policy: &testPolicyA},
+			attr: topicAttributes(withAttrPolicy(&testPolicyA2)),
+		},
+		"DifferentPolicy": {
+			p:    v1beta1.TopicParameters{Policy: &testPolicyA},
+			attr: topicAttributes(withAttrPolicy(&testPolicyB)),
+			want: "added statements: [PublishToTopic]; removed statements: [PublishToTopic]; added actions: [Allow SNS:GetTopicAttributes]",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, PolicyDiff(tc.p, tc.attr)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -239,12 +239,25 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	return true, "", nil
 }
 
+// PolicyDiff returns the statement-level diff of the queue policy, or an empty
+// string if the policy is up to date.
+func PolicyDiff(p v1beta1.QueueParameters, attributes map[string]string) string {
+	_, diff, _ := isSQSPolicyUpToDate(pointer.StringValue(p.Policy), attributes[v1beta1.AttributePolicy])
+	return diff
+}
+
 // isSQSPolicyUpToDate determines whether a SQS queue policy needs to be updated
 func isSQSPolicyUpToDate(specPolicyStr, currPolicyStr string) (bool, string, error) {
-	if specPolicyStr == "" {
-		return currPolicyStr == "", "", nil
-	} else if currPolicyStr == "" {
-		return false, "", nil
+	if specPolicyStr == "" || currPolicyStr == "" {
+		if specPolicyStr == currPolicyStr {
+			return true, "", nil
+		}
+		// A policy that cannot be parsed is reported without a diff.
+		d, err := policyutils.DiffPolicyDocuments(specPolicyStr, currPolicyStr)
+		if err != nil {
+			return false, "", nil
+		}
+		return false, d.String(), nil
 	}
 
 	currPolicy, err := policyutils.ParsePolicyString(currPolicyStr)
@@ -255,8 +268,10 @@ func isSQSPolicyUpToDate(specPolicyStr, currPolicyStr string) (bool, string, err
 	if err != nil {
 		return false, "", errors.Wrap(err, "spec policy")
 	}
-	equalPolicies, diff := policyutils.ArePoliciesEqal(&currPolicy, &specPolicy)
-	return equalPolicies, diff, nil
+	if equalPolicies, _ := policyutils.ArePoliciesEqal(&currPolicy, &specPolicy); equalPolicies {
+		return true, "", nil
+	}
+	return false, policyutils.DiffPolicies(&specPolicy, &currPolicy).String(), nil
 }
 
 // TagsDiff returns the tags added and removed from spec when compared to the AWS SQS tags.
@@ -422,3 +422,37 @@ func TestGetConnectionDetails(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyDiff(t *testing.T) {
+	sendPolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Send","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}]}`
+	receivePolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Send","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":["sqs:SendMessage","sqs:ReceiveMessage"],"Resource":"*"}]}`
+
+	cases := map[string]struct {
+		p          v1beta1.QueueParameters
+		attributes map[string]string
+		want       string
+	}{
+		"UpToDate": {
+			p:          v1beta1.QueueParameters{Policy: aws.String(sendPolicy)},
+			attributes: map[string]string{v1beta1.AttributePolicy: sendPolicy},
+		},
+		"NoPolicy": {},
+		"ChangedActions": {
+			p:          v1beta1.QueueParameters{Policy: aws.String(receivePolicy)},
+			attributes: map[string]string{v1beta1.AttributePolicy: sendPolicy},
+			want:       "added statements: [Send]; removed statements: [Send]; added actions: [Allow sqs:ReceiveMessage]",
+		},
+		"RemovedPolicy": {
+			attributes: map[string]string{v1beta1.AttributePolicy: sendPolicy},
+			want:       "removed statements: [Send]; removed actions: [Allow sqs:SendMessage]; removed principals: [Service:sns.amazonaws.com]",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, PolicyDiff(tc.p, tc.attributes)); diff != "" {
+				t.Errorf("PolicyDiff(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+)
+
+const (
+	// ListLimit is the maximum number of entries WAF returns for a single
+	// list call.
+	ListLimit = 100
+
+	errStatement = "cannot parse statement of rule %q"
+	errConvert   = "cannot convert %T to %T"
+	errListTags  = "cannot list tags"
+)
+
+// ListPageFunc lists a single page of WAF resources starting at the given
+// marker. It returns the names of the resources on that page and the marker
+// of the next page.
+type ListPageFunc func(ctx context.Context, marker *string) (names []*string, next *string, err error)
+
+// FindPageMarker pages through the WAF resources using list and returns the
+// marker of the page that contains the resource with the given name. WAF
+// list calls do not support filtering, so this is required to observe a
+// resource by its name. A nil marker is returned if the resource is on the
+// first page or does not exist at all.
+func FindPageMarker(ctx context.Context, name string, list ListPageFunc) (*string, error) {
+	var marker *string
+	for {
+		names, next, err := list(ctx, marker)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range names {
+			if aws.StringValue(n) == name {
+				return marker, nil
+			}
+		}
+		if aws.StringValue(next) == "" {
+			return nil, nil
+		}
+		marker = next
+	}
+}
+
+// Convert converts the given API type into its SDK counterpart. The API
+// types mirror the SDK shapes with camel case JSON tags, which the case
+// insensitive JSON decoder maps back onto the SDK fields.
+func Convert(in, out any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return errors.Wrapf(err, errConvert, in, out)
+	}
+	return errors.Wrapf(json.Unmarshal(b, out), errConvert, in, out)
+}
+
+// GenerateRules returns the SDK representation of the given rules, sorted by
+// their priority.
+func GenerateRules(rules []*svcapitypes.Rule) ([]*svcsdk.Rule, error) {
+	res := make([]*svcsdk.Rule, 0, len(rules))
+	for _, r := range rules {
+		if r == nil {
+			continue
+		}
+		rule := &svcsdk.Rule{
+			Name:     aws.String(r.Name),
+			Priority: aws.Int64(r.Priority),
+		}
+		stmt := &svcsdk.Statement{}
+		if err := json.Unmarshal([]byte(r.Statement), stmt); err != nil {
+			return nil, errors.Wrapf(err, errStatement, r.Name)
+		}
+		rule.Statement = stmt
+		if err := Convert(r.VisibilityConfig, &rule.VisibilityConfig); err != nil {
+			return nil, err
+		}
+		if r.Action != nil {
+			if err := Convert(r.Action, &rule.Action); err != nil {
+				return nil, err
+			}
+		}
+		if r.OverrideAction != nil {
+			if err := Convert(r.OverrideAction, &rule.OverrideAction); err != nil {
+				return nil, err
+			}
+		}
+		if r.CaptchaConfig != nil {
+			if err := Convert(r.CaptchaConfig, &rule.CaptchaConfig); err != nil {
+				return nil, err
+			}
+		}
+		if r.ChallengeConfig != nil {
+			if err := Convert(r.ChallengeConfig, &rule.ChallengeConfig); err != nil {
+				return nil, err
+			}
+		}
+		if len(r.RuleLabels) > 0 {
+			if err := Convert(r.RuleLabels, &rule.RuleLabels); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, rule)
+	}
+	SortRules(res)
+	return res, nil
+}
+
+// SortRules sorts the given rules by their priority, which is the order in
+// which WAF evaluates them.
+func SortRules(rules []*svcsdk.Rule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+	})
+}
+
+// DiffRules returns the difference between the desired rules, as returned by
+// GenerateRules, and the current rules of a WebACL or RuleGroup. The order of
+// the current rules is ignored.
+func DiffRules(desired, current []*svcsdk.Rule) string {
+	cur := make([]*svcsdk.Rule, len(current))
+	copy(cur, current)
+	SortRules(cur)
+	return cmp.Diff(desired, cur, cmpopts.EquateEmpty())
+}
+
+// GenerateCustomResponseBodies returns the SDK representation of the given
+// custom response bodies.
+func GenerateCustomResponseBodies(in map[string]*svcapitypes.CustomResponseBody) map[string]*svcsdk.CustomResponseBody {
+	if in == nil {
+		return nil
+	}
+	res := make(map[string]*svcsdk.CustomResponseBody, len(in))
+	for k, v := range in {
+		if v == nil {
+			continue
+		}
+		res[k] = &svcsdk.CustomResponseBody{
+			Content:     v.Content,
+			ContentType: v.ContentType,
+		}
+	}
+	return res
+}
+
+// ListTags returns all tags of the WAF resource with the given ARN.
+func ListTags(ctx context.Context, client wafv2iface.WAFV2API, arn *string) ([]*svcsdk.Tag, error) {
+	var tags []*svcsdk.Tag
+	input := &svcsdk.ListTagsForResourceInput{ResourceARN: arn}
+	for {
+		resp, err := client.ListTagsForResourceWithContext(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, errListTags)
+		}
+		if resp.TagInfoForResource != nil {
+			tags = append(tags, resp.TagInfoForResource.TagList...)
+		}
+		if aws.StringValue(resp.NextMarker) == "" {
+			return tags, nil
+		}
+		input.NextMarker = resp.NextMarker
+	}
+}
+
+// DiffTags returns the tags that need to be added to and the tag keys that
+// need to be removed from the current tags to match the desired ones.
+func DiffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (add []*svcsdk.Tag, remove []*string) {
+	desired := make(map[string]string, len(spec))
+	for _, t := range spec {
+		desired[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	observed := make(map[string]string, len(current))
+	for _, t := range current {
+		observed[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	for k, v := range desired {
+		if ov, ok := observed[k]; !ok || ov != v {
+			add = append(add, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+	for k := range observed {
+		if _, ok := desired[k]; !ok {
+			remove = append(remove, aws.String(k))
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+	sort.Slice(remove, func(i, j int) bool { return aws.StringValue(remove[i]) < aws.StringValue(remove[j]) })
+	return add, remove
+}
+
+// UpdateTags brings the tags of the WAF resource with the given ARN in line
+// with the desired tags.
+func UpdateTags(ctx context.Context, client wafv2iface.WAFV2API, arn *string, spec []*svcapitypes.Tag) error {
+	current, err := ListTags(ctx, client, arn)
+	if err != nil {
+		return err
+	}
+	add, remove := DiffTags(spec, current)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceARN: arn, TagKeys: remove}); err != nil {
+			return errors.Wrap(err, "cannot untag resource")
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceARN: arn, Tags: add}); err != nil {
+			return errors.Wrap(err, "cannot tag resource")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+func TestGenerateRules(t *testing.T) {
+	visibility := svcapitypes.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(true),
+		MetricName:               aws.String("metric"),
+		SampledRequestsEnabled:   aws.Bool(false),
+	}
+	sdkVisibility := &svcsdk.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(true),
+		MetricName:               aws.String("metric"),
+		SampledRequestsEnabled:   aws.Bool(false),
+	}
+	type want struct {
+		rules []*svcsdk.Rule
+		err   bool
+	}
+	cases := map[string]struct {
+		rules []*svcapitypes.Rule
+		want  want
+	}{
+		"Empty": {
+			want: want{rules: []*svcsdk.Rule{}},
+		},
+		"SortedByPriority": {
+			rules: []*svcapitypes.Rule{
+				{
+					Name:             "geo",
+					Priority:         2,
+					Statement:        `{"geoMatchStatement":{"countryCodes":["DE"]}}`,
+					Action:           &svcapitypes.RuleAction{Block: &svcapitypes.BlockAction{}},
+					VisibilityConfig: visibility,
+				},
+				{
+					Name:             "managed",
+					Priority:         1,
+					Statement:        `{"managedRuleGroupStatement":{"vendorName":"AWS","name":"AWSManagedRulesCommonRuleSet"}}`,
+					OverrideAction:   &svcapitypes.OverrideAction{None: &svcapitypes.NoneAction{}},
+					RuleLabels:       []*svcapitypes.Label{{Name: aws.String("a:b")}},
+					VisibilityConfig: visibility,
+				},
+			},
+			want: want{rules: []*svcsdk.Rule{
+				{
+					Name:     aws.String("managed"),
+					Priority: aws.Int64(1),
+					Statement: &svcsdk.Statement{ManagedRuleGroupStatement: &svcsdk.ManagedRuleGroupStatement{
+						VendorName: aws.String("AWS"),
+						Name:       aws.String("AWSManagedRulesCommonRuleSet"),
+					}},
+					OverrideAction:   &svcsdk.OverrideAction{None: &svcsdk.NoneAction{}},
+					RuleLabels:       []*svcsdk.Label{{Name: aws.String("a:b")}},
+					VisibilityConfig: sdkVisibility,
+				},
+				{
+					Name:             aws.String("geo"),
+					Priority:         aws.Int64(2),
+					Statement:        &svcsdk.Statement{GeoMatchStatement: &svcsdk.GeoMatchStatement{CountryCodes: aws.StringSlice([]string{"DE"})}},
+					Action:           &svcsdk.RuleAction{Block: &svcsdk.BlockAction{}},
+					VisibilityConfig: sdkVisibility,
+				},
+			}},
+		},
+		"BinarySearchString": {
+			rules: []*svcapitypes.Rule{
+				{
+					Name:             "bytes",
+					Statement:        `{"byteMatchStatement":{"searchString":"Ym90","positionalConstraint":"CONTAINS","fieldToMatch":{"uriPath":{}},"textTransformations":[{"priority":0,"type":"NONE"}]}}`,
+					Action:           &svcapitypes.RuleAction{Count: &svcapitypes.CountAction{}},
+					VisibilityConfig: visibility,
+				},
+			},
+			want: want{rules: []*svcsdk.Rule{
+				{
+					Name:     aws.String("bytes"),
+					Priority: aws.Int64(0),
+					Statement: &svcsdk.Statement{ByteMatchStatement: &svcsdk.ByteMatchStatement{
+						SearchString:         []byte("bot"),
+						PositionalConstraint: aws.String("CONTAINS"),
+						FieldToMatch:         &svcsdk.FieldToMatch{UriPath: &svcsdk.UriPath{}},
+						TextTransformations:  []*svcsdk.TextTransformation{{Priority: aws.Int64(0), Type: aws.String("NONE")}},
+					}},
+					Action:           &svcsdk.RuleAction{Count: &svcsdk.CountAction{}},
+					VisibilityConfig: sdkVisibility,
+				},
+			}},
+		},
+		"InvalidStatement": {
+			rules: []*svcapitypes.Rule{{Name: "broken", Statement: `{"geoMatchStatement":`}},
+			want:  want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateRules(tc.rules)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rules, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	first := &svcsdk.Rule{Name: aws.String("first"), Priority: aws.Int64(1)}
+	second := &svcsdk.Rule{Name: aws.String("second"), Priority: aws.Int64(2)}
+	cases := map[string]struct {
+		desired []*svcsdk.Rule
+		current []*svcsdk.Rule
+		want    bool
+	}{
+		"BothEmpty": {
+			desired: []*svcsdk.Rule{},
+			want:    true,
+		},
+		"DifferentOrder": {
+			desired: []*svcsdk.Rule{first, second},
+			current: []*svcsdk.Rule{second, first},
+			want:    true,
+		},
+		"Missing": {
+			desired: []*svcsdk.Rule{first, second},
+			current: []*svcsdk.Rule{first},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffRules(tc.desired, tc.current) == ""
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*svcsdk.Tag
+		remove []*string
+	}
+	cases := map[string]struct {
+		spec    []*svcapitypes.Tag
+		current []*svcsdk.Tag
+		want    want
+	}{
+		"UpToDate": {
+			spec:    []*svcapitypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			current: []*svcsdk.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddChangeAndRemove": {
+			spec: []*svcapitypes.Tag{
+				{Key: aws.String("changed"), Value: aws.String("new")},
+				{Key: aws.String("added"), Value: aws.String("v")},
+			},
+			current: []*svcsdk.Tag{
+				{Key: aws.String("changed"), Value: aws.String("old")},
+				{Key: aws.String("removed"), Value: aws.String("v")},
+			},
+			want: want{
+				add: []*svcsdk.Tag{
+					{Key: aws.String("added"), Value: aws.String("v")},
+					{Key: aws.String("changed"), Value: aws.String("new")},
+				},
+				remove: []*string{aws.String("removed")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindPageMarker(t *testing.T) {
+	pages := map[string]struct {
+		names []string
+		next  string
+	}{
+		"":   {names: []string{"a", "b"}, next: "p2"},
+		"p2": {names: []string{"c"}, next: "p3"},
+		"p3": {names: []string{"d"}},
+	}
+	list := func(_ context.Context, marker *string) ([]*string, *string, error) {
+		p := pages[aws.StringValue(marker)]
+		var next *string
+		if p.next != "" {
+			next = aws.String(p.next)
+		}
+		return aws.StringSlice(p.names), next, nil
+	}
+	type want struct {
+		marker *string
+		err    error
+	}
+	cases := map[string]struct {
+		name string
+		list ListPageFunc
+		want want
+	}{
+		"FirstPage": {
+			name: "b",
+			list: list,
+		},
+		"LaterPage": {
+			name: "d",
+			list: list,
+			want: want{marker: aws.String("p3")},
+		},
+		"NotFound": {
+			name: "e",
+			list: list,
+		},
+		"ListFailed": {
+			name: "a",
+			list: func(context.Context, *string) ([]*string, *string, error) {
+				return nil, nil, errBoom
+			},
+			want: want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			marker, err := FindPageMarker(context.Background(), tc.name, tc.list)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.marker, marker); diff != "" {
+				t.Errorf("marker: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
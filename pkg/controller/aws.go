@@ -26,6 +26,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/apigatewayv2"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/athena"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/autoscaling"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/backup"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/batch"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cache"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront"
@@ -47,6 +48,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/elasticloadbalancing"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/elbv2"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/emrcontainers"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eventbridge"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/firehose"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/globalaccelerator"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/glue"
@@ -75,7 +77,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/sfn"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/sns"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/sqs"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ssm"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/sts"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/transfer"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/wafv2"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -90,6 +95,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		apigatewayv2.Setup,
 		athena.Setup,
 		autoscaling.Setup,
+		backup.Setup,
 		batch.Setup,
 		cache.Setup,
 		cloudfront.Setup,
@@ -111,6 +117,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		elasticloadbalancing.Setup,
 		elbv2.Setup,
 		emrcontainers.Setup,
+		eventbridge.Setup,
 		firehose.Setup,
 		glue.Setup,
 		globalaccelerator.Setup,
@@ -139,6 +146,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		sfn.Setup,
 		sns.Setup,
 		sqs.Setup,
+		ssm.Setup,
+		sts.Setup,
 		transfer.Setup,
+		wafv2.Setup,
 	)
 }
@@ -0,0 +1,274 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupplan
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/backup/backupiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/backup/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	backup "github.com/crossplane-contrib/provider-aws/pkg/clients/backup"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+// SetupBackupPlan adds a controller that reconciles BackupPlan.
+func SetupBackupPlan(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.BackupPlanGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.BackupPlanGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.BackupPlan{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = postCreate
+	e.preUpdate = preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.BackupAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.BackupPlan, obj *svcsdk.GetBackupPlanInput) error {
+	obj.BackupPlanId = aws.String(meta.GetExternalName(cr))
+	// Always observe the latest version of the plan.
+	obj.VersionId = nil
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.BackupPlan, resp *svcsdk.GetBackupPlanOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Deleted backup plans can still be retrieved by their ID.
+	if resp.DeletionDate != nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.BackupPlan, resp *svcsdk.GetBackupPlanOutput) (bool, string, error) {
+	if diff := DiffPlan(GeneratePlanInput(cr), resp.BackupPlan); diff != "" {
+		return false, diff, nil
+	}
+	upToDate, err := backup.AreTagsUpToDate(ctx, h.client, resp.BackupPlanArn, cr.Spec.ForProvider.BackupPlanTags)
+	if err != nil {
+		return false, "", err
+	}
+	if !upToDate {
+		return false, "backupPlanTags differ", nil
+	}
+	return true, "", nil
+}
+
+// GeneratePlanInput returns the backup plan described by the spec of the
+// given BackupPlan.
+func GeneratePlanInput(cr *svcapitypes.BackupPlan) *svcsdk.PlanInput {
+	p := cr.Spec.ForProvider
+	res := &svcsdk.PlanInput{
+		BackupPlanName: aws.String(p.BackupPlanName),
+		Rules:          make([]*svcsdk.RuleInput, 0, len(p.Rules)),
+	}
+	for _, s := range p.AdvancedBackupSettings {
+		if s == nil {
+			continue
+		}
+		res.AdvancedBackupSettings = append(res.AdvancedBackupSettings, &svcsdk.AdvancedBackupSetting{
+			BackupOptions: s.BackupOptions,
+			ResourceType:  s.ResourceType,
+		})
+	}
+	for _, r := range p.Rules {
+		if r == nil {
+			continue
+		}
+		rule := &svcsdk.RuleInput{
+			CompletionWindowMinutes:    r.CompletionWindowMinutes,
+			EnableContinuousBackup:     r.EnableContinuousBackup,
+			Lifecycle:                  generateLifecycle(r.Lifecycle),
+			RecoveryPointTags:          r.RecoveryPointTags,
+			RuleName:                   aws.String(r.RuleName),
+			ScheduleExpression:         r.ScheduleExpression,
+			ScheduleExpressionTimezone: r.ScheduleExpressionTimezone,
+			StartWindowMinutes:         r.StartWindowMinutes,
+			TargetBackupVaultName:      r.TargetBackupVaultName,
+		}
+		for _, a := range r.CopyActions {
+			if a == nil {
+				continue
+			}
+			rule.CopyActions = append(rule.CopyActions, &svcsdk.CopyAction{
+				DestinationBackupVaultArn: a.DestinationBackupVaultARN,
+				Lifecycle:                 generateLifecycle(a.Lifecycle),
+			})
+		}
+		res.Rules = append(res.Rules, rule)
+	}
+	return res
+}
+
+func generateLifecycle(in *svcapitypes.Lifecycle) *svcsdk.Lifecycle {
+	if in == nil {
+		return nil
+	}
+	return &svcsdk.Lifecycle{
+		DeleteAfterDays:            in.DeleteAfterDays,
+		MoveToColdStorageAfterDays: in.MoveToColdStorageAfterDays,
+	}
+}
+
+// DiffPlan returns the difference between the desired and the current backup
+// plan. Rules are matched by their name and settings of a rule that are not
+// specified in the desired plan are filled with their current, possibly
+// defaulted, values.
+func DiffPlan(desired *svcsdk.PlanInput, current *svcsdk.Plan) string {
+	if current == nil {
+		return "backup plan not found"
+	}
+	observed := &svcsdk.PlanInput{
+		AdvancedBackupSettings: current.AdvancedBackupSettings,
+		BackupPlanName:         current.BackupPlanName,
+		Rules:                  make([]*svcsdk.RuleInput, 0, len(current.Rules)),
+	}
+	byName := make(map[string]*svcsdk.RuleInput, len(current.Rules))
+	for _, r := range current.Rules {
+		rule := &svcsdk.RuleInput{
+			CompletionWindowMinutes:    r.CompletionWindowMinutes,
+			CopyActions:                r.CopyActions,
+			EnableContinuousBackup:     r.EnableContinuousBackup,
+			Lifecycle:                  r.Lifecycle,
+			RecoveryPointTags:          r.RecoveryPointTags,
+			RuleName:                   r.RuleName,
+			ScheduleExpression:         r.ScheduleExpression,
+			ScheduleExpressionTimezone: r.ScheduleExpressionTimezone,
+			StartWindowMinutes:         r.StartWindowMinutes,
+			TargetBackupVaultName:      r.TargetBackupVaultName,
+		}
+		byName[aws.StringValue(r.RuleName)] = rule
+		observed.Rules = append(observed.Rules, rule)
+	}
+	want := &svcsdk.PlanInput{
+		AdvancedBackupSettings: desired.AdvancedBackupSettings,
+		BackupPlanName:         desired.BackupPlanName,
+		Rules:                  make([]*svcsdk.RuleInput, 0, len(desired.Rules)),
+	}
+	for _, r := range desired.Rules {
+		rule := *r
+		if cur, ok := byName[aws.StringValue(r.RuleName)]; ok {
+			rule.CompletionWindowMinutes = pointer.LateInitialize(rule.CompletionWindowMinutes, cur.CompletionWindowMinutes)
+			rule.EnableContinuousBackup = pointer.LateInitialize(rule.EnableContinuousBackup, cur.EnableContinuousBackup)
+			rule.ScheduleExpressionTimezone = pointer.LateInitialize(rule.ScheduleExpressionTimezone, cur.ScheduleExpressionTimezone)
+			rule.StartWindowMinutes = pointer.LateInitialize(rule.StartWindowMinutes, cur.StartWindowMinutes)
+		}
+		want.Rules = append(want.Rules, &rule)
+	}
+	return cmp.Diff(want, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *svcsdk.RuleInput) bool {
+			return aws.StringValue(a.RuleName) < aws.StringValue(b.RuleName)
+		}),
+		cmpopts.SortSlices(func(a, b *svcsdk.CopyAction) bool {
+			return aws.StringValue(a.DestinationBackupVaultArn) < aws.StringValue(b.DestinationBackupVaultArn)
+		}),
+		cmpopts.SortSlices(func(a, b *svcsdk.AdvancedBackupSetting) bool {
+			return aws.StringValue(a.ResourceType) < aws.StringValue(b.ResourceType)
+		}),
+	)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.BackupPlan, obj *svcsdk.CreateBackupPlanInput) error {
+	obj.BackupPlan = GeneratePlanInput(cr)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.BackupPlan, resp *svcsdk.CreateBackupPlanOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.BackupPlanId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.BackupPlan, obj *svcsdk.UpdateBackupPlanInput) error {
+	obj.BackupPlanId = aws.String(meta.GetExternalName(cr))
+	obj.BackupPlan = GeneratePlanInput(cr)
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.BackupPlan, resp *svcsdk.UpdateBackupPlanOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cr.Status.AtProvider.VersionID = resp.VersionId
+	return upd, backup.UpdateTags(ctx, h.client, cr.Status.AtProvider.BackupPlanARN, cr.Spec.ForProvider.BackupPlanTags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.BackupPlan, obj *svcsdk.DeleteBackupPlanInput) (bool, error) {
+	obj.BackupPlanId = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
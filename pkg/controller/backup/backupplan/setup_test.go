@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupplan
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/backup/v1alpha1"
+)
+
+const (
+	testVaultARN = "arn:aws:backup:eu-west-1:123456789012:backup-vault:copies"
+)
+
+func plan(m ...func(*svcapitypes.BackupPlan)) *svcapitypes.BackupPlan {
+	cr := &svcapitypes.BackupPlan{}
+	cr.Spec.ForProvider.BackupPlanName = "daily"
+	cr.Spec.ForProvider.Rules = []*svcapitypes.BackupRule{{
+		RuleName:              "daily",
+		TargetBackupVaultName: aws.String("primary"),
+		ScheduleExpression:    aws.String("cron(0 5 ? * * *)"),
+		Lifecycle:             &svcapitypes.Lifecycle{DeleteAfterDays: aws.Int64(35)},
+		CopyActions: []*svcapitypes.BackupCopyAction{{
+			DestinationBackupVaultARN: aws.String(testVaultARN),
+			Lifecycle:                 &svcapitypes.Lifecycle{DeleteAfterDays: aws.Int64(90)},
+		}},
+	}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func remote(m ...func(*svcsdk.Plan)) *svcsdk.Plan {
+	p := &svcsdk.Plan{
+		BackupPlanName: aws.String("daily"),
+		Rules: []*svcsdk.Rule{{
+			RuleId:                     aws.String("rule-id"),
+			RuleName:                   aws.String("daily"),
+			TargetBackupVaultName:      aws.String("primary"),
+			ScheduleExpression:         aws.String("cron(0 5 ? * * *)"),
+			ScheduleExpressionTimezone: aws.String("Etc/UTC"),
+			StartWindowMinutes:         aws.Int64(480),
+			CompletionWindowMinutes:    aws.Int64(10080),
+			EnableContinuousBackup:     aws.Bool(false),
+			Lifecycle:                  &svcsdk.Lifecycle{DeleteAfterDays: aws.Int64(35)},
+			CopyActions: []*svcsdk.CopyAction{{
+				DestinationBackupVaultArn: aws.String(testVaultARN),
+				Lifecycle:                 &svcsdk.Lifecycle{DeleteAfterDays: aws.Int64(90)},
+			}},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestDiffPlan(t *testing.T) {
+	cases := map[string]struct {
+		cr       *svcapitypes.BackupPlan
+		current  *svcsdk.Plan
+		upToDate bool
+	}{
+		"DefaultsIgnored": {
+			cr:       plan(),
+			current:  remote(),
+			upToDate: true,
+		},
+		"ExplicitWindowChanged": {
+			cr: plan(func(cr *svcapitypes.BackupPlan) {
+				cr.Spec.ForProvider.Rules[0].StartWindowMinutes = aws.Int64(60)
+			}),
+			current:  remote(),
+			upToDate: false,
+		},
+		"LifecycleChanged": {
+			cr: plan(),
+			current: remote(func(p *svcsdk.Plan) {
+				p.Rules[0].Lifecycle.DeleteAfterDays = aws.Int64(7)
+			}),
+			upToDate: false,
+		},
+		"CopyActionRemoved": {
+			cr: plan(func(cr *svcapitypes.BackupPlan) {
+				cr.Spec.ForProvider.Rules[0].CopyActions = nil
+			}),
+			current:  remote(),
+			upToDate: false,
+		},
+		"RuleAdded": {
+			cr: plan(func(cr *svcapitypes.BackupPlan) {
+				cr.Spec.ForProvider.Rules = append(cr.Spec.ForProvider.Rules, &svcapitypes.BackupRule{
+					RuleName:              "weekly",
+					TargetBackupVaultName: aws.String("primary"),
+				})
+			}),
+			current:  remote(),
+			upToDate: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffPlan(GeneratePlanInput(tc.cr), tc.current) == ""
+			if diff := cmp.Diff(tc.upToDate, got); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupselection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/backup/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+// SetupBackupSelection adds a controller that reconciles BackupSelection.
+func SetupBackupSelection(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.BackupSelectionGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.BackupSelectionGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.BackupSelection{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = postCreate
+	e.update = update
+	e.preDelete = preDelete
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.BackupSelection, obj *svcsdk.GetBackupSelectionInput) error {
+	obj.BackupPlanId = cr.Spec.ForProvider.BackupPlanID
+	obj.SelectionId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.BackupSelection, _ *svcsdk.GetBackupSelectionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(context.Context, *svcapitypes.BackupSelection, *svcsdk.GetBackupSelectionOutput) (bool, string, error) {
+	// Backup selections cannot be updated.
+	return true, "", nil
+}
+
+// GenerateSelection returns the backup selection described by the spec of
+// the given BackupSelection. The ARNs of referenced resources are assigned in
+// addition to the explicitly given resources.
+func GenerateSelection(cr *svcapitypes.BackupSelection) *svcsdk.Selection {
+	p := cr.Spec.ForProvider
+	res := &svcsdk.Selection{
+		IamRoleArn:    p.IAMRoleARN,
+		NotResources:  p.NotResources,
+		SelectionName: aws.String(p.SelectionName),
+	}
+	for _, arns := range [][]*string{p.Resources, p.DBClusterARNs, p.TableARNs, p.FileSystemARNs} {
+		res.Resources = append(res.Resources, arns...)
+	}
+	for _, c := range p.ListOfTags {
+		if c == nil {
+			continue
+		}
+		res.ListOfTags = append(res.ListOfTags, &svcsdk.Condition{
+			ConditionKey:   c.ConditionKey,
+			ConditionType:  c.ConditionType,
+			ConditionValue: c.ConditionValue,
+		})
+	}
+	if p.Conditions != nil {
+		res.Conditions = &svcsdk.Conditions{
+			StringEquals:    generateConditionParameters(p.Conditions.StringEquals),
+			StringLike:      generateConditionParameters(p.Conditions.StringLike),
+			StringNotEquals: generateConditionParameters(p.Conditions.StringNotEquals),
+			StringNotLike:   generateConditionParameters(p.Conditions.StringNotLike),
+		}
+	}
+	return res
+}
+
+func generateConditionParameters(in []*svcapitypes.ConditionParameter) []*svcsdk.ConditionParameter {
+	if in == nil {
+		return nil
+	}
+	res := make([]*svcsdk.ConditionParameter, 0, len(in))
+	for _, c := range in {
+		if c == nil {
+			continue
+		}
+		res = append(res, &svcsdk.ConditionParameter{
+			ConditionKey:   c.ConditionKey,
+			ConditionValue: c.ConditionValue,
+		})
+	}
+	return res
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.BackupSelection, obj *svcsdk.CreateBackupSelectionInput) error {
+	obj.BackupPlanId = cr.Spec.ForProvider.BackupPlanID
+	obj.BackupSelection = GenerateSelection(cr)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.BackupSelection, resp *svcsdk.CreateBackupSelectionOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.SelectionId))
+	return cre, nil
+}
+
+func update(context.Context, resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.BackupSelection, obj *svcsdk.DeleteBackupSelectionInput) (bool, error) {
+	obj.BackupPlanId = cr.Spec.ForProvider.BackupPlanID
+	obj.SelectionId = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupselection
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/backup/v1alpha1"
+)
+
+func TestGenerateSelection(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.BackupSelection
+		want *svcsdk.Selection
+	}{
+		"ByTag": {
+			cr: &svcapitypes.BackupSelection{Spec: svcapitypes.BackupSelectionSpec{ForProvider: svcapitypes.BackupSelectionParameters{
+				CustomBackupSelectionParameters: svcapitypes.CustomBackupSelectionParameters{
+					SelectionName: "tagged",
+					IAMRoleARN:    aws.String("arn:aws:iam::123456789012:role/backup"),
+					ListOfTags: []*svcapitypes.Condition{{
+						ConditionType:  aws.String(svcsdk.ConditionTypeStringequals),
+						ConditionKey:   aws.String("backup"),
+						ConditionValue: aws.String("daily"),
+					}},
+					Conditions: &svcapitypes.Conditions{
+						StringNotEquals: []*svcapitypes.ConditionParameter{{
+							ConditionKey:   aws.String("aws:ResourceTag/environment"),
+							ConditionValue: aws.String("dev"),
+						}},
+					},
+				},
+			}}},
+			want: &svcsdk.Selection{
+				SelectionName: aws.String("tagged"),
+				IamRoleArn:    aws.String("arn:aws:iam::123456789012:role/backup"),
+				ListOfTags: []*svcsdk.Condition{{
+					ConditionType:  aws.String(svcsdk.ConditionTypeStringequals),
+					ConditionKey:   aws.String("backup"),
+					ConditionValue: aws.String("daily"),
+				}},
+				Conditions: &svcsdk.Conditions{
+					StringNotEquals: []*svcsdk.ConditionParameter{{
+						ConditionKey:   aws.String("aws:ResourceTag/environment"),
+						ConditionValue: aws.String("dev"),
+					}},
+				},
+			},
+		},
+		"ReferencedResources": {
+			cr: &svcapitypes.BackupSelection{Spec: svcapitypes.BackupSelectionSpec{ForProvider: svcapitypes.BackupSelectionParameters{
+				CustomBackupSelectionParameters: svcapitypes.CustomBackupSelectionParameters{
+					SelectionName:  "resources",
+					Resources:      aws.StringSlice([]string{"arn:aws:ec2:*:*:volume/*"}),
+					DBClusterARNs:  aws.StringSlice([]string{"arn:aws:rds:us-east-1:123456789012:cluster:db"}),
+					TableARNs:      aws.StringSlice([]string{"arn:aws:dynamodb:us-east-1:123456789012:table/t"}),
+					FileSystemARNs: aws.StringSlice([]string{"arn:aws:elasticfilesystem:us-east-1:123456789012:file-system/fs-1"}),
+				},
+			}}},
+			want: &svcsdk.Selection{
+				SelectionName: aws.String("resources"),
+				Resources: aws.StringSlice([]string{
+					"arn:aws:ec2:*:*:volume/*",
+					"arn:aws:rds:us-east-1:123456789012:cluster:db",
+					"arn:aws:dynamodb:us-east-1:123456789012:table/t",
+					"arn:aws:elasticfilesystem:us-east-1:123456789012:file-system/fs-1",
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateSelection(tc.cr)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
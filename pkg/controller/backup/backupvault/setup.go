@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupvault
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/backup"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/backup/backupiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/backup/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	backup "github.com/crossplane-contrib/provider-aws/pkg/clients/backup"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errPutLockConfiguration    = "cannot put backup vault lock configuration"
+	errDeleteLockConfiguration = "cannot delete backup vault lock configuration"
+)
+
+// SetupBackupVault adds a controller that reconciles BackupVault.
+func SetupBackupVault(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.BackupVaultGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.BackupVaultGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.BackupVault{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.lateInitialize = lateInitialize
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = h.postCreate
+	e.update = h.update
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.BackupAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.BackupVault, obj *svcsdk.DescribeBackupVaultInput) error {
+	obj.BackupVaultName = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.BackupVault, _ *svcsdk.DescribeBackupVaultOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func lateInitialize(spec *svcapitypes.BackupVaultParameters, resp *svcsdk.DescribeBackupVaultOutput) error {
+	// Backup encrypts the vault with the AWS managed key if none is given.
+	spec.EncryptionKeyARN = pointer.LateInitialize(spec.EncryptionKeyARN, resp.EncryptionKeyArn)
+	return nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.BackupVault, resp *svcsdk.DescribeBackupVaultOutput) (bool, string, error) {
+	if diff := diffLockConfiguration(cr.Spec.ForProvider.LockConfiguration, resp); diff != "" {
+		return false, diff, nil
+	}
+	upToDate, err := backup.AreTagsUpToDate(ctx, h.client, resp.BackupVaultArn, cr.Spec.ForProvider.BackupVaultTags)
+	if err != nil {
+		return false, "", err
+	}
+	if !upToDate {
+		return false, "backupVaultTags differ", nil
+	}
+	return true, "", nil
+}
+
+// diffLockConfiguration compares the desired lock configuration with the
+// observed one. ChangeableForDays is not returned by the API, it only
+// determines the lock date when the configuration is applied.
+func diffLockConfiguration(spec *svcapitypes.BackupVaultLockConfiguration, resp *svcsdk.DescribeBackupVaultOutput) string {
+	if spec == nil {
+		if resp.MinRetentionDays != nil || resp.MaxRetentionDays != nil {
+			return "lockConfiguration should be removed"
+		}
+		return ""
+	}
+	if aws.Int64Value(spec.MinRetentionDays) != aws.Int64Value(resp.MinRetentionDays) {
+		return "lockConfiguration.minRetentionDays differs"
+	}
+	if aws.Int64Value(spec.MaxRetentionDays) != aws.Int64Value(resp.MaxRetentionDays) {
+		return "lockConfiguration.maxRetentionDays differs"
+	}
+	return ""
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.BackupVault, obj *svcsdk.CreateBackupVaultInput) error {
+	obj.BackupVaultName = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.BackupVault, _ *svcsdk.CreateBackupVaultOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil || cr.Spec.ForProvider.LockConfiguration == nil {
+		return cre, err
+	}
+	return cre, h.putLockConfiguration(ctx, cr)
+}
+
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.BackupVault)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	switch {
+	case cr.Spec.ForProvider.LockConfiguration != nil:
+		if err := h.putLockConfiguration(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	case cr.Status.AtProvider.MinRetentionDays != nil || cr.Status.AtProvider.MaxRetentionDays != nil:
+		if _, err := h.client.DeleteBackupVaultLockConfigurationWithContext(ctx, &svcsdk.DeleteBackupVaultLockConfigurationInput{
+			BackupVaultName: aws.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteLockConfiguration)
+		}
+	}
+	return managed.ExternalUpdate{}, backup.UpdateTags(ctx, h.client, cr.Status.AtProvider.BackupVaultARN, cr.Spec.ForProvider.BackupVaultTags)
+}
+
+func (h *hooks) putLockConfiguration(ctx context.Context, cr *svcapitypes.BackupVault) error {
+	lock := cr.Spec.ForProvider.LockConfiguration
+	_, err := h.client.PutBackupVaultLockConfigurationWithContext(ctx, &svcsdk.PutBackupVaultLockConfigurationInput{
+		BackupVaultName:   aws.String(meta.GetExternalName(cr)),
+		ChangeableForDays: lock.ChangeableForDays,
+		MaxRetentionDays:  lock.MaxRetentionDays,
+		MinRetentionDays:  lock.MinRetentionDays,
+	})
+	return errors.Wrap(err, errPutLockConfiguration)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.BackupVault, obj *svcsdk.DeleteBackupVaultInput) (bool, error) {
+	obj.BackupVaultName = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudfront/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	cloudfront "github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/utils"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errGetConfigMap     = "cannot get function code config map"
+	errNoFunctionCode   = "function code config map does not contain the referenced key"
+	errGetFunctionCode  = "cannot get function code"
+	errPublishFunction  = "cannot publish function"
+	diffFunctionCode    = "spec.forProvider.functionCodeConfigMapRef: function code differs"
+	diffFunctionNotLive = "function code is not published to the LIVE stage"
+)
+
+// SetupFunction adds a controller that reconciles Function.
+func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.FunctionGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.FunctionGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Function{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{kube: e.kube, client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.lateInitialize = lateInitialize
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = h.preCreate
+	e.postCreate = h.postCreate
+	e.preUpdate = h.preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	kube   client.Client
+	client svcsdkapi.CloudFrontAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.DescribeFunctionInput) error {
+	obj.Name = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.Stage = aws.String(svcsdk.FunctionStageDevelopment)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Function, _ *svcsdk.DescribeFunctionOutput,
+	obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+var mappingOptions = []cloudfront.LateInitOption{cloudfront.Replacer("ID", "Id")}
+
+func lateInitialize(in *svcapitypes.FunctionParameters, resp *svcsdk.DescribeFunctionOutput) error {
+	if resp.FunctionSummary == nil {
+		return nil
+	}
+	_, err := cloudfront.LateInitializeFromResponse("",
+		in.FunctionConfig, resp.FunctionSummary.FunctionConfig, mappingOptions...)
+	return err
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.Function, resp *svcsdk.DescribeFunctionOutput) (bool, string, error) {
+	if resp.FunctionSummary != nil {
+		upToDate, diff, err := cloudfront.IsUpToDate(resp.FunctionSummary.FunctionConfig, cr.Spec.ForProvider.FunctionConfig,
+			mappingOptions...)
+		if err != nil || !upToDate {
+			return upToDate, diff, err
+		}
+	}
+
+	code, err := h.getFunctionCode(ctx, cr)
+	if err != nil {
+		return false, "", err
+	}
+	dev, err := h.client.GetFunctionWithContext(ctx, &svcsdk.GetFunctionInput{
+		Name:  pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		Stage: aws.String(svcsdk.FunctionStageDevelopment),
+	})
+	if err != nil {
+		return false, "", errors.Wrap(err, errGetFunctionCode)
+	}
+	if !bytes.Equal(dev.FunctionCode, code) {
+		return false, diffFunctionCode, nil
+	}
+	if !publish(cr) {
+		return true, "", nil
+	}
+
+	// The LIVE stage does not exist until the function is published for
+	// the first time.
+	live, err := h.client.GetFunctionWithContext(ctx, &svcsdk.GetFunctionInput{
+		Name:  pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		Stage: aws.String(svcsdk.FunctionStageLive),
+	})
+	if IsNotFound(err) {
+		return false, diffFunctionNotLive, nil
+	}
+	if err != nil {
+		return false, "", errors.Wrap(err, errGetFunctionCode)
+	}
+	if !bytes.Equal(live.FunctionCode, code) {
+		return false, diffFunctionNotLive, nil
+	}
+	return true, "", nil
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.Function, obj *svcsdk.CreateFunctionInput) error {
+	code, err := h.getFunctionCode(ctx, cr)
+	if err != nil {
+		return err
+	}
+	obj.Name = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.FunctionCode = code
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.Function, _ *svcsdk.CreateFunctionOutput,
+	cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return cre, h.publish(ctx, cr)
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Function, obj *svcsdk.UpdateFunctionInput) error {
+	code, err := h.getFunctionCode(ctx, cr)
+	if err != nil {
+		return err
+	}
+	obj.Name = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.SetIfMatch(pointer.StringValue(cr.Status.AtProvider.ETag))
+	obj.FunctionCode = code
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Function, _ *svcsdk.UpdateFunctionOutput,
+	upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return upd, h.publish(ctx, cr)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.DeleteFunctionInput) (bool, error) {
+	obj.Name = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.SetIfMatch(pointer.StringValue(cr.Status.AtProvider.ETag))
+	return false, nil
+}
+
+// publish copies the DEVELOPMENT stage of the function to the LIVE stage if
+// publishing is enabled.
+func (h *hooks) publish(ctx context.Context, cr *svcapitypes.Function) error {
+	if !publish(cr) {
+		return nil
+	}
+	// The ETag header of the UpdateFunction response is not populated by the
+	// SDK, so the current version is described again.
+	resp, err := h.client.DescribeFunctionWithContext(ctx, &svcsdk.DescribeFunctionInput{
+		Name:  pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		Stage: aws.String(svcsdk.FunctionStageDevelopment),
+	})
+	if err != nil {
+		return errors.Wrap(err, errDescribe)
+	}
+	_, err = h.client.PublishFunctionWithContext(ctx, &svcsdk.PublishFunctionInput{
+		Name:    pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		IfMatch: resp.ETag,
+	})
+	return errors.Wrap(err, errPublishFunction)
+}
+
+// getFunctionCode returns the function code from the referenced config map.
+func (h *hooks) getFunctionCode(ctx context.Context, cr *svcapitypes.Function) ([]byte, error) {
+	ref := cr.Spec.ForProvider.FunctionCodeConfigMapRef
+	cm := &corev1.ConfigMap{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return nil, errors.Wrap(err, errGetConfigMap)
+	}
+	if code, ok := cm.Data[ref.Key]; ok {
+		return []byte(code), nil
+	}
+	if code, ok := cm.BinaryData[ref.Key]; ok {
+		return code, nil
+	}
+	return nil, errors.New(errNoFunctionCode)
+}
+
+func publish(cr *svcapitypes.Function) bool {
+	return cr.Spec.ForProvider.Publish == nil || *cr.Spec.ForProvider.Publish
+}
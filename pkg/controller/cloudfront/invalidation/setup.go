@@ -0,0 +1,212 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invalidation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudfront/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errCallerReference  = "cannot compute caller reference"
+	errKubeUpdateFailed = "cannot update Invalidation custom resource"
+
+	statusCompleted = "Completed"
+)
+
+// SetupInvalidation adds a controller that reconciles Invalidation.
+func SetupInvalidation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.InvalidationGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.InvalidationGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Invalidation{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{kube: e.kube, client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = postCreate
+	e.update = h.update
+}
+
+type hooks struct {
+	kube   client.Client
+	client svcsdkapi.CloudFrontAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Invalidation, obj *svcsdk.GetInvalidationInput) error {
+	obj.DistributionId = cr.Spec.ForProvider.DistributionID
+	obj.Id = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Invalidation, resp *svcsdk.GetInvalidationOutput,
+	obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch {
+	case meta.WasDeleted(cr):
+		// Invalidations cannot be deleted, they are only forgotten.
+		obs.ResourceExists = false
+	case resp.Invalidation != nil && pointer.StringValue(resp.Invalidation.Status) == statusCompleted:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Creating())
+	}
+	return obs, nil
+}
+
+// An invalidation cannot be changed once it was created. Instead, a new
+// invalidation is created whenever the caller reference computed from the
+// spec differs from the one of the observed invalidation.
+func isUpToDate(_ context.Context, cr *svcapitypes.Invalidation, resp *svcsdk.GetInvalidationOutput) (bool, string, error) {
+	ref, err := callerReference(cr.Spec.ForProvider)
+	if err != nil {
+		return false, "", err
+	}
+	if resp.Invalidation == nil || resp.Invalidation.InvalidationBatch == nil {
+		return true, "", nil
+	}
+	if pointer.StringValue(resp.Invalidation.InvalidationBatch.CallerReference) != ref {
+		return false, "spec.forProvider: distribution, paths or triggers changed", nil
+	}
+	return true, "", nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Invalidation, obj *svcsdk.CreateInvalidationInput) error {
+	ref, err := callerReference(cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj.DistributionId = cr.Spec.ForProvider.DistributionID
+	obj.InvalidationBatch = &svcsdk.InvalidationBatch{
+		CallerReference: pointer.ToOrNilIfZeroValue(ref),
+		Paths: &svcsdk.Paths{
+			Items:    cr.Spec.ForProvider.Paths,
+			Quantity: pointer.ToIntAsInt64(len(cr.Spec.ForProvider.Paths)),
+		},
+	}
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Invalidation, resp *svcsdk.CreateInvalidationOutput,
+	cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, pointer.StringValue(resp.Invalidation.Id))
+	return cre, nil
+}
+
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Invalidation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	input := GenerateCreateInvalidationInput(cr)
+	if err := preCreate(ctx, cr, input); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	resp, err := h.client.CreateInvalidationWithContext(ctx, input)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreate)
+	}
+
+	// The new invalidation replaces the observed one, so its ID has to be
+	// stored as external name. Updating the resource resets the status to
+	// the state of the API server, so the observation is restored
+	// afterwards.
+	status := cr.Status.DeepCopy()
+	meta.SetExternalName(cr, pointer.StringValue(resp.Invalidation.Id))
+	if err := h.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+	cr.Status = *status
+	return managed.ExternalUpdate{}, nil
+}
+
+// callerReference returns a hash of the distribution, the paths and the
+// triggers of the invalidation.
+func callerReference(p svcapitypes.InvalidationParameters) (string, error) {
+	b, err := json.Marshal(struct {
+		DistributionID *string           `json:"distributionID"`
+		Paths          []*string         `json:"paths"`
+		Triggers       map[string]string `json:"triggers"`
+	}{
+		DistributionID: p.DistributionID,
+		Paths:          p.Paths,
+		Triggers:       p.Triggers,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errCallerReference)
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invalidation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudfront/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+var errBoom = errors.New("boom")
+
+type mockCloudFront struct {
+	svcsdkapi.CloudFrontAPI
+}
+
+func (m *mockCloudFront) CreateInvalidationWithContext(context.Context, *svcsdk.CreateInvalidationInput, ...request.Option) (*svcsdk.CreateInvalidationOutput, error) {
+	return &svcsdk.CreateInvalidationOutput{Invalidation: &svcsdk.Invalidation{Id: pointer.ToOrNilIfZeroValue("I2J0I21PCUYOIK")}}, nil
+}
+
+func invalidation(distributionID string, paths []string, triggers map[string]string) *svcapitypes.Invalidation {
+	return &svcapitypes.Invalidation{
+		Spec: svcapitypes.InvalidationSpec{
+			ForProvider: svcapitypes.InvalidationParameters{
+				Region: "us-east-1",
+				CustomInvalidationParameters: svcapitypes.CustomInvalidationParameters{
+					DistributionID: &distributionID,
+					Paths:          pointer.SliceValueToPtr(paths),
+					Triggers:       triggers,
+				},
+			},
+		},
+	}
+}
+
+func getInvalidationOutput(cr *svcapitypes.Invalidation) *svcsdk.GetInvalidationOutput {
+	ref, _ := callerReference(cr.Spec.ForProvider)
+	return &svcsdk.GetInvalidationOutput{
+		Invalidation: &svcsdk.Invalidation{
+			InvalidationBatch: &svcsdk.InvalidationBatch{CallerReference: &ref},
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := invalidation("E2QWRUHAPOMQZL", []string{"/*"}, map[string]string{"revision": "1"})
+
+	cases := map[string]struct {
+		cr   *svcapitypes.Invalidation
+		resp *svcsdk.GetInvalidationOutput
+		want bool
+	}{
+		"NothingChanged": {
+			cr:   invalidation("E2QWRUHAPOMQZL", []string{"/*"}, map[string]string{"revision": "1"}),
+			resp: getInvalidationOutput(observed),
+			want: true,
+		},
+		"TriggerChanged": {
+			cr:   invalidation("E2QWRUHAPOMQZL", []string{"/*"}, map[string]string{"revision": "2"}),
+			resp: getInvalidationOutput(observed),
+			want: false,
+		},
+		"PathsChanged": {
+			cr:   invalidation("E2QWRUHAPOMQZL", []string{"/index.html"}, map[string]string{"revision": "1"}),
+			resp: getInvalidationOutput(observed),
+			want: false,
+		},
+		"DistributionChanged": {
+			cr:   invalidation("E1TXA3EXAMPLE", []string{"/*"}, map[string]string{"revision": "1"}),
+			resp: getInvalidationOutput(observed),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, _, err := isUpToDate(context.TODO(), tc.cr, tc.resp)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreCreate(t *testing.T) {
+	cr := invalidation("E2QWRUHAPOMQZL", []string{"/images/*", "/index.html"}, nil)
+	ref, _ := callerReference(cr.Spec.ForProvider)
+
+	input := &svcsdk.CreateInvalidationInput{}
+	if err := preCreate(context.TODO(), cr, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &svcsdk.CreateInvalidationInput{
+		DistributionId: pointer.ToOrNilIfZeroValue("E2QWRUHAPOMQZL"),
+		InvalidationBatch: &svcsdk.InvalidationBatch{
+			CallerReference: &ref,
+			Paths: &svcsdk.Paths{
+				Items:    pointer.SliceValueToPtr([]string{"/images/*", "/index.html"}),
+				Quantity: pointer.ToIntAsInt64(2),
+			},
+		},
+	}
+	if diff := cmp.Diff(want, input); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		status       svcapitypes.InvalidationStatus
+		err          error
+	}
+
+	observed := svcapitypes.InvalidationStatus{
+		AtProvider: svcapitypes.InvalidationObservation{
+			Location: pointer.ToOrNilIfZeroValue("https://cloudfront.amazonaws.com/2020-05-31/distribution/E2QWRUHAPOMQZL/invalidation/IDFDVBD632BHDS5"),
+		},
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		want want
+	}{
+		"StatusKept": {
+			kube: &test.MockClient{
+				// Updating resets the status to the one of the API server.
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					obj.(*svcapitypes.Invalidation).Status = svcapitypes.InvalidationStatus{}
+					return nil
+				},
+			},
+			want: want{
+				externalName: "I2J0I21PCUYOIK",
+				status:       observed,
+			},
+		},
+		"KubeUpdateFailed": {
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			want: want{
+				externalName: "I2J0I21PCUYOIK",
+				status:       observed,
+				err:          errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := invalidation("E2QWRUHAPOMQZL", []string{"/*"}, map[string]string{"revision": "2"})
+			meta.SetExternalName(cr, "IDFDVBD632BHDS5")
+			cr.Status = *observed.DeepCopy()
+			h := &hooks{kube: tc.kube, client: &mockCloudFront{}}
+			_, err := h.update(context.TODO(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("externalName: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originrequestpolicy
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudfront/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	cloudfront "github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/utils"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+// SetupOriginRequestPolicy adds a controller that reconciles OriginRequestPolicy.
+func SetupOriginRequestPolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.OriginRequestPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{
+			kube: mgr.GetClient(),
+			opts: []option{
+				func(e *external) {
+					e.preObserve = preObserve
+					e.postObserve = postObserve
+					e.preCreate = preCreate
+					e.postCreate = postCreate
+					e.lateInitialize = lateInitialize
+					e.preUpdate = preUpdate
+					e.isUpToDate = isUpToDate
+					e.preDelete = preDelete
+				},
+			},
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.OriginRequestPolicyGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.OriginRequestPolicy{}).
+		Complete(r)
+}
+
+func preCreate(_ context.Context, orp *svcapitypes.OriginRequestPolicy, corpi *svcsdk.CreateOriginRequestPolicyInput) error {
+	setHeadersQuantity(orp.Spec.ForProvider.OriginRequestPolicyConfig, corpi.OriginRequestPolicyConfig)
+	return nil
+}
+
+func postCreate(_ context.Context, orp *svcapitypes.OriginRequestPolicy, orpo *svcsdk.CreateOriginRequestPolicyOutput,
+	ec managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(orp, pointer.StringValue(orpo.OriginRequestPolicy.Id))
+	return ec, nil
+}
+
+func preObserve(_ context.Context, orp *svcapitypes.OriginRequestPolicy, gorpi *svcsdk.GetOriginRequestPolicyInput) error {
+	gorpi.Id = pointer.ToOrNilIfZeroValue(meta.GetExternalName(orp))
+	return nil
+}
+
+func postObserve(_ context.Context, orp *svcapitypes.OriginRequestPolicy, _ *svcsdk.GetOriginRequestPolicyOutput,
+	eo managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	orp.SetConditions(xpv1.Available())
+	return eo, nil
+}
+
+func preUpdate(_ context.Context, orp *svcapitypes.OriginRequestPolicy, uorpi *svcsdk.UpdateOriginRequestPolicyInput) error {
+	uorpi.Id = pointer.ToOrNilIfZeroValue(meta.GetExternalName(orp))
+	uorpi.SetIfMatch(pointer.StringValue(orp.Status.AtProvider.ETag))
+	setHeadersQuantity(orp.Spec.ForProvider.OriginRequestPolicyConfig, uorpi.OriginRequestPolicyConfig)
+	return nil
+}
+
+// setHeadersQuantity sets the ignored Headers.Quantity field which is
+// required by the API.
+func setHeadersQuantity(in *svcapitypes.OriginRequestPolicyConfig, out *svcsdk.OriginRequestPolicyConfig) {
+	if in.HeadersConfig != nil && in.HeadersConfig.Headers != nil {
+		out.HeadersConfig.Headers.Quantity = pointer.ToIntAsInt64(len(in.HeadersConfig.Headers.Items))
+	}
+}
+
+func preDelete(_ context.Context, orp *svcapitypes.OriginRequestPolicy, dorpi *svcsdk.DeleteOriginRequestPolicyInput) (bool, error) {
+	dorpi.Id = pointer.ToOrNilIfZeroValue(meta.GetExternalName(orp))
+	dorpi.SetIfMatch(pointer.StringValue(orp.Status.AtProvider.ETag))
+	return false, nil
+}
+
+var mappingOptions = []cloudfront.LateInitOption{cloudfront.Replacer("ID", "Id")}
+
+func lateInitialize(in *svcapitypes.OriginRequestPolicyParameters, gorpo *svcsdk.GetOriginRequestPolicyOutput) error {
+	_, err := cloudfront.LateInitializeFromResponse("",
+		in.OriginRequestPolicyConfig, gorpo.OriginRequestPolicy.OriginRequestPolicyConfig, mappingOptions...)
+	return err
+}
+
+func isUpToDate(_ context.Context, orp *svcapitypes.OriginRequestPolicy, gorpo *svcsdk.GetOriginRequestPolicyOutput) (bool, string, error) {
+	return cloudfront.IsUpToDate(gorpo.OriginRequestPolicy.OriginRequestPolicyConfig, orp.Spec.ForProvider.OriginRequestPolicyConfig,
+		mappingOptions...)
+}
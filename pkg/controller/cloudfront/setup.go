@@ -23,7 +23,12 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/cachepolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/cloudfrontoriginaccessidentity"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/distribution"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/function"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/invalidation"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/keygroup"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/originaccesscontrol"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/originrequestpolicy"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/publickey"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudfront/responseheaderspolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
@@ -37,5 +42,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		distribution.SetupDistribution,
 		responseheaderspolicy.SetupResponseHeadersPolicy,
 		originaccesscontrol.SetupOriginAccessControl,
+		function.SetupFunction,
+		invalidation.SetupInvalidation,
+		keygroup.SetupKeyGroup,
+		originrequestpolicy.SetupOriginRequestPolicy,
+		publickey.SetupPublicKey,
 	)
 }
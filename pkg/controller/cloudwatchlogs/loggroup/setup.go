@@ -15,6 +15,8 @@ package loggroup
 
 import (
 	"context"
+	"encoding/json"
+	"reflect"
 	"strings"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
@@ -42,6 +44,10 @@ const (
 	errListTags      = "cannot list tags"
 	errTagResource   = "cannot tag resource"
 	errUntagResource = "cannot untag resource"
+
+	errGetDataProtectionPolicy    = "cannot get data protection policy"
+	errPutDataProtectionPolicy    = "cannot put data protection policy"
+	errDeleteDataProtectionPolicy = "cannot delete data protection policy"
 )
 
 // SetupLogGroup adds a controller that reconciles LogGroup.
@@ -51,9 +57,9 @@ func SetupLogGroup(mgr ctrl.Manager, o controller.Options) error {
 		func(e *external) {
 			e.postObserve = postObserve
 			e.preCreate = preCreate
-			e.postCreate = postCreate
 			e.filterList = filterList
 			u := &updater{client: e.client}
+			e.postCreate = u.postCreate
 			e.isUpToDate = u.isUpToDate
 			e.update = u.update
 			e.preObserve = preObserve
@@ -134,7 +140,21 @@ func postCreate(ctx context.Context, cr *svcapitypes.LogGroup, obj *svcsdk.Creat
 	return managed.ExternalCreation{}, nil
 }
 
-func (u *updater) isUpToDate(_ context.Context, cr *svcapitypes.LogGroup, obj *svcsdk.DescribeLogGroupsOutput) (bool, string, error) {
+func (u *updater) postCreate(ctx context.Context, cr *svcapitypes.LogGroup, obj *svcsdk.CreateLogGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	cre, err = postCreate(ctx, cr, obj, cre, err)
+	if err != nil || cr.Spec.ForProvider.DataProtectionPolicy == nil {
+		return cre, err
+	}
+	if _, err := u.client.PutDataProtectionPolicyWithContext(ctx, &svcsdk.PutDataProtectionPolicyInput{
+		LogGroupIdentifier: cr.Spec.ForProvider.LogGroupName,
+		PolicyDocument:     cr.Spec.ForProvider.DataProtectionPolicy,
+	}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPutDataProtectionPolicy)
+	}
+	return cre, nil
+}
+
+func (u *updater) isUpToDate(ctx context.Context, cr *svcapitypes.LogGroup, obj *svcsdk.DescribeLogGroupsOutput) (bool, string, error) {
 	if pointer.Int64Value(cr.Spec.ForProvider.RetentionInDays) != pointer.Int64Value(obj.LogGroups[0].RetentionInDays) {
 		return false, "", nil
 	}
@@ -151,8 +171,51 @@ func (u *updater) isUpToDate(_ context.Context, cr *svcapitypes.LogGroup, obj *s
 		return false, "", errors.Wrap(err, errListTags)
 	}
 	add, remove := tagutils.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(add) != 0 || len(remove) != 0 {
+		return false, "", nil
+	}
+
+	if !isDataProtectionPolicyManaged(cr.Spec.ForProvider) {
+		return true, "", nil
+	}
+	current, err := u.getDataProtectionPolicy(ctx, cr)
+	if err != nil {
+		return false, "", err
+	}
+	return isDataProtectionPolicyUpToDate(cr.Spec.ForProvider.DataProtectionPolicy, current), "", nil
+}
+
+// isDataProtectionPolicyManaged returns true if the data protection policy of
+// the log group is either set or requested to be deleted. Otherwise policies
+// that are managed outside of Crossplane are kept.
+func isDataProtectionPolicyManaged(p svcapitypes.LogGroupParameters) bool {
+	return p.DataProtectionPolicy != nil || pointer.BoolValue(p.DeleteDataProtectionPolicy)
+}
+
+func (u *updater) getDataProtectionPolicy(ctx context.Context, cr *svcapitypes.LogGroup) (*string, error) {
+	resp, err := u.client.GetDataProtectionPolicyWithContext(ctx, &svcsdk.GetDataProtectionPolicyInput{
+		LogGroupIdentifier: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetDataProtectionPolicy)
+	}
+	return resp.PolicyDocument, nil
+}
 
-	return len(add) == 0 && len(remove) == 0, "", nil
+// isDataProtectionPolicyUpToDate compares the desired and the current data
+// protection policy documents semantically, ignoring formatting differences.
+func isDataProtectionPolicyUpToDate(desired, current *string) bool {
+	if pointer.StringValue(desired) == "" || pointer.StringValue(current) == "" {
+		return pointer.StringValue(desired) == pointer.StringValue(current)
+	}
+	var d, c any
+	if err := json.Unmarshal([]byte(*desired), &d); err != nil {
+		return *desired == *current
+	}
+	if err := json.Unmarshal([]byte(*current), &c); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(d, c)
 }
 
 func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { //nolint:gocyclo
@@ -233,6 +296,30 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		}
 	}
 
+	if !isDataProtectionPolicyManaged(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	current, err := u.getDataProtectionPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !isDataProtectionPolicyUpToDate(cr.Spec.ForProvider.DataProtectionPolicy, current) {
+		if pointer.StringValue(cr.Spec.ForProvider.DataProtectionPolicy) == "" {
+			if _, err := u.client.DeleteDataProtectionPolicyWithContext(ctx, &svcsdk.DeleteDataProtectionPolicyInput{
+				LogGroupIdentifier: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+			}); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteDataProtectionPolicy)
+			}
+		} else {
+			if _, err := u.client.PutDataProtectionPolicyWithContext(ctx, &svcsdk.PutDataProtectionPolicyInput{
+				LogGroupIdentifier: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+				PolicyDocument:     cr.Spec.ForProvider.DataProtectionPolicy,
+			}); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errPutDataProtectionPolicy)
+			}
+		}
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -242,13 +329,14 @@ func generateObservation(obj *svcsdk.DescribeLogGroupsOutput) svcapitypes.LogGro
 	}
 
 	o := svcapitypes.LogGroupObservation{
-		ARN:               obj.LogGroups[0].Arn,
-		CreationTime:      obj.LogGroups[0].CreationTime,
-		KMSKeyID:          obj.LogGroups[0].KmsKeyId,
-		LogGroupName:      obj.LogGroups[0].LogGroupName,
-		MetricFilterCount: obj.LogGroups[0].MetricFilterCount,
-		RetentionInDays:   obj.LogGroups[0].RetentionInDays,
-		StoredBytes:       obj.LogGroups[0].StoredBytes,
+		ARN:                  obj.LogGroups[0].Arn,
+		CreationTime:         obj.LogGroups[0].CreationTime,
+		DataProtectionStatus: obj.LogGroups[0].DataProtectionStatus,
+		KMSKeyID:             obj.LogGroups[0].KmsKeyId,
+		LogGroupName:         obj.LogGroups[0].LogGroupName,
+		MetricFilterCount:    obj.LogGroups[0].MetricFilterCount,
+		RetentionInDays:      obj.LogGroups[0].RetentionInDays,
+		StoredBytes:          obj.LogGroups[0].StoredBytes,
 	}
 
 	return o
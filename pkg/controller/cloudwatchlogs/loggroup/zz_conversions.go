@@ -47,6 +47,11 @@ func GenerateLogGroup(resp *svcsdk.DescribeLogGroupsOutput) *svcapitypes.LogGrou
 		} else {
 			cr.Status.AtProvider.CreationTime = nil
 		}
+		if elem.DataProtectionStatus != nil {
+			cr.Status.AtProvider.DataProtectionStatus = elem.DataProtectionStatus
+		} else {
+			cr.Status.AtProvider.DataProtectionStatus = nil
+		}
 		if elem.KmsKeyId != nil {
 			cr.Status.AtProvider.KMSKeyID = elem.KmsKeyId
 		} else {
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logstream
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// SetupLogStream adds a controller that reconciles LogStream.
+func SetupLogStream(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.LogStreamGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.filterList = filterList
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+		},
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.LogStream{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.LogStreamGroupVersionKind),
+			reconcilerOpts...))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.LogStream, obj *svcsdk.DescribeLogStreamsInput) error {
+	obj.LogGroupName = cr.Spec.ForProvider.LogGroupName
+	obj.LogStreamNamePrefix = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func filterList(cr *svcapitypes.LogStream, obj *svcsdk.DescribeLogStreamsOutput) *svcsdk.DescribeLogStreamsOutput {
+	resp := &svcsdk.DescribeLogStreamsOutput{}
+	for _, logStream := range obj.LogStreams {
+		if pointer.StringValue(logStream.LogStreamName) == meta.GetExternalName(cr) {
+			resp.LogStreams = append(resp.LogStreams, logStream)
+			break
+		}
+	}
+	return resp
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.LogStream, _ *svcsdk.DescribeLogStreamsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.LogStream, obj *svcsdk.CreateLogStreamInput) error {
+	obj.LogGroupName = cr.Spec.ForProvider.LogGroupName
+	obj.LogStreamName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.LogStream, obj *svcsdk.DeleteLogStreamInput) (bool, error) {
+	obj.LogGroupName = cr.Spec.ForProvider.LogGroupName
+	obj.LogStreamName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return false, nil
+}
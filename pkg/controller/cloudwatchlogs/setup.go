@@ -21,7 +21,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudwatchlogs/loggroup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudwatchlogs/logstream"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudwatchlogs/metricfilter"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudwatchlogs/resourcepolicy"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/cloudwatchlogs/subscriptionfilter"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -30,6 +33,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
 		loggroup.SetupLogGroup,
+		logstream.SetupLogStream,
+		metricfilter.SetupMetricFilter,
 		resourcepolicy.SetupResourcePolicy,
+		subscriptionfilter.SetupSubscriptionFilter,
 	)
 }
@@ -0,0 +1,154 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriptionfilter
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// SetupSubscriptionFilter adds a controller that reconciles SubscriptionFilter.
+func SetupSubscriptionFilter(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.SubscriptionFilterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	opts := []option{
+		func(e *external) {
+			c := &custom{client: e.client}
+			e.preObserve = preObserve
+			e.filterList = filterList
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+			e.isUpToDate = isUpToDate
+			e.update = c.update
+		},
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.SubscriptionFilter{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.SubscriptionFilterGroupVersionKind),
+			reconcilerOpts...))
+}
+
+type custom struct {
+	client svcsdkapi.CloudWatchLogsAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.SubscriptionFilter, obj *svcsdk.DescribeSubscriptionFiltersInput) error {
+	obj.LogGroupName = cr.Spec.ForProvider.LogGroupName
+	obj.FilterNamePrefix = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func filterList(cr *svcapitypes.SubscriptionFilter, obj *svcsdk.DescribeSubscriptionFiltersOutput) *svcsdk.DescribeSubscriptionFiltersOutput {
+	resp := &svcsdk.DescribeSubscriptionFiltersOutput{}
+	for _, filter := range obj.SubscriptionFilters {
+		if pointer.StringValue(filter.FilterName) == meta.GetExternalName(cr) {
+			resp.SubscriptionFilters = append(resp.SubscriptionFilters, filter)
+			break
+		}
+	}
+	return resp
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.SubscriptionFilter, _ *svcsdk.DescribeSubscriptionFiltersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.SubscriptionFilter, obj *svcsdk.PutSubscriptionFilterInput) error {
+	obj.LogGroupName = cr.Spec.ForProvider.LogGroupName
+	obj.FilterName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.DestinationArn = cr.Spec.ForProvider.DestinationARN
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.SubscriptionFilter, obj *svcsdk.DeleteSubscriptionFilterInput) (bool, error) {
+	obj.LogGroupName = cr.Spec.ForProvider.LogGroupName
+	obj.FilterName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func isUpToDate(_ context.Context, cr *svcapitypes.SubscriptionFilter, obj *svcsdk.DescribeSubscriptionFiltersOutput) (bool, string, error) {
+	filter := obj.SubscriptionFilters[0]
+	switch {
+	case pointer.StringValue(cr.Spec.ForProvider.FilterPattern) != pointer.StringValue(filter.FilterPattern),
+		pointer.StringValue(cr.Spec.ForProvider.DestinationARN) != pointer.StringValue(filter.DestinationArn):
+		return false, "", nil
+	// AWS defaults the distribution to ByLogStream and the role is only
+	// required for some destinations, so we only compare them when set.
+	case cr.Spec.ForProvider.Distribution != nil && pointer.StringValue(cr.Spec.ForProvider.Distribution) != pointer.StringValue(filter.Distribution),
+		cr.Spec.ForProvider.RoleARN != nil && pointer.StringValue(cr.Spec.ForProvider.RoleARN) != pointer.StringValue(filter.RoleArn):
+		return false, "", nil
+	}
+	return true, "", nil
+}
+
+func (e *custom) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.SubscriptionFilter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	input := GeneratePutSubscriptionFilterInput(cr)
+	if err := preCreate(ctx, cr, input); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err := e.client.PutSubscriptionFilterWithContext(ctx, input)
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriptionfilter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/cloudwatchlogs/v1alpha1"
+)
+
+const (
+	testPattern        = "ERROR"
+	testOtherPattern   = "WARN"
+	testDestination    = "arn:aws:lambda:us-east-1:123456789012:function:sample"
+	testRole           = "arn:aws:iam::123456789012:role/sample"
+	testByLogStream    = "ByLogStream"
+	testRandomDelivery = "Random"
+)
+
+type args struct {
+	cr  *svcapitypes.SubscriptionFilter
+	obj *svcsdk.DescribeSubscriptionFiltersOutput
+}
+
+type filterModifier func(*svcapitypes.SubscriptionFilter)
+
+func withSpec(p svcapitypes.SubscriptionFilterParameters) filterModifier {
+	return func(r *svcapitypes.SubscriptionFilter) { r.Spec.ForProvider = p }
+}
+
+func filter(m ...filterModifier) *svcapitypes.SubscriptionFilter {
+	cr := &svcapitypes.SubscriptionFilter{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		result bool
+		err    error
+	}
+
+	observed := &svcsdk.DescribeSubscriptionFiltersOutput{
+		SubscriptionFilters: []*svcsdk.SubscriptionFilter{{
+			FilterPattern:  aws.String(testPattern),
+			DestinationArn: aws.String(testDestination),
+			Distribution:   aws.String(testByLogStream),
+			RoleArn:        aws.String(testRole),
+		}},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cr: filter(withSpec(svcapitypes.SubscriptionFilterParameters{
+					FilterPattern: aws.String(testPattern),
+					CustomSubscriptionFilterParameters: svcapitypes.CustomSubscriptionFilterParameters{
+						DestinationARN: aws.String(testDestination),
+						RoleARN:        aws.String(testRole),
+					},
+				})),
+				obj: observed,
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"DefaultedDistributionIsIgnored": {
+			args: args{
+				cr: filter(withSpec(svcapitypes.SubscriptionFilterParameters{
+					FilterPattern: aws.String(testPattern),
+					CustomSubscriptionFilterParameters: svcapitypes.CustomSubscriptionFilterParameters{
+						DestinationARN: aws.String(testDestination),
+					},
+				})),
+				obj: observed,
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"DifferentPattern": {
+			args: args{
+				cr: filter(withSpec(svcapitypes.SubscriptionFilterParameters{
+					FilterPattern: aws.String(testOtherPattern),
+					CustomSubscriptionFilterParameters: svcapitypes.CustomSubscriptionFilterParameters{
+						DestinationARN: aws.String(testDestination),
+					},
+				})),
+				obj: observed,
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"DifferentDistribution": {
+			args: args{
+				cr: filter(withSpec(svcapitypes.SubscriptionFilterParameters{
+					FilterPattern: aws.String(testPattern),
+					Distribution:  aws.String(testRandomDelivery),
+					CustomSubscriptionFilterParameters: svcapitypes.CustomSubscriptionFilterParameters{
+						DestinationARN: aws.String(testDestination),
+					},
+				})),
+				obj: observed,
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"DifferentDestination": {
+			args: args{
+				cr: filter(withSpec(svcapitypes.SubscriptionFilterParameters{
+					FilterPattern: aws.String(testPattern),
+					CustomSubscriptionFilterParameters: svcapitypes.CustomSubscriptionFilterParameters{
+						DestinationARN: aws.String(testRole),
+					},
+				})),
+				obj: observed,
+			},
+			want: want{
+				result: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _, err := isUpToDate(context.Background(), tc.args.cr, tc.args.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("isUpToDate(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"sort"
 	"strings"
+	"time"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
@@ -50,7 +51,16 @@ import (
 )
 
 const (
-	errResolveKMSMasterKeyArn = "cannot resolve kms master key ARN"
+	errResolveKMSMasterKeyArn      = "cannot resolve kms master key ARN"
+	errImportTable                 = "cannot import Table from S3"
+	errListImports                 = "cannot list imports of Table"
+	errDescribeTimeToLive          = "cannot describe time to live of Table"
+	errUpdateTimeToLive            = "cannot update time to live of Table"
+	errDescribeKinesisDestinations = "cannot describe Kinesis streaming destinations of Table"
+	errEnableKinesisDestination    = "cannot enable Kinesis streaming destination of Table"
+	errDisableKinesisDestination   = "cannot disable Kinesis streaming destination of Table"
+	errDescribeContributorInsights = "cannot describe contributor insights of Table"
+	errUpdateContributorInsights   = "cannot update contributor insights of Table"
 )
 
 // SetupTable adds a controller that reconciles Table.
@@ -123,10 +133,69 @@ func (c *customConnector) Connect(ctx context.Context, mg cpresource.Managed) (m
 			e.postUpdate = u.postUpdate
 		},
 	}
-	return newExternal(c.kube, svcsdk.New(sess), opts), nil
+	return &importingExternal{external: newExternal(c.kube, svcsdk.New(sess), opts)}, nil
 }
 
-func (e *updateClient) postUpdate(_ context.Context, cr *svcapitypes.Table, obj *svcsdk.UpdateTableOutput, _ managed.ExternalUpdate, _ error) (managed.ExternalUpdate, error) {
+// importingExternal creates the table through ImportTable instead of
+// CreateTable if spec.forProvider.importFrom is set. Everything else is
+// handled by the generated external client.
+type importingExternal struct {
+	*external
+}
+
+func (e *importingExternal) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.ImportFrom == nil {
+		return e.external.Create(ctx, mg)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	input := GenerateCreateTableInput(cr)
+	if err := e.preCreate(ctx, cr, input); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "pre-create failed")
+	}
+	importInput := generateImportTableInput(cr.Spec.ForProvider.ImportFrom, input)
+	// Retried creations must not start another import of the same data.
+	importInput.ClientToken = pointer.ToOrNilIfZeroValue(string(cr.GetUID()))
+	_, err := e.client.ImportTableWithContext(ctx, importInput)
+	return managed.ExternalCreation{}, errorutils.Wrap(err, errImportTable)
+}
+
+// generateImportTableInput returns the ImportTableInput for the given source
+// and the parameters of the table that would otherwise have been created.
+func generateImportTableInput(src *svcapitypes.TableImportSource, in *svcsdk.CreateTableInput) *svcsdk.ImportTableInput {
+	res := &svcsdk.ImportTableInput{
+		InputFormat:          pointer.ToOrNilIfZeroValue(src.InputFormat),
+		InputCompressionType: src.InputCompressionType,
+		S3BucketSource: &svcsdk.S3BucketSource{
+			S3Bucket:      src.S3Bucket,
+			S3BucketOwner: src.S3BucketOwner,
+			S3KeyPrefix:   src.S3KeyPrefix,
+		},
+		TableCreationParameters: &svcsdk.TableCreationParameters{
+			TableName:              in.TableName,
+			AttributeDefinitions:   in.AttributeDefinitions,
+			KeySchema:              in.KeySchema,
+			BillingMode:            in.BillingMode,
+			ProvisionedThroughput:  in.ProvisionedThroughput,
+			GlobalSecondaryIndexes: in.GlobalSecondaryIndexes,
+			SSESpecification:       in.SSESpecification,
+		},
+	}
+	if src.CSV != nil {
+		res.InputFormatOptions = &svcsdk.InputFormatOptions{
+			Csv: &svcsdk.CsvOptions{
+				Delimiter:  src.CSV.Delimiter,
+				HeaderList: src.CSV.HeaderList,
+			},
+		}
+	}
+	return res
+}
+
+func (e *updateClient) postUpdate(ctx context.Context, cr *svcapitypes.Table, obj *svcsdk.UpdateTableOutput, _ managed.ExternalUpdate, _ error) (managed.ExternalUpdate, error) {
 	cbresult, err := e.client.DescribeContinuousBackups(&svcsdk.DescribeContinuousBackupsInput{
 		TableName: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
 	})
@@ -152,6 +221,20 @@ func (e *updateClient) postUpdate(_ context.Context, cr *svcapitypes.Table, obj
 		}
 	}
 
+	extras, err := e.observeExtras(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateTimeToLive(ctx, cr, extras.timeToLive); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateKinesisStreamingDestinations(ctx, cr, extras.kinesisDestinations); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateContributorInsights(ctx, cr, extras.contributorInsightsStatus); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -242,6 +325,9 @@ func lateInitialize(in *svcapitypes.TableParameters, t *svcsdk.DescribeTableOutp
 			in.BillingMode = t.Table.BillingModeSummary.BillingMode
 		}
 	}
+	// DescribeTableOutput omits DeletionProtectionEnabled unless it is
+	// enabled.
+	in.DeletionProtectionEnabled = pointer.LateInitialize(in.DeletionProtectionEnabled, ptr.To(ptr.Deref(t.Table.DeletionProtectionEnabled, false)))
 	if in.ProvisionedThroughput == nil && t.Table.ProvisionedThroughput != nil {
 		in.ProvisionedThroughput = &svcapitypes.ProvisionedThroughput{
 			ReadCapacityUnits:  t.Table.ProvisionedThroughput.ReadCapacityUnits,
@@ -419,6 +505,8 @@ func (e *updateClient) isCoreResourceUpToDate(ctx context.Context, cr *svcapityp
 		return false, nil
 	case patch.SSESpecification != nil:
 		return false, nil
+	case patch.DeletionProtectionEnabled != nil:
+		return false, nil
 	case len(diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), resp.Table.GlobalSecondaryIndexes)) != 0:
 		return false, nil
 	}
@@ -433,7 +521,13 @@ func isPitrUpToDate(cr *svcapitypes.Table, pitrStatusBool bool) bool {
 		(cr.Spec.ForProvider.PointInTimeRecoveryEnabled == nil && pitrStatusBool))
 }
 
-func (e *updateClient) isUpToDate(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool, string, error) {
+func (e *updateClient) isUpToDate(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool, string, error) { //nolint:gocyclo
+	if cr.Spec.ForProvider.ImportFrom != nil {
+		if err := e.observeImport(ctx, cr, resp); err != nil {
+			return false, "", err
+		}
+	}
+
 	// A table that's currently creating, deleting, or updating can't be
 	// updated, so we temporarily consider it to be up-to-date no matter
 	// what.
@@ -470,9 +564,227 @@ func (e *updateClient) isUpToDate(ctx context.Context, cr *svcapitypes.Table, re
 		return false, "", nil
 	}
 
+	extras, err := e.observeExtras(ctx, cr)
+	if err != nil {
+		return false, "", err
+	}
+	if !isTimeToLiveUpToDate(cr.Spec.ForProvider.TimeToLive, extras.timeToLive) {
+		return false, "spec.forProvider.timeToLive: time to live differs", nil
+	}
+	if enable, disable := diffKinesisStreamingDestinations(cr.Spec.ForProvider.KinesisStreamingDestinations, extras.kinesisDestinations); len(enable) != 0 || len(disable) != 0 {
+		return false, "spec.forProvider.kinesisStreamingDestinations: destinations differ", nil
+	}
+	if !isContributorInsightsUpToDate(cr.Spec.ForProvider.ContributorInsightsEnabled, extras.contributorInsightsStatus) {
+		return false, "spec.forProvider.contributorInsightsEnabled: contributor insights status differs", nil
+	}
+
 	return true, "", nil
 }
 
+// observeImport records the most recent import of the table in its status.
+func (e *updateClient) observeImport(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) error {
+	out, err := e.client.ListImportsWithContext(ctx, &svcsdk.ListImportsInput{TableArn: resp.Table.TableArn})
+	if err != nil {
+		return errorutils.Wrap(err, errListImports)
+	}
+	var latest *svcsdk.ImportSummary
+	for _, i := range out.ImportSummaryList {
+		if latest == nil || ptr.Deref(i.StartTime, time.Time{}).After(ptr.Deref(latest.StartTime, time.Time{})) {
+			latest = i
+		}
+	}
+	if latest != nil {
+		cr.Status.AtProvider.ImportARN = latest.ImportArn
+		cr.Status.AtProvider.ImportStatus = latest.ImportStatus
+	}
+	return nil
+}
+
+// tableExtras holds the state of the table settings that are managed through
+// separate APIs.
+type tableExtras struct {
+	timeToLive                *svcsdk.TimeToLiveDescription
+	kinesisDestinations       []*svcsdk.KinesisDataStreamDestination
+	contributorInsightsStatus *string
+}
+
+// observeExtras reads the table settings that are not part of
+// DescribeTableOutput and records them in the status of the table. Each
+// setting is only read if it is managed, so that tables which do not use it
+// do not require the permission to describe it.
+func (e *updateClient) observeExtras(ctx context.Context, cr *svcapitypes.Table) (*tableExtras, error) {
+	name := pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	extras := &tableExtras{}
+	if cr.Spec.ForProvider.TimeToLive != nil {
+		ttl, err := e.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: name})
+		if err != nil {
+			return nil, errorutils.Wrap(err, errDescribeTimeToLive)
+		}
+		extras.timeToLive = ttl.TimeToLiveDescription
+		if extras.timeToLive != nil {
+			cr.Status.AtProvider.TimeToLiveStatus = extras.timeToLive.TimeToLiveStatus
+		}
+	}
+	if cr.Spec.ForProvider.KinesisStreamingDestinations != nil {
+		kinesis, err := e.client.DescribeKinesisStreamingDestinationWithContext(ctx, &svcsdk.DescribeKinesisStreamingDestinationInput{TableName: name})
+		if err != nil {
+			return nil, errorutils.Wrap(err, errDescribeKinesisDestinations)
+		}
+		extras.kinesisDestinations = kinesis.KinesisDataStreamDestinations
+		cr.Status.AtProvider.KinesisDataStreamDestinations = nil
+		for _, d := range extras.kinesisDestinations {
+			cr.Status.AtProvider.KinesisDataStreamDestinations = append(cr.Status.AtProvider.KinesisDataStreamDestinations, &svcapitypes.KinesisDataStreamDestination{
+				DestinationStatus:            d.DestinationStatus,
+				DestinationStatusDescription: d.DestinationStatusDescription,
+				StreamARN:                    d.StreamArn,
+			})
+		}
+	}
+	if cr.Spec.ForProvider.ContributorInsightsEnabled != nil {
+		insights, err := e.client.DescribeContributorInsightsWithContext(ctx, &svcsdk.DescribeContributorInsightsInput{TableName: name})
+		if err != nil {
+			return nil, errorutils.Wrap(err, errDescribeContributorInsights)
+		}
+		extras.contributorInsightsStatus = insights.ContributorInsightsStatus
+		cr.Status.AtProvider.ContributorInsightsStatus = extras.contributorInsightsStatus
+	}
+	return extras, nil
+}
+
+// isTimeToLiveUpToDate reports whether the time to live of the table matches
+// the desired one. An unset time to live is not managed, and a time to live
+// that is currently being enabled or disabled cannot be changed.
+func isTimeToLiveUpToDate(spec *svcapitypes.TimeToLive, current *svcsdk.TimeToLiveDescription) bool {
+	if spec == nil {
+		return true
+	}
+	if current == nil {
+		current = &svcsdk.TimeToLiveDescription{}
+	}
+	switch ptr.Deref(current.TimeToLiveStatus, string(svcapitypes.TimeToLiveStatus_DISABLED)) {
+	case string(svcapitypes.TimeToLiveStatus_ENABLING), string(svcapitypes.TimeToLiveStatus_DISABLING):
+		return true
+	case string(svcapitypes.TimeToLiveStatus_ENABLED):
+		return ptr.Deref(spec.Enabled, true) && spec.AttributeName == ptr.Deref(current.AttributeName, "")
+	default:
+		return !ptr.Deref(spec.Enabled, true)
+	}
+}
+
+// updateTimeToLive enables or disables the time to live of the table. The
+// attribute of an enabled time to live cannot be changed directly, so it is
+// disabled first and enabled with the new attribute on a later reconcile.
+func (e *updateClient) updateTimeToLive(ctx context.Context, cr *svcapitypes.Table, current *svcsdk.TimeToLiveDescription) error {
+	spec := cr.Spec.ForProvider.TimeToLive
+	if isTimeToLiveUpToDate(spec, current) {
+		return nil
+	}
+	ttl := &svcsdk.TimeToLiveSpecification{
+		AttributeName: pointer.ToOrNilIfZeroValue(spec.AttributeName),
+		Enabled:       ptr.To(ptr.Deref(spec.Enabled, true)),
+	}
+	if current != nil && ptr.Deref(current.TimeToLiveStatus, "") == string(svcapitypes.TimeToLiveStatus_ENABLED) {
+		ttl = &svcsdk.TimeToLiveSpecification{
+			AttributeName: current.AttributeName,
+			Enabled:       ptr.To(false),
+		}
+	}
+	_, err := e.client.UpdateTimeToLiveWithContext(ctx, &svcsdk.UpdateTimeToLiveInput{
+		TableName:               pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		TimeToLiveSpecification: ttl,
+	})
+	return errorutils.Wrap(err, errUpdateTimeToLive)
+}
+
+// diffKinesisStreamingDestinations returns the stream ARNs that need to be
+// enabled and the ones that need to be disabled. Destinations that are not
+// desired are disabled, destinations that failed to be enabled are retried.
+func diffKinesisStreamingDestinations(spec []*svcapitypes.KinesisStreamingDestination, current []*svcsdk.KinesisDataStreamDestination) (enable, disable []string) {
+	desired := map[string]bool{}
+	for _, d := range spec {
+		desired[ptr.Deref(d.StreamARN, "")] = true
+	}
+	active := map[string]bool{}
+	for _, d := range current {
+		switch ptr.Deref(d.DestinationStatus, "") {
+		case string(svcapitypes.DestinationStatus_ACTIVE), string(svcapitypes.DestinationStatus_ENABLING):
+			active[ptr.Deref(d.StreamArn, "")] = true
+		case string(svcapitypes.DestinationStatus_DISABLING):
+			// Neither can be changed until the destination is disabled.
+			active[ptr.Deref(d.StreamArn, "")] = desired[ptr.Deref(d.StreamArn, "")]
+		}
+	}
+	for _, d := range spec {
+		if arn := ptr.Deref(d.StreamARN, ""); !active[arn] {
+			enable = append(enable, arn)
+		}
+	}
+	for _, d := range current {
+		arn := ptr.Deref(d.StreamArn, "")
+		if active[arn] && !desired[arn] && ptr.Deref(d.DestinationStatus, "") == string(svcapitypes.DestinationStatus_ACTIVE) {
+			disable = append(disable, arn)
+		}
+	}
+	return enable, disable
+}
+
+func (e *updateClient) updateKinesisStreamingDestinations(ctx context.Context, cr *svcapitypes.Table, current []*svcsdk.KinesisDataStreamDestination) error {
+	name := pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	enable, disable := diffKinesisStreamingDestinations(cr.Spec.ForProvider.KinesisStreamingDestinations, current)
+	for _, arn := range disable {
+		if _, err := e.client.DisableKinesisStreamingDestinationWithContext(ctx, &svcsdk.DisableKinesisStreamingDestinationInput{
+			TableName: name,
+			StreamArn: pointer.ToOrNilIfZeroValue(arn),
+		}); err != nil {
+			return errorutils.Wrap(err, errDisableKinesisDestination)
+		}
+	}
+	for _, arn := range enable {
+		if _, err := e.client.EnableKinesisStreamingDestinationWithContext(ctx, &svcsdk.EnableKinesisStreamingDestinationInput{
+			TableName: name,
+			StreamArn: pointer.ToOrNilIfZeroValue(arn),
+		}); err != nil {
+			return errorutils.Wrap(err, errEnableKinesisDestination)
+		}
+	}
+	return nil
+}
+
+// isContributorInsightsUpToDate reports whether contributor insights are
+// enabled or disabled as desired. Unset means not managed.
+func isContributorInsightsUpToDate(spec *bool, status *string) bool {
+	if spec == nil {
+		return true
+	}
+	switch ptr.Deref(status, string(svcapitypes.ContributorInsightsStatus_DISABLED)) {
+	case string(svcapitypes.ContributorInsightsStatus_ENABLING), string(svcapitypes.ContributorInsightsStatus_DISABLING):
+		return true
+	case string(svcapitypes.ContributorInsightsStatus_ENABLED):
+		return *spec
+	case string(svcapitypes.ContributorInsightsStatus_DISABLED):
+		return !*spec
+	default:
+		// Failed, retry enabling them.
+		return !*spec
+	}
+}
+
+func (e *updateClient) updateContributorInsights(ctx context.Context, cr *svcapitypes.Table, status *string) error {
+	spec := cr.Spec.ForProvider.ContributorInsightsEnabled
+	if isContributorInsightsUpToDate(spec, status) {
+		return nil
+	}
+	action := svcapitypes.ContributorInsightsAction_DISABLE
+	if *spec {
+		action = svcapitypes.ContributorInsightsAction_ENABLE
+	}
+	_, err := e.client.UpdateContributorInsightsWithContext(ctx, &svcsdk.UpdateContributorInsightsInput{
+		TableName:                 pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		ContributorInsightsAction: pointer.ToOrNilIfZeroValue(string(action)),
+	})
+	return errorutils.Wrap(err, errUpdateContributorInsights)
+}
+
 func pitrStatusToBool(pitrStatus *string) bool {
 	return ptr.Deref(pitrStatus, "") == string(svcapitypes.PointInTimeRecoveryStatus_ENABLED)
 }
@@ -537,6 +849,8 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		if p.SSESpecification.KMSMasterKeyID != nil {
 			filtered.SSESpecification.KMSMasterKeyId = u.SSESpecification.KMSMasterKeyId
 		}
+	case p.DeletionProtectionEnabled != nil:
+		filtered.DeletionProtectionEnabled = u.DeletionProtectionEnabled
 	case len(gsiUpdates) != 0:
 		filtered.SetGlobalSecondaryIndexUpdates(gsiUpdates)
 	}
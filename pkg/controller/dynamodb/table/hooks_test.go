@@ -21,7 +21,9 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	kmstypes "github.com/aws/aws-sdk-go/service/kms"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
@@ -434,8 +436,9 @@ func TestLateInitialize(t *testing.T) {
 			},
 			want: want{
 				p: &svcapitypes.TableParameters{
-					BillingMode:         aws.String(svcsdk.BillingModeProvisioned),
-					StreamSpecification: &svcapitypes.StreamSpecification{StreamEnabled: aws.Bool(false)},
+					BillingMode:               aws.String(svcsdk.BillingModeProvisioned),
+					DeletionProtectionEnabled: aws.Bool(false),
+					StreamSpecification:       &svcapitypes.StreamSpecification{StreamEnabled: aws.Bool(false)},
 				},
 			},
 		},
@@ -474,12 +477,14 @@ func TestLateInitialize(t *testing.T) {
 						BillingModeSummary: &svcsdk.BillingModeSummary{
 							BillingMode: aws.String(svcsdk.BillingModePayPerRequest),
 						},
+						DeletionProtectionEnabled: aws.Bool(true),
 					},
 				},
 			},
 			want: want{
 				p: &svcapitypes.TableParameters{
-					BillingMode: aws.String(svcsdk.BillingModePayPerRequest),
+					BillingMode:               aws.String(svcsdk.BillingModePayPerRequest),
+					DeletionProtectionEnabled: aws.Bool(true),
 					AttributeDefinitions: []*svcapitypes.AttributeDefinition{{
 						AttributeName: aws.String("N"),
 						AttributeType: aws.String("T"),
@@ -513,7 +518,8 @@ func TestLateInitialize(t *testing.T) {
 		"ExistingParams": {
 			args: args{
 				p: &svcapitypes.TableParameters{
-					BillingMode: aws.String(svcsdk.BillingModePayPerRequest),
+					BillingMode:               aws.String(svcsdk.BillingModePayPerRequest),
+					DeletionProtectionEnabled: aws.Bool(true),
 					AttributeDefinitions: []*svcapitypes.AttributeDefinition{{
 						AttributeName: aws.String("N"),
 						AttributeType: aws.String("T"),
@@ -579,7 +585,8 @@ func TestLateInitialize(t *testing.T) {
 			},
 			want: want{
 				p: &svcapitypes.TableParameters{
-					BillingMode: aws.String(svcsdk.BillingModePayPerRequest),
+					BillingMode:               aws.String(svcsdk.BillingModePayPerRequest),
+					DeletionProtectionEnabled: aws.Bool(true),
 					AttributeDefinitions: []*svcapitypes.AttributeDefinition{{
 						AttributeName: aws.String("N"),
 						AttributeType: aws.String("T"),
@@ -837,3 +844,320 @@ func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTimeToLiveUpToDate(t *testing.T) {
+	type args struct {
+		spec    *svcapitypes.TimeToLive
+		current *svcsdk.TimeToLiveDescription
+	}
+
+	type want struct {
+		result bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Unmanaged": {
+			args: args{
+				current: &svcsdk.TimeToLiveDescription{
+					AttributeName:    aws.String("expires"),
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"Enabled": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expires"},
+				current: &svcsdk.TimeToLiveDescription{
+					AttributeName:    aws.String("expires"),
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"DifferentAttribute": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expires"},
+				current: &svcsdk.TimeToLiveDescription{
+					AttributeName:    aws.String("ttl"),
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"NeedsEnabling": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expires"},
+				current: &svcsdk.TimeToLiveDescription{
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled),
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"NeedsDisabling": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expires", Enabled: aws.Bool(false)},
+				current: &svcsdk.TimeToLiveDescription{
+					AttributeName:    aws.String("expires"),
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"Enabling": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expires", Enabled: aws.Bool(false)},
+				current: &svcsdk.TimeToLiveDescription{
+					AttributeName:    aws.String("expires"),
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabling),
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTimeToLiveUpToDate(tc.args.spec, tc.args.current)
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("isTimeToLiveUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffKinesisStreamingDestinations(t *testing.T) {
+	type args struct {
+		spec    []*svcapitypes.KinesisStreamingDestination
+		current []*svcsdk.KinesisDataStreamDestination
+	}
+
+	type want struct {
+		enable  []string
+		disable []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				spec: []*svcapitypes.KinesisStreamingDestination{{StreamARN: aws.String("a")}},
+				current: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("a"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					{StreamArn: aws.String("b"), DestinationStatus: aws.String(svcsdk.DestinationStatusDisabled)},
+				},
+			},
+			want: want{},
+		},
+		"EnableNewAndFailed": {
+			args: args{
+				spec: []*svcapitypes.KinesisStreamingDestination{
+					{StreamARN: aws.String("a")},
+					{StreamARN: aws.String("b")},
+				},
+				current: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("b"), DestinationStatus: aws.String(svcsdk.DestinationStatusEnableFailed)},
+				},
+			},
+			want: want{
+				enable: []string{"a", "b"},
+			},
+		},
+		"DisableRemoved": {
+			args: args{
+				current: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("a"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					{StreamArn: aws.String("b"), DestinationStatus: aws.String(svcsdk.DestinationStatusEnabling)},
+				},
+			},
+			want: want{
+				disable: []string{"a"},
+			},
+		},
+		"WaitForDisabling": {
+			args: args{
+				spec: []*svcapitypes.KinesisStreamingDestination{{StreamARN: aws.String("a")}},
+				current: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("a"), DestinationStatus: aws.String(svcsdk.DestinationStatusDisabling)},
+				},
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enable, disable := diffKinesisStreamingDestinations(tc.args.spec, tc.args.current)
+			if diff := cmp.Diff(tc.want.enable, enable); diff != "" {
+				t.Errorf("enable: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disable, disable); diff != "" {
+				t.Errorf("disable: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsContributorInsightsUpToDate(t *testing.T) {
+	type args struct {
+		spec   *bool
+		status *string
+	}
+
+	type want struct {
+		result bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Unmanaged": {
+			args: args{
+				status: aws.String(svcsdk.ContributorInsightsStatusEnabled),
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"Enabled": {
+			args: args{
+				spec:   aws.Bool(true),
+				status: aws.String(svcsdk.ContributorInsightsStatusEnabled),
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"NeedsEnabling": {
+			args: args{
+				spec: aws.Bool(true),
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"NeedsDisabling": {
+			args: args{
+				spec:   aws.Bool(false),
+				status: aws.String(svcsdk.ContributorInsightsStatusEnabled),
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"Failed": {
+			args: args{
+				spec:   aws.Bool(true),
+				status: aws.String(svcsdk.ContributorInsightsStatusFailed),
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"Disabling": {
+			args: args{
+				spec:   aws.Bool(true),
+				status: aws.String(svcsdk.ContributorInsightsStatusDisabling),
+			},
+			want: want{
+				result: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isContributorInsightsUpToDate(tc.args.spec, tc.args.status)
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("isContributorInsightsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// extrasClient records which of the table settings are described.
+type extrasClient struct {
+	svcsdkapi.DynamoDBAPI
+	described []string
+}
+
+func (c *extrasClient) DescribeTimeToLiveWithContext(_ context.Context, _ *svcsdk.DescribeTimeToLiveInput, _ ...request.Option) (*svcsdk.DescribeTimeToLiveOutput, error) {
+	c.described = append(c.described, "TimeToLive")
+	return &svcsdk.DescribeTimeToLiveOutput{TimeToLiveDescription: &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)}}, nil
+}
+
+func (c *extrasClient) DescribeKinesisStreamingDestinationWithContext(_ context.Context, _ *svcsdk.DescribeKinesisStreamingDestinationInput, _ ...request.Option) (*svcsdk.DescribeKinesisStreamingDestinationOutput, error) {
+	c.described = append(c.described, "KinesisStreamingDestination")
+	return &svcsdk.DescribeKinesisStreamingDestinationOutput{}, nil
+}
+
+func (c *extrasClient) DescribeContributorInsightsWithContext(_ context.Context, _ *svcsdk.DescribeContributorInsightsInput, _ ...request.Option) (*svcsdk.DescribeContributorInsightsOutput, error) {
+	c.described = append(c.described, "ContributorInsights")
+	return &svcsdk.DescribeContributorInsightsOutput{ContributorInsightsStatus: aws.String(svcsdk.ContributorInsightsStatusEnabled)}, nil
+}
+
+func TestObserveExtras(t *testing.T) {
+	type want struct {
+		described []string
+		status    svcapitypes.TableObservation
+	}
+
+	cases := map[string]struct {
+		spec svcapitypes.TableParameters
+		want want
+	}{
+		"NothingManaged": {
+			want: want{},
+		},
+		"AllManaged": {
+			spec: svcapitypes.TableParameters{
+				CustomTableParameters: svcapitypes.CustomTableParameters{
+					TimeToLive:                   &svcapitypes.TimeToLive{},
+					KinesisStreamingDestinations: []*svcapitypes.KinesisStreamingDestination{},
+					ContributorInsightsEnabled:   aws.Bool(true),
+				},
+			},
+			want: want{
+				described: []string{"TimeToLive", "KinesisStreamingDestination", "ContributorInsights"},
+				status: svcapitypes.TableObservation{
+					TimeToLiveStatus:          aws.String(svcsdk.TimeToLiveStatusEnabled),
+					ContributorInsightsStatus: aws.String(svcsdk.ContributorInsightsStatusEnabled),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &extrasClient{}
+			cr := &svcapitypes.Table{Spec: svcapitypes.TableSpec{ForProvider: tc.spec}}
+			if _, err := (&updateClient{client: client}).observeExtras(context.Background(), cr); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.described, client.described); diff != "" {
+				t.Errorf("described: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amilookup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AMILookup resource"
+
+	errNoScope  = "at least one of owners or filters is required"
+	errDescribe = "cannot describe images"
+	errNotFound = "no image matches the lookup"
+
+	keyImageID = "imageId"
+)
+
+// SetupAMILookup adds a controller that reconciles AMILookups.
+func SetupAMILookup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.AMILookupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewAMILookupClient}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.AMILookupGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.AMILookup{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.AMILookupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.AMILookup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	custommanaged.ObserveOnlyClient
+
+	client ec2.AMILookupClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*manualv1alpha1.AMILookup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The lookup only reads images, so deleting it leaves every AMI untouched.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// An unscoped lookup would page through every public AMI on each poll.
+	if len(cr.Spec.ForProvider.Owners) == 0 && len(cr.Spec.ForProvider.Filters) == 0 {
+		return managed.ExternalObservation{}, errors.New(errNoScope)
+	}
+
+	input := ec2.GenerateDescribeImagesInput(cr.Spec.ForProvider)
+	var images []awsec2types.Image
+	for {
+		out, err := e.client.DescribeImages(ctx, input)
+		if err != nil {
+			return managed.ExternalObservation{}, errorutils.Wrap(err, errDescribe)
+		}
+		images = append(images, out.Images...)
+		if aws.ToString(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	img := ec2.LatestImage(images)
+	if img == nil {
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{}, errors.New(errNotFound)
+	}
+	cr.Status.AtProvider = ec2.GenerateAMILookupObservation(*img)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+		ConnectionDetails: managed.ConnectionDetails{
+			keyImageID: []byte(cr.Status.AtProvider.ImageID),
+		},
+	}, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amilookup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	imageID        = "ami-0123456789abcdef0"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ec2 *fake.MockAMILookupClient
+	cr  resource.Managed
+}
+
+type lookupModifier func(*manualv1alpha1.AMILookup)
+
+func withConditions(c ...xpv1.Condition) lookupModifier {
+	return func(r *manualv1alpha1.AMILookup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o manualv1alpha1.AMILookupObservation) lookupModifier {
+	return func(r *manualv1alpha1.AMILookup) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() lookupModifier {
+	return func(r *manualv1alpha1.AMILookup) {
+		t := metav1.Unix(1, 0)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withoutScope() lookupModifier {
+	return func(r *manualv1alpha1.AMILookup) {
+		r.Spec.ForProvider.Owners = nil
+		r.Spec.ForProvider.Filters = nil
+	}
+}
+
+func amiLookup(m ...lookupModifier) *manualv1alpha1.AMILookup {
+	cr := &manualv1alpha1.AMILookup{
+		Spec: manualv1alpha1.AMILookupSpec{
+			ForProvider: manualv1alpha1.AMILookupParameters{
+				Region:  "eu-central-1",
+				Owners:  []string{"amazon"},
+				Filters: []manualv1alpha1.Filter{{Name: "name", Values: []string{"al2023-ami-*"}}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: amiLookup(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: amiLookup(withDeletionTimestamp()),
+			},
+		},
+		"NoScope": {
+			args: args{
+				cr: amiLookup(withoutScope()),
+			},
+			want: want{
+				cr:  amiLookup(withoutScope()),
+				err: errors.New(errNoScope),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ec2: &fake.MockAMILookupClient{
+					MockDescribeImages: func(ctx context.Context, input *awsec2.DescribeImagesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: amiLookup(),
+			},
+			want: want{
+				cr:  amiLookup(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ec2: &fake.MockAMILookupClient{
+					MockDescribeImages: func(ctx context.Context, input *awsec2.DescribeImagesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+						return &awsec2.DescribeImagesOutput{}, nil
+					},
+				},
+				cr: amiLookup(),
+			},
+			want: want{
+				cr:  amiLookup(withConditions(xpv1.Unavailable())),
+				err: errors.New(errNotFound),
+			},
+		},
+		"LatestImage": {
+			args: args{
+				ec2: &fake.MockAMILookupClient{
+					MockDescribeImages: func(ctx context.Context, input *awsec2.DescribeImagesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+						if aws.ToString(input.NextToken) == "" {
+							return &awsec2.DescribeImagesOutput{
+								Images:    []awsec2types.Image{{ImageId: aws.String("ami-old"), CreationDate: aws.String("2024-01-01T00:00:00.000Z")}},
+								NextToken: aws.String("next"),
+							}, nil
+						}
+						return &awsec2.DescribeImagesOutput{
+							Images: []awsec2types.Image{{
+								ImageId:      aws.String(imageID),
+								Name:         aws.String("al2023-ami-2023.4"),
+								Architecture: awsec2types.ArchitectureValuesX8664,
+								CreationDate: aws.String("2024-05-01T00:00:00.000Z"),
+								OwnerId:      aws.String("137112412989"),
+							}},
+						}, nil
+					},
+				},
+				cr: amiLookup(),
+			},
+			want: want{
+				cr: amiLookup(withConditions(xpv1.Available()), withObservation(manualv1alpha1.AMILookupObservation{
+					ImageID:      imageID,
+					Name:         "al2023-ami-2023.4",
+					Architecture: "x86_64",
+					CreationDate: "2024-05-01T00:00:00.000Z",
+					OwnerID:      "137112412989",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{keyImageID: []byte(imageID)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ec2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
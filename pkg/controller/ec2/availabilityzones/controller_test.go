@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package availabilityzones
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ec2 *fake.MockAvailabilityZonesClient
+	cr  resource.Managed
+}
+
+type zonesModifier func(*manualv1alpha1.AvailabilityZones)
+
+func withConditions(c ...xpv1.Condition) zonesModifier {
+	return func(r *manualv1alpha1.AvailabilityZones) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o manualv1alpha1.AvailabilityZonesObservation) zonesModifier {
+	return func(r *manualv1alpha1.AvailabilityZones) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() zonesModifier {
+	return func(r *manualv1alpha1.AvailabilityZones) {
+		t := metav1.Unix(1, 0)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func availabilityZones(m ...zonesModifier) *manualv1alpha1.AvailabilityZones {
+	cr := &manualv1alpha1.AvailabilityZones{
+		Spec: manualv1alpha1.AvailabilityZonesSpec{
+			ForProvider: manualv1alpha1.AvailabilityZonesParameters{
+				Region: "eu-central-1",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: availabilityZones(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: availabilityZones(withDeletionTimestamp()),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ec2: &fake.MockAvailabilityZonesClient{
+					MockDescribeAvailabilityZones: func(ctx context.Context, input *awsec2.DescribeAvailabilityZonesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeAvailabilityZonesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: availabilityZones(),
+			},
+			want: want{
+				cr:  availabilityZones(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Zones": {
+			args: args{
+				ec2: &fake.MockAvailabilityZonesClient{
+					MockDescribeAvailabilityZones: func(ctx context.Context, input *awsec2.DescribeAvailabilityZonesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeAvailabilityZonesOutput, error) {
+						return &awsec2.DescribeAvailabilityZonesOutput{AvailabilityZones: []awsec2types.AvailabilityZone{
+							{ZoneName: aws.String("eu-central-1b"), ZoneId: aws.String("euc1-az3")},
+							{ZoneName: aws.String("eu-central-1a"), ZoneId: aws.String("euc1-az2")},
+						}}, nil
+					},
+				},
+				cr: availabilityZones(),
+			},
+			want: want{
+				cr: availabilityZones(withConditions(xpv1.Available()), withObservation(manualv1alpha1.AvailabilityZonesObservation{
+					Names:   []string{"eu-central-1a", "eu-central-1b"},
+					ZoneIDs: []string{"euc1-az2", "euc1-az3"},
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyNames:   []byte("eu-central-1a,eu-central-1b"),
+						keyZoneIDs: []byte("euc1-az2,euc1-az3"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ec2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/address"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/amilookup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/availabilityzones"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/flowlog"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/internetgateway"
@@ -41,6 +43,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpccidrblock"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcendpoint"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpclookup"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
@@ -50,6 +53,8 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
 		address.SetupAddress,
+		amilookup.SetupAMILookup,
+		availabilityzones.SetupAvailabilityZones,
 		flowlog.SetupFlowLog,
 		instance.SetupInstance,
 		internetgateway.SetupInternetGateway,
@@ -70,6 +75,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		vpccidrblock.SetupVPCCIDRBlock,
 		vpcendpoint.SetupVPCEndpoint,
 		vpcendpointserviceconfiguration.SetupVPCEndpointServiceConfiguration,
+		vpclookup.SetupVPCLookup,
 		vpcpeeringconnection.SetupVPCPeeringConnection,
 	)
 }
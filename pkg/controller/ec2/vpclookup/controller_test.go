@@ -0,0 +1,203 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpclookup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	vpcID          = "vpc-0123456789abcdef0"
+	cidrBlock      = "10.0.0.0/16"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ec2 *fake.MockVPCLookupClient
+	cr  resource.Managed
+}
+
+type lookupModifier func(*manualv1alpha1.VPCLookup)
+
+func withConditions(c ...xpv1.Condition) lookupModifier {
+	return func(r *manualv1alpha1.VPCLookup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o manualv1alpha1.VPCLookupObservation) lookupModifier {
+	return func(r *manualv1alpha1.VPCLookup) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() lookupModifier {
+	return func(r *manualv1alpha1.VPCLookup) {
+		t := metav1.Unix(1, 0)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func vpcLookup(m ...lookupModifier) *manualv1alpha1.VPCLookup {
+	cr := &manualv1alpha1.VPCLookup{
+		Spec: manualv1alpha1.VPCLookupSpec{
+			ForProvider: manualv1alpha1.VPCLookupParameters{
+				Region: "eu-central-1",
+				Tags:   []manualv1alpha1.Tag{{Key: "Name", Value: "shared"}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVpcs(vpcs ...awsec2types.Vpc) func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+		return &awsec2.DescribeVpcsOutput{Vpcs: vpcs}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: vpcLookup(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: vpcLookup(withDeletionTimestamp()),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ec2: &fake.MockVPCLookupClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpcLookup(),
+			},
+			want: want{
+				cr:  vpcLookup(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ec2: &fake.MockVPCLookupClient{
+					MockDescribeVpcs: describeVpcs(),
+				},
+				cr: vpcLookup(),
+			},
+			want: want{
+				cr:  vpcLookup(withConditions(xpv1.Unavailable())),
+				err: errors.New(errNotFound),
+			},
+		},
+		"MultipleVPCs": {
+			args: args{
+				ec2: &fake.MockVPCLookupClient{
+					MockDescribeVpcs: describeVpcs(awsec2types.Vpc{VpcId: aws.String(vpcID)}, awsec2types.Vpc{VpcId: aws.String("vpc-other")}),
+				},
+				cr: vpcLookup(),
+			},
+			want: want{
+				cr:  vpcLookup(withConditions(xpv1.Unavailable())),
+				err: errors.Errorf(errFmtMultiple, 2),
+			},
+		},
+		"VPC": {
+			args: args{
+				ec2: &fake.MockVPCLookupClient{
+					MockDescribeVpcs: describeVpcs(awsec2types.Vpc{
+						VpcId:     aws.String(vpcID),
+						CidrBlock: aws.String(cidrBlock),
+						OwnerId:   aws.String("123456789012"),
+						State:     awsec2types.VpcStateAvailable,
+					}),
+				},
+				cr: vpcLookup(),
+			},
+			want: want{
+				cr: vpcLookup(withConditions(xpv1.Available()), withObservation(manualv1alpha1.VPCLookupObservation{
+					VPCID:     vpcID,
+					CIDRBlock: cidrBlock,
+					OwnerID:   "123456789012",
+					State:     "available",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyVPCID:     []byte(vpcID),
+						keyCIDRBlock: []byte(cidrBlock),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ec2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityprovider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ecs/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/tags"
+)
+
+const (
+	errTagResource   = "cannot tag resource"
+	errUntagResource = "cannot untag resource"
+)
+
+// SetupCapacityProvider adds a controller that reconciles CapacityProvider.
+func SetupCapacityProvider(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.CapacityProviderGroupKind)
+	opts := []option{setupHooks}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.CapacityProviderGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.CapacityProvider{}).
+		Complete(r)
+}
+
+func setupHooks(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.lateInitialize = lateInitialize
+	e.isUpToDate = isUpToDate
+	e.preCreate = preCreate
+	e.preUpdate = preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.ECSAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.CapacityProvider, obj *svcsdk.DescribeCapacityProvidersInput) error {
+	obj.CapacityProviders = []*string{aws.String(meta.GetExternalName(cr))}
+	obj.Include = []*string{aws.String(svcsdk.CapacityProviderFieldTags)}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.CapacityProvider, resp *svcsdk.DescribeCapacityProvidersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return obs, err
+	}
+	// Unknown capacity providers are reported as failures instead of an
+	// error, and deleted ones can still be described with an INACTIVE status.
+	if len(resp.CapacityProviders) == 0 || aws.StringValue(resp.CapacityProviders[0].Status) == svcsdk.CapacityProviderStatusInactive {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cp := resp.CapacityProviders[0]
+	switch aws.StringValue(cp.UpdateStatus) {
+	case svcsdk.CapacityProviderUpdateStatusDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.CapacityProviderUpdateStatusDeleteFailed, svcsdk.CapacityProviderUpdateStatusUpdateFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.StringValue(cp.UpdateStatusReason)))
+	case svcsdk.CapacityProviderUpdateStatusUpdateInProgress:
+		// ECS rejects updates until the previous one is finished.
+		obs.ResourceUpToDate = true
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+	return obs, nil
+}
+
+func lateInitialize(spec *svcapitypes.CapacityProviderParameters, resp *svcsdk.DescribeCapacityProvidersOutput) error {
+	if len(resp.CapacityProviders) == 0 || resp.CapacityProviders[0].AutoScalingGroupProvider == nil {
+		return nil
+	}
+	in := &spec.AutoScalingGroupProvider
+	o := resp.CapacityProviders[0].AutoScalingGroupProvider
+
+	if in.ManagedTerminationProtection == nil {
+		in.ManagedTerminationProtection = o.ManagedTerminationProtection
+	}
+	if o.ManagedScaling == nil {
+		return nil
+	}
+	if in.ManagedScaling == nil {
+		in.ManagedScaling = &svcapitypes.ManagedScaling{}
+	}
+	ms := in.ManagedScaling
+	if ms.InstanceWarmupPeriod == nil {
+		ms.InstanceWarmupPeriod = o.ManagedScaling.InstanceWarmupPeriod
+	}
+	if ms.MaximumScalingStepSize == nil {
+		ms.MaximumScalingStepSize = o.ManagedScaling.MaximumScalingStepSize
+	}
+	if ms.MinimumScalingStepSize == nil {
+		ms.MinimumScalingStepSize = o.ManagedScaling.MinimumScalingStepSize
+	}
+	if ms.Status == nil {
+		ms.Status = o.ManagedScaling.Status
+	}
+	if ms.TargetCapacity == nil {
+		ms.TargetCapacity = o.ManagedScaling.TargetCapacity
+	}
+	return nil
+}
+
+func isUpToDate(_ context.Context, cr *svcapitypes.CapacityProvider, resp *svcsdk.DescribeCapacityProvidersOutput) (bool, string, error) {
+	if len(resp.CapacityProviders) == 0 {
+		return false, "", nil
+	}
+	cp := resp.CapacityProviders[0]
+
+	current := svcapitypes.CustomAutoScalingGroupProvider{}
+	if cp.AutoScalingGroupProvider != nil {
+		current.ManagedTerminationProtection = cp.AutoScalingGroupProvider.ManagedTerminationProtection
+		current.ManagedScaling = generateManagedScaling(cp.AutoScalingGroupProvider.ManagedScaling)
+	}
+	diff := cmp.Diff(current, cr.Spec.ForProvider.AutoScalingGroupProvider,
+		cmpopts.EquateEmpty(),
+		// The Auto Scaling group of a capacity provider cannot be changed.
+		cmpopts.IgnoreFields(svcapitypes.CustomAutoScalingGroupProvider{}, "AutoScalingGroupARN"),
+		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}))
+	if diff != "" {
+		return false, diff, nil
+	}
+
+	add, remove := tags.DiffTags(tagMap(cr.Spec.ForProvider.Tags), sdkTagMap(cp.Tags))
+	if len(add) > 0 || len(remove) > 0 {
+		return false, "tags are not up to date", nil
+	}
+	return true, "", nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.CapacityProvider, obj *svcsdk.CreateCapacityProviderInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider.AutoScalingGroupProvider
+	obj.AutoScalingGroupProvider = &svcsdk.AutoScalingGroupProvider{
+		AutoScalingGroupArn:          p.AutoScalingGroupARN,
+		ManagedScaling:               generateSDKManagedScaling(p.ManagedScaling),
+		ManagedTerminationProtection: p.ManagedTerminationProtection,
+	}
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.CapacityProvider, obj *svcsdk.UpdateCapacityProviderInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider.AutoScalingGroupProvider
+	obj.AutoScalingGroupProvider = &svcsdk.AutoScalingGroupProviderUpdate{
+		ManagedScaling:               generateSDKManagedScaling(p.ManagedScaling),
+		ManagedTerminationProtection: p.ManagedTerminationProtection,
+	}
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.CapacityProvider, resp *svcsdk.UpdateCapacityProviderOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil || resp.CapacityProvider == nil {
+		return upd, err
+	}
+
+	// UpdateCapacityProvider does not return the tags of the capacity
+	// provider, so they have to be described separately.
+	desc, err := h.client.DescribeCapacityProvidersWithContext(ctx, &svcsdk.DescribeCapacityProvidersInput{
+		CapacityProviders: []*string{resp.CapacityProvider.CapacityProviderArn},
+		Include:           []*string{aws.String(svcsdk.CapacityProviderFieldTags)},
+	})
+	if err != nil {
+		return upd, errors.Wrap(err, errDescribe)
+	}
+	var current []*svcsdk.Tag
+	if len(desc.CapacityProviders) > 0 {
+		current = desc.CapacityProviders[0].Tags
+	}
+
+	add, remove := tags.DiffTags(tagMap(cr.Spec.ForProvider.Tags), sdkTagMap(current))
+	if len(remove) > 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceArn: resp.CapacityProvider.CapacityProviderArn,
+			TagKeys:     aws.StringSlice(remove),
+		}); err != nil {
+			return upd, errors.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		t := make([]*svcsdk.Tag, 0, len(add))
+		for k, v := range add {
+			t = append(t, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceArn: resp.CapacityProvider.CapacityProviderArn,
+			Tags:        t,
+		}); err != nil {
+			return upd, errors.Wrap(err, errTagResource)
+		}
+	}
+	return upd, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.CapacityProvider, obj *svcsdk.DeleteCapacityProviderInput) (bool, error) {
+	obj.CapacityProvider = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func generateManagedScaling(in *svcsdk.ManagedScaling) *svcapitypes.ManagedScaling {
+	if in == nil {
+		return nil
+	}
+	return &svcapitypes.ManagedScaling{
+		InstanceWarmupPeriod:   in.InstanceWarmupPeriod,
+		MaximumScalingStepSize: in.MaximumScalingStepSize,
+		MinimumScalingStepSize: in.MinimumScalingStepSize,
+		Status:                 in.Status,
+		TargetCapacity:         in.TargetCapacity,
+	}
+}
+
+func generateSDKManagedScaling(in *svcapitypes.ManagedScaling) *svcsdk.ManagedScaling {
+	if in == nil {
+		return nil
+	}
+	return &svcsdk.ManagedScaling{
+		InstanceWarmupPeriod:   in.InstanceWarmupPeriod,
+		MaximumScalingStepSize: in.MaximumScalingStepSize,
+		MinimumScalingStepSize: in.MinimumScalingStepSize,
+		Status:                 in.Status,
+		TargetCapacity:         in.TargetCapacity,
+	}
+}
+
+func tagMap(in []*svcapitypes.Tag) map[string]string {
+	res := make(map[string]string, len(in))
+	for _, t := range in {
+		res[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return res
+}
+
+func sdkTagMap(in []*svcsdk.Tag) map[string]string {
+	res := make(map[string]string, len(in))
+	for _, t := range in {
+		res[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return res
+}
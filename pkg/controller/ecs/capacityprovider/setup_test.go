@@ -0,0 +1,259 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ecs/v1alpha1"
+)
+
+func capacityProvider(asg svcapitypes.CustomAutoScalingGroupProvider, tags ...*svcapitypes.Tag) *svcapitypes.CapacityProvider {
+	cr := &svcapitypes.CapacityProvider{}
+	cr.Spec.ForProvider.AutoScalingGroupProvider = asg
+	cr.Spec.ForProvider.Tags = tags
+	return cr
+}
+
+func managedScaling(target int64) *svcapitypes.ManagedScaling {
+	return &svcapitypes.ManagedScaling{
+		InstanceWarmupPeriod:   aws.Int64(300),
+		MaximumScalingStepSize: aws.Int64(10000),
+		MinimumScalingStepSize: aws.Int64(1),
+		Status:                 aws.String("ENABLED"),
+		TargetCapacity:         aws.Int64(target),
+	}
+}
+
+func describeOutput(target int64, protection string, tags ...*svcsdk.Tag) *svcsdk.DescribeCapacityProvidersOutput {
+	return &svcsdk.DescribeCapacityProvidersOutput{
+		CapacityProviders: []*svcsdk.CapacityProvider{{
+			AutoScalingGroupProvider: &svcsdk.AutoScalingGroupProvider{
+				AutoScalingGroupArn:          aws.String("arn:asg"),
+				ManagedScaling:               generateSDKManagedScaling(managedScaling(target)),
+				ManagedTerminationProtection: aws.String(protection),
+			},
+			Status: aws.String(svcsdk.CapacityProviderStatusActive),
+			Tags:   tags,
+		}},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		UpToDate bool
+	}
+
+	cases := map[string]struct {
+		CR   *svcapitypes.CapacityProvider
+		Resp *svcsdk.DescribeCapacityProvidersOutput
+		Want want
+	}{
+		"UpToDate": {
+			CR: capacityProvider(svcapitypes.CustomAutoScalingGroupProvider{
+				AutoScalingGroupARN:          aws.String("other"),
+				ManagedScaling:               managedScaling(90),
+				ManagedTerminationProtection: aws.String("ENABLED"),
+			}, &svcapitypes.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+			Resp: describeOutput(90, "ENABLED", &svcsdk.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+			Want: want{UpToDate: true},
+		},
+		"TargetCapacityChanged": {
+			CR: capacityProvider(svcapitypes.CustomAutoScalingGroupProvider{
+				ManagedScaling:               managedScaling(100),
+				ManagedTerminationProtection: aws.String("ENABLED"),
+			}),
+			Resp: describeOutput(90, "ENABLED"),
+			Want: want{UpToDate: false},
+		},
+		"TerminationProtectionChanged": {
+			CR: capacityProvider(svcapitypes.CustomAutoScalingGroupProvider{
+				ManagedScaling:               managedScaling(90),
+				ManagedTerminationProtection: aws.String("DISABLED"),
+			}),
+			Resp: describeOutput(90, "ENABLED"),
+			Want: want{UpToDate: false},
+		},
+		"TagsChanged": {
+			CR: capacityProvider(svcapitypes.CustomAutoScalingGroupProvider{
+				ManagedScaling:               managedScaling(90),
+				ManagedTerminationProtection: aws.String("ENABLED"),
+			}, &svcapitypes.Tag{Key: aws.String("k"), Value: aws.String("new")}),
+			Resp: describeOutput(90, "ENABLED", &svcsdk.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+			Want: want{UpToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _, err := isUpToDate(context.Background(), tc.CR, tc.Resp)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.Want.UpToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		Spec *svcapitypes.CapacityProviderParameters
+		Resp *svcsdk.DescribeCapacityProvidersOutput
+		Want *svcapitypes.CapacityProviderParameters
+	}{
+		"FillsDefaults": {
+			Spec: &svcapitypes.CapacityProviderParameters{},
+			Resp: describeOutput(100, "DISABLED"),
+			Want: &svcapitypes.CapacityProviderParameters{
+				CustomCapacityProviderParameters: svcapitypes.CustomCapacityProviderParameters{
+					AutoScalingGroupProvider: svcapitypes.CustomAutoScalingGroupProvider{
+						ManagedScaling:               managedScaling(100),
+						ManagedTerminationProtection: aws.String("DISABLED"),
+					},
+				},
+			},
+		},
+		"KeepsDesired": {
+			Spec: &svcapitypes.CapacityProviderParameters{
+				CustomCapacityProviderParameters: svcapitypes.CustomCapacityProviderParameters{
+					AutoScalingGroupProvider: svcapitypes.CustomAutoScalingGroupProvider{
+						ManagedScaling: &svcapitypes.ManagedScaling{TargetCapacity: aws.Int64(80)},
+					},
+				},
+			},
+			Resp: describeOutput(100, "DISABLED"),
+			Want: &svcapitypes.CapacityProviderParameters{
+				CustomCapacityProviderParameters: svcapitypes.CustomCapacityProviderParameters{
+					AutoScalingGroupProvider: svcapitypes.CustomAutoScalingGroupProvider{
+						ManagedScaling:               managedScaling(80),
+						ManagedTerminationProtection: aws.String("DISABLED"),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			Spec: &svcapitypes.CapacityProviderParameters{},
+			Resp: &svcsdk.DescribeCapacityProvidersOutput{},
+			Want: &svcapitypes.CapacityProviderParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := lateInitialize(tc.Spec, tc.Resp); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.Want, tc.Spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	type want struct {
+		Obs  managed.ExternalObservation
+		Cond xpv1.Condition
+	}
+
+	withUpdateStatus := func(status, reason string) *svcsdk.DescribeCapacityProvidersOutput {
+		out := describeOutput(100, "DISABLED")
+		out.CapacityProviders[0].UpdateStatus = aws.String(status)
+		out.CapacityProviders[0].UpdateStatusReason = aws.String(reason)
+		return out
+	}
+
+	cases := map[string]struct {
+		Resp *svcsdk.DescribeCapacityProvidersOutput
+		Obs  managed.ExternalObservation
+		Want want
+	}{
+		"NotFound": {
+			Resp: &svcsdk.DescribeCapacityProvidersOutput{},
+			Obs:  managed.ExternalObservation{ResourceExists: true},
+			Want: want{Obs: managed.ExternalObservation{}},
+		},
+		"Inactive": {
+			Resp: &svcsdk.DescribeCapacityProvidersOutput{CapacityProviders: []*svcsdk.CapacityProvider{{
+				Status: aws.String(svcsdk.CapacityProviderStatusInactive),
+			}}},
+			Obs:  managed.ExternalObservation{ResourceExists: true},
+			Want: want{Obs: managed.ExternalObservation{}},
+		},
+		"Available": {
+			Resp: describeOutput(100, "DISABLED"),
+			Obs:  managed.ExternalObservation{ResourceExists: true},
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true},
+				Cond: xpv1.Available(),
+			},
+		},
+		"UpdateFailed": {
+			Resp: withUpdateStatus(svcsdk.CapacityProviderUpdateStatusUpdateFailed, "nope"),
+			Obs:  managed.ExternalObservation{ResourceExists: true},
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true},
+				Cond: xpv1.Unavailable().WithMessage("nope"),
+			},
+		},
+		"UpdateInProgress": {
+			Resp: withUpdateStatus(svcsdk.CapacityProviderUpdateStatusUpdateInProgress, ""),
+			Obs:  managed.ExternalObservation{ResourceExists: true},
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Available(),
+			},
+		},
+		"DeleteInProgress": {
+			Resp: withUpdateStatus(svcsdk.CapacityProviderUpdateStatusDeleteInProgress, ""),
+			Obs:  managed.ExternalObservation{ResourceExists: true},
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true},
+				Cond: xpv1.Deleting(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.CapacityProvider{}
+			obs, err := postObserve(context.Background(), cr, tc.Resp, tc.Obs, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.Want.Obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.Want.Cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.Want.Cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
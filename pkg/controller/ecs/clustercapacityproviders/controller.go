@@ -0,0 +1,271 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustercapacityproviders
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecsiface "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ecs "github.com/crossplane-contrib/provider-aws/apis/ecs/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ClusterCapacityProviders resource"
+	errCreateSession    = "cannot create a new session"
+	errPut              = "cannot put cluster capacity providers in AWS"
+	errDescribe         = "failed to describe Cluster"
+	errDelete           = "failed to remove cluster capacity providers"
+
+	attachmentsStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+	attachmentsStatusUpdateFailed     = "UPDATE_FAILED"
+)
+
+// SetupClusterCapacityProviders adds a controller that reconciles
+// ClusterCapacityProviders.
+func SetupClusterCapacityProviders(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(ecs.ClusterCapacityProvidersGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(ecs.ClusterCapacityProvidersGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&ecs.ClusterCapacityProviders{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+type external struct {
+	client awsecsiface.ECSAPI
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*ecs.ClusterCapacityProviders)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: awsecs.New(sess)}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*ecs.ClusterCapacityProviders)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	resp, err := e.client.DescribeClustersWithContext(ctx, &awsecs.DescribeClustersInput{
+		Clusters: []*string{aws.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errDescribe)
+	}
+	// Unknown clusters are reported as failures instead of an error, and
+	// deleted ones can still be described with an INACTIVE status.
+	if len(resp.Clusters) == 0 || aws.StringValue(resp.Clusters[0].Status) == "INACTIVE" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cluster := resp.Clusters[0]
+
+	// The association only goes away together with the cluster, so consider
+	// it gone as soon as all capacity providers have been removed.
+	if meta.WasDeleted(cr) && len(cluster.CapacityProviders) == 0 && len(cluster.DefaultCapacityProviderStrategy) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = ecs.ClusterCapacityProvidersObservation{
+		ClusterARN:        cluster.ClusterArn,
+		AttachmentsStatus: cluster.AttachmentsStatus,
+	}
+
+	switch aws.StringValue(cluster.AttachmentsStatus) {
+	case attachmentsStatusUpdateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	upToDate, diff := isUpToDate(cr.Spec.ForProvider, cluster)
+	if aws.StringValue(cluster.AttachmentsStatus) == attachmentsStatusUpdateInProgress {
+		// ECS rejects changes while the previous ones are still being
+		// applied.
+		upToDate = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*ecs.ClusterCapacityProviders)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.PutClusterCapacityProvidersWithContext(ctx, generatePutClusterCapacityProvidersInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errPut)
+	}
+	if resp.Cluster != nil {
+		meta.SetExternalName(cr, aws.StringValue(resp.Cluster.ClusterArn))
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*ecs.ClusterCapacityProviders)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.PutClusterCapacityProvidersWithContext(ctx, generatePutClusterCapacityProvidersInput(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*ecs.ClusterCapacityProviders)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.PutClusterCapacityProvidersWithContext(ctx, &awsecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(meta.GetExternalName(cr)),
+		CapacityProviders:               []*string{},
+		DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{},
+	})
+	return errorutils.Wrap(resource.Ignore(isNotFound, err), errDelete)
+}
+
+func generatePutClusterCapacityProvidersInput(p ecs.ClusterCapacityProvidersParameters) *awsecs.PutClusterCapacityProvidersInput {
+	input := &awsecs.PutClusterCapacityProvidersInput{
+		Cluster:                         p.Cluster,
+		CapacityProviders:               []*string{},
+		DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{},
+	}
+	input.CapacityProviders = append(input.CapacityProviders, p.CapacityProviders...)
+	for _, s := range p.DefaultCapacityProviderStrategy {
+		input.DefaultCapacityProviderStrategy = append(input.DefaultCapacityProviderStrategy, &awsecs.CapacityProviderStrategyItem{
+			Base:             s.Base,
+			CapacityProvider: s.CapacityProvider,
+			Weight:           s.Weight,
+		})
+	}
+	return input
+}
+
+// isUpToDate compares the desired capacity providers and default strategy
+// with the ones of the cluster. The order of both lists is not significant,
+// and unset base and weight values default to zero.
+func isUpToDate(p ecs.ClusterCapacityProvidersParameters, cluster *awsecs.Cluster) (bool, string) {
+	type strategyItem struct {
+		CapacityProvider string
+		Base             int64
+		Weight           int64
+	}
+	type state struct {
+		CapacityProviders []string
+		Strategy          []strategyItem
+	}
+
+	desired := state{CapacityProviders: aws.StringValueSlice(p.CapacityProviders)}
+	for _, s := range p.DefaultCapacityProviderStrategy {
+		desired.Strategy = append(desired.Strategy, strategyItem{
+			CapacityProvider: aws.StringValue(s.CapacityProvider),
+			Base:             aws.Int64Value(s.Base),
+			Weight:           aws.Int64Value(s.Weight),
+		})
+	}
+	current := state{CapacityProviders: aws.StringValueSlice(cluster.CapacityProviders)}
+	for _, s := range cluster.DefaultCapacityProviderStrategy {
+		current.Strategy = append(current.Strategy, strategyItem{
+			CapacityProvider: aws.StringValue(s.CapacityProvider),
+			Base:             aws.Int64Value(s.Base),
+			Weight:           aws.Int64Value(s.Weight),
+		})
+	}
+
+	diff := cmp.Diff(current, desired,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b strategyItem) bool { return a.CapacityProvider < b.CapacityProvider }))
+	return diff == "", diff
+}
+
+func isNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsecs.ErrCodeClusterNotFoundException
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustercapacityproviders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecsiface "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ecs "github.com/crossplane-contrib/provider-aws/apis/ecs/v1alpha1"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	clusterARN = "arn:aws:ecs:us-east-1:123456789012:cluster/test"
+
+	errBoom = errors.New("boom")
+)
+
+type mockECSClient struct {
+	awsecsiface.ECSAPI
+
+	MockDescribeClusters func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error)
+}
+
+func (m *mockECSClient) DescribeClustersWithContext(_ aws.Context, in *awsecs.DescribeClustersInput, _ ...request.Option) (*awsecs.DescribeClustersOutput, error) {
+	return m.MockDescribeClusters(in)
+}
+
+func params() ecs.ClusterCapacityProvidersParameters {
+	return ecs.ClusterCapacityProvidersParameters{
+		Cluster:           aws.String(clusterARN),
+		CapacityProviders: aws.StringSlice([]string{"FARGATE_SPOT", "ec2"}),
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+			{CapacityProvider: aws.String("ec2"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+			{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: aws.Int64(4)},
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		UpToDate bool
+	}
+
+	cases := map[string]struct {
+		Params  ecs.ClusterCapacityProvidersParameters
+		Cluster *awsecs.Cluster
+		Want    want
+	}{
+		"UpToDateIgnoringOrder": {
+			Params: params(),
+			Cluster: &awsecs.Cluster{
+				CapacityProviders: aws.StringSlice([]string{"ec2", "FARGATE_SPOT"}),
+				DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Base: aws.Int64(0), Weight: aws.Int64(4)},
+					{CapacityProvider: aws.String("ec2"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+			},
+			Want: want{UpToDate: true},
+		},
+		"MissingCapacityProvider": {
+			Params: params(),
+			Cluster: &awsecs.Cluster{
+				CapacityProviders: aws.StringSlice([]string{"ec2"}),
+				DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: aws.Int64(4)},
+					{CapacityProvider: aws.String("ec2"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+			},
+			Want: want{UpToDate: false},
+		},
+		"ChangedWeight": {
+			Params: params(),
+			Cluster: &awsecs.Cluster{
+				CapacityProviders: aws.StringSlice([]string{"ec2", "FARGATE_SPOT"}),
+				DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: aws.Int64(1)},
+					{CapacityProvider: aws.String("ec2"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+			},
+			Want: want{UpToDate: false},
+		},
+		"EmptyEqualsUnset": {
+			Params:  ecs.ClusterCapacityProvidersParameters{},
+			Cluster: &awsecs.Cluster{CapacityProviders: []*string{}},
+			Want:    want{UpToDate: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := isUpToDate(tc.Params, tc.Cluster)
+			if diff := cmp.Diff(tc.Want.UpToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutClusterCapacityProvidersInput(t *testing.T) {
+	cases := map[string]struct {
+		Params ecs.ClusterCapacityProvidersParameters
+		Want   *awsecs.PutClusterCapacityProvidersInput
+	}{
+		"Full": {
+			Params: params(),
+			Want: &awsecs.PutClusterCapacityProvidersInput{
+				Cluster:           aws.String(clusterARN),
+				CapacityProviders: aws.StringSlice([]string{"FARGATE_SPOT", "ec2"}),
+				DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("ec2"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: aws.Int64(4)},
+				},
+			},
+		},
+		"EmptyListsAreSent": {
+			Params: ecs.ClusterCapacityProvidersParameters{Cluster: aws.String(clusterARN)},
+			Want: &awsecs.PutClusterCapacityProvidersInput{
+				Cluster:                         aws.String(clusterARN),
+				CapacityProviders:               []*string{},
+				DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generatePutClusterCapacityProvidersInput(tc.Params)
+			if diff := cmp.Diff(tc.Want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		Obs  managed.ExternalObservation
+		Err  error
+		Cond xpv1.Condition
+	}
+
+	withExternalName := func(cr *ecs.ClusterCapacityProviders) *ecs.ClusterCapacityProviders {
+		meta.SetExternalName(cr, clusterARN)
+		return cr
+	}
+	upToDateCluster := func(status string) *awsecs.DescribeClustersOutput {
+		return &awsecs.DescribeClustersOutput{Clusters: []*awsecs.Cluster{{
+			ClusterArn:        aws.String(clusterARN),
+			Status:            aws.String("ACTIVE"),
+			AttachmentsStatus: aws.String(status),
+			CapacityProviders: aws.StringSlice([]string{"ec2", "FARGATE_SPOT"}),
+			DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+				{CapacityProvider: aws.String("ec2"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+				{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: aws.Int64(4)},
+			},
+		}}}
+	}
+
+	cases := map[string]struct {
+		Client awsecsiface.ECSAPI
+		CR     *ecs.ClusterCapacityProviders
+		Want   want
+	}{
+		"NoExternalName": {
+			CR:   &ecs.ClusterCapacityProviders{},
+			Want: want{Obs: managed.ExternalObservation{}},
+		},
+		"DescribeFailed": {
+			Client: &mockECSClient{MockDescribeClusters: func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error) {
+				return nil, errBoom
+			}},
+			CR:   withExternalName(&ecs.ClusterCapacityProviders{}),
+			Want: want{Err: errorutils.Wrap(errBoom, errDescribe)},
+		},
+		"ClusterInactive": {
+			Client: &mockECSClient{MockDescribeClusters: func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error) {
+				return &awsecs.DescribeClustersOutput{Clusters: []*awsecs.Cluster{{Status: aws.String("INACTIVE")}}}, nil
+			}},
+			CR:   withExternalName(&ecs.ClusterCapacityProviders{}),
+			Want: want{Obs: managed.ExternalObservation{}},
+		},
+		"UpToDate": {
+			Client: &mockECSClient{MockDescribeClusters: func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error) {
+				return upToDateCluster("UPDATE_COMPLETE"), nil
+			}},
+			CR: withExternalName(&ecs.ClusterCapacityProviders{Spec: ecs.ClusterCapacityProvidersSpec{ForProvider: params()}}),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Available(),
+			},
+		},
+		"UpdateFailed": {
+			Client: &mockECSClient{MockDescribeClusters: func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error) {
+				return upToDateCluster(attachmentsStatusUpdateFailed), nil
+			}},
+			CR: withExternalName(&ecs.ClusterCapacityProviders{Spec: ecs.ClusterCapacityProvidersSpec{ForProvider: params()}}),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Unavailable(),
+			},
+		},
+		"UpdateInProgress": {
+			Client: &mockECSClient{MockDescribeClusters: func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error) {
+				return upToDateCluster(attachmentsStatusUpdateInProgress), nil
+			}},
+			CR: withExternalName(&ecs.ClusterCapacityProviders{}),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Available(),
+			},
+		},
+		"DeletedAndRemoved": {
+			Client: &mockECSClient{MockDescribeClusters: func(*awsecs.DescribeClustersInput) (*awsecs.DescribeClustersOutput, error) {
+				return &awsecs.DescribeClustersOutput{Clusters: []*awsecs.Cluster{{Status: aws.String("ACTIVE")}}}, nil
+			}},
+			CR: func() *ecs.ClusterCapacityProviders {
+				cr := withExternalName(&ecs.ClusterCapacityProviders{})
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+				return cr
+			}(),
+			Want: want{Obs: managed.ExternalObservation{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.Client}
+			obs, err := e.Observe(context.Background(), tc.CR)
+			if diff := cmp.Diff(tc.Want.Err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Obs, obs, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.Want.Cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.Want.Cond, tc.CR.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
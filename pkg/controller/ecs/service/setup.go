@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
@@ -26,6 +28,15 @@ import (
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
+const (
+	// maxServiceEvents is the number of most recent service events kept in
+	// the status.
+	maxServiceEvents = 10
+
+	deploymentStatusPrimary = "PRIMARY"
+	circuitBreakerRollback  = "circuit breaker: rolling back"
+)
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.ECSAPI
@@ -97,6 +108,7 @@ func isUpToDate(context context.Context, service *svcapitypes.Service, output *s
 		cmpopts.IgnoreFields(svcapitypes.ServiceParameters{}, "Region"),
 		cmpopts.IgnoreFields(svcapitypes.CustomServiceParameters{}, "Cluster"),
 		cmpopts.IgnoreFields(svcapitypes.CustomServiceParameters{}, "ForceDeletion"),
+		cmpopts.IgnoreFields(svcapitypes.CustomServiceParameters{}, "WaitForSteadyState"),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}))
 
 	return diff == "", diff, nil
@@ -199,13 +211,15 @@ func (e *custom) postObserve(_ context.Context, cr *svcapitypes.Service, resp *s
 	}
 	cr.Status.AtProvider.TaskARNs = listTasksOutput.TaskArns
 
+	// Only keep the most recent events, the full event stream is available
+	// through the ECS API.
+	if len(cr.Status.AtProvider.Events) > maxServiceEvents {
+		cr.Status.AtProvider.Events = cr.Status.AtProvider.Events[:maxServiceEvents]
+	}
+
 	switch aws.StringValue(resp.Services[0].Status) {
 	case "ACTIVE":
-		if resp.Services[0].DesiredCount == nil || resp.Services[0].RunningCount == nil || *resp.Services[0].DesiredCount != *resp.Services[0].RunningCount {
-			cr.SetConditions(xpv1.Creating())
-		} else {
-			cr.SetConditions(xpv1.Available())
-		}
+		cr.SetConditions(serviceReadiness(resp.Services[0], cr.Spec.ForProvider.WaitForSteadyState))
 	case "DRAINING":
 		cr.SetConditions(xpv1.Deleting())
 	case "INACTIVE":
@@ -217,6 +231,69 @@ func (e *custom) postObserve(_ context.Context, cr *svcapitypes.Service, resp *s
 	return obs, nil
 }
 
+// serviceReadiness derives the Ready condition of an active service from its
+// deployments and task counts.
+func serviceReadiness(svc *svcsdk.Service, waitForSteadyState bool) xpv1.Condition {
+	if msg := failedDeploymentMessage(svc); msg != "" {
+		return xpv1.Unavailable().WithMessage(withLatestEvent(msg, svc))
+	}
+
+	running, desired := aws.Int64Value(svc.RunningCount), aws.Int64Value(svc.DesiredCount)
+	primary := primaryDeployment(svc)
+	if svc.DesiredCount == nil || svc.RunningCount == nil || running != desired {
+		msg := fmt.Sprintf("%d of %d desired tasks running", running, desired)
+		if primary != nil && aws.Int64Value(primary.FailedTasks) > 0 {
+			msg = fmt.Sprintf("%s, %d tasks failed to start", msg, aws.Int64Value(primary.FailedTasks))
+		}
+		return xpv1.Creating().WithMessage(withLatestEvent(msg, svc))
+	}
+	if !waitForSteadyState {
+		return xpv1.Available()
+	}
+
+	switch {
+	case len(svc.Deployments) > 1:
+		return xpv1.Creating().WithMessage(fmt.Sprintf("waiting for %d deployments to finish", len(svc.Deployments)))
+	case primary != nil && primary.RolloutState != nil && aws.StringValue(primary.RolloutState) != svcsdk.DeploymentRolloutStateCompleted:
+		return xpv1.Creating().WithMessage(fmt.Sprintf("deployment %s is %s", aws.StringValue(primary.Id), aws.StringValue(primary.RolloutState)))
+	}
+	return xpv1.Available()
+}
+
+// failedDeploymentMessage returns a description of why the latest rollout of
+// the service failed, or an empty string if it did not fail. A failed rollout
+// is either a deployment in the FAILED state or a primary deployment that
+// the deployment circuit breaker created to roll back a failed one.
+func failedDeploymentMessage(svc *svcsdk.Service) string {
+	for _, d := range svc.Deployments {
+		if aws.StringValue(d.RolloutState) == svcsdk.DeploymentRolloutStateFailed {
+			return fmt.Sprintf("deployment %s failed: %s", aws.StringValue(d.Id), aws.StringValue(d.RolloutStateReason))
+		}
+	}
+	if primary := primaryDeployment(svc); primary != nil && strings.Contains(aws.StringValue(primary.RolloutStateReason), circuitBreakerRollback) {
+		return aws.StringValue(primary.RolloutStateReason)
+	}
+	return ""
+}
+
+func primaryDeployment(svc *svcsdk.Service) *svcsdk.Deployment {
+	for _, d := range svc.Deployments {
+		if aws.StringValue(d.Status) == deploymentStatusPrimary {
+			return d
+		}
+	}
+	return nil
+}
+
+// withLatestEvent appends the most recent service event to msg, since it
+// usually explains why tasks fail to start.
+func withLatestEvent(msg string, svc *svcsdk.Service) string {
+	if len(svc.Events) == 0 || svc.Events[0].Message == nil {
+		return msg
+	}
+	return fmt.Sprintf("%s; latest event: %s", msg, aws.StringValue(svc.Events[0].Message))
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.CreateServiceInput) error {
 	obj.ClientToken = aws.String(string(cr.UID))
 	obj.Cluster = cr.Spec.ForProvider.Cluster
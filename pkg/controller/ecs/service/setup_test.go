@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/utils/ptr"
 
@@ -503,3 +504,148 @@ func TestMapUpdateServiceInput(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceReadiness(t *testing.T) {
+	type args struct {
+		svc                *svcsdk.Service
+		waitForSteadyState bool
+	}
+
+	completed := &svcsdk.Deployment{
+		Id:           ptr.To("ecs-svc/2"),
+		Status:       ptr.To(deploymentStatusPrimary),
+		RolloutState: ptr.To(svcsdk.DeploymentRolloutStateCompleted),
+	}
+	inProgress := &svcsdk.Deployment{
+		Id:           ptr.To("ecs-svc/2"),
+		Status:       ptr.To(deploymentStatusPrimary),
+		RolloutState: ptr.To(svcsdk.DeploymentRolloutStateInProgress),
+	}
+	old := &svcsdk.Deployment{
+		Id:           ptr.To("ecs-svc/1"),
+		Status:       ptr.To("ACTIVE"),
+		RolloutState: ptr.To(svcsdk.DeploymentRolloutStateCompleted),
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   xpv1.Condition
+	}{
+		"Available": {
+			reason: "A service running all desired tasks should be available",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments:  []*svcsdk.Deployment{completed},
+				},
+			},
+			want: xpv1.Available(),
+		},
+		"TasksStarting": {
+			reason: "A service that is not running all desired tasks should report failed tasks and the latest event",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(1)),
+					Deployments: []*svcsdk.Deployment{{
+						Id:          ptr.To("ecs-svc/2"),
+						Status:      ptr.To(deploymentStatusPrimary),
+						FailedTasks: ptr.To(int64(3)),
+					}},
+					Events: []*svcsdk.ServiceEvent{{Message: ptr.To("task failed container health checks")}},
+				},
+			},
+			want: xpv1.Creating().WithMessage("1 of 2 desired tasks running, 3 tasks failed to start; latest event: task failed container health checks"),
+		},
+		"DeploymentFailed": {
+			reason: "A failed deployment should make the service unavailable",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments: []*svcsdk.Deployment{{
+						Id:                 ptr.To("ecs-svc/2"),
+						Status:             ptr.To(deploymentStatusPrimary),
+						RolloutState:       ptr.To(svcsdk.DeploymentRolloutStateFailed),
+						RolloutStateReason: ptr.To("tasks failed to start"),
+					}},
+				},
+			},
+			want: xpv1.Unavailable().WithMessage("deployment ecs-svc/2 failed: tasks failed to start"),
+		},
+		"CircuitBreakerRollback": {
+			reason: "A circuit breaker rollback should make the service unavailable",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments: []*svcsdk.Deployment{{
+						Id:                 ptr.To("ecs-svc/3"),
+						Status:             ptr.To(deploymentStatusPrimary),
+						RolloutState:       ptr.To(svcsdk.DeploymentRolloutStateInProgress),
+						RolloutStateReason: ptr.To("ECS deployment circuit breaker: rolling back to deploymentId ecs-svc/1."),
+					}},
+				},
+			},
+			want: xpv1.Unavailable().WithMessage("ECS deployment circuit breaker: rolling back to deploymentId ecs-svc/1."),
+		},
+		"RolloutInProgress": {
+			reason: "A deployment in progress should not block readiness by default",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments:  []*svcsdk.Deployment{inProgress, old},
+				},
+			},
+			want: xpv1.Available(),
+		},
+		"WaitForSteadyStateDeployments": {
+			reason: "Multiple deployments should block readiness when waiting for steady state",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments:  []*svcsdk.Deployment{inProgress, old},
+				},
+				waitForSteadyState: true,
+			},
+			want: xpv1.Creating().WithMessage("waiting for 2 deployments to finish"),
+		},
+		"WaitForSteadyStateRollout": {
+			reason: "A primary deployment in progress should block readiness when waiting for steady state",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments:  []*svcsdk.Deployment{inProgress},
+				},
+				waitForSteadyState: true,
+			},
+			want: xpv1.Creating().WithMessage("deployment ecs-svc/2 is IN_PROGRESS"),
+		},
+		"SteadyState": {
+			reason: "A single completed deployment running all tasks should be available when waiting for steady state",
+			args: args{
+				svc: &svcsdk.Service{
+					DesiredCount: ptr.To(int64(2)),
+					RunningCount: ptr.To(int64(2)),
+					Deployments:  []*svcsdk.Deployment{completed},
+				},
+				waitForSteadyState: true,
+			},
+			want: xpv1.Available(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := serviceReadiness(tc.args.svc, tc.args.waitForSteadyState)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("%s\nserviceReadiness(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecs/capacityprovider"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecs/cluster"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecs/clustercapacityproviders"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecs/service"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecs/taskdefinition"
 	taskdefinitionfamiliy "github.com/crossplane-contrib/provider-aws/pkg/controller/ecs/taskdefinitionfamily"
@@ -31,7 +33,9 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
+		capacityprovider.SetupCapacityProvider,
 		cluster.SetupCluster,
+		clustercapacityproviders.SetupClusterCapacityProviders,
 		service.SetupService,
 		taskdefinition.SetupTaskDefinition,
 		taskdefinitionfamiliy.SetupTaskDefinitionFamily,
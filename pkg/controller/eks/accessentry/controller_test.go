@@ -0,0 +1,349 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessentry
+
+import (
+	"context"
+	"testing"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	principalARN   = "arn:aws:iam::123456789012:role/team"
+	accessEntryARN = "arn:aws:eks:us-east-1:123456789012:access-entry/my-cluster/role/123456789012/team/1"
+	username       = "arn:aws:sts::123456789012:assumed-role/team/{{SessionName}}"
+	entryType      = "STANDARD"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.AccessEntry
+}
+
+type accessEntryModifier func(*manualv1alpha1.AccessEntry)
+
+func withConditions(c ...xpv1.Condition) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { meta.SetExternalName(r, n) }
+}
+
+func withPrincipalARN(arn string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.PrincipalARN = arn }
+}
+
+func withKubernetesGroups(g ...string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.KubernetesGroups = g }
+}
+
+func withUsername(u string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.Username = &u }
+}
+
+func withType(t string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.Type = &t }
+}
+
+func withAccessEntryARN(arn string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Status.AtProvider.AccessEntryARN = arn }
+}
+
+func accessEntry(m ...accessEntryModifier) *manualv1alpha1.AccessEntry {
+	cr := &manualv1alpha1.AccessEntry{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				eks: &fake.MockClient{},
+				cr:  accessEntry(),
+			},
+			want: want{
+				cr: accessEntry(),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn:   &accessEntryARN,
+								PrincipalArn:     &principalARN,
+								KubernetesGroups: []string{"viewers"},
+								Username:         &username,
+								Type:             &entryType,
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN), withKubernetesGroups("viewers")),
+			},
+			want: want{
+				cr: accessEntry(
+					withExternalName(principalARN),
+					withKubernetesGroups("viewers"),
+					withUsername(username),
+					withType(entryType),
+					withAccessEntryARN(accessEntryARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"GroupsChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn:   &accessEntryARN,
+								KubernetesGroups: []string{"viewers"},
+								Username:         &username,
+								Type:             &entryType,
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(
+					withExternalName(principalARN),
+					withKubernetesGroups("editors"),
+					withUsername(username),
+					withType(entryType)),
+			},
+			want: want{
+				cr: accessEntry(
+					withExternalName(principalARN),
+					withKubernetesGroups("editors"),
+					withUsername(username),
+					withType(entryType),
+					withAccessEntryARN(accessEntryARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withExternalName(principalARN)),
+				err: errorutils.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreateAccessEntry: func(ctx context.Context, input *awseks.CreateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.CreateAccessEntryOutput, error) {
+						return &awseks.CreateAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{PrincipalArn: input.PrincipalArn},
+						}, nil
+					},
+				},
+				cr: accessEntry(withPrincipalARN(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(
+					withPrincipalARN(principalARN),
+					withExternalName(principalARN),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreateAccessEntry: func(ctx context.Context, input *awseks.CreateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.CreateAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withPrincipalARN(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withPrincipalARN(principalARN), withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return &awseks.DeleteAccessEntryOutput{}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withExternalName(principalARN), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspolicyassociation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	viewPolicyARN  = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+	adminPolicyARN = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSAdminPolicy"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.AccessPolicyAssociation
+}
+
+type accessPolicyAssociationModifier func(*manualv1alpha1.AccessPolicyAssociation)
+
+func withConditions(c ...xpv1.Condition) accessPolicyAssociationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) accessPolicyAssociationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { meta.SetExternalName(r, n) }
+}
+
+func withPolicyARN(arn string) accessPolicyAssociationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { r.Spec.ForProvider.PolicyARN = arn }
+}
+
+func withAccessScope(s manualv1alpha1.AccessScope) accessPolicyAssociationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { r.Spec.ForProvider.AccessScope = s }
+}
+
+func accessPolicyAssociation(m ...accessPolicyAssociationModifier) *manualv1alpha1.AccessPolicyAssociation {
+	cr := &manualv1alpha1.AccessPolicyAssociation{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	clusterScope := manualv1alpha1.AccessScope{Type: manualv1alpha1.AccessScopeTypeCluster}
+
+	type want struct {
+		cr     *manualv1alpha1.AccessPolicyAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				eks: &fake.MockClient{},
+				cr:  accessPolicyAssociation(),
+			},
+			want: want{
+				cr: accessPolicyAssociation(),
+			},
+		},
+		"FoundOnSecondPage": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						if input.NextToken == nil {
+							return &awseks.ListAssociatedAccessPoliciesOutput{
+								AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{PolicyArn: &adminPolicyARN}},
+								NextToken:                aws.String("next"),
+							}, nil
+						}
+						return &awseks.ListAssociatedAccessPoliciesOutput{
+							AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{
+								PolicyArn:   &viewPolicyARN,
+								AccessScope: &awsekstypes.AccessScope{Type: awsekstypes.AccessScopeTypeCluster},
+							}},
+						}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN), withAccessScope(clusterScope)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withExternalName(viewPolicyARN),
+					withAccessScope(clusterScope),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ScopeChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return &awseks.ListAssociatedAccessPoliciesOutput{
+							AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{
+								PolicyArn: &viewPolicyARN,
+								AccessScope: &awsekstypes.AccessScope{
+									Type:       awsekstypes.AccessScopeTypeNamespace,
+									Namespaces: []string{"default"},
+								},
+							}},
+						}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN), withAccessScope(clusterScope)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withExternalName(viewPolicyARN),
+					withAccessScope(clusterScope),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return &awseks.ListAssociatedAccessPoliciesOutput{
+							AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{PolicyArn: &adminPolicyARN}},
+						}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+		},
+		"AccessEntryNotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+		},
+		"FailedList": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+			want: want{
+				cr:  accessPolicyAssociation(withExternalName(viewPolicyARN)),
+				err: errorutils.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessPolicyAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return &awseks.AssociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withPolicyARN(viewPolicyARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withPolicyARN(viewPolicyARN),
+					withExternalName(viewPolicyARN),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withPolicyARN(viewPolicyARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withPolicyARN(viewPolicyARN),
+					withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessPolicyAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return &awseks.DisassociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withExternalName(viewPolicyARN)),
+			},
+			want: want{
+				cr:  accessPolicyAssociation(withExternalName(viewPolicyARN), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDisassociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
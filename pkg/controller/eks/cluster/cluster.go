@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
@@ -73,6 +74,7 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollIntervalHook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -94,6 +96,17 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 		Complete(r)
 }
 
+// pollIntervalHook makes sure that clusters publishing a presigned token in
+// their kubeconfig are observed, and hence the token re-published, before the
+// token expires.
+func pollIntervalHook(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	cr, ok := mg.(*v1beta1.Cluster)
+	if !ok || eks.GetKubeconfigStyle(&cr.Spec.ForProvider) != v1beta1.KubeconfigStylePresignedToken {
+		return pollInterval
+	}
+	return min(pollInterval, eks.TokenRefreshInterval)
+}
+
 type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) eks.Client
@@ -156,7 +169,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts),
+		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, &cr.Spec.ForProvider, e.sts),
 	}, nil
 }
 
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"testing"
+	"time"
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -79,6 +80,10 @@ func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
 
+func withKubeconfigStyle(k v1beta1.KubeconfigStyle) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.Kubeconfig = &v1beta1.KubeconfigOptions{Style: &k} }
+}
+
 func cluster(m ...clusterModifier) *v1beta1.Cluster {
 	cr := &v1beta1.Cluster{}
 	for _, f := range m {
@@ -121,7 +126,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, nil, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -145,7 +150,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, nil, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -169,7 +174,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, nil, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -227,7 +232,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, nil, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -630,3 +635,36 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestPollIntervalHook(t *testing.T) {
+	cases := map[string]struct {
+		cr           *v1beta1.Cluster
+		pollInterval time.Duration
+		want         time.Duration
+	}{
+		"PresignedTokenShortensInterval": {
+			cr:           cluster(),
+			pollInterval: time.Hour,
+			want:         eks.TokenRefreshInterval,
+		},
+		"PresignedTokenKeepsShorterInterval": {
+			cr:           cluster(),
+			pollInterval: time.Minute,
+			want:         time.Minute,
+		},
+		"ExecKeepsInterval": {
+			cr:           cluster(withKubeconfigStyle(v1beta1.KubeconfigStyleExec)),
+			pollInterval: time.Hour,
+			want:         time.Hour,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := pollIntervalHook(tc.cr, tc.pollInterval)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -54,6 +54,7 @@ const (
 	errAddTagsFailed       = "cannot add tags to EKS node group"
 	errDeleteFailed        = "cannot delete EKS node group"
 	errDescribeFailed      = "cannot describe EKS node group"
+	errDescribeUpdate      = "cannot describe EKS node group update"
 )
 
 // SetupNodeGroup adds a controller that reconciles NodeGroups.
@@ -67,7 +68,7 @@ func SetupNodeGroup(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient, newEC2ClientFn: eks.NewLaunchTemplateClient}),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
@@ -95,6 +96,7 @@ func SetupNodeGroup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube           client.Client
 	newEKSClientFn func(config aws.Config) eks.Client
+	newEC2ClientFn func(config aws.Config) eks.LaunchTemplateClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -106,11 +108,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newEKSClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newEKSClientFn(*cfg), ec2: c.newEC2ClientFn(*cfg), kube: c.kube}, nil
 }
 
 type external struct {
 	client eks.Client
+	ec2    eks.LaunchTemplateClient
 	kube   client.Client
 }
 
@@ -137,7 +140,29 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
+	// NOTE: the last update is not part of the node group description, so we
+	// keep what we recorded when starting it and refresh it while it is still
+	// in progress.
+	lastUpdate := cr.Status.AtProvider.LastUpdate
+	if eks.IsNodeGroupUpdateInProgress(lastUpdate) {
+		u, err := e.client.DescribeUpdate(ctx, &awseks.DescribeUpdateInput{
+			Name:          &cr.Spec.ForProvider.ClusterName,
+			NodegroupName: aws.String(meta.GetExternalName(cr)),
+			UpdateId:      aws.String(lastUpdate.ID),
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, errorutils.Wrap(err, errDescribeUpdate)
+		}
+		lastUpdate = eks.GenerateNodeGroupUpdateStatus(u.Update)
+	}
+
+	ltVersion, err := eks.ResolveLaunchTemplateVersion(ctx, e.ec2, cr.Spec.ForProvider.LaunchTemplate)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	cr.Status.AtProvider = eks.GenerateNodeGroupObservation(rsp.Nodegroup)
+	cr.Status.AtProvider.LastUpdate = lastUpdate
 	// Any of the statuses we don't explicitly address should be considered as
 	// the node group being unavailable.
 	switch cr.Status.AtProvider.Status { //nolint:exhaustive
@@ -153,7 +178,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: eks.IsNodeGroupUpToDate(&cr.Spec.ForProvider, rsp.Nodegroup),
+		ResourceUpToDate: eks.IsNodeGroupUpToDate(eks.WithResolvedLaunchTemplateVersion(&cr.Spec.ForProvider, ltVersion), rsp.Nodegroup),
 	}, nil
 }
 
@@ -166,7 +191,11 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status == manualv1alpha1.NodeGroupStatusCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	_, err := e.client.CreateNodegroup(ctx, eks.GenerateCreateNodeGroupInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	ltVersion, err := eks.ResolveLaunchTemplateVersion(ctx, e.ec2, cr.Spec.ForProvider.LaunchTemplate)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.CreateNodegroup(ctx, eks.GenerateCreateNodeGroupInput(meta.GetExternalName(cr), eks.WithResolvedLaunchTemplateVersion(&cr.Spec.ForProvider, ltVersion)))
 	return managed.ExternalCreation{}, errorutils.Wrap(err, errCreateFailed)
 }
 
@@ -179,6 +208,11 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	case manualv1alpha1.NodeGroupStatusUpdating, manualv1alpha1.NodeGroupStatusCreating:
 		return managed.ExternalUpdate{}, nil
 	}
+	// Do not start a new update while the previous one is still rolling the
+	// nodes.
+	if eks.IsNodeGroupUpdateInProgress(cr.Status.AtProvider.LastUpdate) {
+		return managed.ExternalUpdate{}, nil
+	}
 
 	// NOTE(hasheddan): we have to describe the node group again because
 	// different fields require different update methods.
@@ -197,12 +231,27 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, errorutils.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAddTagsFailed)
 		}
 	}
-	if update, updateInput := eks.GenerateUpdateNodeGroupVersionInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup); update {
-		_, err := e.client.UpdateNodegroupVersion(ctx, updateInput)
-		return managed.ExternalUpdate{}, errorutils.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+	ltVersion, err := eks.ResolveLaunchTemplateVersion(ctx, e.ec2, cr.Spec.ForProvider.LaunchTemplate)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := eks.WithResolvedLaunchTemplateVersion(&cr.Spec.ForProvider, ltVersion)
+	// The update configuration controls how a version update rolls the nodes,
+	// so a pending change to it is applied before the version update starts.
+	if update, updateInput := eks.GenerateUpdateNodeGroupVersionInput(meta.GetExternalName(cr), p, rsp.Nodegroup); update && eks.IsNodeGroupUpdateConfigUpToDate(p, rsp.Nodegroup) {
+		out, err := e.client.UpdateNodegroupVersion(ctx, updateInput)
+		if err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		}
+		cr.Status.AtProvider.LastUpdate = eks.GenerateNodeGroupUpdateStatus(out.Update)
+		return managed.ExternalUpdate{}, nil
+	}
+	out, err := e.client.UpdateNodegroupConfig(ctx, eks.GenerateUpdateNodeGroupConfigInput(meta.GetExternalName(cr), p, rsp.Nodegroup))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
 	}
-	_, err = e.client.UpdateNodegroupConfig(ctx, eks.GenerateUpdateNodeGroupConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup))
-	return managed.ExternalUpdate{}, errorutils.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
+	cr.Status.AtProvider.LastUpdate = eks.GenerateNodeGroupUpdateStatus(out.Update)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
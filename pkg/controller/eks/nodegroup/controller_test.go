@@ -20,6 +20,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -40,11 +43,15 @@ var (
 	desiredSize int32 = 3
 	force             = false
 
+	updateID = "update-id"
+	ltName   = "lt-name"
+
 	errBoom = errors.New("boom")
 )
 
 type args struct {
 	eks  eks.Client
+	ec2  eks.LaunchTemplateClient
 	kube client.Client
 	cr   *manualv1alpha1.NodeGroup
 }
@@ -89,6 +96,16 @@ func withDefaultUpdateConfig() nodeGroupModifier {
 	return withUpdateConfig(&manualv1alpha1.NodeGroupUpdateConfig{Force: &force})
 }
 
+func withLaunchTemplate(lt *manualv1alpha1.LaunchTemplateSpecification) nodeGroupModifier {
+	return func(r *manualv1alpha1.NodeGroup) { r.Spec.ForProvider.LaunchTemplate = lt }
+}
+
+func withLastUpdate(id string, s awsekstypes.UpdateStatus) nodeGroupModifier {
+	return func(r *manualv1alpha1.NodeGroup) {
+		r.Status.AtProvider.LastUpdate = &manualv1alpha1.NodeGroupUpdateStatus{ID: id, Status: string(s)}
+	}
+}
+
 func nodeGroup(m ...nodeGroupModifier) *manualv1alpha1.NodeGroup {
 	cr := &manualv1alpha1.NodeGroup{}
 	for _, f := range m {
@@ -97,6 +114,16 @@ func nodeGroup(m ...nodeGroupModifier) *manualv1alpha1.NodeGroup {
 	return cr
 }
 
+func latestLaunchTemplate(v int64) *fake.MockLaunchTemplateClient {
+	return &fake.MockLaunchTemplateClient{
+		MockDescribeLaunchTemplates: func(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput, opts []func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+			return &ec2.DescribeLaunchTemplatesOutput{
+				LaunchTemplates: []ec2types.LaunchTemplate{{LaunchTemplateName: &ltName, DefaultVersionNumber: aws.Int64(1), LatestVersionNumber: aws.Int64(v)}},
+			}, nil
+		},
+	}
+}
+
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connector{}
 
@@ -135,6 +162,64 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RefreshesLastUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								Status: awsekstypes.NodegroupStatusActive,
+							},
+						}, nil
+					},
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return &awseks.DescribeUpdateOutput{
+							Update: &awsekstypes.Update{Id: input.UpdateId, Status: awsekstypes.UpdateStatusSuccessful},
+						}, nil
+					},
+				},
+				cr: nodeGroup(withDefaultUpdateConfig(), withLastUpdate(updateID, awsekstypes.UpdateStatusInProgress)),
+			},
+			want: want{
+				cr: nodeGroup(
+					withConditions(xpv1.Available()),
+					withStatus(manualv1alpha1.NodeGroupStatusActive),
+					withDefaultUpdateConfig(),
+					withLastUpdate(updateID, awsekstypes.UpdateStatusSuccessful)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LatestLaunchTemplateVersionChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								Status:         awsekstypes.NodegroupStatusActive,
+								LaunchTemplate: &awsekstypes.LaunchTemplateSpecification{Name: &ltName, Version: aws.String("1")},
+							},
+						}, nil
+					},
+				},
+				ec2: latestLaunchTemplate(2),
+				cr:  nodeGroup(withDefaultUpdateConfig(), withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: aws.String(eks.LaunchTemplateVersionLatest)})),
+			},
+			want: want{
+				cr: nodeGroup(
+					withConditions(xpv1.Available()),
+					withStatus(manualv1alpha1.NodeGroupStatusActive),
+					withDefaultUpdateConfig(),
+					withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: aws.String(eks.LaunchTemplateVersionLatest)}),
+					func(r *manualv1alpha1.NodeGroup) { r.Status.AtProvider.LaunchTemplateVersion = "1" }),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"DeletingState": {
 			args: args{
 				eks: &fake.MockClient{
@@ -267,7 +352,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, ec2: tc.ec2}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -336,7 +421,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, ec2: tc.ec2}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -443,6 +528,67 @@ func TestUpdate(t *testing.T) {
 				cr: nodeGroup(withScalingConfig(&manualv1alpha1.NodeGroupScalingConfig{DesiredSize: &desiredSize})),
 			},
 		},
+		"RecordsVersionUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateNodegroupVersion: func(tx context.Context, input *awseks.UpdateNodegroupVersionInput, opts []func(*awseks.Options)) (*awseks.UpdateNodegroupVersionOutput, error) {
+						if aws.ToString(input.LaunchTemplate.Version) != "2" {
+							return nil, errBoom
+						}
+						return &awseks.UpdateNodegroupVersionOutput{
+							Update: &awsekstypes.Update{Id: aws.String(updateID), Status: awsekstypes.UpdateStatusInProgress},
+						}, nil
+					},
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								LaunchTemplate: &awsekstypes.LaunchTemplateSpecification{Name: &ltName, Version: aws.String("1")},
+							},
+						}, nil
+					},
+				},
+				ec2: latestLaunchTemplate(2),
+				cr:  nodeGroup(withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: aws.String(eks.LaunchTemplateVersionLatest)})),
+			},
+			want: want{
+				cr: nodeGroup(
+					withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: aws.String(eks.LaunchTemplateVersionLatest)}),
+					withLastUpdate(updateID, awsekstypes.UpdateStatusInProgress)),
+			},
+		},
+		"UpdateConfigBeforeVersion": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateNodegroupConfig: func(tx context.Context, input *awseks.UpdateNodegroupConfigInput, opts []func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error) {
+						return &awseks.UpdateNodegroupConfigOutput{
+							Update: &awsekstypes.Update{Id: aws.String(updateID), Status: awsekstypes.UpdateStatusInProgress},
+						}, nil
+					},
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								UpdateConfig: &awsekstypes.NodegroupUpdateConfig{MaxUnavailable: aws.Int32(1)},
+							},
+						}, nil
+					},
+				},
+				cr: nodeGroup(withVersion(&version), withUpdateConfig(&manualv1alpha1.NodeGroupUpdateConfig{MaxUnavailable: aws.Int32(3)})),
+			},
+			want: want{
+				cr: nodeGroup(
+					withVersion(&version),
+					withUpdateConfig(&manualv1alpha1.NodeGroupUpdateConfig{MaxUnavailable: aws.Int32(3)}),
+					withLastUpdate(updateID, awsekstypes.UpdateStatusInProgress)),
+			},
+		},
+		"UpdateInProgress": {
+			args: args{
+				cr: nodeGroup(withVersion(&version), withLastUpdate(updateID, awsekstypes.UpdateStatusInProgress)),
+			},
+			want: want{
+				cr: nodeGroup(withVersion(&version), withLastUpdate(updateID, awsekstypes.UpdateStatusInProgress)),
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: nodeGroup(withStatus(manualv1alpha1.NodeGroupStatusUpdating)),
@@ -547,7 +693,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, ec2: tc.ec2}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -626,7 +772,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, ec2: tc.ec2}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentityassociation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+	tagutils "github.com/crossplane-contrib/provider-aws/pkg/utils/tags"
+)
+
+const (
+	errNotEKSPodIdentityAssociation = "managed resource is not an EKS Pod Identity Association custom resource"
+
+	errCreateFailed     = "cannot create EKS pod identity association"
+	errUpdateFailed     = "cannot update EKS pod identity association"
+	errDeleteFailed     = "cannot delete EKS pod identity association"
+	errDescribeFailed   = "cannot describe EKS pod identity association"
+	errAddTagsFailed    = "cannot add tags to EKS pod identity association"
+	errRemoveTagsFailed = "cannot remove tags from EKS pod identity association"
+)
+
+// SetupPodIdentityAssociation adds a controller that reconciles PodIdentityAssociations.
+func SetupPodIdentityAssociation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.PodIdentityAssociationKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.PodIdentityAssociationGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.PodIdentityAssociation{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube           client.Client
+	newEKSClientFn func(config aws.Config) eks.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.PodIdentityAssociation)
+	if !ok {
+		return nil, errors.New(errNotEKSPodIdentityAssociation)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newEKSClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client eks.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*manualv1alpha1.PodIdentityAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSPodIdentityAssociation)
+	}
+	// The external name is the association ID that AWS assigns on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.DescribePodIdentityAssociation(ctx, &awseks.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(meta.GetExternalName(cr)),
+		ClusterName:   &cr.Spec.ForProvider.ClusterName,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDescribeFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	eks.LateInitializePodIdentityAssociation(&cr.Spec.ForProvider, rsp.Association)
+
+	cr.Status.AtProvider = eks.GeneratePodIdentityAssociationObservation(rsp.Association)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        eks.IsPodIdentityAssociationUpToDate(&cr.Spec.ForProvider, rsp.Association),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*manualv1alpha1.PodIdentityAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSPodIdentityAssociation)
+	}
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := e.client.CreatePodIdentityAssociation(ctx, eks.GenerateCreatePodIdentityAssociationInput(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, aws.ToString(rsp.Association.AssociationId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*manualv1alpha1.PodIdentityAssociation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSPodIdentityAssociation)
+	}
+	rsp, err := e.client.DescribePodIdentityAssociation(ctx, &awseks.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(meta.GetExternalName(cr)),
+		ClusterName:   &cr.Spec.ForProvider.ClusterName,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribeFailed)
+	}
+	if !eks.IsPodIdentityAssociationRoleUpToDate(&cr.Spec.ForProvider, rsp.Association) {
+		if _, err := e.client.UpdatePodIdentityAssociation(ctx, eks.GenerateUpdatePodIdentityAssociationInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdateFailed)
+		}
+	}
+	add, remove := tagutils.DiffTags(cr.Spec.ForProvider.Tags, rsp.Association.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResource(ctx, &awseks.UntagResourceInput{ResourceArn: rsp.Association.AssociationArn, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResource(ctx, &awseks.TagResourceInput{ResourceArn: rsp.Association.AssociationArn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.PodIdentityAssociation)
+	if !ok {
+		return errors.New(errNotEKSPodIdentityAssociation)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeletePodIdentityAssociation(ctx, &awseks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(meta.GetExternalName(cr)),
+		ClusterName:   &cr.Spec.ForProvider.ClusterName,
+	})
+	return errorutils.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentityassociation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	associationID  = "a-1234567890"
+	associationARN = "arn:aws:eks:us-east-1:123456789012:podidentityassociation/my-cluster/a-1234567890"
+	roleARN        = "arn:aws:iam::123456789012:role/workload"
+	otherRoleARN   = "arn:aws:iam::123456789012:role/other"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.PodIdentityAssociation
+}
+
+type podIdentityAssociationModifier func(*manualv1alpha1.PodIdentityAssociation)
+
+func withConditions(c ...xpv1.Condition) podIdentityAssociationModifier {
+	return func(r *manualv1alpha1.PodIdentityAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) podIdentityAssociationModifier {
+	return func(r *manualv1alpha1.PodIdentityAssociation) { meta.SetExternalName(r, n) }
+}
+
+func withRoleARN(arn string) podIdentityAssociationModifier {
+	return func(r *manualv1alpha1.PodIdentityAssociation) { r.Spec.ForProvider.RoleARN = arn }
+}
+
+func withTags(tags map[string]string) podIdentityAssociationModifier {
+	return func(r *manualv1alpha1.PodIdentityAssociation) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withObservation(o manualv1alpha1.PodIdentityAssociationObservation) podIdentityAssociationModifier {
+	return func(r *manualv1alpha1.PodIdentityAssociation) { r.Status.AtProvider = o }
+}
+
+func podIdentityAssociation(m ...podIdentityAssociationModifier) *manualv1alpha1.PodIdentityAssociation {
+	cr := &manualv1alpha1.PodIdentityAssociation{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.PodIdentityAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				eks: &fake.MockClient{},
+				cr:  podIdentityAssociation(),
+			},
+			want: want{
+				cr: podIdentityAssociation(),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribePodIdentityAssociation: func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+						return &awseks.DescribePodIdentityAssociationOutput{
+							Association: &awsekstypes.PodIdentityAssociation{
+								AssociationArn: &associationARN,
+								AssociationId:  &associationID,
+								RoleArn:        &roleARN,
+							},
+						}, nil
+					},
+				},
+				cr: podIdentityAssociation(withExternalName(associationID), withRoleARN(roleARN)),
+			},
+			want: want{
+				cr: podIdentityAssociation(
+					withExternalName(associationID),
+					withRoleARN(roleARN),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.PodIdentityAssociationObservation{
+						AssociationARN: associationARN,
+						AssociationID:  associationID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RoleChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribePodIdentityAssociation: func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+						return &awseks.DescribePodIdentityAssociationOutput{
+							Association: &awsekstypes.PodIdentityAssociation{
+								AssociationId: &associationID,
+								RoleArn:       &otherRoleARN,
+							},
+						}, nil
+					},
+				},
+				cr: podIdentityAssociation(withExternalName(associationID), withRoleARN(roleARN)),
+			},
+			want: want{
+				cr: podIdentityAssociation(
+					withExternalName(associationID),
+					withRoleARN(roleARN),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.PodIdentityAssociationObservation{
+						AssociationID: associationID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribePodIdentityAssociation: func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: podIdentityAssociation(withExternalName(associationID)),
+			},
+			want: want{
+				cr: podIdentityAssociation(withExternalName(associationID)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribePodIdentityAssociation: func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: podIdentityAssociation(withExternalName(associationID)),
+			},
+			want: want{
+				cr:  podIdentityAssociation(withExternalName(associationID)),
+				err: errorutils.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.PodIdentityAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreatePodIdentityAssociation: func(ctx context.Context, input *awseks.CreatePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.CreatePodIdentityAssociationOutput, error) {
+						return &awseks.CreatePodIdentityAssociationOutput{
+							Association: &awsekstypes.PodIdentityAssociation{AssociationId: &associationID},
+						}, nil
+					},
+				},
+				cr: podIdentityAssociation(withRoleARN(roleARN)),
+			},
+			want: want{
+				cr: podIdentityAssociation(
+					withRoleARN(roleARN),
+					withExternalName(associationID),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreatePodIdentityAssociation: func(ctx context.Context, input *awseks.CreatePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.CreatePodIdentityAssociationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: podIdentityAssociation(withRoleARN(roleARN)),
+			},
+			want: want{
+				cr:  podIdentityAssociation(withRoleARN(roleARN), withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks, kube: &test.MockClient{}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		Updated bool
+		Tagged  map[string]string
+		Removed []string
+		Err     error
+	}
+
+	describe := func(role string, tags map[string]string) func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+		return func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+			return &awseks.DescribePodIdentityAssociationOutput{
+				Association: &awsekstypes.PodIdentityAssociation{
+					AssociationArn: &associationARN,
+					AssociationId:  &associationID,
+					RoleArn:        aws.String(role),
+					Tags:           tags,
+				},
+			}, nil
+		}
+	}
+
+	cases := map[string]struct {
+		describe func(ctx context.Context, input *awseks.DescribePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error)
+		update   error
+		cr       *manualv1alpha1.PodIdentityAssociation
+		want
+	}{
+		"RoleChanged": {
+			describe: describe(otherRoleARN, nil),
+			cr:       podIdentityAssociation(withExternalName(associationID), withRoleARN(roleARN)),
+			want: want{
+				Updated: true,
+			},
+		},
+		"TagsChanged": {
+			describe: describe(roleARN, map[string]string{"old": "tag"}),
+			cr:       podIdentityAssociation(withExternalName(associationID), withRoleARN(roleARN), withTags(map[string]string{"new": "tag"})),
+			want: want{
+				Tagged:  map[string]string{"new": "tag"},
+				Removed: []string{"old"},
+			},
+		},
+		"FailedUpdate": {
+			describe: describe(otherRoleARN, nil),
+			update:   errBoom,
+			cr:       podIdentityAssociation(withExternalName(associationID), withRoleARN(roleARN)),
+			want: want{
+				Updated: true,
+				Err:     errorutils.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{kube: &test.MockClient{}, client: &fake.MockClient{
+				MockDescribePodIdentityAssociation: tc.describe,
+				MockUpdatePodIdentityAssociation: func(ctx context.Context, input *awseks.UpdatePodIdentityAssociationInput, opts []func(*awseks.Options)) (*awseks.UpdatePodIdentityAssociationOutput, error) {
+					got.Updated = aws.ToString(input.RoleArn) == roleARN
+					return &awseks.UpdatePodIdentityAssociationOutput{}, tc.update
+				},
+				MockTagResource: func(ctx context.Context, input *awseks.TagResourceInput, opts []func(*awseks.Options)) (*awseks.TagResourceOutput, error) {
+					got.Tagged = input.Tags
+					return &awseks.TagResourceOutput{}, nil
+				},
+				MockUntagResource: func(ctx context.Context, input *awseks.UntagResourceInput, opts []func(*awseks.Options)) (*awseks.UntagResourceOutput, error) {
+					got.Removed = input.TagKeys
+					return &awseks.UntagResourceOutput{}, nil
+				},
+			}}
+			_, got.Err = e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
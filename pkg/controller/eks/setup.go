@@ -20,11 +20,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/accessentry"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/accesspolicyassociation"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/addon"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/cluster"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/fargateprofile"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/identityproviderconfig"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/nodegroup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/podidentityassociation"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -32,10 +35,13 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
+		accessentry.SetupAccessEntry,
+		accesspolicyassociation.SetupAccessPolicyAssociation,
 		addon.SetupAddon,
 		cluster.SetupCluster,
 		fargateprofile.SetupFargateProfile,
 		identityproviderconfig.SetupIdentityProviderConfig,
 		nodegroup.SetupNodeGroup,
+		podidentityassociation.SetupPodIdentityAssociation,
 	)
 }
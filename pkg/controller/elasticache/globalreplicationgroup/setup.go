@@ -0,0 +1,192 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	statusAvailable   = "available"
+	statusPrimaryOnly = "primary-only"
+	statusCreating    = "creating"
+	statusModifying   = "modifying"
+	statusDeleting    = "deleting"
+)
+
+// SetupGlobalReplicationGroup adds a controller that reconciles a
+// GlobalReplicationGroup.
+func SetupGlobalReplicationGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.GlobalReplicationGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.GlobalReplicationGroupGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&svcapitypes.GlobalReplicationGroup{}).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.lateInitialize = lateInitialize
+	e.isUpToDate = isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = postCreate
+	e.preUpdate = preUpdate
+	e.preDelete = preDelete
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.DescribeGlobalReplicationGroupsInput) error {
+	obj.GlobalReplicationGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.ShowMemberInfo = aws.Bool(true)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, _ *svcsdk.DescribeGlobalReplicationGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch pointer.StringValue(cr.Status.AtProvider.Status) {
+	case statusAvailable, statusPrimaryOnly:
+		cr.SetConditions(xpv1.Available())
+	case statusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case statusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"globalReplicationGroupID": []byte(meta.GetExternalName(cr)),
+	}
+	return obs, nil
+}
+
+func lateInitialize(p *svcapitypes.GlobalReplicationGroupParameters, resp *svcsdk.DescribeGlobalReplicationGroupsOutput) error {
+	if len(resp.GlobalReplicationGroups) == 0 {
+		return nil
+	}
+	g := resp.GlobalReplicationGroups[0]
+	p.CacheNodeType = pointer.LateInitialize(p.CacheNodeType, g.CacheNodeType)
+	p.EngineVersion = pointer.LateInitialize(p.EngineVersion, g.EngineVersion)
+	return nil
+}
+
+func isUpToDate(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, resp *svcsdk.DescribeGlobalReplicationGroupsOutput) (bool, string, error) {
+	// A Global datastore can only be modified while it is available.
+	if len(resp.GlobalReplicationGroups) == 0 {
+		return true, "", nil
+	}
+	g := resp.GlobalReplicationGroups[0]
+	switch pointer.StringValue(g.Status) {
+	case statusAvailable, statusPrimaryOnly:
+	default:
+		return true, "", nil
+	}
+	p := cr.Spec.ForProvider
+	switch {
+	case p.GlobalReplicationGroupDescription != nil && pointer.StringValue(p.GlobalReplicationGroupDescription) != pointer.StringValue(g.GlobalReplicationGroupDescription):
+		return false, "spec.forProvider.globalReplicationGroupDescription: description differs", nil
+	case p.CacheNodeType != nil && pointer.StringValue(p.CacheNodeType) != pointer.StringValue(g.CacheNodeType):
+		return false, "spec.forProvider.cacheNodeType: cache node type differs", nil
+	case p.EngineVersion != nil && pointer.StringValue(p.EngineVersion) != pointer.StringValue(g.EngineVersion):
+		return false, "spec.forProvider.engineVersion: engine version differs", nil
+	}
+	return true, "", nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.CreateGlobalReplicationGroupInput) error {
+	obj.PrimaryReplicationGroupId = cr.Spec.ForProvider.PrimaryReplicationGroupID
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.CreateGlobalReplicationGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	// The ID of a Global datastore is the suffix prefixed by AWS.
+	meta.SetExternalName(cr, pointer.StringValue(obj.GlobalReplicationGroup.GlobalReplicationGroupId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.ModifyGlobalReplicationGroupInput) error {
+	obj.GlobalReplicationGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.ApplyImmediately = aws.Bool(true)
+
+	// The cache node type and the engine version of a Global datastore
+	// cannot be modified in the same request.
+	p := cr.Spec.ForProvider
+	switch {
+	case p.CacheNodeType != nil && pointer.StringValue(p.CacheNodeType) != pointer.StringValue(cr.Status.AtProvider.CacheNodeType):
+		obj.CacheNodeType = p.CacheNodeType
+	case p.EngineVersion != nil && pointer.StringValue(p.EngineVersion) != pointer.StringValue(cr.Status.AtProvider.EngineVersion):
+		obj.EngineVersion = p.EngineVersion
+		obj.CacheParameterGroupName = p.CacheParameterGroupName
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.DeleteGlobalReplicationGroupInput) (bool, error) {
+	obj.GlobalReplicationGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.RetainPrimaryReplicationGroup = aws.Bool(true)
+	if cr.Spec.ForProvider.RetainPrimaryReplicationGroup != nil {
+		obj.RetainPrimaryReplicationGroup = cr.Spec.ForProvider.RetainPrimaryReplicationGroup
+	}
+	return pointer.StringValue(cr.Status.AtProvider.Status) == statusDeleting, nil
+}
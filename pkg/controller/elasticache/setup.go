@@ -21,6 +21,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/elasticache/cacheparametergroup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/elasticache/globalreplicationgroup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/elasticache/snapshot"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/elasticache/user"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/elasticache/usergroup"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -29,5 +33,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
 		cacheparametergroup.SetupCacheParameterGroup,
+		globalreplicationgroup.SetupGlobalReplicationGroup,
+		snapshot.SetupSnapshot,
+		user.SetupUser,
+		usergroup.SetupUserGroup,
 	)
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	statusAvailable = "available"
+	statusCreating  = "creating"
+	statusDeleting  = "deleting"
+)
+
+// SetupSnapshot adds a controller that reconciles a Snapshot.
+func SetupSnapshot(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.SnapshotKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.SnapshotGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&svcapitypes.Snapshot{}).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.preCreate = preCreate
+	e.preDelete = preDelete
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Snapshot, obj *svcsdk.DescribeSnapshotsInput) error {
+	obj.SnapshotName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Snapshot, _ *svcsdk.DescribeSnapshotsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch pointer.StringValue(cr.Status.AtProvider.SnapshotStatus) {
+	case statusAvailable:
+		cr.SetConditions(xpv1.Available())
+	case statusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case statusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Snapshot, obj *svcsdk.CreateSnapshotInput) error {
+	obj.SnapshotName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.CacheClusterId = cr.Spec.ForProvider.CacheClusterID
+	obj.ReplicationGroupId = cr.Spec.ForProvider.ReplicationGroupID
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Snapshot, obj *svcsdk.DeleteSnapshotInput) (bool, error) {
+	obj.SnapshotName = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return pointer.StringValue(cr.Status.AtProvider.SnapshotStatus) == statusDeleting, nil
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errGetPasswordSecret = "cannot get password secret"
+	errFmtKeyNotFound    = "key %s is not found in referenced Kubernetes secret"
+	errUpdatePasswordAnn = "cannot update password hash annotation"
+
+	// annotationKeyPasswordHash is the annotation that holds the hash of the
+	// passwords that were last sent to AWS.
+	annotationKeyPasswordHash = "elasticache.aws.crossplane.io/password-hash"
+
+	statusActive   = "active"
+	statusDeleting = "deleting"
+
+	authTypePassword           = "password"
+	authTypeNoPasswordRequired = "no-password-required"
+	authTypeNoPassword         = "no-password"
+)
+
+// SetupUser adds a controller that reconciles a User.
+func SetupUser(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.UserKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.UserGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&svcapitypes.User{}).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{kube: e.kube}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = h.preCreate
+	e.postCreate = h.postCreate
+	e.preUpdate = h.preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	kube client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.User, obj *svcsdk.DescribeUsersInput) error {
+	obj.UserId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.User, _ *svcsdk.DescribeUsersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch pointer.StringValue(cr.Status.AtProvider.Status) {
+	case statusActive:
+		cr.SetConditions(xpv1.Available())
+	case statusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	// Passwords are only published once they have been sent to AWS, see
+	// isUpToDate.
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(pointer.StringValue(cr.Spec.ForProvider.UserName)),
+	}
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.User, resp *svcsdk.DescribeUsersOutput) (bool, string, error) {
+	if len(resp.Users) == 0 || pointer.StringValue(resp.Users[0].Status) != statusActive {
+		return true, "", nil
+	}
+	u := resp.Users[0]
+	if pointer.StringValue(cr.Spec.ForProvider.AccessString) != pointer.StringValue(u.AccessString) {
+		return false, "spec.forProvider.accessString: access string differs", nil
+	}
+	if !isAuthenticationUpToDate(cr.Spec.ForProvider, u.Authentication) {
+		return false, "spec.forProvider.authenticationMode: authentication differs", nil
+	}
+	passwords, err := h.getPasswords(ctx, cr)
+	if err != nil {
+		return false, "", err
+	}
+	if hashPasswords(passwords) != cr.GetAnnotations()[annotationKeyPasswordHash] {
+		return false, "spec.forProvider.passwordSecretRefs: passwords changed", nil
+	}
+	return true, "", nil
+}
+
+// isAuthenticationUpToDate reports whether the authentication type and the
+// number of passwords of the user match the desired ones.
+func isAuthenticationUpToDate(p svcapitypes.UserParameters, current *svcsdk.Authentication) bool {
+	if current == nil {
+		return true
+	}
+	if p.AuthenticationMode != nil && p.AuthenticationMode.Type != nil {
+		want := pointer.StringValue(p.AuthenticationMode.Type)
+		if want == authTypeNoPasswordRequired {
+			want = authTypeNoPassword
+		}
+		if want != pointer.StringValue(current.Type) {
+			return false
+		}
+	}
+	if pointer.StringValue(current.Type) == authTypePassword {
+		return int64(len(p.PasswordSecretRefs)) == pointer.Int64Value(current.PasswordCount)
+	}
+	return true
+}
+
+// hashPasswords returns a hash of the passwords that is stored on the user
+// to detect password changes without reading them back from AWS. It returns
+// an empty string if there are no passwords.
+func hashPasswords(passwords []string) string {
+	if len(passwords) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, p := range passwords {
+		h.Write([]byte(strconv.Itoa(len(p))))
+		h.Write([]byte{0})
+		h.Write([]byte(p))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// getPasswords returns the passwords of the user from the referenced secrets.
+func (h *hooks) getPasswords(ctx context.Context, cr *svcapitypes.User) ([]string, error) {
+	passwords := make([]string, 0, len(cr.Spec.ForProvider.PasswordSecretRefs))
+	for _, ref := range cr.Spec.ForProvider.PasswordSecretRefs {
+		s := &corev1.Secret{}
+		if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, errors.Wrap(err, errGetPasswordSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtKeyNotFound, ref.Key)
+		}
+		passwords = append(passwords, string(v))
+	}
+	return passwords, nil
+}
+
+func connectionDetails(cr *svcapitypes.User, passwords []string) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(pointer.StringValue(cr.Spec.ForProvider.UserName)),
+	}
+	for i, p := range passwords {
+		k := xpv1.ResourceCredentialsSecretPasswordKey
+		if i > 0 {
+			k += strconv.Itoa(i + 1)
+		}
+		cd[k] = []byte(p)
+	}
+	return cd
+}
+
+// setPasswords adds the passwords to the authentication mode if it is set,
+// and to the passwords of the request otherwise.
+func setPasswords(mode **svcsdk.AuthenticationMode, passwords *[]*string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	if *mode != nil {
+		(*mode).Passwords = pointer.SliceValueToPtr(values)
+		return
+	}
+	*passwords = pointer.SliceValueToPtr(values)
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.User, obj *svcsdk.CreateUserInput) error {
+	obj.UserId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	passwords, err := h.getPasswords(ctx, cr)
+	if err != nil {
+		return err
+	}
+	setPasswords(&obj.AuthenticationMode, &obj.Passwords, passwords)
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.User, _ *svcsdk.CreateUserOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return cre, err
+	}
+	passwords, err := h.getPasswords(ctx, cr)
+	if err != nil {
+		return cre, err
+	}
+	// The annotation is persisted together with the external name.
+	meta.AddAnnotations(cr, map[string]string{annotationKeyPasswordHash: hashPasswords(passwords)})
+	cre.ConnectionDetails = connectionDetails(cr, passwords)
+	return cre, nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.User, obj *svcsdk.ModifyUserInput) error {
+	obj.UserId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	passwords, err := h.getPasswords(ctx, cr)
+	if err != nil {
+		return err
+	}
+	setPasswords(&obj.AuthenticationMode, &obj.Passwords, passwords)
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.User, _ *svcsdk.ModifyUserOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	passwords, err := h.getPasswords(ctx, cr)
+	if err != nil {
+		return upd, err
+	}
+	// Updating the resource resets the status to the state of the API
+	// server, so the observation is restored afterwards.
+	status := cr.Status
+	meta.AddAnnotations(cr, map[string]string{annotationKeyPasswordHash: hashPasswords(passwords)})
+	if err := h.kube.Update(ctx, cr); err != nil {
+		return upd, errors.Wrap(err, errUpdatePasswordAnn)
+	}
+	cr.Status = status
+	upd.ConnectionDetails = connectionDetails(cr, passwords)
+	return upd, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.User, obj *svcsdk.DeleteUserInput) (bool, error) {
+	obj.UserId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return pointer.StringValue(cr.Status.AtProvider.Status) == statusDeleting, nil
+}
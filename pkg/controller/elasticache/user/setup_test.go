@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+func TestIsAuthenticationUpToDate(t *testing.T) {
+	type args struct {
+		p       svcapitypes.UserParameters
+		current *svcsdk.Authentication
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoAuthentication": {
+			args: args{},
+			want: true,
+		},
+		"SamePasswordCount": {
+			args: args{
+				p: svcapitypes.UserParameters{
+					AuthenticationMode: &svcapitypes.AuthenticationMode{Type: pointer.ToOrNilIfZeroValue(authTypePassword)},
+					CustomUserParameters: svcapitypes.CustomUserParameters{
+						PasswordSecretRefs: []xpv1.SecretKeySelector{{Key: "a"}, {Key: "b"}},
+					},
+				},
+				current: &svcsdk.Authentication{
+					Type:          pointer.ToOrNilIfZeroValue(authTypePassword),
+					PasswordCount: pointer.ToIntAsInt64(2),
+				},
+			},
+			want: true,
+		},
+		"DifferentPasswordCount": {
+			args: args{
+				p: svcapitypes.UserParameters{
+					CustomUserParameters: svcapitypes.CustomUserParameters{
+						PasswordSecretRefs: []xpv1.SecretKeySelector{{Key: "a"}},
+					},
+				},
+				current: &svcsdk.Authentication{
+					Type:          pointer.ToOrNilIfZeroValue(authTypePassword),
+					PasswordCount: pointer.ToIntAsInt64(2),
+				},
+			},
+			want: false,
+		},
+		"NoPasswordRequired": {
+			args: args{
+				p: svcapitypes.UserParameters{
+					AuthenticationMode: &svcapitypes.AuthenticationMode{Type: pointer.ToOrNilIfZeroValue(authTypeNoPasswordRequired)},
+				},
+				current: &svcsdk.Authentication{Type: pointer.ToOrNilIfZeroValue(authTypeNoPassword)},
+			},
+			want: true,
+		},
+		"DifferentType": {
+			args: args{
+				p: svcapitypes.UserParameters{
+					AuthenticationMode: &svcapitypes.AuthenticationMode{Type: pointer.ToOrNilIfZeroValue(authTypePassword)},
+				},
+				current: &svcsdk.Authentication{Type: pointer.ToOrNilIfZeroValue(authTypeNoPassword)},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isAuthenticationUpToDate(tc.args.p, tc.args.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cr := &svcapitypes.User{}
+	cr.Spec.ForProvider.UserName = pointer.ToOrNilIfZeroValue("app")
+
+	want := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("app"),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte("first"),
+		"password2": []byte("second"),
+	}
+	got := connectionDetails(cr, []string{"first", "second"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("current")}
+			return nil
+		},
+	}
+	resp := &svcsdk.DescribeUsersOutput{Users: []*svcsdk.User{{Status: pointer.ToOrNilIfZeroValue(statusActive)}}}
+	user := func(key, hash string) *svcapitypes.User {
+		cr := &svcapitypes.User{}
+		cr.Spec.ForProvider.PasswordSecretRefs = []xpv1.SecretKeySelector{{Key: key}}
+		if hash != "" {
+			meta.AddAnnotations(cr, map[string]string{annotationKeyPasswordHash: hash})
+		}
+		return cr
+	}
+	type want struct {
+		upToDate bool
+		err      error
+	}
+	cases := map[string]struct {
+		cr   *svcapitypes.User
+		want want
+	}{
+		"PasswordsUnchanged": {
+			cr:   user("password", hashPasswords([]string{"current"})),
+			want: want{upToDate: true},
+		},
+		"PasswordsChanged": {
+			cr:   user("password", hashPasswords([]string{"previous"})),
+			want: want{upToDate: false},
+		},
+		"NoPasswordHash": {
+			cr:   user("password", ""),
+			want: want{upToDate: false},
+		},
+		"KeyNotFound": {
+			cr:   user("missing", ""),
+			want: want{err: errors.Errorf(errFmtKeyNotFound, "missing")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{kube: kube}
+			got, _, err := h.isUpToDate(context.Background(), tc.cr, resp)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
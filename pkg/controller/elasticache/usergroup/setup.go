@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usergroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	statusActive   = "active"
+	statusCreating = "creating"
+	statusDeleting = "deleting"
+)
+
+// SetupUserGroup adds a controller that reconciles a UserGroup.
+func SetupUserGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.UserGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.UserGroupGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&svcapitypes.UserGroup{}).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.lateInitialize = lateInitialize
+	e.isUpToDate = isUpToDate
+	e.preCreate = preCreate
+	e.preUpdate = h.preUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client elasticacheiface.ElastiCacheAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.UserGroup, obj *svcsdk.DescribeUserGroupsInput) error {
+	obj.UserGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.UserGroup, _ *svcsdk.DescribeUserGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch pointer.StringValue(cr.Status.AtProvider.Status) {
+	case statusActive:
+		cr.SetConditions(xpv1.Available())
+	case statusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case statusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func lateInitialize(p *svcapitypes.UserGroupParameters, resp *svcsdk.DescribeUserGroupsOutput) error {
+	if len(resp.UserGroups) == 0 {
+		return nil
+	}
+	if len(p.UserIDs) == 0 && len(resp.UserGroups[0].UserIds) != 0 {
+		p.UserIDs = resp.UserGroups[0].UserIds
+	}
+	return nil
+}
+
+func isUpToDate(_ context.Context, cr *svcapitypes.UserGroup, resp *svcsdk.DescribeUserGroupsOutput) (bool, string, error) {
+	// A user group can only be modified while it is active.
+	if len(resp.UserGroups) == 0 || pointer.StringValue(resp.UserGroups[0].Status) != statusActive {
+		return true, "", nil
+	}
+	add, remove := diffUserIDs(cr.Spec.ForProvider.UserIDs, resp.UserGroups[0].UserIds)
+	if len(add) != 0 || len(remove) != 0 {
+		return false, "spec.forProvider.userIDs: users differ", nil
+	}
+	return true, "", nil
+}
+
+// diffUserIDs returns the users that have to be added to and removed from the
+// user group.
+func diffUserIDs(spec, current []*string) (add, remove []*string) {
+	desired := map[string]bool{}
+	for _, id := range spec {
+		desired[pointer.StringValue(id)] = true
+	}
+	existing := map[string]bool{}
+	for _, id := range current {
+		existing[pointer.StringValue(id)] = true
+		if !desired[pointer.StringValue(id)] {
+			remove = append(remove, id)
+		}
+	}
+	for _, id := range spec {
+		if !existing[pointer.StringValue(id)] {
+			add = append(add, id)
+		}
+	}
+	return add, remove
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.UserGroup, obj *svcsdk.CreateUserGroupInput) error {
+	obj.UserGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	obj.UserIds = cr.Spec.ForProvider.UserIDs
+	return nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.UserGroup, obj *svcsdk.ModifyUserGroupInput) error {
+	obj.UserGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	resp, err := h.client.DescribeUserGroupsWithContext(ctx, &svcsdk.DescribeUserGroupsInput{UserGroupId: obj.UserGroupId})
+	if err != nil {
+		return errors.Wrap(err, errDescribe)
+	}
+	if len(resp.UserGroups) == 0 {
+		return nil
+	}
+	obj.UserIdsToAdd, obj.UserIdsToRemove = diffUserIDs(cr.Spec.ForProvider.UserIDs, resp.UserGroups[0].UserIds)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.UserGroup, obj *svcsdk.DeleteUserGroupInput) (bool, error) {
+	obj.UserGroupId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
+	return pointer.StringValue(cr.Status.AtProvider.Status) == statusDeleting, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eventbridge"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+// SetupArchive adds a controller that reconciles Archive.
+func SetupArchive(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ArchiveGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ArchiveGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Archive{}).
+		Complete(r)
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.DescribeArchiveInput) error {
+	obj.ArchiveName = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Archive, resp *svcsdk.DescribeArchiveOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch pointer.StringValue(resp.State) {
+	case svcsdk.ArchiveStateEnabled, svcsdk.ArchiveStateDisabled:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ArchiveStateCreating, svcsdk.ArchiveStateUpdating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ArchiveStateCreateFailed, svcsdk.ArchiveStateUpdateFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(pointer.StringValue(resp.StateReason)))
+	}
+	return obs, nil
+}
+
+func isUpToDate(_ context.Context, cr *svcapitypes.Archive, resp *svcsdk.DescribeArchiveOutput) (bool, string, error) {
+	spec := cr.Spec.ForProvider
+	if pointer.StringValue(spec.Description) != pointer.StringValue(resp.Description) {
+		return false, "", nil
+	}
+	if spec.RetentionDays != nil && pointer.Int64Value(spec.RetentionDays) != pointer.Int64Value(resp.RetentionDays) {
+		return false, "", nil
+	}
+	upToDate, diff := eventbridge.AreEventPatternsEqual(spec.EventPattern, resp.EventPattern)
+	return upToDate, diff, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.CreateArchiveInput) error {
+	obj.ArchiveName = aws.String(meta.GetExternalName(cr))
+	obj.EventSourceArn = cr.Spec.ForProvider.EventSourceARN
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.UpdateArchiveInput) error {
+	obj.ArchiveName = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.DeleteArchiveInput) (bool, error) {
+	obj.ArchiveName = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eventbridge"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errListTags         = "cannot list tags"
+	errTagResource      = "cannot tag resource"
+	errUntagResource    = "cannot untag resource"
+	errPutPermission    = "cannot put event bus policy"
+	errRemovePermission = "cannot remove event bus policy"
+)
+
+// SetupEventBus adds a controller that reconciles EventBus.
+func SetupEventBus(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.EventBusGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+			e.isUpToDate = h.isUpToDate
+			e.update = h.update
+		},
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.EventBusGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.EventBus{}).
+		Complete(r)
+}
+
+type hooks struct {
+	client svcsdkapi.EventBridgeAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.EventBus, obj *svcsdk.DescribeEventBusInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.EventBus, resp *svcsdk.DescribeEventBusOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.EventBusARN = resp.Arn
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.EventBus, obj *svcsdk.CreateEventBusInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.EventBus, obj *svcsdk.DeleteEventBusInput) (bool, error) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func isPolicyUpToDate(cr *svcapitypes.EventBus, resp *svcsdk.DescribeEventBusOutput) bool {
+	desired, current := aws.StringValue(cr.Spec.ForProvider.Policy), aws.StringValue(resp.Policy)
+	if desired == "" || current == "" {
+		return desired == current
+	}
+	return policy.ArePolicyDocumentsEqual(desired, current)
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.EventBus, resp *svcsdk.DescribeEventBusOutput) (bool, string, error) {
+	if !isPolicyUpToDate(cr, resp) {
+		return false, "", nil
+	}
+	add, remove, err := h.diffTags(ctx, cr, resp.Arn)
+	if err != nil {
+		return false, "", err
+	}
+	return len(add) == 0 && len(remove) == 0, "", nil
+}
+
+func (h *hooks) diffTags(ctx context.Context, cr *svcapitypes.EventBus, arn *string) ([]*svcsdk.Tag, []*string, error) {
+	tags, err := h.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListTags)
+	}
+	add, remove := eventbridge.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	return add, remove, nil
+}
+
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.EventBus)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := h.client.DescribeEventBusWithContext(ctx, &svcsdk.DescribeEventBusInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	if !isPolicyUpToDate(cr, resp) {
+		if cr.Spec.ForProvider.Policy == nil {
+			_, err = h.client.RemovePermissionWithContext(ctx, &svcsdk.RemovePermissionInput{
+				EventBusName:         resp.Name,
+				RemoveAllPermissions: aws.Bool(true),
+			})
+			if err != nil {
+				return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemovePermission)
+			}
+		} else {
+			_, err = h.client.PutPermissionWithContext(ctx, &svcsdk.PutPermissionInput{
+				EventBusName: resp.Name,
+				Policy:       cr.Spec.ForProvider.Policy,
+			})
+			if err != nil {
+				return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutPermission)
+			}
+		}
+	}
+
+	add, remove, err := h.diffTags(ctx, cr, resp.Arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(add) > 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceARN: resp.Arn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errTagResource)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceARN: resp.Arn, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUntagResource)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eventbridge"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errListTags      = "cannot list tags"
+	errTagResource   = "cannot tag resource"
+	errUntagResource = "cannot untag resource"
+)
+
+// SetupRule adds a controller that reconciles Rule.
+func SetupRule(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.RuleGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.RuleGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Rule{}).
+		Complete(r)
+}
+
+type hooks struct {
+	client svcsdkapi.EventBridgeAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.DescribeRuleInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Rule, resp *svcsdk.DescribeRuleOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.RuleARN = resp.Arn
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func lateInitialize(spec *svcapitypes.RuleParameters, resp *svcsdk.DescribeRuleOutput) error {
+	spec.State = pointer.LateInitialize(spec.State, resp.State)
+	spec.EventBusName = pointer.LateInitialize(spec.EventBusName, resp.EventBusName)
+	return nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.PutRuleInput) error {
+	setPutRuleInput(cr, obj)
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.PutRuleInput) error {
+	setPutRuleInput(cr, obj)
+	// Tags passed to PutRule are only honoured on creation, they are
+	// reconciled separately in postUpdate.
+	obj.Tags = nil
+	return nil
+}
+
+func setPutRuleInput(cr *svcapitypes.Rule, obj *svcsdk.PutRuleInput) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.DeleteRuleInput) (bool, error) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	return false, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.Rule, resp *svcsdk.DescribeRuleOutput) (bool, string, error) {
+	if upToDate, diff := isRuleUpToDate(cr, resp); !upToDate {
+		return false, diff, nil
+	}
+	add, remove, err := h.diffTags(ctx, cr, resp.Arn)
+	if err != nil {
+		return false, "", err
+	}
+	return len(add) == 0 && len(remove) == 0, "", nil
+}
+
+func isRuleUpToDate(cr *svcapitypes.Rule, resp *svcsdk.DescribeRuleOutput) (bool, string) {
+	spec := cr.Spec.ForProvider
+	switch {
+	case pointer.StringValue(spec.Description) != pointer.StringValue(resp.Description),
+		pointer.StringValue(spec.ScheduleExpression) != pointer.StringValue(resp.ScheduleExpression),
+		pointer.StringValue(spec.RoleARN) != pointer.StringValue(resp.RoleArn),
+		spec.State != nil && *spec.State != pointer.StringValue(resp.State):
+		return false, ""
+	}
+	return eventbridge.AreEventPatternsEqual(spec.EventPattern, resp.EventPattern)
+}
+
+func (h *hooks) diffTags(ctx context.Context, cr *svcapitypes.Rule, arn *string) ([]*svcsdk.Tag, []*string, error) {
+	tags, err := h.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListTags)
+	}
+	add, remove := eventbridge.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	return add, remove, nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Rule, resp *svcsdk.PutRuleOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	add, remove, err := h.diffTags(ctx, cr, resp.RuleArn)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(add) > 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceARN: resp.RuleArn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errTagResource)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceARN: resp.RuleArn, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUntagResource)
+		}
+	}
+	return upd, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/scheduler"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/scheduler/scheduleriface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Schedule resource"
+	errCreateSession    = "cannot create a new session"
+	errCreate           = "cannot create Schedule in AWS"
+	errUpdate           = "cannot update Schedule in AWS"
+	errDescribe         = "failed to describe Schedule"
+	errDelete           = "failed to delete Schedule"
+)
+
+// SetupSchedule adds a controller that reconciles a Schedule.
+func SetupSchedule(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ScheduleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ScheduleGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Schedule{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+type external struct {
+	client svcsdkapi.SchedulerAPI
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Schedule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{
+		kube:   c.kube,
+		client: svcsdk.New(sess),
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Schedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetScheduleWithContext(ctx, &svcsdk.GetScheduleInput{
+		Name:      aws.String(meta.GetExternalName(cr)),
+		GroupName: cr.Spec.ForProvider.GroupName,
+	})
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errorutils.Wrap(resource.Ignore(isNotFound, err), errDescribe)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	LateInitialize(&cr.Spec.ForProvider, resp)
+	cr.Status.AtProvider = GenerateObservation(resp)
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, diff := IsUpToDate(cr, resp)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		Diff:                    diff,
+		ResourceLateInitialized: !cmp.Equal(cr.Spec.ForProvider, *currentSpec),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Schedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.CreateScheduleWithContext(ctx, GenerateCreateScheduleInput(cr))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.ARN = resp.ScheduleArn
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Schedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.UpdateScheduleWithContext(ctx, GenerateUpdateScheduleInput(cr))
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Schedule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteScheduleWithContext(ctx, &svcsdk.DeleteScheduleInput{
+		Name:      aws.String(meta.GetExternalName(cr)),
+		GroupName: cr.Spec.ForProvider.GroupName,
+	})
+	return errorutils.Wrap(resource.Ignore(isNotFound, err), errDelete)
+}
+
+// isNotFound returns whether the given error is of type NotFound or not.
+func isNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == svcsdk.ErrCodeResourceNotFoundException
+}
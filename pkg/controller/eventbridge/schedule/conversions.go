@@ -0,0 +1,219 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// GenerateCreateScheduleInput returns the create input for the given
+// schedule.
+func GenerateCreateScheduleInput(cr *svcapitypes.Schedule) *svcsdk.CreateScheduleInput {
+	p := cr.Spec.ForProvider
+	return &svcsdk.CreateScheduleInput{
+		Name:                       aws.String(meta.GetExternalName(cr)),
+		GroupName:                  p.GroupName,
+		Description:                p.Description,
+		ScheduleExpression:         p.ScheduleExpression,
+		ScheduleExpressionTimezone: p.ScheduleExpressionTimezone,
+		StartDate:                  fromTime(p.StartDate),
+		EndDate:                    fromTime(p.EndDate),
+		State:                      p.State,
+		ActionAfterCompletion:      p.ActionAfterCompletion,
+		KmsKeyArn:                  p.KMSKeyARN,
+		FlexibleTimeWindow:         generateFlexibleTimeWindow(p.FlexibleTimeWindow),
+		Target:                     generateTarget(p.Target),
+	}
+}
+
+// GenerateUpdateScheduleInput returns the update input for the given
+// schedule. UpdateSchedule replaces all the parameters of the schedule, so
+// the input carries the complete desired state.
+func GenerateUpdateScheduleInput(cr *svcapitypes.Schedule) *svcsdk.UpdateScheduleInput {
+	p := cr.Spec.ForProvider
+	return &svcsdk.UpdateScheduleInput{
+		Name:                       aws.String(meta.GetExternalName(cr)),
+		GroupName:                  p.GroupName,
+		Description:                p.Description,
+		ScheduleExpression:         p.ScheduleExpression,
+		ScheduleExpressionTimezone: p.ScheduleExpressionTimezone,
+		StartDate:                  fromTime(p.StartDate),
+		EndDate:                    fromTime(p.EndDate),
+		State:                      p.State,
+		ActionAfterCompletion:      p.ActionAfterCompletion,
+		KmsKeyArn:                  p.KMSKeyARN,
+		FlexibleTimeWindow:         generateFlexibleTimeWindow(p.FlexibleTimeWindow),
+		Target:                     generateTarget(p.Target),
+	}
+}
+
+// LateInitialize fills the unset fields of the given parameters with the
+// values AWS defaulted them to.
+func LateInitialize(p *svcapitypes.ScheduleParameters, resp *svcsdk.GetScheduleOutput) {
+	p.GroupName = pointer.LateInitialize(p.GroupName, resp.GroupName)
+	p.State = pointer.LateInitialize(p.State, resp.State)
+	p.ActionAfterCompletion = pointer.LateInitialize(p.ActionAfterCompletion, resp.ActionAfterCompletion)
+	if resp.Target != nil && resp.Target.RetryPolicy != nil && p.Target.RetryPolicy == nil {
+		p.Target.RetryPolicy = &svcapitypes.RetryPolicy{
+			MaximumEventAgeInSeconds: resp.Target.RetryPolicy.MaximumEventAgeInSeconds,
+			MaximumRetryAttempts:     resp.Target.RetryPolicy.MaximumRetryAttempts,
+		}
+	}
+	if resp.Target != nil && resp.Target.EcsParameters != nil && p.Target.ECSParameters != nil {
+		e := p.Target.ECSParameters
+		e.TaskCount = pointer.LateInitialize(e.TaskCount, resp.Target.EcsParameters.TaskCount)
+		e.EnableECSManagedTags = pointer.LateInitialize(e.EnableECSManagedTags, resp.Target.EcsParameters.EnableECSManagedTags)
+		e.EnableExecuteCommand = pointer.LateInitialize(e.EnableExecuteCommand, resp.Target.EcsParameters.EnableExecuteCommand)
+	}
+}
+
+// GenerateObservation returns the observed state of the schedule.
+func GenerateObservation(resp *svcsdk.GetScheduleOutput) svcapitypes.ScheduleObservation {
+	return svcapitypes.ScheduleObservation{
+		ARN:                  resp.Arn,
+		CreationDate:         toTime(resp.CreationDate),
+		LastModificationDate: toTime(resp.LastModificationDate),
+	}
+}
+
+// IsUpToDate checks whether the observed schedule matches the desired one.
+func IsUpToDate(cr *svcapitypes.Schedule, resp *svcsdk.GetScheduleOutput) (bool, string) {
+	desired := GenerateUpdateScheduleInput(cr)
+	observed := &svcsdk.UpdateScheduleInput{
+		Name:                       resp.Name,
+		GroupName:                  resp.GroupName,
+		Description:                resp.Description,
+		ScheduleExpression:         resp.ScheduleExpression,
+		ScheduleExpressionTimezone: resp.ScheduleExpressionTimezone,
+		StartDate:                  resp.StartDate,
+		EndDate:                    resp.EndDate,
+		State:                      resp.State,
+		ActionAfterCompletion:      resp.ActionAfterCompletion,
+		KmsKeyArn:                  resp.KmsKeyArn,
+		FlexibleTimeWindow:         resp.FlexibleTimeWindow,
+		Target:                     resp.Target,
+	}
+	diff := cmp.Diff(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcsdk.UpdateScheduleInput{}, "ClientToken"),
+		cmpopts.IgnoreFields(svcsdk.Target{}, "EventBridgeParameters", "KinesisParameters", "SageMakerPipelineParameters"),
+	)
+	return diff == "", diff
+}
+
+func generateFlexibleTimeWindow(w svcapitypes.FlexibleTimeWindow) *svcsdk.FlexibleTimeWindow {
+	return &svcsdk.FlexibleTimeWindow{
+		MaximumWindowInMinutes: w.MaximumWindowInMinutes,
+		Mode:                   aws.String(w.Mode),
+	}
+}
+
+func generateTarget(t svcapitypes.ScheduleTarget) *svcsdk.Target {
+	res := &svcsdk.Target{
+		Arn:     t.ARN,
+		RoleArn: t.RoleARN,
+		Input:   t.Input,
+	}
+	if t.RetryPolicy != nil {
+		res.RetryPolicy = &svcsdk.RetryPolicy{
+			MaximumEventAgeInSeconds: t.RetryPolicy.MaximumEventAgeInSeconds,
+			MaximumRetryAttempts:     t.RetryPolicy.MaximumRetryAttempts,
+		}
+	}
+	if t.DeadLetterConfig != nil {
+		res.DeadLetterConfig = &svcsdk.DeadLetterConfig{
+			Arn: t.DeadLetterConfig.ARN,
+		}
+	}
+	if t.SQSParameters != nil {
+		res.SqsParameters = &svcsdk.SqsParameters{
+			MessageGroupId: t.SQSParameters.MessageGroupID,
+		}
+	}
+	if t.ECSParameters != nil {
+		res.EcsParameters = generateECSParameters(t.ECSParameters)
+	}
+	return res
+}
+
+func generateECSParameters(p *svcapitypes.ScheduleECSParameters) *svcsdk.EcsParameters {
+	res := &svcsdk.EcsParameters{
+		TaskDefinitionArn:    p.TaskDefinitionARN,
+		TaskCount:            p.TaskCount,
+		LaunchType:           p.LaunchType,
+		PlatformVersion:      p.PlatformVersion,
+		Group:                p.Group,
+		EnableECSManagedTags: p.EnableECSManagedTags,
+		EnableExecuteCommand: p.EnableExecuteCommand,
+		PropagateTags:        p.PropagateTags,
+		ReferenceId:          p.ReferenceID,
+	}
+	for _, s := range p.CapacityProviderStrategy {
+		res.CapacityProviderStrategy = append(res.CapacityProviderStrategy, &svcsdk.CapacityProviderStrategyItem{
+			CapacityProvider: aws.String(s.CapacityProvider),
+			Base:             s.Base,
+			Weight:           s.Weight,
+		})
+	}
+	if p.NetworkConfiguration != nil && p.NetworkConfiguration.AWSVPCConfiguration != nil {
+		vpc := p.NetworkConfiguration.AWSVPCConfiguration
+		res.NetworkConfiguration = &svcsdk.NetworkConfiguration{
+			AwsvpcConfiguration: &svcsdk.AwsVpcConfiguration{
+				AssignPublicIp: vpc.AssignPublicIP,
+				SecurityGroups: aws.StringSlice(vpc.SecurityGroups),
+				Subnets:        aws.StringSlice(vpc.Subnets),
+			},
+		}
+	}
+	for _, c := range p.PlacementConstraints {
+		res.PlacementConstraints = append(res.PlacementConstraints, &svcsdk.PlacementConstraint{
+			Type:       aws.String(c.Type),
+			Expression: c.Expression,
+		})
+	}
+	for _, s := range p.PlacementStrategy {
+		res.PlacementStrategy = append(res.PlacementStrategy, &svcsdk.PlacementStrategy{
+			Type:  aws.String(s.Type),
+			Field: s.Field,
+		})
+	}
+	return res
+}
+
+func fromTime(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
+
+func toTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	return &metav1.Time{Time: *t}
+}
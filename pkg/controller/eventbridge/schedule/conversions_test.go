@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+)
+
+var startDate = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func schedule(m ...func(*svcapitypes.Schedule)) *svcapitypes.Schedule {
+	cr := &svcapitypes.Schedule{}
+	meta.SetExternalName(cr, "my-schedule")
+	cr.Spec.ForProvider.GroupName = aws.String("default")
+	cr.Spec.ForProvider.ScheduleExpression = aws.String("rate(5 minutes)")
+	cr.Spec.ForProvider.StartDate = &metav1.Time{Time: startDate}
+	cr.Spec.ForProvider.FlexibleTimeWindow = svcapitypes.FlexibleTimeWindow{Mode: "OFF"}
+	cr.Spec.ForProvider.Target = svcapitypes.ScheduleTarget{
+		ARN:     aws.String("arn:aws:sqs:us-east-1:123456789012:queue"),
+		RoleARN: aws.String("arn:aws:iam::123456789012:role/scheduler"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func output(m ...func(*svcsdk.GetScheduleOutput)) *svcsdk.GetScheduleOutput {
+	o := &svcsdk.GetScheduleOutput{
+		Name:               aws.String("my-schedule"),
+		GroupName:          aws.String("default"),
+		ScheduleExpression: aws.String("rate(5 minutes)"),
+		StartDate:          aws.Time(startDate.In(time.Local)),
+		FlexibleTimeWindow: &svcsdk.FlexibleTimeWindow{Mode: aws.String("OFF")},
+		Target: &svcsdk.Target{
+			Arn:     aws.String("arn:aws:sqs:us-east-1:123456789012:queue"),
+			RoleArn: aws.String("arn:aws:iam::123456789012:role/scheduler"),
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func withECSTask(count int64) func(*svcapitypes.Schedule) {
+	return func(cr *svcapitypes.Schedule) {
+		cr.Spec.ForProvider.Target.ARN = aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/jobs")
+		cr.Spec.ForProvider.Target.ECSParameters = &svcapitypes.ScheduleECSParameters{
+			TaskDefinitionARN: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/report"),
+			TaskCount:         aws.Int64(count),
+			CapacityProviderStrategy: []svcapitypes.ScheduleCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE_SPOT", Weight: aws.Int64(1)},
+			},
+			NetworkConfiguration: &svcapitypes.ScheduleNetworkConfiguration{
+				AWSVPCConfiguration: &svcapitypes.ScheduleAWSVPCConfiguration{
+					Subnets:        []string{"subnet-1"},
+					SecurityGroups: []string{"sg-1"},
+				},
+			},
+		}
+	}
+}
+
+func withECSTaskOutput(o *svcsdk.GetScheduleOutput) {
+	o.Target.Arn = aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/jobs")
+	o.Target.EcsParameters = &svcsdk.EcsParameters{
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/report"),
+		TaskCount:         aws.Int64(1),
+		CapacityProviderStrategy: []*svcsdk.CapacityProviderStrategyItem{
+			{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: aws.Int64(1)},
+		},
+		NetworkConfiguration: &svcsdk.NetworkConfiguration{
+			AwsvpcConfiguration: &svcsdk.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice([]string{"subnet-1"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+			},
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.Schedule
+		resp *svcsdk.GetScheduleOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr:   schedule(),
+			resp: output(),
+			want: true,
+		},
+		"ExpressionChanged": {
+			cr: schedule(func(cr *svcapitypes.Schedule) {
+				cr.Spec.ForProvider.ScheduleExpression = aws.String("rate(1 hour)")
+			}),
+			resp: output(),
+			want: false,
+		},
+		"TargetInputChanged": {
+			cr: schedule(func(cr *svcapitypes.Schedule) {
+				cr.Spec.ForProvider.Target.Input = aws.String(`{"a":"b"}`)
+			}),
+			resp: output(),
+			want: false,
+		},
+		"FlexibleTimeWindowChanged": {
+			cr: schedule(func(cr *svcapitypes.Schedule) {
+				cr.Spec.ForProvider.FlexibleTimeWindow = svcapitypes.FlexibleTimeWindow{
+					Mode:                   "FLEXIBLE",
+					MaximumWindowInMinutes: aws.Int64(15),
+				}
+			}),
+			resp: output(),
+			want: false,
+		},
+		"ECSTaskUpToDate": {
+			cr:   schedule(withECSTask(1)),
+			resp: output(withECSTaskOutput),
+			want: true,
+		},
+		"ECSTaskCountChanged": {
+			cr:   schedule(withECSTask(2)),
+			resp: output(withECSTaskOutput),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := IsUpToDate(tc.cr, tc.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cr := schedule()
+	cr.Spec.ForProvider.State = nil
+	LateInitialize(&cr.Spec.ForProvider, output(func(o *svcsdk.GetScheduleOutput) {
+		o.State = aws.String(svcsdk.ScheduleStateEnabled)
+		o.ActionAfterCompletion = aws.String(svcsdk.ActionAfterCompletionNone)
+		o.Target.RetryPolicy = &svcsdk.RetryPolicy{MaximumEventAgeInSeconds: aws.Int64(86400), MaximumRetryAttempts: aws.Int64(185)}
+	}))
+	want := schedule(func(cr *svcapitypes.Schedule) {
+		cr.Spec.ForProvider.State = aws.String(svcsdk.ScheduleStateEnabled)
+		cr.Spec.ForProvider.ActionAfterCompletion = aws.String(svcsdk.ActionAfterCompletionNone)
+		cr.Spec.ForProvider.Target.RetryPolicy = &svcapitypes.RetryPolicy{MaximumEventAgeInSeconds: aws.Int64(86400), MaximumRetryAttempts: aws.Int64(185)}
+	})
+	if diff := cmp.Diff(want.Spec.ForProvider, cr.Spec.ForProvider); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eventbridge/archive"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eventbridge/eventbus"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eventbridge/rule"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eventbridge/schedule"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eventbridge/target"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
+)
+
+// Setup eventbridge controllers.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	return setup.SetupControllers(
+		mgr, o,
+		archive.SetupArchive,
+		eventbus.SetupEventBus,
+		rule.SetupRule,
+		schedule.SetupSchedule,
+		target.SetupTarget,
+	)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Target resource"
+	errCreateSession    = "cannot create a new session"
+	errNoRule           = "rule must be set"
+	errPut              = "cannot put Target in AWS"
+	errPutFailed        = "failed to put Target"
+	errDescribe         = "failed to list Targets"
+	errDelete           = "failed to delete Target"
+)
+
+// SetupTarget adds a controller that reconciles a Target.
+func SetupTarget(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.TargetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.TargetGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Target{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+type external struct {
+	client svcsdkapi.EventBridgeAPI
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Target)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{
+		kube:   c.kube,
+		client: svcsdk.New(sess),
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Target)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Rule == nil {
+		return managed.ExternalObservation{}, errors.New(errNoRule)
+	}
+
+	observed, err := e.findTarget(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(isNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = observed.Id
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, diff := IsUpToDate(cr, observed)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+// findTarget returns the target whose ID matches the external name of the
+// given resource, or nil if the rule has no such target.
+func (e *external) findTarget(ctx context.Context, cr *svcapitypes.Target) (*svcsdk.Target, error) {
+	id := meta.GetExternalName(cr)
+	input := &svcsdk.ListTargetsByRuleInput{
+		Rule:         cr.Spec.ForProvider.Rule,
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+	}
+	for {
+		resp, err := e.client.ListTargetsByRuleWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range resp.Targets {
+			if aws.StringValue(t.Id) == id {
+				return t, nil
+			}
+		}
+		if resp.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Target)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Target)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// PutTargets replaces the target with the same ID.
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) put(ctx context.Context, cr *svcapitypes.Target) error {
+	resp, err := e.client.PutTargetsWithContext(ctx, &svcsdk.PutTargetsInput{
+		Rule:         cr.Spec.ForProvider.Rule,
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+		Targets:      []*svcsdk.Target{GenerateTarget(cr)},
+	})
+	if err != nil {
+		return errorutils.Wrap(err, errPut)
+	}
+	if aws.Int64Value(resp.FailedEntryCount) > 0 && len(resp.FailedEntries) > 0 {
+		f := resp.FailedEntries[0]
+		return errors.Errorf("%s: %s: %s", errPutFailed, aws.StringValue(f.ErrorCode), aws.StringValue(f.ErrorMessage))
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Target)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	resp, err := e.client.RemoveTargetsWithContext(ctx, &svcsdk.RemoveTargetsInput{
+		Rule:         cr.Spec.ForProvider.Rule,
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+		Ids:          []*string{aws.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return errorutils.Wrap(resource.Ignore(isNotFound, err), errDelete)
+	}
+	if aws.Int64Value(resp.FailedEntryCount) > 0 && len(resp.FailedEntries) > 0 {
+		f := resp.FailedEntries[0]
+		return errors.Errorf("%s: %s: %s", errDelete, aws.StringValue(f.ErrorCode), aws.StringValue(f.ErrorMessage))
+	}
+	return nil
+}
+
+// isNotFound returns whether the given error means that the rule the target
+// belongs to does not exist.
+func isNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == svcsdk.ErrCodeResourceNotFoundException
+}
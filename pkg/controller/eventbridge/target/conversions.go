@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+)
+
+// GenerateTarget returns the AWS representation of the desired target.
+func GenerateTarget(cr *svcapitypes.Target) *svcsdk.Target {
+	p := cr.Spec.ForProvider
+	t := &svcsdk.Target{
+		Id:        aws.String(meta.GetExternalName(cr)),
+		Arn:       p.ARN,
+		RoleArn:   p.RoleARN,
+		Input:     p.Input,
+		InputPath: p.InputPath,
+	}
+	if p.InputTransformer != nil {
+		t.InputTransformer = &svcsdk.InputTransformer{
+			InputPathsMap: p.InputTransformer.InputPathsMap,
+			InputTemplate: p.InputTransformer.InputTemplate,
+		}
+	}
+	if p.RetryPolicy != nil {
+		t.RetryPolicy = &svcsdk.RetryPolicy{
+			MaximumEventAgeInSeconds: p.RetryPolicy.MaximumEventAgeInSeconds,
+			MaximumRetryAttempts:     p.RetryPolicy.MaximumRetryAttempts,
+		}
+	}
+	if p.DeadLetterConfig != nil {
+		t.DeadLetterConfig = &svcsdk.DeadLetterConfig{
+			Arn: p.DeadLetterConfig.ARN,
+		}
+	}
+	if p.SQSParameters != nil {
+		t.SqsParameters = &svcsdk.SqsParameters{
+			MessageGroupId: p.SQSParameters.MessageGroupID,
+		}
+	}
+	return t
+}
+
+// IsUpToDate checks whether the observed target matches the desired one.
+func IsUpToDate(cr *svcapitypes.Target, observed *svcsdk.Target) (bool, string) {
+	desired := GenerateTarget(cr)
+	// AWS fills in default retry settings when none are given.
+	if desired.RetryPolicy == nil {
+		o := *observed
+		o.RetryPolicy = nil
+		observed = &o
+	}
+	diff := cmp.Diff(desired, observed, cmpopts.EquateEmpty())
+	return diff == "", diff
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/eventbridge/v1alpha1"
+)
+
+func target(m ...func(*svcapitypes.Target)) *svcapitypes.Target {
+	cr := &svcapitypes.Target{}
+	meta.SetExternalName(cr, "my-target")
+	cr.Spec.ForProvider.Rule = aws.String("my-rule")
+	cr.Spec.ForProvider.ARN = aws.String("arn:aws:sqs:us-east-1:123456789012:queue")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr       *svcapitypes.Target
+		observed *svcsdk.Target
+		want     bool
+	}{
+		"UpToDateWithDefaultRetryPolicy": {
+			cr: target(),
+			observed: &svcsdk.Target{
+				Id:  aws.String("my-target"),
+				Arn: aws.String("arn:aws:sqs:us-east-1:123456789012:queue"),
+				RetryPolicy: &svcsdk.RetryPolicy{
+					MaximumEventAgeInSeconds: aws.Int64(86400),
+					MaximumRetryAttempts:     aws.Int64(185),
+				},
+			},
+			want: true,
+		},
+		"InputChanged": {
+			cr: target(func(cr *svcapitypes.Target) {
+				cr.Spec.ForProvider.Input = aws.String(`{"a":"b"}`)
+			}),
+			observed: &svcsdk.Target{
+				Id:  aws.String("my-target"),
+				Arn: aws.String("arn:aws:sqs:us-east-1:123456789012:queue"),
+			},
+			want: false,
+		},
+		"SQSParametersChanged": {
+			cr: target(func(cr *svcapitypes.Target) {
+				cr.Spec.ForProvider.SQSParameters = &svcapitypes.SQSParameters{MessageGroupID: aws.String("new")}
+			}),
+			observed: &svcsdk.Target{
+				Id:            aws.String("my-target"),
+				Arn:           aws.String("arn:aws:sqs:us-east-1:123456789012:queue"),
+				SqsParameters: &svcsdk.SqsParameters{MessageGroupId: aws.String("old")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := IsUpToDate(tc.cr, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package accesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -30,6 +31,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -49,6 +51,20 @@ const (
 	errCreate           = "failed to create the AccessKey resource"
 	errDelete           = "failed to delete the AccessKey resource"
 	errUpdate           = "failed to update the AccessKey resource"
+	errRotate           = "failed to rotate the AccessKey resource"
+	errDeletePrevious   = "failed to delete the replaced AccessKey resource"
+	errPersistKeyID     = "failed to persist the ID of the rotated AccessKey resource"
+	errRemoveKeyID      = "failed to remove the ID of the replaced AccessKey resource"
+
+	// annotationKeyPreviousAccessKeyID is the annotation that holds the ID
+	// of the access key that was replaced during the last rotation. It is
+	// persisted together with the external name so that the replaced access
+	// key is never lost.
+	annotationKeyPreviousAccessKeyID = "iam.aws.crossplane.io/previous-access-key-id"
+
+	// defaultOverlapPeriod is the time a replaced access key stays active if
+	// no overlap period is configured.
+	defaultOverlapPeriod = 24 * time.Hour
 )
 
 // SetupAccessKey adds a controller that reconciles AccessKeys.
@@ -118,14 +134,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil || len(keys.AccessKeyMetadata) == 0 {
 		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
 	}
-	found := false
-	var accessKey awsiamtypes.AccessKeyMetadata
-	for _, key := range keys.AccessKeyMetadata {
-		if aws.ToString(key.AccessKeyId) == meta.GetExternalName(cr) {
-			found = true
-			accessKey = key
-		}
-	}
+	accessKey, found := findAccessKey(keys.AccessKeyMetadata, meta.GetExternalName(cr))
 	if !found {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
@@ -135,11 +144,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	case awsiamtypes.StatusTypeInactive:
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	cr.Status.AtProvider.AccessKeyID = aws.ToString(accessKey.AccessKeyId)
+	cr.Status.AtProvider.CreateDate = pointer.TimeToMetaTime(accessKey.CreateDate)
+	if previous, ok := findAccessKey(keys.AccessKeyMetadata, cr.GetAnnotations()[annotationKeyPreviousAccessKeyID]); ok {
+		cr.Status.AtProvider.PreviousAccessKeyID = aws.ToString(previous.AccessKeyId)
+		cr.Status.AtProvider.PreviousCreateDate = pointer.TimeToMetaTime(previous.CreateDate)
+	} else {
+		cr.Status.AtProvider.PreviousAccessKeyID = ""
+		cr.Status.AtProvider.PreviousCreateDate = nil
+	}
+
 	current := cr.Spec.ForProvider.Status
 	cr.Spec.ForProvider.Status = pointer.LateInitializeValueFromPtr(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status && !needsRotation(cr, time.Now()) && !overlapExpired(cr, time.Now()),
 		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
 	}, nil
 }
@@ -177,8 +196,86 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Status:      awsiamtypes.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	now := time.Now()
+	if overlapExpired(cr, now) {
+		if err := e.deletePrevious(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if needsRotation(cr, now) {
+		return e.rotate(ctx, cr, now)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// rotate creates a new access key that replaces the current one. The current
+// access key stays active until the overlap period is over.
+func (e *external) rotate(ctx context.Context, cr *v1beta1.AccessKey, now time.Time) (managed.ExternalUpdate, error) {
+	response, err := e.client.CreateAccessKey(ctx, &awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errRotate)
+	}
+
+	// The status is reset to the state of the API server when the external
+	// name is persisted, so the observation is updated afterwards.
+	previous := cr.Status.AtProvider
+	meta.SetExternalName(cr, aws.ToString(response.AccessKey.AccessKeyId))
+	meta.AddAnnotations(cr, map[string]string{annotationKeyPreviousAccessKeyID: previous.AccessKeyID})
+	if err := custommanaged.NewRetryingCriticalAnnotationUpdater(e.kube).UpdateCriticalAnnotations(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPersistKeyID)
+	}
+	rotated := &metav1.Time{Time: now}
+	if response.AccessKey.CreateDate != nil {
+		rotated = pointer.TimeToMetaTime(response.AccessKey.CreateDate)
+	}
+	cr.Status.AtProvider = v1beta1.AccessKeyObservation{
+		AccessKeyID:         aws.ToString(response.AccessKey.AccessKeyId),
+		CreateDate:          pointer.TimeToMetaTime(response.AccessKey.CreateDate),
+		PreviousAccessKeyID: previous.AccessKeyID,
+		PreviousCreateDate:  previous.CreateDate,
+		LastRotationTime:    rotated,
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(response.AccessKey.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(response.AccessKey.SecretAccessKey)),
+	}}, nil
+}
+
+// deletePrevious deactivates and deletes the access key that was replaced
+// during the last rotation.
+func (e *external) deletePrevious(ctx context.Context, cr *v1beta1.AccessKey) error {
+	id := aws.String(cr.Status.AtProvider.PreviousAccessKeyID)
+	if _, err := e.client.UpdateAccessKey(ctx, &awsiam.UpdateAccessKeyInput{
+		AccessKeyId: id,
+		Status:      awsiamtypes.StatusTypeInactive,
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+	}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return errorutils.Wrap(err, errDeletePrevious)
+	}
+	if _, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+		AccessKeyId: id,
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+	}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return errorutils.Wrap(err, errDeletePrevious)
+	}
+	// Only the annotation is patched, but the response still resets the
+	// status to the state of the API server, so the observation is restored
+	// afterwards.
+	status := cr.Status.DeepCopy()
+	orig := cr.DeepCopy()
+	meta.RemoveAnnotations(cr, annotationKeyPreviousAccessKeyID)
+	if err := e.kube.Patch(ctx, cr, client.MergeFrom(orig)); err != nil {
+		return errors.Wrap(err, errRemoveKeyID)
+	}
+	cr.Status = *status
+	cr.Status.AtProvider.PreviousAccessKeyID = ""
+	cr.Status.AtProvider.PreviousCreateDate = nil
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -189,6 +286,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if cr.Status.AtProvider.PreviousAccessKeyID != "" {
+		if err := e.deletePrevious(ctx, cr); err != nil {
+			return err
+		}
+	}
+
 	_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
@@ -196,3 +299,45 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+func findAccessKey(keys []awsiamtypes.AccessKeyMetadata, id string) (awsiamtypes.AccessKeyMetadata, bool) {
+	if id == "" {
+		return awsiamtypes.AccessKeyMetadata{}, false
+	}
+	for _, key := range keys {
+		if aws.ToString(key.AccessKeyId) == id {
+			return key, true
+		}
+	}
+	return awsiamtypes.AccessKeyMetadata{}, false
+}
+
+// needsRotation returns true if the current access key is older than the
+// rotation period. An access key is not rotated again while the previous one
+// still exists because IAM users can only have two access keys.
+func needsRotation(cr *v1beta1.AccessKey, now time.Time) bool {
+	r := cr.Spec.ForProvider.Rotation
+	if r == nil || cr.Status.AtProvider.CreateDate == nil || cr.Status.AtProvider.PreviousAccessKeyID != "" {
+		return false
+	}
+	return !now.Before(cr.Status.AtProvider.CreateDate.Add(r.RotateAfter.Duration))
+}
+
+// overlapExpired returns true if the access key replaced during the last
+// rotation has to be deleted.
+func overlapExpired(cr *v1beta1.AccessKey, now time.Time) bool {
+	if cr.Status.AtProvider.PreviousAccessKeyID == "" {
+		return false
+	}
+	overlap := defaultOverlapPeriod
+	if r := cr.Spec.ForProvider.Rotation; r != nil && r.OverlapPeriod != nil {
+		overlap = r.OverlapPeriod.Duration
+	}
+	// Without a known rotation time the overlap is measured from the
+	// creation of the current access key.
+	rotated := cr.Status.AtProvider.CreateDate
+	if cr.Status.AtProvider.LastRotationTime != nil {
+		rotated = cr.Status.AtProvider.LastRotationTime
+	}
+	return rotated == nil || !now.Before(rotated.Add(overlap))
+}
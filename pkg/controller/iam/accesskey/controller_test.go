@@ -19,6 +19,7 @@ package accesskey
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -30,6 +31,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -46,6 +48,8 @@ var (
 	inactiveStatus = awsiamtypes.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	newSecretKeyID = "newSecretKeyID"
 
 	errBoom = errors.New("boom")
 )
@@ -80,6 +84,27 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withRotation(rotateAfter, overlap time.Duration) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Spec.ForProvider.Rotation = &v1beta1.AccessKeyRotation{
+			RotateAfter:   metav1.Duration{Duration: rotateAfter},
+			OverlapPeriod: &metav1.Duration{Duration: overlap},
+		}
+	}
+}
+
+func withPreviousAccessKey(keyid string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		meta.AddAnnotations(r, map[string]string{annotationKeyPreviousAccessKeyID: keyid})
+	}
+}
+
+func withObservation(o v1beta1.AccessKeyObservation) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Status.AtProvider = o
+	}
+}
+
 func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 	cr := &v1beta1.AccessKey{}
 	for _, f := range m {
@@ -89,6 +114,8 @@ func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 }
 
 func TestObserve(t *testing.T) {
+	keyCreated := time.Now().Add(-48 * time.Hour).Round(time.Second)
+
 	type want struct {
 		cr     resource.Managed
 		result managed.ExternalObservation
@@ -118,6 +145,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID}),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -144,6 +172,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID}),
 					withConditions(xpv1.Unavailable())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -151,6 +180,68 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDue": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								CreateDate:  &keyCreated,
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID: accessKeyID,
+						CreateDate:  &metav1.Time{Time: keyCreated},
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousKeyDeletedExternally": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withPreviousAccessKey("previous"), withStatus(string(activeStatus)),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID, PreviousAccessKeyID: "previous"})),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withPreviousAccessKey("previous"),
+					withStatus(string(activeStatus)),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"ValidInputNotExists": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -380,6 +471,10 @@ func TestDelete(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
+	now := time.Now().Round(time.Second)
+	keyCreated := metav1.NewTime(now.Add(-48 * time.Hour))
+	rotated := metav1.NewTime(now.Add(-2 * time.Hour))
+
 	type want struct {
 		cr     resource.Managed
 		err    error
@@ -412,6 +507,95 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								CreateDate:      &now,
+								SecretAccessKey: aws.String(newSecretKeyID),
+								Status:          activeStatus,
+								UserName:        aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID, CreateDate: &keyCreated})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour),
+					withPreviousAccessKey(accessKeyID),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:         newAccessKeyID,
+						CreateDate:          &metav1.Time{Time: now},
+						PreviousAccessKeyID: accessKeyID,
+						PreviousCreateDate:  &keyCreated,
+						LastRotationTime:    &metav1.Time{Time: now},
+					})),
+				update: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(newSecretKeyID),
+					},
+				},
+			},
+		},
+		"DeletePreviousAfterOverlap": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == accessKeyID && input.Status != inactiveStatus {
+							return nil, errors.New("previous key must be deactivated")
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != accessKeyID {
+							return nil, errors.New("only the previous key must be deleted")
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					// Patching resets the status to the one of the API server.
+					MockPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						obj.(*v1beta1.AccessKey).Status = v1beta1.AccessKeyStatus{}
+						return nil
+					},
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour),
+					withPreviousAccessKey(accessKeyID),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:         newAccessKeyID,
+						CreateDate:          &rotated,
+						PreviousAccessKeyID: accessKeyID,
+						PreviousCreateDate:  &keyCreated,
+						LastRotationTime:    &rotated,
+					})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:      newAccessKeyID,
+						CreateDate:       &rotated,
+						LastRotationTime: &rotated,
+					})),
+			},
+		},
 		"ClientError": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -430,7 +614,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
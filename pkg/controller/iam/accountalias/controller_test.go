@@ -0,0 +1,343 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+	"testing"
+
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	alias          = "my-company"
+	otherAlias     = "my-other-company"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam *fake.MockAccountAliasClient
+	cr  resource.Managed
+}
+
+type aliasModifier func(*svcapitypes.AccountAlias)
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *svcapitypes.AccountAlias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) aliasModifier {
+	return func(r *svcapitypes.AccountAlias) { meta.SetExternalName(r, name) }
+}
+
+func withAlias(a string) aliasModifier {
+	return func(r *svcapitypes.AccountAlias) { r.Spec.ForProvider.AccountAlias = a }
+}
+
+func accountAlias(m ...aliasModifier) *svcapitypes.AccountAlias {
+	cr := &svcapitypes.AccountAlias{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listAliases(aliases ...string) func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+		return &awsiam.ListAccountAliasesOutput{AccountAliases: aliases}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+			want: want{
+				cr:  accountAlias(withAlias(alias), withExternalName(alias)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"NoAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAliases(),
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAliases(alias),
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(alias),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ReplacedAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAliases(otherAlias),
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(otherAlias),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						return &awsiam.CreateAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr:  accountAlias(withAlias(alias)),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						if *input.AccountAlias != alias {
+							return nil, errBoom
+						}
+						return &awsiam.CreateAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(otherAlias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return &awsiam.DeleteAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(alias),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: accountAlias(withAlias(alias), withExternalName(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAlias(alias), withExternalName(alias),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
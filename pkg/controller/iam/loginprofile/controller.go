@@ -0,0 +1,299 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a LoginProfile resource"
+
+	errGet            = "cannot get LoginProfile in AWS"
+	errCreate         = "cannot create LoginProfile in AWS"
+	errUpdate         = "cannot update LoginProfile in AWS"
+	errDelete         = "cannot delete LoginProfile in AWS"
+	errNoUserName     = "userName is required"
+	errGetSecret      = "cannot get password secret"
+	errFmtKeyNotFound = "key %s is not found in referenced Kubernetes secret"
+	errGeneratePw     = "cannot generate password"
+
+	errUpdatePasswordAnn = "cannot update password hash annotation"
+
+	// annotationKeyPasswordHash is the annotation that holds the hash of the
+	// password from the referenced secret that was last sent to AWS.
+	annotationKeyPasswordHash = "iam.aws.crossplane.io/password-hash"
+
+	lowerCharacters  = "abcdefghijklmnopqrstuvwxyz"
+	upperCharacters  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitCharacters  = "0123456789"
+	symbolCharacters = "!@#$%^&*()_+-=[]{}|'"
+)
+
+// passwordSettings generates passwords that satisfy any password policy with a
+// minimum length of up to 32 characters.
+var passwordSettings = password.Settings{
+	CharacterSet: lowerCharacters + upperCharacters + digitCharacters + symbolCharacters,
+	Length:       32,
+}
+
+// SetupLoginProfile adds a controller that reconciles LoginProfiles.
+func SetupLoginProfile(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.LoginProfileGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewLoginProfileClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.LoginProfileGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.LoginProfile{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.LoginProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client iam.LoginProfileClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.LoginProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.client.GetLoginProfile(ctx, &awsiam.GetLoginProfileInput{
+		UserName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	if observed.LoginProfile != nil {
+		cr.Status.AtProvider.CreateDate = pointer.TimeToMetaTime(observed.LoginProfile.CreateDate)
+	}
+	cr.SetConditions(xpv1.Available())
+
+	// The password cannot be read, so a referenced password is compared to
+	// the hash of the one that was last applied. The password reset flag is
+	// only applied on creation.
+	upToDate := true
+	if cr.Spec.ForProvider.PasswordSecretRef != nil {
+		pw, err := e.password(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = hashPassword(pw) == cr.GetAnnotations()[annotationKeyPasswordHash]
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.LoginProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	userName := aws.ToString(cr.Spec.ForProvider.UserName)
+	if userName == "" {
+		return managed.ExternalCreation{}, errors.New(errNoUserName)
+	}
+
+	pw, err := e.password(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if _, err := e.client.CreateLoginProfile(ctx, &awsiam.CreateLoginProfileInput{
+		UserName:              aws.String(userName),
+		Password:              aws.String(pw),
+		PasswordResetRequired: pointer.BoolValue(cr.Spec.ForProvider.PasswordResetRequired),
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, userName)
+	if cr.Spec.ForProvider.PasswordSecretRef != nil {
+		meta.AddAnnotations(cr, map[string]string{annotationKeyPasswordHash: hashPassword(pw)})
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(userName),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.LoginProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Only a referenced password can change, a generated one is kept.
+	if cr.Spec.ForProvider.PasswordSecretRef == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	pw, err := e.password(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if _, err := e.client.UpdateLoginProfile(ctx, &awsiam.UpdateLoginProfileInput{
+		UserName: aws.String(meta.GetExternalName(cr)),
+		Password: aws.String(pw),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	}
+
+	// Updating the resource resets the status to the state of the API
+	// server, so the observation is restored afterwards.
+	status := cr.Status.DeepCopy()
+	meta.AddAnnotations(cr, map[string]string{annotationKeyPasswordHash: hashPassword(pw)})
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePasswordAnn)
+	}
+	cr.Status = *status
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(meta.GetExternalName(cr)),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.LoginProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteLoginProfile(ctx, &awsiam.DeleteLoginProfileInput{
+		UserName: aws.String(meta.GetExternalName(cr)),
+	})
+	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// password returns the password from the referenced secret or generates a
+// new one if no secret is referenced.
+func (e *external) password(ctx context.Context, cr *svcapitypes.LoginProfile) (string, error) {
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	if ref == nil {
+		pw, err := generatePassword()
+		return pw, errors.Wrap(err, errGeneratePw)
+	}
+
+	sc := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sc); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	val, ok := sc.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errFmtKeyNotFound, ref.Key)
+	}
+	return string(val), nil
+}
+
+// hashPassword returns the hash of the given password that is stored in the
+// password hash annotation.
+func hashPassword(pw string) string {
+	h := sha256.Sum256([]byte(pw))
+	return hex.EncodeToString(h[:])
+}
+
+// generatePassword generates a password that contains characters of every
+// class, so that it is accepted by any password policy.
+func generatePassword() (string, error) {
+	for {
+		pw, err := passwordSettings.Generate()
+		if err != nil {
+			return "", err
+		}
+		if strings.ContainsAny(pw, lowerCharacters) && strings.ContainsAny(pw, upperCharacters) &&
+			strings.ContainsAny(pw, digitCharacters) && strings.ContainsAny(pw, symbolCharacters) {
+			return pw, nil
+		}
+	}
+}
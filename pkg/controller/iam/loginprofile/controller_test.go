@@ -0,0 +1,558 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	userName       = "some-user"
+	userPassword   = "Some-Password-1"
+	newPassword    = "Some-Password-2"
+	secretKey      = "password"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockLoginProfileClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type loginProfileModifier func(*svcapitypes.LoginProfile)
+
+func withConditions(c ...xpv1.Condition) loginProfileModifier {
+	return func(r *svcapitypes.LoginProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) loginProfileModifier {
+	return func(r *svcapitypes.LoginProfile) { meta.SetExternalName(r, name) }
+}
+
+func withUserName(name string) loginProfileModifier {
+	return func(r *svcapitypes.LoginProfile) { r.Spec.ForProvider.UserName = aws.String(name) }
+}
+
+func withPasswordSecretRef() loginProfileModifier {
+	return func(r *svcapitypes.LoginProfile) {
+		r.Spec.ForProvider.PasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             secretKey,
+		}
+	}
+}
+
+func withPasswordHash(pw string) loginProfileModifier {
+	return func(r *svcapitypes.LoginProfile) {
+		meta.AddAnnotations(r, map[string]string{annotationKeyPasswordHash: hashPassword(pw)})
+	}
+}
+
+func withCreateDate(t time.Time) loginProfileModifier {
+	return func(r *svcapitypes.LoginProfile) { r.Status.AtProvider.CreateDate = &metav1.Time{Time: t} }
+}
+
+func secretGet(pw string) test.MockGetFn {
+	return func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{secretKey: []byte(pw)}
+		return nil
+	}
+}
+
+func loginProfile(m ...loginProfileModifier) *svcapitypes.LoginProfile {
+	cr := &svcapitypes.LoginProfile{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: loginProfile(withUserName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withUserName(userName), withExternalName(userName)),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"Exists": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return &awsiam.GetLoginProfileOutput{LoginProfile: &iamtypes.LoginProfile{UserName: aws.String(userName)}}, nil
+					},
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PasswordUnchanged": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return &awsiam.GetLoginProfileOutput{LoginProfile: &iamtypes.LoginProfile{UserName: aws.String(userName)}}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: secretGet(userPassword),
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return &awsiam.GetLoginProfileOutput{LoginProfile: &iamtypes.LoginProfile{UserName: aws.String(userName)}}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: secretGet(newPassword),
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"SecretError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return &awsiam.GetLoginProfileOutput{LoginProfile: &iamtypes.LoginProfile{UserName: aws.String(userName)}}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword),
+					withConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoUserName": {
+			args: args{
+				cr: loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(),
+				err: errors.New(errNoUserName),
+			},
+		},
+		"PasswordFromSecret": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						if aws.ToString(input.Password) != userPassword {
+							return nil, errBoom
+						}
+						return &awsiam.CreateLoginProfileOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: secretGet(userPassword),
+				},
+				cr: loginProfile(withUserName(userName), withPasswordSecretRef()),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withPasswordSecretRef(), withExternalName(userName), withPasswordHash(userPassword)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(userName),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(userPassword),
+					},
+				},
+			},
+		},
+		"SecretError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: loginProfile(withUserName(userName), withPasswordSecretRef()),
+			},
+			want: want{
+				cr:  loginProfile(withUserName(userName), withPasswordSecretRef()),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withUserName(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withUserName(userName)),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateGeneratesPassword(t *testing.T) {
+	var generated string
+	e := &external{client: &fake.MockLoginProfileClient{
+		MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+			generated = aws.ToString(input.Password)
+			return &awsiam.CreateLoginProfileOutput{}, nil
+		},
+	}}
+
+	o, err := e.Create(context.Background(), loginProfile(withUserName(userName)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(generated) != passwordSettings.Length {
+		t.Errorf("generated password has length %d, want %d", len(generated), passwordSettings.Length)
+	}
+	for _, class := range []string{lowerCharacters, upperCharacters, digitCharacters, symbolCharacters} {
+		if !strings.ContainsAny(generated, class) {
+			t.Errorf("generated password does not contain any of %q", class)
+		}
+	}
+	if diff := cmp.Diff([]byte(generated), o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	created := time.Now()
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"GeneratedPassword": {
+			args: args{
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+		},
+		"PasswordRotated": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+						if aws.ToString(input.UserName) != userName || aws.ToString(input.Password) != newPassword {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateLoginProfileOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: secretGet(newPassword),
+					// Updating resets the status to the one of the API server.
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						obj.(*svcapitypes.LoginProfile).Status = svcapitypes.LoginProfileStatus{}
+						return nil
+					},
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword),
+					withCreateDate(created)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(newPassword),
+					withCreateDate(created)),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(userName),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(newPassword),
+					},
+				},
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: secretGet(newPassword),
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword)),
+			},
+			want: want{
+				cr:  loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword)),
+				err: errorutils.Wrap(errBoom, errUpdate),
+			},
+		},
+		"KubeUpdateError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+						return &awsiam.UpdateLoginProfileOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet:    secretGet(newPassword),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(userPassword)),
+			},
+			want: want{
+				cr:  loginProfile(withUserName(userName), withExternalName(userName), withPasswordSecretRef(), withPasswordHash(newPassword)),
+				err: errors.Wrap(errBoom, errUpdatePasswordAnn),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return &awsiam.DeleteLoginProfileOutput{}, nil
+					},
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withUserName(userName), withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUserName(userName), withExternalName(userName),
+					withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
@@ -42,6 +43,7 @@ import (
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -59,11 +61,13 @@ const (
 	errKubeUpdateFailed = "cannot late initialize IAM Policy"
 	errTag              = "cannot tag policy"
 	errUntag            = "cannot untag policy"
+	errValidatorConfig  = "cannot get the config of the policy validator"
 )
 
 // SetupPolicy adds a controller that reconciles IAM Policy.
 func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.PolicyGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -72,12 +76,12 @@ func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient, recorder: recorder}),
 		managed.WithConnectionPublishers(),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -101,20 +105,40 @@ type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) iam.PolicyClient
 	newSTSClientFn func(config aws.Config) iam.STSClient
+	recorder       event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Policy)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
 	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	e := &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube, recorder: c.recorder}
+
+	if v := cr.Spec.ForProvider.PolicyValidation; v != nil {
+		analyzerCfg, err := connectaws.GetConfig(ctx, c.kube, mg, iam.PolicyValidationRegion(v))
+		if err != nil {
+			return nil, errors.Wrap(err, errValidatorConfig)
+		}
+		e.validator = iam.NewPolicyValidator(iam.NewAccessAnalyzerClient(*analyzerCfg), iam.NewPolicySimulatorClient(*cfg))
+	}
+	return e, nil
 }
 
 type external struct {
-	client iam.PolicyClient
-	sts    iam.STSClient
-	kube   client.Client
+	client    iam.PolicyClient
+	sts       iam.STSClient
+	kube      client.Client
+	recorder  event.Recorder
+	validator *iam.PolicyValidator
+
+	// policyDiff is the diff of the policy document that is observed before
+	// an update.
+	policyDiff string
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { //nolint:gocyclo
@@ -178,6 +202,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		crTagMap[v.Key] = v.Value
 	}
 	_, _, areRolesUpdated := iam.DiffIAMTags(crTagMap, policyResp.Policy.Tags)
+	e.policyDiff = diff
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -192,6 +217,10 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if err := e.validatePolicy(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	tags := cr.Spec.ForProvider.Tags
 	inputPolicyTags := make([]awsiamtypes.Tag, len(tags))
 	for i := range tags {
@@ -229,8 +258,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	// for an update request when 5 versions already exist.
 	// The new version is set as default.
 
+	if err := e.validatePolicy(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if e.policyDiff != "" {
+		e.recorder.Event(cr, event.Normal(policyutils.ReasonPolicyDiff, policyutils.WithDiff("Updating the policy document", e.policyDiff)))
+	}
+
 	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
-		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, policyutils.WithDiff(errUpdate, e.policyDiff))
 	}
 
 	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
@@ -240,7 +277,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	})
 
 	if err != nil {
-		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, policyutils.WithDiff(errUpdate, e.policyDiff))
 	}
 
 	observed, err := e.client.GetPolicy(ctx, &awsiam.GetPolicyInput{
@@ -292,6 +329,20 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
 
+// validatePolicy validates the policy document if a policy validation is
+// configured.
+func (e *external) validatePolicy(ctx context.Context, cr *v1beta1.Policy) error {
+	if e.validator == nil || cr.Spec.ForProvider.PolicyValidation == nil {
+		return nil
+	}
+	docs := []iam.ValidatedPolicyDocument{{
+		Name:     "spec.forProvider.document",
+		Document: cr.Spec.ForProvider.Document,
+		Type:     accessanalyzertypes.PolicyTypeIdentityPolicy,
+	}}
+	return e.validator.ValidateResource(ctx, cr, e.recorder, *cr.Spec.ForProvider.PolicyValidation, docs)
+}
+
 func (e *external) getCallerIdentityArn(ctx context.Context) (arn.ARN, error) {
 	resp, err := e.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
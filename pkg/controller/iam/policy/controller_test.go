@@ -21,12 +21,15 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/smithy-go/middleware"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -34,6 +37,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -41,6 +45,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 var (
@@ -973,3 +978,100 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePolicyValidation(t *testing.T) {
+	finding := accessanalyzertypes.ValidatePolicyFinding{
+		FindingType:    accessanalyzertypes.ValidatePolicyFindingTypeError,
+		IssueCode:      aws.String("INVALID_ACTION"),
+		FindingDetails: aws.String("The action elastic-inference:Connect does not exist."),
+	}
+	validation := &v1beta1.PolicyValidation{}
+	result := iam.PolicyValidationResult{Findings: []iam.PolicyFinding{{
+		Policy:    "spec.forProvider.document",
+		Type:      iam.FindingTypeError,
+		IssueCode: "INVALID_ACTION",
+		Details:   "The action elastic-inference:Connect does not exist.",
+	}}}
+
+	cr := policy(withSpec(v1beta1.PolicyParameters{Document: document, Name: name, PolicyValidation: validation}), withExternalName(policyArn))
+	e := &external{
+		client: &fake.MockPolicyClient{
+			MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+				t.Error("policy version must not be created if the validation fails")
+				return nil, errBoom
+			},
+		},
+		validator: iam.NewPolicyValidator(&fake.MockAccessAnalyzerClient{
+			MockValidatePolicy: func(ctx context.Context, input *accessanalyzer.ValidatePolicyInput, opts []func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+				return &accessanalyzer.ValidatePolicyOutput{Findings: []accessanalyzertypes.ValidatePolicyFinding{finding}}, nil
+			},
+		}, nil),
+		recorder: event.NewNopRecorder(),
+	}
+
+	_, err := e.Update(context.Background(), cr)
+	if diff := cmp.Diff(errors.Errorf("policy validation failed:\n%s", result.String()), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	want := policy(withSpec(v1beta1.PolicyParameters{Document: document, Name: name, PolicyValidation: validation}), withExternalName(policyArn), withConditions(result.Condition()))
+	if diff := cmp.Diff(want, cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestUpdatePolicyDiff(t *testing.T) {
+	policyDiff := "added actions: [Allow elastic-inference:Connect]"
+
+	cases := map[string]struct {
+		createErr error
+		want      error
+	}{
+		"Successful": {},
+		"CreateVersionError": {
+			createErr: errBoom,
+			want:      errorutils.Wrap(errBoom, errUpdate+" (policy diff: "+policyDiff+")"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &eventRecorder{}
+			e := &external{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
+					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return &awsiam.CreatePolicyVersionOutput{}, tc.createErr
+					},
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{Policy: &awsiamtypes.Policy{}}, nil
+					},
+				},
+				recorder:   rec,
+				policyDiff: policyDiff,
+			}
+
+			_, err := e.Update(context.Background(), policy(withExternalName(policyArn)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			want := []event.Event{event.Normal(policyutils.ReasonPolicyDiff, "Updating the policy document (policy diff: "+policyDiff+")")}
+			if diff := cmp.Diff(want, rec.events); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
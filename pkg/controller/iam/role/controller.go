@@ -18,8 +18,10 @@ package role
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -39,6 +41,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -53,11 +56,16 @@ const (
 
 	errKubeUpdateFailed = "cannot late initialize Role"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
+	errGetPolicies      = "cannot get the policies of the Role"
+	errUpdatePolicies   = "cannot update the policies of the Role"
+	errRemovePolicies   = "cannot remove the policies of the Role"
+	errValidatorConfig  = "cannot get the config of the policy validator"
 )
 
 // SetupRole adds a controller that reconciles Roles.
 func SetupRole(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.RoleGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -66,13 +74,13 @@ func SetupRole(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient, recorder: recorder}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -95,19 +103,35 @@ func SetupRole(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) iam.RoleClient
+	recorder    event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Role)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
 	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	e := &external{client: c.newClientFn(*cfg), kube: c.kube, recorder: c.recorder}
+
+	if v := cr.Spec.ForProvider.PolicyValidation; v != nil {
+		analyzerCfg, err := connectaws.GetConfig(ctx, c.kube, mg, iam.PolicyValidationRegion(v))
+		if err != nil {
+			return nil, errors.Wrap(err, errValidatorConfig)
+		}
+		e.validator = iam.NewPolicyValidator(iam.NewAccessAnalyzerClient(*analyzerCfg), iam.NewPolicySimulatorClient(*cfg))
+	}
+	return e, nil
 }
 
 type external struct {
-	client iam.RoleClient
-	kube   client.Client
+	client    iam.RoleClient
+	kube      client.Client
+	recorder  event.Recorder
+	validator *iam.PolicyValidator
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -146,6 +170,20 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	if managesPolicies(cr) {
+		changes, err := e.policyChanges(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errorutils.Wrap(err, errGetPolicies)
+		}
+		if !changes.IsEmpty() {
+			upToDate = false
+			if diff != "" {
+				diff += "\n"
+			}
+			diff += changes.String()
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
@@ -164,6 +202,10 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Creating())
 
+	if err := e.validatePolicies(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	_, err := e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
 	return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
 }
@@ -174,6 +216,10 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	if err := e.validatePolicies(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	observed, err := e.client.GetRole(ctx, &awsiam.GetRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	})
@@ -255,6 +301,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
 		}
 	}
+
+	if managesPolicies(cr) {
+		changes, err := e.policyChanges(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errGetPolicies)
+		}
+		if err := iam.ApplyRolePolicyChanges(ctx, e.client, meta.GetExternalName(cr), changes); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdatePolicies)
+		}
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -266,9 +322,67 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if managesPolicies(cr) {
+		if err := e.removePolicies(ctx, cr); err != nil {
+			return err
+		}
+	}
+
 	_, err := e.client.DeleteRole(ctx, &awsiam.DeleteRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	})
 
 	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+// managesPolicies returns true if the managed and inline policies of the role
+// are declared in its spec.
+func managesPolicies(cr *v1beta1.Role) bool {
+	p := cr.Spec.ForProvider
+	return iam.ManagesIdentityPolicies(p.ManagedPolicyARNs, p.InlinePolicies, p.Exclusive)
+}
+
+func (e *external) policyChanges(ctx context.Context, cr *v1beta1.Role) (iam.IdentityPolicyChanges, error) {
+	p := cr.Spec.ForProvider
+	observed, err := iam.GetRolePolicies(ctx, e.client, meta.GetExternalName(cr), p.InlinePolicies)
+	if err != nil {
+		return iam.IdentityPolicyChanges{}, err
+	}
+	return iam.DiffIdentityPolicies(p.ManagedPolicyARNs, p.InlinePolicies, pointer.BoolValue(p.Exclusive), observed), nil
+}
+
+// validatePolicies validates the trust policy and the inline policies of the
+// role if a policy validation is configured.
+func (e *external) validatePolicies(ctx context.Context, cr *v1beta1.Role) error {
+	if e.validator == nil || cr.Spec.ForProvider.PolicyValidation == nil {
+		return nil
+	}
+	p := cr.Spec.ForProvider
+	docs := []iam.ValidatedPolicyDocument{{
+		Name:         "spec.forProvider.assumeRolePolicyDocument",
+		Document:     p.AssumeRolePolicyDocument,
+		Type:         accessanalyzertypes.PolicyTypeResourcePolicy,
+		ResourceType: accessanalyzertypes.ValidatePolicyResourceTypeRoleTrust,
+	}}
+	for _, ip := range p.InlinePolicies {
+		docs = append(docs, iam.ValidatedPolicyDocument{
+			Name:     fmt.Sprintf("spec.forProvider.inlinePolicies[%s]", ip.PolicyName),
+			Document: ip.PolicyDocument,
+			Type:     accessanalyzertypes.PolicyTypeIdentityPolicy,
+		})
+	}
+	return e.validator.ValidateResource(ctx, cr, e.recorder, *p.PolicyValidation, docs)
+}
+
+// removePolicies detaches and deletes the policies that are declared in the
+// spec, or all policies in exclusive mode, since a role can only be deleted
+// once it has no policies left.
+func (e *external) removePolicies(ctx context.Context, cr *v1beta1.Role) error {
+	p := cr.Spec.ForProvider
+	observed, err := iam.GetRolePolicies(ctx, e.client, meta.GetExternalName(cr), nil)
+	if err != nil {
+		return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGetPolicies)
+	}
+	changes := iam.RemovableIdentityPolicies(p.ManagedPolicyARNs, p.InlinePolicies, pointer.BoolValue(p.Exclusive), observed)
+	return errorutils.Wrap(iam.ApplyRolePolicyChanges(ctx, e.client, meta.GetExternalName(cr), changes), errRemovePolicies)
+}
@@ -22,9 +22,11 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -58,12 +60,16 @@ var (
 		]
 	   }`
 
+	policyArn      = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+	otherPolicyArn = "arn:aws:iam::aws:policy/AdministratorAccess"
+
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	iam iam.RoleClient
-	cr  resource.Managed
+	iam       iam.RoleClient
+	validator *iam.PolicyValidator
+	cr        resource.Managed
 }
 
 type roleModifier func(*v1beta1.Role)
@@ -92,6 +98,25 @@ func withDescription() roleModifier {
 	}
 }
 
+func withManagedPolicies(arns ...string) roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.ManagedPolicyARNs = arns
+	}
+}
+
+func withExclusive() roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.Exclusive = aws.Bool(true)
+	}
+}
+
+func withPolicyValidation(v v1beta1.PolicyValidation) roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.AssumeRolePolicyDocument = policy
+		r.Spec.ForProvider.PolicyValidation = &v
+	}
+}
+
 func role(m ...roleModifier) *v1beta1.Role {
 	cr := &v1beta1.Role{}
 	for _, f := range m {
@@ -140,6 +165,44 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PolicyDrift": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: pointer.ToOrNilIfZeroValue(arn),
+							},
+						}, nil
+					},
+					MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+						return &awsiam.ListAttachedRolePoliciesOutput{
+							AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(policyArn)}, {PolicyArn: aws.String(otherPolicyArn)}},
+						}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withManagedPolicies(policyArn), withExclusive()),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withManagedPolicies(policyArn),
+					withExclusive(),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+					Diff: "spec.forProvider.managedPolicyArns: policies to detach [" + otherPolicyArn + "]",
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -246,11 +309,50 @@ func TestCreate(t *testing.T) {
 				err: errorutils.Wrap(errBoom, errCreate),
 			},
 		},
+		"PolicyValidationPassed": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockCreateRole: func(ctx context.Context, input *awsiam.CreateRoleInput, opts []func(*awsiam.Options)) (*awsiam.CreateRoleOutput, error) {
+						return &awsiam.CreateRoleOutput{}, nil
+					},
+				},
+				validator: iam.NewPolicyValidator(&fake.MockAccessAnalyzerClient{
+					MockValidatePolicy: func(ctx context.Context, input *accessanalyzer.ValidatePolicyInput, opts []func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+						return &accessanalyzer.ValidatePolicyOutput{}, nil
+					},
+				}, nil),
+				cr: role(withPolicyValidation(v1beta1.PolicyValidation{})),
+			},
+			want: want{
+				cr: role(withPolicyValidation(v1beta1.PolicyValidation{}),
+					withConditions(xpv1.Creating(), iam.PolicyValidationResult{}.Condition())),
+			},
+		},
+		"PolicyValidationFailed": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockCreateRole: func(ctx context.Context, input *awsiam.CreateRoleInput, opts []func(*awsiam.Options)) (*awsiam.CreateRoleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				validator: iam.NewPolicyValidator(&fake.MockAccessAnalyzerClient{
+					MockValidatePolicy: func(ctx context.Context, input *accessanalyzer.ValidatePolicyInput, opts []func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+						return &accessanalyzer.ValidatePolicyOutput{}, nil
+					},
+				}, nil),
+				cr: role(withPolicyValidation(v1beta1.PolicyValidation{ExpectedAllowedActions: []string{"s3:GetObject"}})),
+			},
+			want: want{
+				cr: role(withPolicyValidation(v1beta1.PolicyValidation{ExpectedAllowedActions: []string{"s3:GetObject"}}),
+					withConditions(xpv1.Creating(), iam.PolicyValidationResult{DeniedActions: []string{"s3:GetObject"}}.Condition())),
+				err: errors.New("policy validation failed:\nexpected allowed actions are denied [s3:GetObject]"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, validator: tc.validator, recorder: event.NewNopRecorder()}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -305,6 +407,66 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"AttachAndDetachPolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+						return &awsiam.ListAttachedRolePoliciesOutput{
+							AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(otherPolicyArn)}},
+						}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{}, nil
+					},
+					MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyArn) != policyArn {
+							return nil, errBoom
+						}
+						return &awsiam.AttachRolePolicyOutput{}, nil
+					},
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyArn) != otherPolicyArn {
+							return nil, errBoom
+						}
+						return &awsiam.DetachRolePolicyOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withManagedPolicies(policyArn), withExclusive()),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withManagedPolicies(policyArn), withExclusive()),
+			},
+		},
+		"AttachPolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+						return &awsiam.ListAttachedRolePoliciesOutput{}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{}, nil
+					},
+					MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withRoleName(&roleName), withManagedPolicies(policyArn)),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withManagedPolicies(policyArn)),
+				err: errorutils.Wrap(errBoom, errUpdatePolicies),
+			},
+		},
 		"ClientUpdateRoleError": {
 			args: args{
 				iam: &fake.MockRoleClient{
@@ -391,6 +553,34 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DetachPoliciesBeforeDelete": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+						return &awsiam.ListAttachedRolePoliciesOutput{
+							AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(policyArn)}, {PolicyArn: aws.String(otherPolicyArn)}},
+						}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{}, nil
+					},
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyArn) != policyArn {
+							return nil, errBoom
+						}
+						return &awsiam.DetachRolePolicyOutput{}, nil
+					},
+					MockDeleteRole: func(ctx context.Context, input *awsiam.DeleteRoleInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRoleOutput, error) {
+						return &awsiam.DeleteRoleOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withManagedPolicies(policyArn)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withManagedPolicies(policyArn),
+					withConditions(xpv1.Deleting())),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
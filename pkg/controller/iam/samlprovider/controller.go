@@ -0,0 +1,259 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SAMLProvider resource"
+
+	errGet                = "cannot get SAMLProvider in AWS"
+	errCreate             = "cannot create SAMLProvider in AWS"
+	errUpdate             = "cannot update SAMLProvider in AWS"
+	errDelete             = "cannot delete SAMLProvider in AWS"
+	errAddTags            = "cannot add tags to SAMLProvider in AWS"
+	errRemoveTags         = "cannot remove tags from SAMLProvider in AWS"
+	errGetConfigMap       = "cannot get SAML metadata document config map"
+	errNoMetadataKey      = "SAML metadata document config map does not contain the referenced key"
+	errNoMetadataDocument = "either samlMetadataDocument or samlMetadataDocumentConfigMapRef is required"
+)
+
+// SetupSAMLProvider adds a controller that reconciles SAMLProviders.
+func SetupSAMLProvider(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.SAMLProviderGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewSAMLProviderClient}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.SAMLProviderGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.SAMLProvider{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.SAMLProviderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client iam.SAMLProviderClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.SAMLProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	cr.Status.AtProvider = svcapitypes.SAMLProviderObservation{
+		ARN:        aws.String(meta.GetExternalName(cr)),
+		CreateDate: pointer.TimeToMetaTime(observed.CreateDate),
+		ValidUntil: pointer.TimeToMetaTime(observed.ValidUntil),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	document, err := e.metadataDocument(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	_, _, areTagsUpToDate := iam.DiffIAMTags(iam.AlphaTagMap(cr.Spec.ForProvider.Tags), observed.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: areTagsUpToDate && strings.TrimSpace(document) == strings.TrimSpace(aws.ToString(observed.SAMLMetadataDocument)),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.SAMLProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	document, err := e.metadataDocument(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	name := cr.GetName()
+	if cr.Spec.ForProvider.Name != nil {
+		name = *cr.Spec.ForProvider.Name
+	}
+	resp, err := e.client.CreateSAMLProvider(ctx, &awsiam.CreateSAMLProviderInput{
+		Name:                 aws.String(name),
+		SAMLMetadataDocument: aws.String(document),
+		Tags:                 iam.BuildAlphaIAMTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(resp.SAMLProviderArn))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.SAMLProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	arn := aws.String(meta.GetExternalName(cr))
+
+	observed, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{SAMLProviderArn: arn})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGet)
+	}
+
+	document, err := e.metadataDocument(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if strings.TrimSpace(document) != strings.TrimSpace(aws.ToString(observed.SAMLMetadataDocument)) {
+		if _, err := e.client.UpdateSAMLProvider(ctx, &awsiam.UpdateSAMLProviderInput{
+			SAMLProviderArn:      arn,
+			SAMLMetadataDocument: aws.String(document),
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		}
+	}
+
+	add, remove, _ := iam.DiffIAMTags(iam.AlphaTagMap(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagSAMLProvider(ctx, &awsiam.UntagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			TagKeys:         remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagSAMLProvider(ctx, &awsiam.TagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			Tags:            add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.SAMLProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteSAMLProvider(ctx, &awsiam.DeleteSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// metadataDocument returns the desired SAML metadata document, which is
+// read from the referenced config map if there is one.
+func (e *external) metadataDocument(ctx context.Context, cr *svcapitypes.SAMLProvider) (string, error) {
+	ref := cr.Spec.ForProvider.SAMLMetadataDocumentConfigMapRef
+	if ref == nil {
+		if cr.Spec.ForProvider.SAMLMetadataDocument == nil {
+			return "", errors.New(errNoMetadataDocument)
+		}
+		return *cr.Spec.ForProvider.SAMLMetadataDocument, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return "", errors.Wrap(err, errGetConfigMap)
+	}
+	if document, ok := cm.Data[ref.Key]; ok {
+		return document, nil
+	}
+	if document, ok := cm.BinaryData[ref.Key]; ok {
+		return string(document), nil
+	}
+	return "", errors.New(errNoMetadataKey)
+}
@@ -0,0 +1,420 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	providerArn    = "arn:aws:iam::123456789012:saml-provider/some-idp"
+	name           = "some-idp"
+	document       = "<EntityDescriptor>some</EntityDescriptor>"
+	otherDocument  = "<EntityDescriptor>other</EntityDescriptor>"
+	configMapKey   = "metadata.xml"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockSAMLProviderClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type samlProviderModifier func(*svcapitypes.SAMLProvider)
+
+func withConditions(c ...xpv1.Condition) samlProviderModifier {
+	return func(r *svcapitypes.SAMLProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withName(n string) samlProviderModifier {
+	return func(r *svcapitypes.SAMLProvider) { r.Name = n }
+}
+
+func withExternalName(n string) samlProviderModifier {
+	return func(r *svcapitypes.SAMLProvider) { meta.SetExternalName(r, n) }
+}
+
+func withDocument(d string) samlProviderModifier {
+	return func(r *svcapitypes.SAMLProvider) { r.Spec.ForProvider.SAMLMetadataDocument = aws.String(d) }
+}
+
+func withConfigMapRef() samlProviderModifier {
+	return func(r *svcapitypes.SAMLProvider) {
+		r.Spec.ForProvider.SAMLMetadataDocumentConfigMapRef = &common.ConfigMapKeySelector{
+			Name:      "idp-metadata",
+			Namespace: "default",
+			Key:       configMapKey,
+		}
+	}
+}
+
+func withAtProvider(o svcapitypes.SAMLProviderObservation) samlProviderModifier {
+	return func(r *svcapitypes.SAMLProvider) { r.Status.AtProvider = o }
+}
+
+func samlProvider(m ...samlProviderModifier) *svcapitypes.SAMLProvider {
+	cr := &svcapitypes.SAMLProvider{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getSAMLProvider(d string) func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+		return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(d)}, nil
+	}
+}
+
+func getConfigMap(data map[string]string) func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.ConfigMap).Data = data
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(document + "\n"),
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document), withExternalName(providerArn),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.SAMLProviderObservation{ARN: aws.String(providerArn)})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigMapDocumentChanged": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(document),
+				},
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{configMapKey: otherDocument}),
+				},
+				cr: samlProvider(withConfigMapRef(), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withConfigMapRef(), withExternalName(providerArn),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.SAMLProviderObservation{ARN: aws.String(providerArn)})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ConfigMapKeyMissing": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(document),
+				},
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{}),
+				},
+				cr: samlProvider(withConfigMapRef(), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withConfigMapRef(), withExternalName(providerArn),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.SAMLProviderObservation{ARN: aws.String(providerArn)})),
+				err: errors.New(errNoMetadataKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoDocument": {
+			args: args{
+				cr: samlProvider(withName(name)),
+			},
+			want: want{
+				cr:  samlProvider(withName(name)),
+				err: errors.New(errNoMetadataDocument),
+			},
+		},
+		"DocumentFromConfigMap": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						if aws.ToString(input.Name) != name || aws.ToString(input.SAMLMetadataDocument) != document {
+							return nil, errBoom
+						}
+						return &awsiam.CreateSAMLProviderOutput{SAMLProviderArn: aws.String(providerArn)}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{configMapKey: document}),
+				},
+				cr: samlProvider(withName(name), withConfigMapRef()),
+			},
+			want: want{
+				cr: samlProvider(withName(name), withConfigMapRef(), withExternalName(providerArn)),
+			},
+		},
+		"ConfigMapError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: samlProvider(withName(name), withConfigMapRef()),
+			},
+			want: want{
+				cr:  samlProvider(withName(name), withConfigMapRef()),
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withName(name), withDocument(document)),
+			},
+			want: want{
+				cr:  samlProvider(withName(name), withDocument(document)),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateDocument": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(otherDocument),
+					MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+						if aws.ToString(input.SAMLMetadataDocument) != document {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(otherDocument),
+					MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return &awsiam.DeleteSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicespecificcredential
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ServiceSpecificCredential resource"
+	errNoUserName       = "userName is required"
+
+	errList   = "cannot list ServiceSpecificCredentials in AWS"
+	errCreate = "cannot create ServiceSpecificCredential in AWS"
+	errUpdate = "cannot update ServiceSpecificCredential in AWS"
+	errDelete = "cannot delete ServiceSpecificCredential in AWS"
+)
+
+// SetupServiceSpecificCredential adds a controller that reconciles
+// ServiceSpecificCredentials.
+func SetupServiceSpecificCredential(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ServiceSpecificCredentialGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewServiceSpecificCredentialClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ServiceSpecificCredentialGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.ServiceSpecificCredential{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.ServiceSpecificCredentialClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client iam.ServiceSpecificCredentialClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	resp, err := e.client.ListServiceSpecificCredentials(ctx, &awsiam.ListServiceSpecificCredentialsInput{
+		UserName:    cr.Spec.ForProvider.UserName,
+		ServiceName: aws.String(cr.Spec.ForProvider.ServiceName),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
+	}
+
+	var observed *iamtypes.ServiceSpecificCredentialMetadata
+	for i := range resp.ServiceSpecificCredentials {
+		if aws.ToString(resp.ServiceSpecificCredentials[i].ServiceSpecificCredentialId) == meta.GetExternalName(cr) {
+			observed = &resp.ServiceSpecificCredentials[i]
+			break
+		}
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = svcapitypes.ServiceSpecificCredentialObservation{
+		ServiceSpecificCredentialID: observed.ServiceSpecificCredentialId,
+		ServiceUserName:             observed.ServiceUserName,
+		CreateDate:                  pointer.TimeToMetaTime(observed.CreateDate),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if aws.ToString(cr.Spec.ForProvider.UserName) == "" {
+		return managed.ExternalCreation{}, errors.New(errNoUserName)
+	}
+
+	resp, err := e.client.CreateServiceSpecificCredential(ctx, &awsiam.CreateServiceSpecificCredentialInput{
+		UserName:    cr.Spec.ForProvider.UserName,
+		ServiceName: aws.String(cr.Spec.ForProvider.ServiceName),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(resp.ServiceSpecificCredential.ServiceSpecificCredentialId))
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(resp.ServiceSpecificCredential.ServiceUserName)),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(resp.ServiceSpecificCredential.ServicePassword)),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.UpdateServiceSpecificCredential(ctx, &awsiam.UpdateServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(meta.GetExternalName(cr)),
+		UserName:                    cr.Spec.ForProvider.UserName,
+		Status:                      iamtypes.StatusType(desiredStatus(cr.Spec.ForProvider)),
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.ServiceSpecificCredential)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteServiceSpecificCredential(ctx, &awsiam.DeleteServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(meta.GetExternalName(cr)),
+		UserName:                    cr.Spec.ForProvider.UserName,
+	})
+	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func desiredStatus(p svcapitypes.ServiceSpecificCredentialParameters) string {
+	if p.Status == nil {
+		return string(iamtypes.StatusTypeActive)
+	}
+	return *p.Status
+}
+
+func isUpToDate(p svcapitypes.ServiceSpecificCredentialParameters, observed *iamtypes.ServiceSpecificCredentialMetadata) bool {
+	return desiredStatus(p) == string(observed.Status)
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicespecificcredential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem  resource.Managed
+	userName        = "some-user"
+	serviceName     = "codecommit.amazonaws.com"
+	credentialID    = "ACCAEXAMPLE"
+	serviceUserName = "some-user-at-123456789012"
+	servicePassword = "secret"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam *fake.MockServiceSpecificCredentialClient
+	cr  resource.Managed
+}
+
+type credentialModifier func(*svcapitypes.ServiceSpecificCredential)
+
+func withConditions(c ...xpv1.Condition) credentialModifier {
+	return func(r *svcapitypes.ServiceSpecificCredential) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) credentialModifier {
+	return func(r *svcapitypes.ServiceSpecificCredential) { meta.SetExternalName(r, name) }
+}
+
+func withStatus(s string) credentialModifier {
+	return func(r *svcapitypes.ServiceSpecificCredential) { r.Spec.ForProvider.Status = aws.String(s) }
+}
+
+func withAtProvider(o svcapitypes.ServiceSpecificCredentialObservation) credentialModifier {
+	return func(r *svcapitypes.ServiceSpecificCredential) { r.Status.AtProvider = o }
+}
+
+func credential(m ...credentialModifier) *svcapitypes.ServiceSpecificCredential {
+	cr := &svcapitypes.ServiceSpecificCredential{
+		Spec: svcapitypes.ServiceSpecificCredentialSpec{
+			ForProvider: svcapitypes.ServiceSpecificCredentialParameters{
+				UserName:    aws.String(userName),
+				ServiceName: serviceName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listCredentials(status iamtypes.StatusType) func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+		return &awsiam.ListServiceSpecificCredentialsOutput{
+			ServiceSpecificCredentials: []iamtypes.ServiceSpecificCredentialMetadata{{
+				ServiceSpecificCredentialId: aws.String(credentialID),
+				ServiceUserName:             aws.String(serviceUserName),
+				ServiceName:                 aws.String(serviceName),
+				UserName:                    aws.String(userName),
+				Status:                      status,
+			}},
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: credential(),
+			},
+			want: want{
+				cr: credential(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr:  credential(withExternalName(credentialID)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeActive),
+				},
+				cr: credential(withExternalName("other")),
+			},
+			want: want{
+				cr: credential(withExternalName("other")),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeActive),
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.ServiceSpecificCredentialObservation{
+						ServiceSpecificCredentialID: aws.String(credentialID),
+						ServiceUserName:             aws.String(serviceUserName),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StatusChanged": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeActive),
+				},
+				cr: credential(withExternalName(credentialID), withStatus("Inactive")),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID), withStatus("Inactive"),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.ServiceSpecificCredentialObservation{
+						ServiceSpecificCredentialID: aws.String(credentialID),
+						ServiceUserName:             aws.String(serviceUserName),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockCreateServiceSpecificCredential: func(ctx context.Context, input *awsiam.CreateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.CreateServiceSpecificCredentialOutput, error) {
+						return &awsiam.CreateServiceSpecificCredentialOutput{
+							ServiceSpecificCredential: &iamtypes.ServiceSpecificCredential{
+								ServiceSpecificCredentialId: aws.String(credentialID),
+								ServiceUserName:             aws.String(serviceUserName),
+								ServicePassword:             aws.String(servicePassword),
+							},
+						}, nil
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(serviceUserName),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(servicePassword),
+					},
+				},
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockCreateServiceSpecificCredential: func(ctx context.Context, input *awsiam.CreateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.CreateServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr:  credential(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Deactivate": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockUpdateServiceSpecificCredential: func(ctx context.Context, input *awsiam.UpdateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.UpdateServiceSpecificCredentialOutput, error) {
+						if input.Status != iamtypes.StatusTypeInactive || aws.ToString(input.ServiceSpecificCredentialId) != credentialID {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateServiceSpecificCredentialOutput{}, nil
+					},
+				},
+				cr: credential(withExternalName(credentialID), withStatus("Inactive")),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockUpdateServiceSpecificCredential: func(ctx context.Context, input *awsiam.UpdateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.UpdateServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockDeleteServiceSpecificCredential: func(ctx context.Context, input *awsiam.DeleteServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.DeleteServiceSpecificCredentialOutput, error) {
+						return &awsiam.DeleteServiceSpecificCredentialOutput{}, nil
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockDeleteServiceSpecificCredential: func(ctx context.Context, input *awsiam.DeleteServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.DeleteServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr:  credential(withExternalName(credentialID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -56,6 +57,10 @@ const (
 	errTag                           = "cannot tag the IAM User resource"
 	errUntag                         = "cannot remove tags from the IAM User resource"
 
+	errGetPolicies    = "cannot get the policies of the IAM User"
+	errUpdatePolicies = "cannot update the policies of the IAM User"
+	errRemovePolicies = "cannot remove the policies of the IAM User"
+
 	errKubeUpdateFailed = "cannot late initialize IAM User"
 )
 
@@ -72,6 +77,7 @@ func SetupUser(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserClient}),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -147,10 +153,22 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		UserID: aws.ToString(user.UserId),
 	}
 
-	return managed.ExternalObservation{
+	obs := managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: isUpToDate(cr, &user),
-	}, nil
+	}
+
+	if managesPolicies(cr) {
+		changes, err := e.policyChanges(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errorutils.Wrap(err, errGetPolicies)
+		}
+		if !changes.IsEmpty() {
+			obs.ResourceUpToDate = false
+			obs.Diff = changes.String()
+		}
+	}
+	return obs, nil
 }
 
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
@@ -198,6 +216,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	// take care of changes to Tags
 	err = e.updateTags(ctx, observed, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// take care of changes to the managed and inline policies
+	err = e.updatePolicies(ctx, cr)
 	return managed.ExternalUpdate{}, err
 }
 
@@ -209,6 +233,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if managesPolicies(cr) {
+		if err := e.removePolicies(ctx, cr); err != nil {
+			return err
+		}
+	}
+
 	_, err := e.client.DeleteUser(ctx, &awsiam.DeleteUserInput{
 		UserName: aws.String(meta.GetExternalName(cr)),
 	})
@@ -282,6 +312,46 @@ func (e *external) updateTags(ctx context.Context, observed *awsiam.GetUserOutpu
 	return nil
 }
 
+func (e *external) updatePolicies(ctx context.Context, cr *v1beta1.User) error {
+	if !managesPolicies(cr) {
+		return nil
+	}
+	changes, err := e.policyChanges(ctx, cr)
+	if err != nil {
+		return errorutils.Wrap(err, errGetPolicies)
+	}
+	return errorutils.Wrap(iam.ApplyUserPolicyChanges(ctx, e.client, meta.GetExternalName(cr), changes), errUpdatePolicies)
+}
+
+func (e *external) policyChanges(ctx context.Context, cr *v1beta1.User) (iam.IdentityPolicyChanges, error) {
+	p := cr.Spec.ForProvider
+	observed, err := iam.GetUserPolicies(ctx, e.client, meta.GetExternalName(cr), p.InlinePolicies)
+	if err != nil {
+		return iam.IdentityPolicyChanges{}, err
+	}
+	return iam.DiffIdentityPolicies(p.ManagedPolicyARNs, p.InlinePolicies, pointer.BoolValue(p.Exclusive), observed), nil
+}
+
+// removePolicies detaches and deletes the policies that are declared in the
+// spec, or all policies in exclusive mode, since a user can only be deleted
+// once it has no policies left.
+func (e *external) removePolicies(ctx context.Context, cr *v1beta1.User) error {
+	p := cr.Spec.ForProvider
+	observed, err := iam.GetUserPolicies(ctx, e.client, meta.GetExternalName(cr), nil)
+	if err != nil {
+		return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGetPolicies)
+	}
+	changes := iam.RemovableIdentityPolicies(p.ManagedPolicyARNs, p.InlinePolicies, pointer.BoolValue(p.Exclusive), observed)
+	return errorutils.Wrap(iam.ApplyUserPolicyChanges(ctx, e.client, meta.GetExternalName(cr), changes), errRemovePolicies)
+}
+
+// managesPolicies returns true if the managed and inline policies of the user
+// are declared in its spec.
+func managesPolicies(cr *v1beta1.User) bool {
+	p := cr.Spec.ForProvider
+	return iam.ManagesIdentityPolicies(p.ManagedPolicyARNs, p.InlinePolicies, p.Exclusive)
+}
+
 func isUpToDate(cr *v1beta1.User, user *types.User) bool {
 	// check path
 	isPathUpdated := aws.ToString(cr.Spec.ForProvider.Path) == aws.ToString(user.Path)
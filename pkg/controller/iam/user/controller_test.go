@@ -18,6 +18,7 @@ package user
 
 import (
 	"context"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -40,6 +41,10 @@ import (
 var (
 	unexpectedItem resource.Managed
 	userName       = "some user"
+	inlinePolicy   = v1beta1.InlinePolicy{
+		PolicyName:     "inline",
+		PolicyDocument: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+	}
 
 	errBoom = errors.New("boom")
 
@@ -110,6 +115,12 @@ func withBoundary(boundary *string) userModifier {
 	}
 }
 
+func withInlinePolicies(p ...v1beta1.InlinePolicy) userModifier {
+	return func(r *v1beta1.User) {
+		r.Spec.ForProvider.InlinePolicies = p
+	}
+}
+
 func user(m ...userModifier) *v1beta1.User {
 	cr := &v1beta1.User{}
 	for _, f := range m {
@@ -150,6 +161,62 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InlinePolicyUpToDate": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+					MockListAttachedUserPolicies: func(ctx context.Context, input *awsiam.ListAttachedUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedUserPoliciesOutput, error) {
+						return &awsiam.ListAttachedUserPoliciesOutput{}, nil
+					},
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return &awsiam.ListUserPoliciesOutput{PolicyNames: []string{inlinePolicy.PolicyName}}, nil
+					},
+					MockGetUserPolicy: func(ctx context.Context, input *awsiam.GetUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetUserPolicyOutput, error) {
+						return &awsiam.GetUserPolicyOutput{PolicyDocument: aws.String(url.QueryEscape(inlinePolicy.PolicyDocument))}, nil
+					},
+				},
+				cr: user(withExternalName(userName), withInlinePolicies(inlinePolicy)),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withInlinePolicies(inlinePolicy),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InlinePolicyMissing": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+					MockListAttachedUserPolicies: func(ctx context.Context, input *awsiam.ListAttachedUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedUserPoliciesOutput, error) {
+						return &awsiam.ListAttachedUserPoliciesOutput{}, nil
+					},
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return &awsiam.ListUserPoliciesOutput{}, nil
+					},
+				},
+				cr: user(withExternalName(userName), withInlinePolicies(inlinePolicy)),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withInlinePolicies(inlinePolicy),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "spec.forProvider.inlinePolicies: policies to put [inline]",
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
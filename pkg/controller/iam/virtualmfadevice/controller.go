@@ -0,0 +1,262 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmfadevice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a VirtualMFADevice resource"
+
+	errList       = "cannot list VirtualMFADevices in AWS"
+	errListTags   = "cannot list VirtualMFADevice tags in AWS"
+	errCreate     = "cannot create VirtualMFADevice in AWS"
+	errDeactivate = "cannot deactivate VirtualMFADevice in AWS"
+	errDelete     = "cannot delete VirtualMFADevice in AWS"
+	errAddTags    = "cannot add tags to VirtualMFADevice in AWS"
+	errRemoveTags = "cannot remove tags from VirtualMFADevice in AWS"
+
+	keySerialNumber     = "serialNumber"
+	keyBase32StringSeed = "base32StringSeed"
+	keyQRCodePNG        = "qrCodePNG"
+)
+
+// SetupVirtualMFADevice adds a controller that reconciles VirtualMFADevices.
+func SetupVirtualMFADevice(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.VirtualMFADeviceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewVirtualMFADeviceClient}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.VirtualMFADeviceGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.VirtualMFADevice{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.VirtualMFADeviceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client iam.VirtualMFADeviceClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	device, err := e.find(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errList)
+	}
+	if device == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = svcapitypes.VirtualMFADeviceObservation{
+		SerialNumber: device.SerialNumber,
+		EnableDate:   pointer.TimeToMetaTime(device.EnableDate),
+	}
+	if device.User != nil {
+		cr.Status.AtProvider.UserName = device.User.UserName
+	}
+	cr.SetConditions(xpv1.Available())
+
+	tags, err := e.client.ListMFADeviceTags(ctx, &awsiam.ListMFADeviceTagsInput{SerialNumber: device.SerialNumber})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errListTags)
+	}
+	_, _, areTagsUpToDate := iam.DiffIAMTags(iam.AlphaTagMap(cr.Spec.ForProvider.Tags), tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: areTagsUpToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	name := cr.GetName()
+	if cr.Spec.ForProvider.Name != nil {
+		name = *cr.Spec.ForProvider.Name
+	}
+	resp, err := e.client.CreateVirtualMFADevice(ctx, &awsiam.CreateVirtualMFADeviceInput{
+		VirtualMFADeviceName: aws.String(name),
+		Path:                 cr.Spec.ForProvider.Path,
+		Tags:                 iam.BuildAlphaIAMTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(resp.VirtualMFADevice.SerialNumber))
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			keySerialNumber:     []byte(aws.ToString(resp.VirtualMFADevice.SerialNumber)),
+			keyBase32StringSeed: resp.VirtualMFADevice.Base32StringSeed,
+			keyQRCodePNG:        resp.VirtualMFADevice.QRCodePNG,
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	serialNumber := aws.String(meta.GetExternalName(cr))
+
+	tags, err := e.client.ListMFADeviceTags(ctx, &awsiam.ListMFADeviceTagsInput{SerialNumber: serialNumber})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errListTags)
+	}
+
+	add, remove, _ := iam.DiffIAMTags(iam.AlphaTagMap(cr.Spec.ForProvider.Tags), tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagMFADevice(ctx, &awsiam.UntagMFADeviceInput{
+			SerialNumber: serialNumber,
+			TagKeys:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagMFADevice(ctx, &awsiam.TagMFADeviceInput{
+			SerialNumber: serialNumber,
+			Tags:         add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.VirtualMFADevice)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	serialNumber := aws.String(meta.GetExternalName(cr))
+
+	// A device that is assigned to an user can only be deleted after it has
+	// been deactivated.
+	if userName := cr.Status.AtProvider.UserName; userName != nil {
+		_, err := e.client.DeactivateMFADevice(ctx, &awsiam.DeactivateMFADeviceInput{
+			SerialNumber: serialNumber,
+			UserName:     userName,
+		})
+		if err := resource.Ignore(iam.IsErrorNotFound, err); err != nil {
+			return errorutils.Wrap(err, errDeactivate)
+		}
+	}
+
+	_, err := e.client.DeleteVirtualMFADevice(ctx, &awsiam.DeleteVirtualMFADeviceInput{
+		SerialNumber: serialNumber,
+	})
+	return errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// find returns the virtual MFA device with the given serial number or nil if
+// there is none.
+func (e *external) find(ctx context.Context, serialNumber string) (*iamtypes.VirtualMFADevice, error) {
+	var marker *string
+	for {
+		resp, err := e.client.ListVirtualMFADevices(ctx, &awsiam.ListVirtualMFADevicesInput{
+			AssignmentStatus: iamtypes.AssignmentStatusTypeAny,
+			Marker:           marker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.VirtualMFADevices {
+			if aws.ToString(resp.VirtualMFADevices[i].SerialNumber) == serialNumber {
+				return &resp.VirtualMFADevices[i], nil
+			}
+		}
+		if !resp.IsTruncated {
+			return nil, nil
+		}
+		marker = resp.Marker
+	}
+}
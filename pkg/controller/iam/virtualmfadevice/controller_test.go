@@ -0,0 +1,374 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmfadevice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	serialNumber   = "arn:aws:iam::123456789012:mfa/some-device"
+	name           = "some-device"
+	userName       = "some-user"
+	seed           = []byte("SEED")
+	qrCode         = []byte("PNG")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam *fake.MockVirtualMFADeviceClient
+	cr  resource.Managed
+}
+
+type deviceModifier func(*svcapitypes.VirtualMFADevice)
+
+func withConditions(c ...xpv1.Condition) deviceModifier {
+	return func(r *svcapitypes.VirtualMFADevice) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withName(n string) deviceModifier {
+	return func(r *svcapitypes.VirtualMFADevice) { r.Name = n }
+}
+
+func withExternalName(n string) deviceModifier {
+	return func(r *svcapitypes.VirtualMFADevice) { meta.SetExternalName(r, n) }
+}
+
+func withTags(tags ...svcapitypes.Tag) deviceModifier {
+	return func(r *svcapitypes.VirtualMFADevice) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withAtProvider(o svcapitypes.VirtualMFADeviceObservation) deviceModifier {
+	return func(r *svcapitypes.VirtualMFADevice) { r.Status.AtProvider = o }
+}
+
+func device(m ...deviceModifier) *svcapitypes.VirtualMFADevice {
+	cr := &svcapitypes.VirtualMFADevice{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listDevices(devices ...iamtypes.VirtualMFADevice) func(ctx context.Context, input *awsiam.ListVirtualMFADevicesInput, opts []func(*awsiam.Options)) (*awsiam.ListVirtualMFADevicesOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListVirtualMFADevicesInput, opts []func(*awsiam.Options)) (*awsiam.ListVirtualMFADevicesOutput, error) {
+		return &awsiam.ListVirtualMFADevicesOutput{VirtualMFADevices: devices}, nil
+	}
+}
+
+func listTags(tags ...iamtypes.Tag) func(ctx context.Context, input *awsiam.ListMFADeviceTagsInput, opts []func(*awsiam.Options)) (*awsiam.ListMFADeviceTagsOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListMFADeviceTagsInput, opts []func(*awsiam.Options)) (*awsiam.ListMFADeviceTagsOutput, error) {
+		return &awsiam.ListMFADeviceTagsOutput{Tags: tags}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: device(),
+			},
+			want: want{
+				cr: device(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: func(ctx context.Context, input *awsiam.ListVirtualMFADevicesInput, opts []func(*awsiam.Options)) (*awsiam.ListVirtualMFADevicesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: device(withExternalName(serialNumber)),
+			},
+			want: want{
+				cr:  device(withExternalName(serialNumber)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: listDevices(iamtypes.VirtualMFADevice{SerialNumber: aws.String("other")}),
+				},
+				cr: device(withExternalName(serialNumber)),
+			},
+			want: want{
+				cr: device(withExternalName(serialNumber)),
+			},
+		},
+		"AssignedAndUpToDate": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: listDevices(iamtypes.VirtualMFADevice{
+						SerialNumber: aws.String(serialNumber),
+						User:         &iamtypes.User{UserName: aws.String(userName)},
+					}),
+					MockListMFADeviceTags: listTags(iamtypes.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: device(withExternalName(serialNumber), withTags(svcapitypes.Tag{Key: aws.String("k"), Value: aws.String("v")})),
+			},
+			want: want{
+				cr: device(withExternalName(serialNumber), withTags(svcapitypes.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.VirtualMFADeviceObservation{
+						SerialNumber: aws.String(serialNumber),
+						UserName:     aws.String(userName),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: listDevices(iamtypes.VirtualMFADevice{SerialNumber: aws.String(serialNumber)}),
+					MockListMFADeviceTags:     listTags(),
+				},
+				cr: device(withExternalName(serialNumber), withTags(svcapitypes.Tag{Key: aws.String("k"), Value: aws.String("v")})),
+			},
+			want: want{
+				cr: device(withExternalName(serialNumber), withTags(svcapitypes.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+					withConditions(xpv1.Available()),
+					withAtProvider(svcapitypes.VirtualMFADeviceObservation{
+						SerialNumber: aws.String(serialNumber),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockCreateVirtualMFADevice: func(ctx context.Context, input *awsiam.CreateVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.CreateVirtualMFADeviceOutput, error) {
+						if aws.ToString(input.VirtualMFADeviceName) != name {
+							return nil, errBoom
+						}
+						return &awsiam.CreateVirtualMFADeviceOutput{
+							VirtualMFADevice: &iamtypes.VirtualMFADevice{
+								SerialNumber:     aws.String(serialNumber),
+								Base32StringSeed: seed,
+								QRCodePNG:        qrCode,
+							},
+						}, nil
+					},
+				},
+				cr: device(withName(name)),
+			},
+			want: want{
+				cr: device(withName(name), withExternalName(serialNumber)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						keySerialNumber:     []byte(serialNumber),
+						keyBase32StringSeed: seed,
+						keyQRCodePNG:        qrCode,
+					},
+				},
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockCreateVirtualMFADevice: func(ctx context.Context, input *awsiam.CreateVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.CreateVirtualMFADeviceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: device(withName(name)),
+			},
+			want: want{
+				cr:  device(withName(name)),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteDevice := func(ctx context.Context, input *awsiam.DeleteVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.DeleteVirtualMFADeviceOutput, error) {
+		return &awsiam.DeleteVirtualMFADeviceOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Unassigned": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockDeleteVirtualMFADevice: deleteDevice,
+				},
+				cr: device(withExternalName(serialNumber)),
+			},
+			want: want{
+				cr: device(withExternalName(serialNumber), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeactivateBeforeDelete": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockDeactivateMFADevice: func(ctx context.Context, input *awsiam.DeactivateMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.DeactivateMFADeviceOutput, error) {
+						if aws.ToString(input.UserName) != userName {
+							return nil, errBoom
+						}
+						return &awsiam.DeactivateMFADeviceOutput{}, nil
+					},
+					MockDeleteVirtualMFADevice: deleteDevice,
+				},
+				cr: device(withExternalName(serialNumber),
+					withAtProvider(svcapitypes.VirtualMFADeviceObservation{UserName: aws.String(userName)})),
+			},
+			want: want{
+				cr: device(withExternalName(serialNumber),
+					withAtProvider(svcapitypes.VirtualMFADeviceObservation{UserName: aws.String(userName)}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeactivateError": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockDeactivateMFADevice: func(ctx context.Context, input *awsiam.DeactivateMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.DeactivateMFADeviceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: device(withExternalName(serialNumber),
+					withAtProvider(svcapitypes.VirtualMFADeviceObservation{UserName: aws.String(userName)})),
+			},
+			want: want{
+				cr: device(withExternalName(serialNumber),
+					withAtProvider(svcapitypes.VirtualMFADeviceObservation{UserName: aws.String(userName)}),
+					withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDeactivate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
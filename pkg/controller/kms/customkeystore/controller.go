@@ -0,0 +1,440 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customkeystore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CustomKeyStore resource"
+	errCreateSession    = "cannot create a new session"
+	errCreate           = "cannot create CustomKeyStore in AWS"
+	errDescribe         = "failed to describe CustomKeyStore"
+	errUpdate           = "cannot update CustomKeyStore in AWS"
+	errConnect          = "cannot connect CustomKeyStore"
+	errDisconnect       = "cannot disconnect CustomKeyStore"
+	errDelete           = "failed to delete CustomKeyStore"
+	errGetSecret        = "cannot get secret"
+	errFmtKeyNotFound   = "key %s is not found in referenced Kubernetes secret"
+)
+
+// SetupCustomKeyStore adds a controller that reconciles CustomKeyStore.
+func SetupCustomKeyStore(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.CustomKeyStoreGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.CustomKeyStoreGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.CustomKeyStore{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+type external struct {
+	kube   client.Client
+	client svcsdkapi.KMSAPI
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.CustomKeyStore)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: svcsdk.New(sess)}, nil
+}
+
+func (e *external) describe(ctx context.Context, id string) (*svcsdk.CustomKeyStoresListEntry, error) {
+	resp, err := e.client.DescribeCustomKeyStoresWithContext(ctx, &svcsdk.DescribeCustomKeyStoresInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.CustomKeyStores) == 0 {
+		return nil, awserr.New(svcsdk.ErrCodeCustomKeyStoreNotFoundException, "", nil)
+	}
+	return resp.CustomKeyStores[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.CustomKeyStore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	store, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errorutils.Wrap(resource.Ignore(isNotFound, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = generateObservation(store)
+	setConditions(cr, store)
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(&cr.Spec.ForProvider, store)
+
+	accessKeyID, err := e.xksProxyAccessKeyID(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	diff := configDiff(cr, store, accessKeyID)
+	upToDate := diff == "" && isConnectionUpToDate(cr.Spec.ForProvider, store)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		Diff:                    diff,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.CustomKeyStore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	p := cr.Spec.ForProvider
+	input := &svcsdk.CreateCustomKeyStoreInput{
+		CustomKeyStoreName:             aws.String(cr.GetName()),
+		CustomKeyStoreType:             p.CustomKeyStoreType,
+		CloudHsmClusterId:              p.CloudHSMClusterID,
+		TrustAnchorCertificate:         p.TrustAnchorCertificate,
+		XksProxyConnectivity:           p.XksProxyConnectivity,
+		XksProxyUriEndpoint:            p.XksProxyURIEndpoint,
+		XksProxyUriPath:                p.XksProxyURIPath,
+		XksProxyVpcEndpointServiceName: p.XksProxyVPCEndpointServiceName,
+	}
+	if p.KeyStorePasswordSecretRef != nil {
+		pw, err := e.getSecretValue(ctx, p.KeyStorePasswordSecretRef)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		input.KeyStorePassword = aws.String(pw)
+	}
+	credential, err := e.xksProxyAuthenticationCredential(ctx, p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	input.XksProxyAuthenticationCredential = credential
+
+	resp, err := e.client.CreateCustomKeyStoreWithContext(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.CustomKeyStoreId))
+	return managed.ExternalCreation{}, nil
+}
+
+// Update applies configuration changes and connects or disconnects the key
+// store. Most configuration changes are rejected by KMS unless the key store
+// is disconnected, which is left to the user by setting connected to false.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { //nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.CustomKeyStore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+
+	store, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+	state := aws.StringValue(store.ConnectionState)
+
+	if !isConnected(p) && state != svcsdk.ConnectionStateTypeDisconnected && state != svcsdk.ConnectionStateTypeDisconnecting {
+		if _, err := e.client.DisconnectCustomKeyStoreWithContext(ctx, &svcsdk.DisconnectCustomKeyStoreInput{CustomKeyStoreId: id}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDisconnect)
+		}
+		state = svcsdk.ConnectionStateTypeDisconnecting
+	}
+
+	accessKeyID, err := e.xksProxyAccessKeyID(ctx, p)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if configDiff(cr, store, accessKeyID) != "" {
+		input := generateUpdateInput(cr, store, accessKeyID)
+		if input.XksProxyAuthenticationCredential != nil {
+			if input.XksProxyAuthenticationCredential, err = e.xksProxyAuthenticationCredential(ctx, p); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		// The password of a CloudHSM key store cannot be observed, it is
+		// sent along with other changes whenever KMS accepts it.
+		if p.KeyStorePasswordSecretRef != nil && state == svcsdk.ConnectionStateTypeDisconnected {
+			pw, err := e.getSecretValue(ctx, p.KeyStorePasswordSecretRef)
+			if err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+			input.KeyStorePassword = aws.String(pw)
+		}
+		if _, err := e.client.UpdateCustomKeyStoreWithContext(ctx, input); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		}
+	}
+
+	if isConnected(p) && state == svcsdk.ConnectionStateTypeDisconnected {
+		if _, err := e.client.ConnectCustomKeyStoreWithContext(ctx, &svcsdk.ConnectCustomKeyStoreInput{CustomKeyStoreId: id}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errConnect)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete disconnects the key store first, as KMS only deletes disconnected
+// key stores.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.CustomKeyStore)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	id := aws.String(meta.GetExternalName(cr))
+
+	switch aws.StringValue(cr.Status.AtProvider.ConnectionState) {
+	case svcsdk.ConnectionStateTypeDisconnecting:
+		return nil
+	case svcsdk.ConnectionStateTypeDisconnected:
+		_, err := e.client.DeleteCustomKeyStoreWithContext(ctx, &svcsdk.DeleteCustomKeyStoreInput{CustomKeyStoreId: id})
+		return errorutils.Wrap(resource.Ignore(isNotFound, err), errDelete)
+	default:
+		_, err := e.client.DisconnectCustomKeyStoreWithContext(ctx, &svcsdk.DisconnectCustomKeyStoreInput{CustomKeyStoreId: id})
+		return errorutils.Wrap(resource.Ignore(isNotFound, err), errDisconnect)
+	}
+}
+
+func (e *external) getSecretValue(ctx context.Context, ref *xpv1.SecretKeySelector) (string, error) {
+	sc := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sc); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	val, ok := sc.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errFmtKeyNotFound, ref.Key)
+	}
+	return string(val), nil
+}
+
+// xksProxyAccessKeyID returns the desired access key ID of the external key
+// store proxy credential, if any. Contrary to the secret access key it is
+// returned by KMS, so changes of the credential can be detected with it.
+func (e *external) xksProxyAccessKeyID(ctx context.Context, p svcapitypes.CustomKeyStoreParameters) (string, error) {
+	if p.XksProxyAuthenticationCredential == nil {
+		return "", nil
+	}
+	return e.getSecretValue(ctx, &p.XksProxyAuthenticationCredential.AccessKeyIDSecretRef)
+}
+
+func (e *external) xksProxyAuthenticationCredential(ctx context.Context, p svcapitypes.CustomKeyStoreParameters) (*svcsdk.XksProxyAuthenticationCredentialType, error) {
+	if p.XksProxyAuthenticationCredential == nil {
+		return nil, nil
+	}
+	id, err := e.getSecretValue(ctx, &p.XksProxyAuthenticationCredential.AccessKeyIDSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.getSecretValue(ctx, &p.XksProxyAuthenticationCredential.RawSecretAccessKeySecretRef)
+	if err != nil {
+		return nil, err
+	}
+	return &svcsdk.XksProxyAuthenticationCredentialType{
+		AccessKeyId:        aws.String(id),
+		RawSecretAccessKey: aws.String(key),
+	}, nil
+}
+
+func isConnected(p svcapitypes.CustomKeyStoreParameters) bool {
+	return ptr.Deref(p.Connected, true)
+}
+
+func generateObservation(store *svcsdk.CustomKeyStoresListEntry) svcapitypes.CustomKeyStoreObservation {
+	o := svcapitypes.CustomKeyStoreObservation{
+		CustomKeyStoreID:    store.CustomKeyStoreId,
+		ConnectionState:     store.ConnectionState,
+		ConnectionErrorCode: store.ConnectionErrorCode,
+	}
+	if store.CreationDate != nil {
+		t := metav1.NewTime(*store.CreationDate)
+		o.CreationDate = &t
+	}
+	return o
+}
+
+func setConditions(cr *svcapitypes.CustomKeyStore, store *svcsdk.CustomKeyStoresListEntry) {
+	switch aws.StringValue(store.ConnectionState) {
+	case svcsdk.ConnectionStateTypeConnected:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ConnectionStateTypeConnecting:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ConnectionStateTypeFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.StringValue(store.ConnectionErrorCode)))
+	case svcsdk.ConnectionStateTypeDisconnected:
+		// A disconnected key store is the desired state if it should not
+		// be connected.
+		if isConnected(cr.Spec.ForProvider) {
+			cr.SetConditions(xpv1.Unavailable())
+		} else {
+			cr.SetConditions(xpv1.Available())
+		}
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+}
+
+func lateInitialize(p *svcapitypes.CustomKeyStoreParameters, store *svcsdk.CustomKeyStoresListEntry) {
+	p.CustomKeyStoreType = pointer.LateInitialize(p.CustomKeyStoreType, store.CustomKeyStoreType)
+	p.CloudHSMClusterID = pointer.LateInitialize(p.CloudHSMClusterID, store.CloudHsmClusterId)
+	p.TrustAnchorCertificate = pointer.LateInitialize(p.TrustAnchorCertificate, store.TrustAnchorCertificate)
+	if xks := store.XksProxyConfiguration; xks != nil {
+		p.XksProxyConnectivity = pointer.LateInitialize(p.XksProxyConnectivity, xks.Connectivity)
+		p.XksProxyURIEndpoint = pointer.LateInitialize(p.XksProxyURIEndpoint, xks.UriEndpoint)
+		p.XksProxyURIPath = pointer.LateInitialize(p.XksProxyURIPath, xks.UriPath)
+		p.XksProxyVPCEndpointServiceName = pointer.LateInitialize(p.XksProxyVPCEndpointServiceName, xks.VpcEndpointServiceName)
+	}
+}
+
+// isConnectionUpToDate reports whether the connection state of the key store
+// matches the desired one. Transitional states and failed connections are
+// not acted upon, a failed connection is surfaced through the Ready condition
+// instead.
+func isConnectionUpToDate(p svcapitypes.CustomKeyStoreParameters, store *svcsdk.CustomKeyStoresListEntry) bool {
+	state := aws.StringValue(store.ConnectionState)
+	if isConnected(p) {
+		return state != svcsdk.ConnectionStateTypeDisconnected
+	}
+	return state == svcsdk.ConnectionStateTypeDisconnected || state == svcsdk.ConnectionStateTypeDisconnecting
+}
+
+// configDiff returns a description of the settings of the key store that
+// differ from the desired ones.
+func configDiff(cr *svcapitypes.CustomKeyStore, store *svcsdk.CustomKeyStoresListEntry, accessKeyID string) string {
+	u := generateUpdateInput(cr, store, accessKeyID)
+	if u.NewCustomKeyStoreName == nil && u.CloudHsmClusterId == nil && u.XksProxyConnectivity == nil &&
+		u.XksProxyUriEndpoint == nil && u.XksProxyUriPath == nil && u.XksProxyVpcEndpointServiceName == nil &&
+		u.XksProxyAuthenticationCredential == nil {
+		return ""
+	}
+	// Do not leak the access key ID.
+	if u.XksProxyAuthenticationCredential != nil {
+		u.XksProxyAuthenticationCredential = &svcsdk.XksProxyAuthenticationCredentialType{}
+	}
+	return u.String()
+}
+
+// generateUpdateInput returns an UpdateCustomKeyStoreInput containing only
+// the settings that differ. A changed credential is indicated by the new
+// access key ID, the secret access key has to be added by the caller.
+func generateUpdateInput(cr *svcapitypes.CustomKeyStore, store *svcsdk.CustomKeyStoresListEntry, accessKeyID string) *svcsdk.UpdateCustomKeyStoreInput {
+	p := cr.Spec.ForProvider
+	u := &svcsdk.UpdateCustomKeyStoreInput{CustomKeyStoreId: store.CustomKeyStoreId}
+	if cr.GetName() != aws.StringValue(store.CustomKeyStoreName) {
+		u.NewCustomKeyStoreName = aws.String(cr.GetName())
+	}
+	u.CloudHsmClusterId = differing(p.CloudHSMClusterID, store.CloudHsmClusterId)
+	xks := store.XksProxyConfiguration
+	if xks == nil {
+		xks = &svcsdk.XksProxyConfigurationType{}
+	}
+	u.XksProxyConnectivity = differing(p.XksProxyConnectivity, xks.Connectivity)
+	u.XksProxyUriEndpoint = differing(p.XksProxyURIEndpoint, xks.UriEndpoint)
+	u.XksProxyUriPath = differing(p.XksProxyURIPath, xks.UriPath)
+	u.XksProxyVpcEndpointServiceName = differing(p.XksProxyVPCEndpointServiceName, xks.VpcEndpointServiceName)
+	if accessKeyID != "" && accessKeyID != aws.StringValue(xks.AccessKeyId) {
+		u.XksProxyAuthenticationCredential = &svcsdk.XksProxyAuthenticationCredentialType{AccessKeyId: aws.String(accessKeyID)}
+	}
+	return u
+}
+
+// differing returns the desired value if it is set and differs from the
+// current one.
+func differing(desired, current *string) *string {
+	if desired == nil || aws.StringValue(desired) == aws.StringValue(current) {
+		return nil
+	}
+	return desired
+}
+
+func isNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == svcsdk.ErrCodeCustomKeyStoreNotFoundException
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customkeystore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+const (
+	storeID     = "cks-1234567890abcdef0"
+	storeName   = "test"
+	accessKeyID = "ABCDEFGHIJKLMNOPQRST"
+	secretKey   = "secret"
+)
+
+var errBoom = errors.New("boom")
+
+type mockKMSClient struct {
+	svcsdkapi.KMSAPI
+
+	MockDescribeCustomKeyStores  func(*svcsdk.DescribeCustomKeyStoresInput) (*svcsdk.DescribeCustomKeyStoresOutput, error)
+	MockUpdateCustomKeyStore     func(*svcsdk.UpdateCustomKeyStoreInput) (*svcsdk.UpdateCustomKeyStoreOutput, error)
+	MockConnectCustomKeyStore    func(*svcsdk.ConnectCustomKeyStoreInput) (*svcsdk.ConnectCustomKeyStoreOutput, error)
+	MockDisconnectCustomKeyStore func(*svcsdk.DisconnectCustomKeyStoreInput) (*svcsdk.DisconnectCustomKeyStoreOutput, error)
+	MockDeleteCustomKeyStore     func(*svcsdk.DeleteCustomKeyStoreInput) (*svcsdk.DeleteCustomKeyStoreOutput, error)
+}
+
+func (m *mockKMSClient) DescribeCustomKeyStoresWithContext(_ aws.Context, in *svcsdk.DescribeCustomKeyStoresInput, _ ...request.Option) (*svcsdk.DescribeCustomKeyStoresOutput, error) {
+	return m.MockDescribeCustomKeyStores(in)
+}
+
+func (m *mockKMSClient) UpdateCustomKeyStoreWithContext(_ aws.Context, in *svcsdk.UpdateCustomKeyStoreInput, _ ...request.Option) (*svcsdk.UpdateCustomKeyStoreOutput, error) {
+	return m.MockUpdateCustomKeyStore(in)
+}
+
+func (m *mockKMSClient) ConnectCustomKeyStoreWithContext(_ aws.Context, in *svcsdk.ConnectCustomKeyStoreInput, _ ...request.Option) (*svcsdk.ConnectCustomKeyStoreOutput, error) {
+	return m.MockConnectCustomKeyStore(in)
+}
+
+func (m *mockKMSClient) DisconnectCustomKeyStoreWithContext(_ aws.Context, in *svcsdk.DisconnectCustomKeyStoreInput, _ ...request.Option) (*svcsdk.DisconnectCustomKeyStoreOutput, error) {
+	return m.MockDisconnectCustomKeyStore(in)
+}
+
+func (m *mockKMSClient) DeleteCustomKeyStoreWithContext(_ aws.Context, in *svcsdk.DeleteCustomKeyStoreInput, _ ...request.Option) (*svcsdk.DeleteCustomKeyStoreOutput, error) {
+	return m.MockDeleteCustomKeyStore(in)
+}
+
+type storeModifier func(*svcapitypes.CustomKeyStore)
+
+func xksStore(m ...storeModifier) *svcapitypes.CustomKeyStore {
+	cr := &svcapitypes.CustomKeyStore{
+		Spec: svcapitypes.CustomKeyStoreSpec{
+			ForProvider: svcapitypes.CustomKeyStoreParameters{
+				Region:               "us-east-1",
+				CustomKeyStoreType:   aws.String(svcsdk.CustomKeyStoreTypeExternalKeyStore),
+				XksProxyConnectivity: aws.String(svcsdk.XksProxyConnectivityTypePublicEndpoint),
+				XksProxyURIEndpoint:  aws.String("https://myproxy.example.com"),
+				XksProxyURIPath:      aws.String("/kms/xks/v1"),
+				XksProxyAuthenticationCredential: &svcapitypes.XksProxyAuthenticationCredential{
+					AccessKeyIDSecretRef: xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "xks", Namespace: "default"},
+						Key:             "accessKeyID",
+					},
+					RawSecretAccessKeySecretRef: xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "xks", Namespace: "default"},
+						Key:             "rawSecretAccessKey",
+					},
+				},
+			},
+		},
+	}
+	cr.SetName(storeName)
+	meta.SetExternalName(cr, storeID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func xksEntry(state, accessKey string) *svcsdk.CustomKeyStoresListEntry {
+	return &svcsdk.CustomKeyStoresListEntry{
+		CustomKeyStoreId:   aws.String(storeID),
+		CustomKeyStoreName: aws.String(storeName),
+		CustomKeyStoreType: aws.String(svcsdk.CustomKeyStoreTypeExternalKeyStore),
+		ConnectionState:    aws.String(state),
+		XksProxyConfiguration: &svcsdk.XksProxyConfigurationType{
+			AccessKeyId:  aws.String(accessKey),
+			Connectivity: aws.String(svcsdk.XksProxyConnectivityTypePublicEndpoint),
+			UriEndpoint:  aws.String("https://myproxy.example.com"),
+			UriPath:      aws.String("/kms/xks/v1"),
+		},
+	}
+}
+
+func kubeWithSecret() client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s, ok := obj.(*corev1.Secret)
+			if !ok {
+				return errBoom
+			}
+			s.Data = map[string][]byte{
+				"accessKeyID":        []byte(accessKeyID),
+				"rawSecretAccessKey": []byte(secretKey),
+			}
+			return nil
+		},
+	}
+}
+
+func TestIsConnectionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		Connected *bool
+		State     string
+		Want      bool
+	}{
+		"ConnectedByDefault": {
+			State: svcsdk.ConnectionStateTypeConnected,
+			Want:  true,
+		},
+		"DisconnectedButShouldBeConnected": {
+			State: svcsdk.ConnectionStateTypeDisconnected,
+			Want:  false,
+		},
+		"FailedIsNotRetried": {
+			State: svcsdk.ConnectionStateTypeFailed,
+			Want:  true,
+		},
+		"ConnectedButShouldBeDisconnected": {
+			Connected: aws.Bool(false),
+			State:     svcsdk.ConnectionStateTypeConnected,
+			Want:      false,
+		},
+		"FailedButShouldBeDisconnected": {
+			Connected: aws.Bool(false),
+			State:     svcsdk.ConnectionStateTypeFailed,
+			Want:      false,
+		},
+		"Disconnecting": {
+			Connected: aws.Bool(false),
+			State:     svcsdk.ConnectionStateTypeDisconnecting,
+			Want:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isConnectionUpToDate(svcapitypes.CustomKeyStoreParameters{Connected: tc.Connected},
+				&svcsdk.CustomKeyStoresListEntry{ConnectionState: aws.String(tc.State)})
+			if diff := cmp.Diff(tc.Want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateInput(t *testing.T) {
+	cases := map[string]struct {
+		CR          *svcapitypes.CustomKeyStore
+		Store       *svcsdk.CustomKeyStoresListEntry
+		AccessKeyID string
+		Want        *svcsdk.UpdateCustomKeyStoreInput
+	}{
+		"NoChanges": {
+			CR:          xksStore(),
+			Store:       xksEntry(svcsdk.ConnectionStateTypeConnected, accessKeyID),
+			AccessKeyID: accessKeyID,
+			Want:        &svcsdk.UpdateCustomKeyStoreInput{CustomKeyStoreId: aws.String(storeID)},
+		},
+		"RenamedAndMoved": {
+			CR: xksStore(func(cr *svcapitypes.CustomKeyStore) {
+				cr.SetName("renamed")
+				cr.Spec.ForProvider.XksProxyURIPath = aws.String("/prefix/kms/xks/v1")
+			}),
+			Store:       xksEntry(svcsdk.ConnectionStateTypeDisconnected, accessKeyID),
+			AccessKeyID: accessKeyID,
+			Want: &svcsdk.UpdateCustomKeyStoreInput{
+				CustomKeyStoreId:      aws.String(storeID),
+				NewCustomKeyStoreName: aws.String("renamed"),
+				XksProxyUriPath:       aws.String("/prefix/kms/xks/v1"),
+			},
+		},
+		"RotatedCredential": {
+			CR:          xksStore(),
+			Store:       xksEntry(svcsdk.ConnectionStateTypeConnected, "TSRQPONMLKJIHGFEDCBA"),
+			AccessKeyID: accessKeyID,
+			Want: &svcsdk.UpdateCustomKeyStoreInput{
+				CustomKeyStoreId:                 aws.String(storeID),
+				XksProxyAuthenticationCredential: &svcsdk.XksProxyAuthenticationCredentialType{AccessKeyId: aws.String(accessKeyID)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateUpdateInput(tc.CR, tc.Store, tc.AccessKeyID)
+			if diff := cmp.Diff(tc.Want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		Calls  []string
+		Update *svcsdk.UpdateCustomKeyStoreInput
+		Err    error
+	}
+
+	cases := map[string]struct {
+		CR    *svcapitypes.CustomKeyStore
+		Store *svcsdk.CustomKeyStoresListEntry
+		Err   error
+		Want  want
+	}{
+		"Connect": {
+			CR:    xksStore(),
+			Store: xksEntry(svcsdk.ConnectionStateTypeDisconnected, accessKeyID),
+			Want:  want{Calls: []string{"connect"}},
+		},
+		"Disconnect": {
+			CR:    xksStore(func(cr *svcapitypes.CustomKeyStore) { cr.Spec.ForProvider.Connected = aws.Bool(false) }),
+			Store: xksEntry(svcsdk.ConnectionStateTypeConnected, accessKeyID),
+			Want:  want{Calls: []string{"disconnect"}},
+		},
+		"RotateCredential": {
+			CR:    xksStore(),
+			Store: xksEntry(svcsdk.ConnectionStateTypeConnected, "TSRQPONMLKJIHGFEDCBA"),
+			Want: want{
+				Calls: []string{"update"},
+				Update: &svcsdk.UpdateCustomKeyStoreInput{
+					CustomKeyStoreId: aws.String(storeID),
+					XksProxyAuthenticationCredential: &svcsdk.XksProxyAuthenticationCredentialType{
+						AccessKeyId:        aws.String(accessKeyID),
+						RawSecretAccessKey: aws.String(secretKey),
+					},
+				},
+			},
+		},
+		"UpdateBeforeConnect": {
+			CR: xksStore(func(cr *svcapitypes.CustomKeyStore) {
+				cr.Spec.ForProvider.XksProxyURIEndpoint = aws.String("https://other.example.com")
+			}),
+			Store: xksEntry(svcsdk.ConnectionStateTypeDisconnected, accessKeyID),
+			Want: want{
+				Calls: []string{"update", "connect"},
+				Update: &svcsdk.UpdateCustomKeyStoreInput{
+					CustomKeyStoreId:    aws.String(storeID),
+					XksProxyUriEndpoint: aws.String("https://other.example.com"),
+				},
+			},
+		},
+		"UpdateFailed": {
+			CR: xksStore(func(cr *svcapitypes.CustomKeyStore) {
+				cr.Spec.ForProvider.XksProxyURIEndpoint = aws.String("https://other.example.com")
+			}),
+			Store: xksEntry(svcsdk.ConnectionStateTypeConnected, accessKeyID),
+			Err:   errBoom,
+			Want: want{
+				Calls: []string{"update"},
+				Update: &svcsdk.UpdateCustomKeyStoreInput{
+					CustomKeyStoreId:    aws.String(storeID),
+					XksProxyUriEndpoint: aws.String("https://other.example.com"),
+				},
+				Err: errorutils.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			var update *svcsdk.UpdateCustomKeyStoreInput
+			e := &external{
+				kube: kubeWithSecret(),
+				client: &mockKMSClient{
+					MockDescribeCustomKeyStores: func(*svcsdk.DescribeCustomKeyStoresInput) (*svcsdk.DescribeCustomKeyStoresOutput, error) {
+						return &svcsdk.DescribeCustomKeyStoresOutput{CustomKeyStores: []*svcsdk.CustomKeyStoresListEntry{tc.Store}}, nil
+					},
+					MockUpdateCustomKeyStore: func(in *svcsdk.UpdateCustomKeyStoreInput) (*svcsdk.UpdateCustomKeyStoreOutput, error) {
+						calls = append(calls, "update")
+						update = in
+						return &svcsdk.UpdateCustomKeyStoreOutput{}, tc.Err
+					},
+					MockConnectCustomKeyStore: func(*svcsdk.ConnectCustomKeyStoreInput) (*svcsdk.ConnectCustomKeyStoreOutput, error) {
+						calls = append(calls, "connect")
+						return &svcsdk.ConnectCustomKeyStoreOutput{}, nil
+					},
+					MockDisconnectCustomKeyStore: func(*svcsdk.DisconnectCustomKeyStoreInput) (*svcsdk.DisconnectCustomKeyStoreOutput, error) {
+						calls = append(calls, "disconnect")
+						return &svcsdk.DisconnectCustomKeyStoreOutput{}, nil
+					},
+				},
+			}
+			_, err := e.Update(context.Background(), tc.CR)
+			if diff := cmp.Diff(tc.Want.Err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Update, update); diff != "" {
+				t.Errorf("update: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		State string
+		Want  []string
+	}{
+		"DisconnectFirst": {
+			State: svcsdk.ConnectionStateTypeConnected,
+			Want:  []string{"disconnect"},
+		},
+		"WaitForDisconnect": {
+			State: svcsdk.ConnectionStateTypeDisconnecting,
+		},
+		"Delete": {
+			State: svcsdk.ConnectionStateTypeDisconnected,
+			Want:  []string{"delete"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &mockKMSClient{
+				MockDisconnectCustomKeyStore: func(*svcsdk.DisconnectCustomKeyStoreInput) (*svcsdk.DisconnectCustomKeyStoreOutput, error) {
+					calls = append(calls, "disconnect")
+					return &svcsdk.DisconnectCustomKeyStoreOutput{}, nil
+				},
+				MockDeleteCustomKeyStore: func(*svcsdk.DeleteCustomKeyStoreInput) (*svcsdk.DeleteCustomKeyStoreOutput, error) {
+					calls = append(calls, "delete")
+					return &svcsdk.DeleteCustomKeyStoreOutput{}, nil
+				},
+			}}
+			cr := xksStore(func(cr *svcapitypes.CustomKeyStore) {
+				cr.Status.AtProvider.ConnectionState = aws.String(tc.State)
+			})
+			if err := e.Delete(context.Background(), cr); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.Want, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ func SetupKey(mgr ctrl.Manager, o controller.Options) error {
 		func(e *external) {
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.preCreate = preCreate
 			e.postCreate = postCreate
 			u := &updater{client: e.client}
 			e.update = u.update
@@ -101,6 +102,11 @@ func postObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKey
 	return obs, nil
 }
 
+func preCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyInput) error {
+	obj.CustomKeyStoreId = cr.Spec.ForProvider.CustomKeyStoreID
+	return nil
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyOutput, creation managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return creation, err
@@ -269,6 +275,7 @@ func (o *observer) lateInitialize(in *svcapitypes.KeyParameters, obj *svcsdk.Des
 	}
 
 	in.Enabled = pointer.LateInitialize(in.Enabled, obj.KeyMetadata.Enabled)
+	in.CustomKeyStoreID = pointer.LateInitialize(in.CustomKeyStoreID, obj.KeyMetadata.CustomKeyStoreId)
 
 	if len(in.Tags) == 0 {
 		resTags, err := o.client.ListResourceTags(&svcsdk.ListResourceTagsInput{
@@ -313,16 +320,8 @@ func (o *observer) isUpToDate(_ context.Context, cr *svcapitypes.Key, obj *svcsd
 	if err != nil {
 		return false, "", errorutils.Wrap(err, "cannot get key policy")
 	}
-	specPolicy, err := policy.ParsePolicyStringPtr(cr.Spec.ForProvider.Policy)
-	if err != nil {
-		return false, "", errors.Wrap(err, "cannot parse spec policy")
-	}
-	currentPolicy, err := policy.ParsePolicyStringPtr(resPolicy.Policy)
-	if err != nil {
-		return false, "", errors.Wrap(err, "cannot parse current policy")
-	}
-	if equal, diff := policy.ArePoliciesEqal(specPolicy, currentPolicy); !equal {
-		return false, "spec.forProvider.policy: " + diff, nil
+	if upToDate, diff, err := isPolicyUpToDate(cr.Spec.ForProvider.Policy, resPolicy.Policy); err != nil || !upToDate {
+		return false, diff, err
 	}
 
 	// EnableKeyRotation
@@ -347,6 +346,22 @@ func (o *observer) isUpToDate(_ context.Context, cr *svcapitypes.Key, obj *svcsd
 	return len(addTags) == 0 && len(removeTags) == 0, "", nil
 }
 
+// isPolicyUpToDate compares the desired and the current key policy
+// semantically, so that formatting differences introduced by AWS (whitespace,
+// statement element ordering, single values vs. lists) are not reported as
+// drift. If they differ, the statement-level diff is returned.
+func isPolicyUpToDate(spec, current *string) (bool, string, error) {
+	specPolicy, currentPolicy := pointer.StringValue(spec), pointer.StringValue(current)
+	if policy.ArePolicyDocumentsEqual(specPolicy, currentPolicy) {
+		return true, "", nil
+	}
+	d, err := policy.DiffPolicyDocuments(specPolicy, currentPolicy)
+	if err != nil {
+		return false, "", errors.Wrap(err, "cannot parse key policy")
+	}
+	return false, "spec.forProvider.policy: " + d.String(), nil
+}
+
 // returns which AWS Tags exist in the resource tags and which are outdated and should be removed
 func diffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk.Tag, remove []*string) {
 	addMap := make(map[string]string, len(spec))
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package key
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	testPolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`
+
+	// testPolicyReformatted is testPolicy as it is returned by AWS.
+	testPolicyReformatted = `{
+  "Version" : "2012-10-17",
+  "Statement" : [ {
+    "Sid" : "Root",
+    "Effect" : "Allow",
+    "Principal" : {
+      "AWS" : [ "arn:aws:iam::123456789012:root" ]
+    },
+    "Action" : [ "kms:*" ],
+    "Resource" : "*"
+  } ]
+}`
+
+	testPolicyOtherAction = `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:Decrypt","Resource":"*"}]}`
+)
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		diff     string
+		err      bool
+	}
+
+	cases := map[string]struct {
+		spec    *string
+		current *string
+		want    want
+	}{
+		"Equal": {
+			spec:    pointer.ToOrNilIfZeroValue(testPolicy),
+			current: pointer.ToOrNilIfZeroValue(testPolicy),
+			want:    want{upToDate: true},
+		},
+		"EqualIgnoringFormat": {
+			spec:    pointer.ToOrNilIfZeroValue(testPolicy),
+			current: pointer.ToOrNilIfZeroValue(testPolicyReformatted),
+			want:    want{upToDate: true},
+		},
+		"Different": {
+			spec:    pointer.ToOrNilIfZeroValue(testPolicy),
+			current: pointer.ToOrNilIfZeroValue(testPolicyOtherAction),
+			want: want{
+				diff: "spec.forProvider.policy: added statements: [Root]; removed statements: [Root]; added actions: [Allow kms:*]; removed actions: [Allow kms:Decrypt]",
+			},
+		},
+		"InvalidSpecPolicy": {
+			spec:    pointer.ToOrNilIfZeroValue("{"),
+			current: pointer.ToOrNilIfZeroValue(testPolicy),
+			want:    want{err: true},
+		},
+		"InvalidCurrentPolicy": {
+			spec:    pointer.ToOrNilIfZeroValue(testPolicy),
+			current: pointer.ToOrNilIfZeroValue("{"),
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, diff, err := isPolicyUpToDate(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.diff, diff); diff != "" {
+				t.Errorf("diff: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
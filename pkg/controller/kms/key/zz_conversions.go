@@ -65,11 +65,6 @@ func GenerateKey(resp *svcsdk.DescribeKeyOutput) *svcapitypes.Key {
 	} else {
 		cr.Status.AtProvider.CreationDate = nil
 	}
-	if resp.KeyMetadata.CustomKeyStoreId != nil {
-		cr.Spec.ForProvider.CustomKeyStoreID = resp.KeyMetadata.CustomKeyStoreId
-	} else {
-		cr.Spec.ForProvider.CustomKeyStoreID = nil
-	}
 	if resp.KeyMetadata.CustomerMasterKeySpec != nil {
 		cr.Spec.ForProvider.CustomerMasterKeySpec = resp.KeyMetadata.CustomerMasterKeySpec
 	} else {
@@ -226,9 +221,6 @@ func GenerateCreateKeyInput(cr *svcapitypes.Key) *svcsdk.CreateKeyInput {
 	if cr.Spec.ForProvider.BypassPolicyLockoutSafetyCheck != nil {
 		res.SetBypassPolicyLockoutSafetyCheck(*cr.Spec.ForProvider.BypassPolicyLockoutSafetyCheck)
 	}
-	if cr.Spec.ForProvider.CustomKeyStoreID != nil {
-		res.SetCustomKeyStoreId(*cr.Spec.ForProvider.CustomKeyStoreID)
-	}
 	if cr.Spec.ForProvider.CustomerMasterKeySpec != nil {
 		res.SetCustomerMasterKeySpec(*cr.Spec.ForProvider.CustomerMasterKeySpec)
 	}
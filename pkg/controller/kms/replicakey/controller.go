@@ -0,0 +1,397 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicakey
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsarn "github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+	tagutils "github.com/crossplane-contrib/provider-aws/pkg/utils/tags"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ReplicaKey resource"
+	errCreateSession    = "cannot create a new session"
+	errPrimaryKeyARN    = "spec.forProvider.primaryKeyArn must be the ARN of a multi-Region primary key"
+	errSameRegion       = "replica key must be in a different region than its primary key"
+	errCreate           = "cannot replicate Key in AWS"
+	errDescribe         = "failed to describe ReplicaKey"
+	errGetPolicy        = "cannot get key policy"
+	errListTags         = "cannot list tags"
+	errUpdate           = "cannot update ReplicaKey in AWS"
+	errDelete           = "failed to schedule ReplicaKey for deletion"
+
+	defaultPolicyName = "default"
+)
+
+// SetupReplicaKey adds a controller that reconciles ReplicaKey.
+func SetupReplicaKey(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ReplicaKeyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ReplicaKeyGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.ReplicaKey{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+// external manages the replica through a client in the region of the replica.
+// Replicas can only be created by calling ReplicateKey in the region of the
+// primary key, which is what the primary client is used for.
+type external struct {
+	client  svcsdkapi.KMSAPI
+	primary svcsdkapi.KMSAPI
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.ReplicaKey)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	primaryRegion, err := primaryKeyRegion(cr.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	primarySess, err := connectaws.GetConfigV1(ctx, c.kube, mg, primaryRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcsdk.New(sess), primary: svcsdk.New(primarySess)}, nil
+}
+
+// primaryKeyRegion returns the region of the primary key, which must differ
+// from the region of the replica.
+func primaryKeyRegion(p svcapitypes.ReplicaKeyParameters) (string, error) {
+	parsed, err := awsarn.Parse(pointer.StringValue(p.PrimaryKeyARN))
+	if err != nil || parsed.Region == "" {
+		return "", errors.New(errPrimaryKeyARN)
+	}
+	if parsed.Region == p.Region {
+		return "", errors.New(errSameRegion)
+	}
+	return parsed.Region, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { //nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.ReplicaKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	resp, err := e.client.DescribeKeyWithContext(ctx, &svcsdk.DescribeKeyInput{
+		KeyId: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errorutils.Wrap(resource.Ignore(isNotFound, err), errDescribe)
+	}
+	key := resp.KeyMetadata
+
+	cr.Status.AtProvider = generateObservation(key)
+
+	switch aws.StringValue(key.KeyState) {
+	case svcsdk.KeyStatePendingDeletion, svcsdk.KeyStatePendingReplicaDeletion:
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	case svcsdk.KeyStateCreating, svcsdk.KeyStateUpdating:
+		// The replica cannot be changed before it has been synchronized with
+		// the primary key.
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case svcsdk.KeyStateEnabled:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	currentPolicy, err := e.client.GetKeyPolicyWithContext(ctx, &svcsdk.GetKeyPolicyInput{
+		KeyId:      key.KeyId,
+		PolicyName: aws.String(defaultPolicyName),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetPolicy)
+	}
+	currentTags, err := e.client.ListResourceTagsWithContext(ctx, &svcsdk.ListResourceTagsInput{
+		KeyId: key.KeyId,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errListTags)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(&cr.Spec.ForProvider, key, currentPolicy.Policy, currentTags.Tags)
+
+	upToDate, diff := isUpToDate(cr.Spec.ForProvider, key, currentPolicy.Policy, currentTags.Tags)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		Diff:                    diff,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.ReplicaKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	input := &svcsdk.ReplicateKeyInput{
+		KeyId:                          cr.Spec.ForProvider.PrimaryKeyARN,
+		ReplicaRegion:                  aws.String(cr.Spec.ForProvider.Region),
+		Description:                    cr.Spec.ForProvider.Description,
+		Policy:                         cr.Spec.ForProvider.Policy,
+		BypassPolicyLockoutSafetyCheck: cr.Spec.ForProvider.BypassPolicyLockoutSafetyCheck,
+	}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		input.Tags = append(input.Tags, &svcsdk.Tag{TagKey: t.TagKey, TagValue: t.TagValue})
+	}
+	resp, err := e.primary.ReplicateKeyWithContext(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.ReplicaKeyMetadata.KeyId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { //nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.ReplicaKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	keyID := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+
+	if p.Description != nil {
+		if _, err := e.client.UpdateKeyDescriptionWithContext(ctx, &svcsdk.UpdateKeyDescriptionInput{
+			KeyId:       keyID,
+			Description: p.Description,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		}
+	}
+
+	if p.Policy != nil {
+		currentPolicy, err := e.client.GetKeyPolicyWithContext(ctx, &svcsdk.GetKeyPolicyInput{
+			KeyId:      keyID,
+			PolicyName: aws.String(defaultPolicyName),
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errGetPolicy)
+		}
+		if !policy.ArePolicyDocumentsEqual(aws.StringValue(p.Policy), aws.StringValue(currentPolicy.Policy)) {
+			if _, err := e.client.PutKeyPolicyWithContext(ctx, &svcsdk.PutKeyPolicyInput{
+				KeyId:                          keyID,
+				PolicyName:                     aws.String(defaultPolicyName),
+				Policy:                         p.Policy,
+				BypassPolicyLockoutSafetyCheck: p.BypassPolicyLockoutSafetyCheck,
+			}); err != nil {
+				return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+			}
+		}
+	}
+
+	if err := e.updateTags(ctx, keyID, p.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if p.Enabled != nil && aws.BoolValue(p.Enabled) != aws.BoolValue(cr.Status.AtProvider.Enabled) {
+		var err error
+		if aws.BoolValue(p.Enabled) {
+			_, err = e.client.EnableKeyWithContext(ctx, &svcsdk.EnableKeyInput{KeyId: keyID})
+		} else {
+			_, err = e.client.DisableKeyWithContext(ctx, &svcsdk.DisableKeyInput{KeyId: keyID})
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) updateTags(ctx context.Context, keyID *string, spec []*svcapitypes.Tag) error {
+	resp, err := e.client.ListResourceTagsWithContext(ctx, &svcsdk.ListResourceTagsInput{KeyId: keyID})
+	if err != nil {
+		return errorutils.Wrap(err, errListTags)
+	}
+	add, remove := tagutils.DiffTags(specTagMap(spec), sdkTagMap(resp.Tags))
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			KeyId:   keyID,
+			TagKeys: aws.StringSlice(remove),
+		}); err != nil {
+			return errorutils.Wrap(err, "cannot untag ReplicaKey")
+		}
+	}
+	if len(add) != 0 {
+		input := &svcsdk.TagResourceInput{KeyId: keyID}
+		for k, v := range add {
+			input.Tags = append(input.Tags, &svcsdk.Tag{TagKey: aws.String(k), TagValue: aws.String(v)})
+		}
+		if _, err := e.client.TagResourceWithContext(ctx, input); err != nil {
+			return errorutils.Wrap(err, "cannot tag ReplicaKey")
+		}
+	}
+	return nil
+}
+
+// Delete schedules the replica for deletion, it is removed by KMS after the
+// pending window.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.ReplicaKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.DeletionDate != nil {
+		return nil
+	}
+
+	_, err := e.client.ScheduleKeyDeletionWithContext(ctx, &svcsdk.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(meta.GetExternalName(cr)),
+		PendingWindowInDays: cr.Spec.ForProvider.PendingWindowInDays,
+	})
+	return errorutils.Wrap(resource.Ignore(isNotFound, err), errDelete)
+}
+
+func generateObservation(key *svcsdk.KeyMetadata) svcapitypes.ReplicaKeyObservation {
+	o := svcapitypes.ReplicaKeyObservation{
+		ARN:      key.Arn,
+		KeyID:    key.KeyId,
+		KeyState: key.KeyState,
+		Enabled:  key.Enabled,
+	}
+	if key.MultiRegionConfiguration != nil && key.MultiRegionConfiguration.PrimaryKey != nil {
+		o.PrimaryKeyARN = key.MultiRegionConfiguration.PrimaryKey.Arn
+	}
+	if key.DeletionDate != nil {
+		t := metav1.NewTime(*key.DeletionDate)
+		o.DeletionDate = &t
+	}
+	return o
+}
+
+func lateInitialize(p *svcapitypes.ReplicaKeyParameters, key *svcsdk.KeyMetadata, currentPolicy *string, currentTags []*svcsdk.Tag) {
+	p.Description = pointer.LateInitialize(p.Description, key.Description)
+	p.Enabled = pointer.LateInitialize(p.Enabled, key.Enabled)
+	p.Policy = pointer.LateInitialize(p.Policy, currentPolicy)
+	if len(p.Tags) == 0 {
+		for _, t := range currentTags {
+			p.Tags = append(p.Tags, &svcapitypes.Tag{TagKey: t.TagKey, TagValue: t.TagValue})
+		}
+	}
+}
+
+// isUpToDate compares the settings that belong to the replica itself. The
+// key material, key spec and usage are shared with the primary key and cannot
+// be changed through the replica.
+func isUpToDate(p svcapitypes.ReplicaKeyParameters, key *svcsdk.KeyMetadata, currentPolicy *string, currentTags []*svcsdk.Tag) (bool, string) {
+	switch {
+	case p.Description != nil && aws.StringValue(p.Description) != aws.StringValue(key.Description):
+		return false, "spec.forProvider.description: description differs"
+	case p.Enabled != nil && aws.BoolValue(p.Enabled) != aws.BoolValue(key.Enabled):
+		return false, "spec.forProvider.enabled: enabled differs"
+	case p.Policy != nil && !policy.ArePolicyDocumentsEqual(aws.StringValue(p.Policy), aws.StringValue(currentPolicy)):
+		return false, "spec.forProvider.policy: policy documents differ"
+	}
+	add, remove := tagutils.DiffTags(specTagMap(p.Tags), sdkTagMap(currentTags))
+	if len(add) != 0 || len(remove) != 0 {
+		return false, "spec.forProvider.tags: tags differ"
+	}
+	return true, ""
+}
+
+func specTagMap(tags []*svcapitypes.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
+	}
+	return m
+}
+
+func sdkTagMap(tags []*svcsdk.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
+	}
+	return m
+}
+
+func isNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == svcsdk.ErrCodeNotFoundException
+}
@@ -0,0 +1,448 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicakey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+const (
+	keyID      = "mrk-1234abcd12ab34cd56ef1234567890ab"
+	primaryARN = "arn:aws:kms:us-east-1:123456789012:key/" + keyID
+	replicaARN = "arn:aws:kms:eu-central-1:123456789012:key/" + keyID
+
+	testPolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`
+	// testPolicyReformatted is testPolicy as returned by KMS.
+	testPolicyReformatted = `{
+  "Version" : "2012-10-17",
+  "Statement" : [ {
+    "Sid" : "Enable IAM User Permissions",
+    "Effect" : "Allow",
+    "Principal" : {
+      "AWS" : [ "arn:aws:iam::123456789012:root" ]
+    },
+    "Action" : "kms:*",
+    "Resource" : "*"
+  } ]
+}`
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	deletionDate = metav1.Now()
+)
+
+type mockKMSClient struct {
+	svcsdkapi.KMSAPI
+
+	MockDescribeKey      func(*svcsdk.DescribeKeyInput) (*svcsdk.DescribeKeyOutput, error)
+	MockGetKeyPolicy     func(*svcsdk.GetKeyPolicyInput) (*svcsdk.GetKeyPolicyOutput, error)
+	MockListResourceTags func(*svcsdk.ListResourceTagsInput) (*svcsdk.ListResourceTagsOutput, error)
+	MockReplicateKey     func(*svcsdk.ReplicateKeyInput) (*svcsdk.ReplicateKeyOutput, error)
+	MockScheduleDeletion func(*svcsdk.ScheduleKeyDeletionInput) (*svcsdk.ScheduleKeyDeletionOutput, error)
+}
+
+func (m *mockKMSClient) DescribeKeyWithContext(_ aws.Context, in *svcsdk.DescribeKeyInput, _ ...request.Option) (*svcsdk.DescribeKeyOutput, error) {
+	return m.MockDescribeKey(in)
+}
+
+func (m *mockKMSClient) GetKeyPolicyWithContext(_ aws.Context, in *svcsdk.GetKeyPolicyInput, _ ...request.Option) (*svcsdk.GetKeyPolicyOutput, error) {
+	return m.MockGetKeyPolicy(in)
+}
+
+func (m *mockKMSClient) ListResourceTagsWithContext(_ aws.Context, in *svcsdk.ListResourceTagsInput, _ ...request.Option) (*svcsdk.ListResourceTagsOutput, error) {
+	return m.MockListResourceTags(in)
+}
+
+func (m *mockKMSClient) ReplicateKeyWithContext(_ aws.Context, in *svcsdk.ReplicateKeyInput, _ ...request.Option) (*svcsdk.ReplicateKeyOutput, error) {
+	return m.MockReplicateKey(in)
+}
+
+func (m *mockKMSClient) ScheduleKeyDeletionWithContext(_ aws.Context, in *svcsdk.ScheduleKeyDeletionInput, _ ...request.Option) (*svcsdk.ScheduleKeyDeletionOutput, error) {
+	return m.MockScheduleDeletion(in)
+}
+
+type replicaModifier func(*svcapitypes.ReplicaKey)
+
+func replica(m ...replicaModifier) *svcapitypes.ReplicaKey {
+	cr := &svcapitypes.ReplicaKey{
+		Spec: svcapitypes.ReplicaKeySpec{
+			ForProvider: svcapitypes.ReplicaKeyParameters{
+				Region:        "eu-central-1",
+				PrimaryKeyARN: aws.String(primaryARN),
+				Description:   aws.String("replica"),
+				Enabled:       aws.Bool(true),
+				Policy:        aws.String(testPolicy),
+				Tags:          []*svcapitypes.Tag{{TagKey: aws.String("k1"), TagValue: aws.String("v1")}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, keyID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func keyMetadata(state string) *svcsdk.KeyMetadata {
+	return &svcsdk.KeyMetadata{
+		Arn:         aws.String(replicaARN),
+		KeyId:       aws.String(keyID),
+		KeyState:    aws.String(state),
+		Enabled:     aws.Bool(state == svcsdk.KeyStateEnabled),
+		Description: aws.String("replica"),
+		MultiRegionConfiguration: &svcsdk.MultiRegionConfiguration{
+			PrimaryKey: &svcsdk.MultiRegionKey{Arn: aws.String(primaryARN), Region: aws.String("us-east-1")},
+		},
+	}
+}
+
+func observingClient(state, policy string, tags ...*svcsdk.Tag) *mockKMSClient {
+	return &mockKMSClient{
+		MockDescribeKey: func(*svcsdk.DescribeKeyInput) (*svcsdk.DescribeKeyOutput, error) {
+			return &svcsdk.DescribeKeyOutput{KeyMetadata: keyMetadata(state)}, nil
+		},
+		MockGetKeyPolicy: func(*svcsdk.GetKeyPolicyInput) (*svcsdk.GetKeyPolicyOutput, error) {
+			return &svcsdk.GetKeyPolicyOutput{Policy: aws.String(policy)}, nil
+		},
+		MockListResourceTags: func(*svcsdk.ListResourceTagsInput) (*svcsdk.ListResourceTagsOutput, error) {
+			return &svcsdk.ListResourceTagsOutput{Tags: tags}, nil
+		},
+	}
+}
+
+func TestPrimaryKeyRegion(t *testing.T) {
+	type want struct {
+		Region string
+		Err    error
+	}
+
+	cases := map[string]struct {
+		Params svcapitypes.ReplicaKeyParameters
+		Want   want
+	}{
+		"ARN": {
+			Params: replica().Spec.ForProvider,
+			Want:   want{Region: "us-east-1"},
+		},
+		"KeyID": {
+			Params: svcapitypes.ReplicaKeyParameters{Region: "eu-central-1", PrimaryKeyARN: aws.String(keyID)},
+			Want:   want{Err: errors.New(errPrimaryKeyARN)},
+		},
+		"SameRegion": {
+			Params: svcapitypes.ReplicaKeyParameters{Region: "us-east-1", PrimaryKeyARN: aws.String(primaryARN)},
+			Want:   want{Err: errors.New(errSameRegion)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			region, err := primaryKeyRegion(tc.Params)
+			if diff := cmp.Diff(tc.Want.Err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Region, region); diff != "" {
+				t.Errorf("region: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		Obs  managed.ExternalObservation
+		Cond xpv1.Condition
+		Err  error
+	}
+
+	tags := []*svcsdk.Tag{{TagKey: aws.String("k1"), TagValue: aws.String("v1")}}
+
+	cases := map[string]struct {
+		CR     *svcapitypes.ReplicaKey
+		Client *mockKMSClient
+		Want   want
+	}{
+		"NoExternalName": {
+			CR:     replica(func(cr *svcapitypes.ReplicaKey) { meta.SetExternalName(cr, "") }),
+			Client: &mockKMSClient{},
+			Want:   want{Obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"NotFound": {
+			CR: replica(),
+			Client: &mockKMSClient{
+				MockDescribeKey: func(*svcsdk.DescribeKeyInput) (*svcsdk.DescribeKeyOutput, error) {
+					return nil, awserr.New(svcsdk.ErrCodeNotFoundException, "", nil)
+				},
+			},
+			Want: want{Obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"DescribeFailed": {
+			CR: replica(),
+			Client: &mockKMSClient{
+				MockDescribeKey: func(*svcsdk.DescribeKeyInput) (*svcsdk.DescribeKeyOutput, error) {
+					return nil, errBoom
+				},
+			},
+			Want: want{Err: errorutils.Wrap(errBoom, errDescribe)},
+		},
+		"PendingDeletion": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStatePendingDeletion, testPolicy, tags...),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: false},
+				Cond: xpv1.Deleting(),
+			},
+		},
+		"Creating": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStateCreating, testPolicy, tags...),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Creating(),
+			},
+		},
+		"UpToDate": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStateEnabled, testPolicy, tags...),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Available(),
+			},
+		},
+		"ReformattedPolicyIsUpToDate": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStateEnabled, testPolicyReformatted, tags...),
+			Want: want{
+				Obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Cond: xpv1.Available(),
+			},
+		},
+		"ChangedPolicy": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStateEnabled, `{"Version":"2012-10-17","Statement":[]}`, tags...),
+			Want: want{
+				Obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "spec.forProvider.policy: policy documents differ",
+				},
+				Cond: xpv1.Available(),
+			},
+		},
+		"ChangedTags": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStateEnabled, testPolicy),
+			Want: want{
+				Obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "spec.forProvider.tags: tags differ",
+				},
+				Cond: xpv1.Available(),
+			},
+		},
+		"Disabled": {
+			CR:     replica(),
+			Client: observingClient(svcsdk.KeyStateDisabled, testPolicy, tags...),
+			Want: want{
+				Obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "spec.forProvider.enabled: enabled differs",
+				},
+				Cond: xpv1.Unavailable(),
+			},
+		},
+		"LateInitialized": {
+			CR: replica(func(cr *svcapitypes.ReplicaKey) {
+				cr.Spec.ForProvider.Description = nil
+				cr.Spec.ForProvider.Policy = nil
+			}),
+			Client: observingClient(svcsdk.KeyStateEnabled, testPolicy, tags...),
+			Want: want{
+				Obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				Cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.Client}
+			obs, err := e.Observe(context.Background(), tc.CR)
+			if diff := cmp.Diff(tc.Want.Err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Obs, obs); diff != "" {
+				t.Errorf("obs: -want, +got:\n%s", diff)
+			}
+			if tc.Want.Cond.Type != "" {
+				if diff := cmp.Diff(tc.Want.Cond, tc.CR.GetCondition(tc.Want.Cond.Type), test.EquateConditions()); diff != "" {
+					t.Errorf("cond: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		ExternalName string
+		Input        *svcsdk.ReplicateKeyInput
+		Err          error
+	}
+
+	cases := map[string]struct {
+		CR      *svcapitypes.ReplicaKey
+		Primary func(*svcsdk.ReplicateKeyInput) (*svcsdk.ReplicateKeyOutput, error)
+		Want    want
+	}{
+		"Successful": {
+			CR: replica(func(cr *svcapitypes.ReplicaKey) { meta.SetExternalName(cr, "") }),
+			Primary: func(*svcsdk.ReplicateKeyInput) (*svcsdk.ReplicateKeyOutput, error) {
+				return &svcsdk.ReplicateKeyOutput{ReplicaKeyMetadata: keyMetadata(svcsdk.KeyStateCreating)}, nil
+			},
+			Want: want{
+				ExternalName: keyID,
+				Input: &svcsdk.ReplicateKeyInput{
+					KeyId:         aws.String(primaryARN),
+					ReplicaRegion: aws.String("eu-central-1"),
+					Description:   aws.String("replica"),
+					Policy:        aws.String(testPolicy),
+					Tags:          []*svcsdk.Tag{{TagKey: aws.String("k1"), TagValue: aws.String("v1")}},
+				},
+			},
+		},
+		"Failed": {
+			CR: replica(func(cr *svcapitypes.ReplicaKey) { meta.SetExternalName(cr, "") }),
+			Primary: func(*svcsdk.ReplicateKeyInput) (*svcsdk.ReplicateKeyOutput, error) {
+				return nil, errBoom
+			},
+			Want: want{
+				Input: &svcsdk.ReplicateKeyInput{
+					KeyId:         aws.String(primaryARN),
+					ReplicaRegion: aws.String("eu-central-1"),
+					Description:   aws.String("replica"),
+					Policy:        aws.String(testPolicy),
+					Tags:          []*svcsdk.Tag{{TagKey: aws.String("k1"), TagValue: aws.String("v1")}},
+				},
+				Err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *svcsdk.ReplicateKeyInput
+			primary := &mockKMSClient{
+				MockReplicateKey: func(in *svcsdk.ReplicateKeyInput) (*svcsdk.ReplicateKeyOutput, error) {
+					got = in
+					return tc.Primary(in)
+				},
+			}
+			e := &external{client: &mockKMSClient{}, primary: primary}
+			_, err := e.Create(context.Background(), tc.CR)
+			if diff := cmp.Diff(tc.Want.Err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Input, got); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.ExternalName, meta.GetExternalName(tc.CR)); diff != "" {
+				t.Errorf("external name: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		Called bool
+		Err    error
+	}
+
+	cases := map[string]struct {
+		CR   *svcapitypes.ReplicaKey
+		Err  error
+		Want want
+	}{
+		"Scheduled": {
+			CR:   replica(func(cr *svcapitypes.ReplicaKey) { cr.Spec.ForProvider.PendingWindowInDays = aws.Int64(7) }),
+			Want: want{Called: true},
+		},
+		"AlreadyScheduled": {
+			CR: replica(func(cr *svcapitypes.ReplicaKey) {
+				cr.Status.AtProvider.DeletionDate = &deletionDate
+			}),
+			Want: want{Called: false},
+		},
+		"NotFound": {
+			CR:   replica(),
+			Err:  awserr.New(svcsdk.ErrCodeNotFoundException, "", nil),
+			Want: want{Called: true},
+		},
+		"Failed": {
+			CR:   replica(),
+			Err:  errBoom,
+			Want: want{Called: true, Err: errorutils.Wrap(errBoom, errDelete)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			e := &external{client: &mockKMSClient{
+				MockScheduleDeletion: func(in *svcsdk.ScheduleKeyDeletionInput) (*svcsdk.ScheduleKeyDeletionOutput, error) {
+					called = true
+					if diff := cmp.Diff(tc.CR.Spec.ForProvider.PendingWindowInDays, in.PendingWindowInDays); diff != "" {
+						t.Errorf("pending window: -want, +got:\n%s", diff)
+					}
+					return &svcsdk.ScheduleKeyDeletionOutput{}, tc.Err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.CR)
+			if diff := cmp.Diff(tc.Want.Err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.Want.Called, called); diff != "" {
+				t.Errorf("called: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
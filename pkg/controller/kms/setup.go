@@ -21,8 +21,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/alias"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/customkeystore"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/grant"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/key"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/replicakey"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -33,5 +35,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		alias.SetupAlias,
 		key.SetupKey,
 		grant.SetupGrant,
+		replicakey.SetupReplicaKey,
+		customkeystore.SetupCustomKeyStore,
 	)
 }
@@ -0,0 +1,212 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+const (
+	batchReplicationDescribeFailed = "cannot describe Bucket batch replication job"
+	batchReplicationCreateFailed   = "cannot create Bucket batch replication job"
+	batchReplicationNoRole         = "batch replication requires a role ARN"
+)
+
+// BatchReplicationClient is the client for replicating existing objects with
+// S3 Batch Replication when replication rules are added.
+type BatchReplicationClient struct {
+	client s3control.JobClient
+}
+
+// NewBatchReplicationClient creates the client for Batch Replication
+func NewBatchReplicationClient(client s3control.JobClient) *BatchReplicationClient {
+	return &BatchReplicationClient{client: client}
+}
+
+// RefreshJobs updates the status of the running Batch Replication jobs. It is
+// called before any subresource is observed, so that the status is refreshed
+// even if another subresource needs an update.
+func (in *BatchReplicationClient) RefreshJobs(ctx context.Context, bucket *v1beta1.Bucket) error {
+	br := getBatchReplication(bucket)
+	obs := bucket.Status.AtProvider.Replication
+	if br == nil || obs == nil {
+		return nil
+	}
+	for i, j := range obs.BatchReplicationJobs {
+		if s3control.IsJobTerminated(j) {
+			continue
+		}
+		external, err := in.client.DescribeJobWithContext(ctx, &svcsdk.DescribeJobInput{
+			AccountId: aws.String(br.AccountID),
+			JobId:     aws.String(j.JobID),
+		})
+		if err != nil {
+			return errorutils.Wrap(err, batchReplicationDescribeFailed)
+		}
+		if external.Job != nil {
+			obs.BatchReplicationJobs[i] = s3control.GenerateBatchReplicationJob(j, external.Job)
+		}
+	}
+	dropRemovedBatchReplicationRules(bucket)
+	return nil
+}
+
+// Observe checks if there are replication rules whose existing objects were
+// not replicated yet
+func (in *BatchReplicationClient) Observe(_ context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	if getBatchReplication(bucket) == nil {
+		return Updated, nil
+	}
+	if len(pendingBatchReplicationRules(bucket)) > 0 {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate creates a Batch Replication job for the replication rules
+// whose existing objects were not replicated yet
+func (in *BatchReplicationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	br := getBatchReplication(bucket)
+	if br == nil {
+		return nil
+	}
+	rules := pendingBatchReplicationRules(bucket)
+	if len(rules) == 0 {
+		return nil
+	}
+	if br.RoleARN == nil {
+		return errors.New(batchReplicationNoRole)
+	}
+	external, err := in.client.CreateJobWithContext(ctx, s3control.GenerateBatchReplicationJobInput(bucket.Status.AtProvider.ARN, bucket.GetGeneration(), rules, br))
+	if err != nil {
+		return errorutils.Wrap(err, batchReplicationCreateFailed)
+	}
+	if bucket.Status.AtProvider.Replication == nil {
+		bucket.Status.AtProvider.Replication = &v1beta1.ReplicationObservation{}
+	}
+	bucket.Status.AtProvider.Replication.BatchReplicationJobs = append(bucket.Status.AtProvider.Replication.BatchReplicationJobs, v1beta1.BatchReplicationJob{
+		JobID:   aws.StringValue(external.JobId),
+		RuleIDs: rules,
+	})
+	return nil
+}
+
+// Delete does not do anything since Batch Replication jobs cannot be deleted.
+func (*BatchReplicationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize does not do anything since Batch Replication jobs are only
+// created by the controller.
+func (*BatchReplicationClient) LateInitialize(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (*BatchReplicationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return getBatchReplication(bucket) != nil
+}
+
+func getBatchReplication(bucket *v1beta1.Bucket) *v1beta1.BatchReplication {
+	if bucket.Spec.ForProvider.ReplicationConfiguration == nil {
+		return nil
+	}
+	return bucket.Spec.ForProvider.ReplicationConfiguration.BatchReplication
+}
+
+// setInitialReplicationRules records the enabled replication rules of a bucket
+// that was just created. It has no objects yet, so no Batch Replication job is
+// needed for them.
+func setInitialReplicationRules(bucket *v1beta1.Bucket) {
+	rules := pendingBatchReplicationRules(bucket)
+	if len(rules) == 0 {
+		return
+	}
+	if bucket.Status.AtProvider.Replication == nil {
+		bucket.Status.AtProvider.Replication = &v1beta1.ReplicationObservation{}
+	}
+	bucket.Status.AtProvider.Replication.InitialRuleIDs = rules
+}
+
+// dropRemovedBatchReplicationRules forgets the replication rules that are no
+// longer configured, so that a job is created again if a rule with the same ID
+// is added later. Terminated jobs without any configured rule are dropped.
+func dropRemovedBatchReplicationRules(bucket *v1beta1.Bucket) {
+	configured := map[string]bool{}
+	for _, r := range bucket.Spec.ForProvider.ReplicationConfiguration.Rules {
+		if r.ID != nil {
+			configured[*r.ID] = true
+		}
+	}
+	obs := bucket.Status.AtProvider.Replication
+	obs.InitialRuleIDs = configuredRuleIDs(obs.InitialRuleIDs, configured)
+	var jobs []v1beta1.BatchReplicationJob
+	for _, j := range obs.BatchReplicationJobs {
+		j.RuleIDs = configuredRuleIDs(j.RuleIDs, configured)
+		if len(j.RuleIDs) == 0 && s3control.IsJobTerminated(j) {
+			continue
+		}
+		jobs = append(jobs, j)
+	}
+	obs.BatchReplicationJobs = jobs
+}
+
+func configuredRuleIDs(ids []string, configured map[string]bool) []string {
+	var res []string
+	for _, id := range ids {
+		if configured[id] {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// pendingBatchReplicationRules returns the sorted IDs of the enabled
+// replication rules that are neither initial rules of the bucket nor covered
+// by a Batch Replication job yet.
+func pendingBatchReplicationRules(bucket *v1beta1.Bucket) []string {
+	done := map[string]bool{}
+	if obs := bucket.Status.AtProvider.Replication; obs != nil {
+		for _, id := range obs.InitialRuleIDs {
+			done[id] = true
+		}
+		for _, j := range obs.BatchReplicationJobs {
+			for _, id := range j.RuleIDs {
+				done[id] = true
+			}
+		}
+	}
+	var rules []string
+	for _, r := range bucket.Spec.ForProvider.ReplicationConfiguration.Rules {
+		if r.ID == nil || r.Status != string(awss3types.ReplicationRuleStatusEnabled) || done[*r.ID] {
+			continue
+		}
+		rules = append(rules, *r.ID)
+	}
+	sort.Strings(rules)
+	return rules
+}
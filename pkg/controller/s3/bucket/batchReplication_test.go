@@ -0,0 +1,360 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	s3controlfake "github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	batchJobID                   = "batch-job"
+	_          SubresourceClient = &BatchReplicationClient{}
+)
+
+func generateBatchReplicationConfig(rules ...string) *v1beta1.ReplicationConfiguration {
+	config := &v1beta1.ReplicationConfiguration{
+		Role: &role,
+		BatchReplication: &v1beta1.BatchReplication{
+			AccountID: accountID,
+			RoleARN:   &role,
+		},
+	}
+	for i := range rules {
+		config.Rules = append(config.Rules, v1beta1.ReplicationRule{ID: &rules[i], Status: enabled})
+	}
+	return config
+}
+
+func TestBatchReplicationObserve(t *testing.T) {
+	type args struct {
+		cl *BatchReplicationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		obs    *v1beta1.ReplicationObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotConfigured": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithReplConfig(generateReplicationConfig())),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"PendingRules": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1"))),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"InitialRulesNotPending": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{InitialRuleIDs: []string{"rule-1"}}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				status: Updated,
+				obs:    &v1beta1.ReplicationObservation{InitialRuleIDs: []string{"rule-1"}},
+			},
+		},
+		"ReplicatedRulesNotPending": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1", "rule-2")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				obs: &v1beta1.ReplicationObservation{
+					BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.args.b.Status.AtProvider.Replication); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchReplicationRefreshJobs(t *testing.T) {
+	type args struct {
+		cl *BatchReplicationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		obs *v1beta1.ReplicationObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DescribeError": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{
+					MockDescribeJobWithContext: func(ctx context.Context, input *svcsdk.DescribeJobInput, opts ...request.Option) (*svcsdk.DescribeJobOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{
+					BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+				},
+				err: errorutils.Wrap(errBoom, batchReplicationDescribeFailed),
+			},
+		},
+		"JobStatusUpdated": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{
+					MockDescribeJobWithContext: func(ctx context.Context, input *svcsdk.DescribeJobInput, opts ...request.Option) (*svcsdk.DescribeJobOutput, error) {
+						return &svcsdk.DescribeJobOutput{Job: &svcsdk.JobDescriptor{
+							JobId:  aws.String(batchJobID),
+							Status: aws.String(svcsdk.JobStatusActive),
+							ProgressSummary: &svcsdk.JobProgressSummary{
+								TotalNumberOfTasks:     aws.Int64(10),
+								NumberOfTasksSucceeded: aws.Int64(4),
+								NumberOfTasksFailed:    aws.Int64(1),
+							},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{
+					BatchReplicationJobs: []v1beta1.BatchReplicationJob{{
+						JobID:                  batchJobID,
+						RuleIDs:                []string{"rule-1"},
+						Status:                 aws.String(svcsdk.JobStatusActive),
+						TotalNumberOfTasks:     aws.Int64(10),
+						NumberOfTasksSucceeded: aws.Int64(4),
+						NumberOfTasksFailed:    aws.Int64(1),
+					}},
+				},
+			},
+		},
+		"TerminatedJobNotDescribed": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}, Status: aws.String(svcsdk.JobStatusComplete)}},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{
+					BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}, Status: aws.String(svcsdk.JobStatusComplete)}},
+				},
+			},
+		},
+		"RemovedRulesDropped": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-3")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						InitialRuleIDs: []string{"rule-1", "rule-3"},
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{
+							{JobID: batchJobID, RuleIDs: []string{"rule-2"}, Status: aws.String(svcsdk.JobStatusComplete)},
+						},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{InitialRuleIDs: []string{"rule-3"}},
+			},
+		},
+		"TerminatedJobOfRemovedRuleDropped": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-2")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{
+							{JobID: batchJobID, RuleIDs: []string{"rule-1"}, Status: aws.String(svcsdk.JobStatusComplete)},
+						},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.RefreshJobs(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.args.b.Status.AtProvider.Replication); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchReplicationCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *BatchReplicationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		obs *v1beta1.ReplicationObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotConfigured": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithReplConfig(generateReplicationConfig())),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{},
+		},
+		"NoRole": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithReplConfig(func() *v1beta1.ReplicationConfiguration {
+					c := generateBatchReplicationConfig("rule-1")
+					c.BatchReplication.RoleARN = nil
+					return c
+				}())),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{}),
+			},
+			want: want{
+				err: errors.New(batchReplicationNoRole),
+			},
+		},
+		"CreateError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithReplConfig(generateBatchReplicationConfig("rule-1"))),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{
+					MockCreateJobWithContext: func(ctx context.Context, input *svcsdk.CreateJobInput, opts ...request.Option) (*svcsdk.CreateJobOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, batchReplicationCreateFailed),
+			},
+		},
+		"CreatePendingRules": {
+			args: args{
+				b: s3testing.Bucket(
+					s3testing.WithArn("arn:aws:s3:::bucket"),
+					s3testing.WithReplConfig(generateBatchReplicationConfig("rule-2", "rule-1", "rule-3")),
+					s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+				cl: NewBatchReplicationClient(&s3controlfake.MockJobClient{
+					MockCreateJobWithContext: func(ctx context.Context, input *svcsdk.CreateJobInput, opts ...request.Option) (*svcsdk.CreateJobOutput, error) {
+						if diff := cmp.Diff("arn:aws:s3:::bucket", aws.StringValue(input.ManifestGenerator.S3JobManifestGenerator.SourceBucket)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &svcsdk.CreateJobOutput{JobId: aws.String("new-job")}, nil
+					},
+				}),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{
+					BatchReplicationJobs: []v1beta1.BatchReplicationJob{
+						{JobID: batchJobID, RuleIDs: []string{"rule-1"}},
+						{JobID: "new-job", RuleIDs: []string{"rule-2", "rule-3"}},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.args.b.Status.AtProvider.Replication); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
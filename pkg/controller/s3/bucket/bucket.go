@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/aws/session"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -38,6 +39,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
@@ -64,7 +66,7 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: o.Logger.WithValues("controller", name)}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, newJobClientFn: s3control.NewJobClient, logger: o.Logger.WithValues("controller", name)}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -89,9 +91,10 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube        client.Client
-	newClientFn func(config aws.Config) s3.BucketClient
-	logger      logging.Logger
+	kube           client.Client
+	newClientFn    func(config aws.Config) s3.BucketClient
+	newJobClientFn func(sess *session.Session) s3control.JobClient
+	logger         logging.Logger
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -104,14 +107,25 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 	s3client := c.newClientFn(*cfg)
-	return &external{s3client: s3client, subresourceClients: NewSubresourceClients(s3client), kube: c.kube, logger: c.logger}, nil
+	subresourceClients := NewSubresourceClients(s3client)
+	var batchReplicationClient *BatchReplicationClient
+	if getBatchReplication(cr) != nil {
+		sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.LocationConstraint)
+		if err != nil {
+			return nil, err
+		}
+		batchReplicationClient = NewBatchReplicationClient(c.newJobClientFn(sess))
+		subresourceClients = append(subresourceClients, batchReplicationClient)
+	}
+	return &external{s3client: s3client, subresourceClients: subresourceClients, batchReplicationClient: batchReplicationClient, kube: c.kube, logger: c.logger}, nil
 }
 
 type external struct {
-	kube               client.Client
-	s3client           s3.BucketClient
-	logger             logging.Logger
-	subresourceClients []SubresourceClient
+	kube                   client.Client
+	s3client               s3.BucketClient
+	logger                 logging.Logger
+	subresourceClients     []SubresourceClient
+	batchReplicationClient *BatchReplicationClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { //nolint: gocyclo
@@ -131,7 +145,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err1
 	}
 
+	// The replication status is observed by the replication subresources and
+	// keeps track of the Batch Replication jobs across reconciles.
+	replication := cr.Status.AtProvider.Replication
 	cr.Status.AtProvider = s3.GenerateBucketObservation(meta.GetExternalName(cr), endpoint.PartitionID)
+	cr.Status.AtProvider.Replication = replication
 
 	lateInit := false
 	current := cr.Spec.ForProvider.DeepCopy()
@@ -151,6 +169,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		lateInit = true
 	}
 
+	if e.batchReplicationClient != nil {
+		if err := e.batchReplicationClient.RefreshJobs(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	for _, awsClient := range e.subresourceClients {
 		obs, err := awsClient.Observe(ctx, cr)
 		if err != nil {
@@ -204,6 +228,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if resource.Ignore(s3.IsAlreadyExists, err) != nil {
 		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
 	}
+	created := err == nil
 	current := cr.Spec.ForProvider.DeepCopy()
 
 	errs := make([]error, 0)
@@ -221,6 +246,14 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			errs = append(errs, errorutils.Wrap(err, errKubeUpdateFailed))
 		}
 	}
+	// The status is persisted right away since the reconciler only persists
+	// the annotations of the bucket after its creation.
+	if created && getBatchReplication(cr) != nil {
+		setInitialReplicationRules(cr)
+		if err := e.kube.Status().Update(ctx, cr); err != nil {
+			errs = append(errs, errorutils.Wrap(err, errKubeUpdateFailed))
+		}
+	}
 	if len(errs) != 0 {
 		return managed.ExternalCreation{}, k8serrors.NewAggregate(errs)
 	}
@@ -24,6 +24,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	awss3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/aws/smithy-go"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -38,6 +40,8 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	clients3 "github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	s3controlfake "github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
 	s3Testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
 )
@@ -50,6 +54,7 @@ var (
 
 type args struct {
 	s3   clients3.BucketClient
+	job  s3control.JobClient
 	kube client.Client
 	cr   resource.Managed
 }
@@ -258,11 +263,72 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"BatchReplicationJobRefreshedIfOutdated": {
+			args: args{
+				s3: s3Testing.Client(),
+				job: &s3controlfake.MockJobClient{
+					MockDescribeJobWithContext: func(ctx context.Context, input *svcsdk.DescribeJobInput, opts ...request.Option) (*svcsdk.DescribeJobOutput, error) {
+						return &svcsdk.DescribeJobOutput{Job: &svcsdk.JobDescriptor{Status: aws.String(svcsdk.JobStatusComplete)}}, nil
+					},
+				},
+				cr: s3Testing.Bucket(
+					s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3Testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3Testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}, Status: aws.String(svcsdk.JobStatusComplete)}},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"BatchReplicationJobDescribeError": {
+			args: args{
+				s3: s3Testing.Client(),
+				job: &s3controlfake.MockJobClient{
+					MockDescribeJobWithContext: func(ctx context.Context, input *svcsdk.DescribeJobInput, opts ...request.Option) (*svcsdk.DescribeJobOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: s3Testing.Bucket(
+					s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3Testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3Testing.WithReplObservation(&v1beta1.ReplicationObservation{
+						BatchReplicationJobs: []v1beta1.BatchReplicationJob{{JobID: batchJobID, RuleIDs: []string{"rule-1"}}},
+					}),
+				),
+				err: errorutils.Wrap(errBoom, batchReplicationDescribeFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, subresourceClients: NewSubresourceClients(tc.s3), kube: tc.kube}
+			subresourceClients := NewSubresourceClients(tc.s3)
+			var batchReplicationClient *BatchReplicationClient
+			if tc.job != nil {
+				batchReplicationClient = NewBatchReplicationClient(tc.job)
+				subresourceClients = append(subresourceClients, batchReplicationClient)
+			}
+			e := &external{s3client: tc.s3, subresourceClients: subresourceClients, batchReplicationClient: batchReplicationClient, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -454,6 +520,36 @@ func TestCreate(t *testing.T) {
 				err:    k8serrors.NewAggregate([]error{errorutils.Wrap(errBoom, errKubeUpdateFailed)}),
 			},
 		},
+		"NewBucketSkipsBatchReplication": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+				s3: s3Testing.Client(),
+				cr: s3Testing.Bucket(s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1"))),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1")),
+					s3Testing.WithReplObservation(&v1beta1.ReplicationObservation{InitialRuleIDs: []string{"rule-1"}}),
+				),
+			},
+		},
+		"ExistingBucketDoesNotSkipBatchReplication": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				s3: s3Testing.Client(s3Testing.WithCreateBucket(func(ctx context.Context, input *awss3.CreateBucketInput, opts []func(*awss3.Options)) (*awss3.CreateBucketOutput, error) {
+					return nil, &awss3types.BucketAlreadyOwnedByYou{}
+				})),
+				cr: s3Testing.Bucket(s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1"))),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithReplConfig(generateBatchReplicationConfig("rule-1"))),
+			},
+		},
 	}
 
 	for name, tc := range cases {
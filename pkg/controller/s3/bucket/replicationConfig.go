@@ -57,11 +57,17 @@ func (in *ReplicationConfigurationClient) Observe(ctx context.Context, bucket *v
 	external, err := in.client.GetBucketReplication(ctx, &awss3.GetBucketReplicationInput{Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket))})
 	config := bucket.Spec.ForProvider.ReplicationConfiguration
 	if err != nil {
-		if s3.ReplicationConfigurationNotFound(err) && config == nil {
-			return Updated, nil
+		if s3.ReplicationConfigurationNotFound(err) {
+			setReplicationRuleSettings(bucket, nil)
+			if config == nil {
+				return Updated, nil
+			}
 		}
 		return NeedsUpdate, errorutils.Wrap(resource.Ignore(s3.ReplicationConfigurationNotFound, err), replicationGetFailed)
 	}
+	if external != nil {
+		setReplicationRuleSettings(bucket, external.ReplicationConfiguration)
+	}
 
 	switch {
 	case (external == nil || external.ReplicationConfiguration == nil) && config != nil:
@@ -218,6 +224,44 @@ func createReplicationRulesFromExternal(external *types.ReplicationConfiguration
 	}
 }
 
+// setReplicationRuleSettings reports the configured replication metrics and
+// S3 RTC settings of the rules in the status of the bucket. The rest of the
+// replication status is kept.
+func setReplicationRuleSettings(bucket *v1beta1.Bucket, external *types.ReplicationConfiguration) {
+	var rules []v1beta1.ReplicationRuleSettings
+	if external != nil {
+		for _, rule := range external.Rules {
+			obs := v1beta1.ReplicationRuleSettings{
+				ID:     rule.ID,
+				Status: string(rule.Status),
+			}
+			if d := rule.Destination; d != nil {
+				obs.DestinationBucket = d.Bucket
+				if d.Metrics != nil {
+					obs.MetricsStatus = pointer.ToOrNilIfZeroValue(string(d.Metrics.Status))
+					if d.Metrics.EventThreshold != nil {
+						obs.EventThresholdMinutes = d.Metrics.EventThreshold.Minutes
+					}
+				}
+				if d.ReplicationTime != nil {
+					obs.ReplicationTimeStatus = pointer.ToOrNilIfZeroValue(string(d.ReplicationTime.Status))
+					if d.ReplicationTime.Time != nil {
+						obs.ReplicationTimeMinutes = d.ReplicationTime.Time.Minutes
+					}
+				}
+			}
+			rules = append(rules, obs)
+		}
+	}
+	if bucket.Status.AtProvider.Replication == nil {
+		if len(rules) == 0 {
+			return
+		}
+		bucket.Status.AtProvider.Replication = &v1beta1.ReplicationObservation{}
+	}
+	bucket.Status.AtProvider.Replication.RuleSettings = rules
+}
+
 func sortReplicationRules(rules []types.ReplicationRule) {
 
 	sort.Slice(rules, func(i, j int) bool {
@@ -913,3 +913,69 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestReplicationObservation(t *testing.T) {
+	type args struct {
+		b        *v1beta1.Bucket
+		external *s3types.ReplicationConfiguration
+	}
+
+	type want struct {
+		obs *v1beta1.ReplicationObservation
+	}
+
+	jobs := []v1beta1.BatchReplicationJob{{JobID: "job", RuleIDs: []string{id}}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoRules": {
+			args: args{
+				b: s3testing.Bucket(),
+			},
+			want: want{
+				obs: nil,
+			},
+		},
+		"Rules": {
+			args: args{
+				b:        s3testing.Bucket(),
+				external: generateAWSReplication(),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{
+					RuleSettings: []v1beta1.ReplicationRuleSettings{{
+						ID:                     &id,
+						Status:                 enabled,
+						DestinationBucket:      &bucketName,
+						MetricsStatus:          pointer.ToOrNilIfZeroValue(enabled),
+						EventThresholdMinutes:  ptr.To(int32(replicationTime)),
+						ReplicationTimeStatus:  pointer.ToOrNilIfZeroValue(enabled),
+						ReplicationTimeMinutes: ptr.To(int32(replicationTime)),
+					}},
+				},
+			},
+		},
+		"KeepJobs": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithReplObservation(&v1beta1.ReplicationObservation{
+					RuleSettings:         []v1beta1.ReplicationRuleSettings{{ID: &id, Status: enabled}},
+					BatchReplicationJobs: jobs,
+				})),
+			},
+			want: want{
+				obs: &v1beta1.ReplicationObservation{BatchReplicationJobs: jobs},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			setReplicationRuleSettings(tc.args.b, tc.args.external)
+			if diff := cmp.Diff(tc.want.obs, tc.args.b.Status.AtProvider.Replication); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
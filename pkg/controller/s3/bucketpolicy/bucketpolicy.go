@@ -40,6 +40,7 @@ import (
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -56,6 +57,7 @@ const (
 // BucketPolicies.
 func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha3.BucketPolicyGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -65,11 +67,11 @@ func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
-			newClientFn: s3.NewBucketPolicyClient}),
+			newClientFn: s3.NewBucketPolicyClient, recorder: recorder}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -92,6 +94,7 @@ func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) s3.BucketPolicyClient
+	recorder    event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -103,12 +106,16 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, recorder: c.recorder}, nil
 }
 
 type external struct {
-	client s3.BucketPolicyClient
-	kube   client.Client
+	client   s3.BucketPolicyClient
+	kube     client.Client
+	recorder event.Recorder
+
+	// policyDiff is the diff of the policy that is observed before an update.
+	policyDiff string
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -136,9 +143,18 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.SetConditions(xpv1.Available())
 
 	// If our version and the external version are the same, we return ResourceUpToDate: true
+	upToDate := cmp.Equal(*policyData, *resp.Policy)
+	e.policyDiff = ""
+	if !upToDate {
+		// A policy that cannot be parsed is reported without a diff.
+		if d, err := policyutils.DiffPolicyDocuments(*policyData, pointer.StringValue(resp.Policy)); err == nil {
+			e.policyDiff = d.String()
+		}
+	}
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(*policyData, *resp.Policy),
+		ResourceUpToDate: upToDate,
+		Diff:             e.policyDiff,
 	}, nil
 }
 
@@ -196,8 +212,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
 	}
 
+	if e.policyDiff != "" {
+		e.recorder.Event(cr, event.Normal(policyutils.ReasonPolicyDiff, policyutils.WithDiff("Updating the bucket policy", e.policyDiff)))
+	}
+
 	_, err = e.client.PutBucketPolicy(ctx, &awss3.PutBucketPolicyInput{Bucket: cr.Spec.Parameters.BucketName, Policy: pointer.ToOrNilIfZeroValue(*policyData)})
-	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, policyutils.WithDiff(errUpdate, e.policyDiff))
 }
 
 // Delete removes the existing policy for a bucket
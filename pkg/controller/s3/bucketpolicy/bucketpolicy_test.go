@@ -42,6 +42,7 @@ var (
 	unexpectedItem resource.Managed
 	bucketName     = "test.s3.crossplane.com"
 	policy         = `{"Statement":[{"Action":"s3:ListBucket","Effect":"Allow","Principal":"*","Resource":"arn:aws:s3:::test.s3.crossplane.com"}],"Version":"2012-10-17"}`
+	otherPolicy    = `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"arn:aws:s3:::test.s3.crossplane.com"}],"Version":"2012-10-17"}`
 
 	params = v1alpha3.BucketPolicyParameters{
 		Policy: &common.BucketPolicyBody{
@@ -125,6 +126,27 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PolicyDiffers": {
+			args: args{
+				s3: &fake.MockBucketPolicyClient{
+					MockGetBucketPolicy: func(ctx context.Context, input *awss3.GetBucketPolicyInput, opts []func(*awss3.Options)) (*awss3.GetBucketPolicyOutput, error) {
+						return &awss3.GetBucketPolicyOutput{
+							Policy: &otherPolicy,
+						}, nil
+					},
+				},
+				cr: bucketPolicy(withPolicy(&params)),
+			},
+			want: want{
+				cr: bucketPolicy(withPolicy(&params),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "added statements: [#0 Allow [s3:ListBucket]]; removed statements: [#0 Allow [s3:GetObject]]; added actions: [Allow s3:ListBucket]; removed actions: [Allow s3:GetObject]",
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -0,0 +1,309 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Object resource"
+
+	errHead              = "cannot get Object in AWS"
+	errPut               = "cannot put Object in AWS"
+	errDelete            = "cannot delete Object in AWS"
+	errGetTagging        = "cannot get tags of Object in AWS"
+	errPutTagging        = "cannot put tags of Object in AWS"
+	errDeleteTagging     = "cannot delete tags of Object in AWS"
+	errPutRetention      = "cannot put object lock retention of Object in AWS"
+	errGetConfigMap      = "cannot get the config map holding the object content"
+	errGetSecret         = "cannot get the secret holding the object content"
+	errFmtKeyNotFound    = "key %s is not found in the referenced %s"
+	errNoContent         = "one of content, contentConfigMapRef or contentSecretRef is required"
+	errOnlyOneContent    = "only one of content, contentConfigMapRef or contentSecretRef must be set"
+	contentKindConfigMap = "config map"
+	contentKindSecret    = "secret"
+)
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.ObjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewObjectClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha3.ObjectGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha3.Object{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client s3.ObjectClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	head, err := e.head(ctx, cr)
+	if s3.IsObjectNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errHead)
+	}
+
+	cr.Status.AtProvider = s3.GenerateObjectObservation(head)
+	cr.SetConditions(xpv1.Available())
+
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	tagging, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetTagging)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: s3.IsObjectContentUpToDate(content, head) &&
+			s3.IsObjectMetadataUpToDate(cr.Spec.ForProvider, head) &&
+			s3.IsObjectRetentionUpToDate(cr.Spec.ForProvider, head) &&
+			s3.AreObjectTagsUpToDate(cr.Spec.ForProvider.Tags, tagging.TagSet),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if _, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(cr.Spec.ForProvider, content)); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errPut)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Key)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+
+	head, err := e.head(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errHead)
+	}
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// A new upload replaces the content, the metadata, the tags and the
+	// retention at once. In versioned buckets the previous content remains
+	// available as a noncurrent version.
+	if !s3.IsObjectContentUpToDate(content, head) || !s3.IsObjectMetadataUpToDate(p, head) {
+		_, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(p, content))
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errPut)
+	}
+
+	tagging, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{Bucket: p.Bucket, Key: aws.String(p.Key)})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGetTagging)
+	}
+	if !s3.AreObjectTagsUpToDate(p.Tags, tagging.TagSet) {
+		if err := e.updateTags(ctx, p); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if !s3.IsObjectRetentionUpToDate(p, head) {
+		if _, err := e.client.PutObjectRetention(ctx, &awss3.PutObjectRetentionInput{
+			Bucket: p.Bucket,
+			Key:    aws.String(p.Key),
+			Retention: &s3types.ObjectLockRetention{
+				Mode:            s3types.ObjectLockRetentionMode(p.ObjectLockRetention.Mode),
+				RetainUntilDate: aws.Time(p.ObjectLockRetention.RetainUntilDate.Time),
+			},
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutRetention)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the Object without a version ID. In versioned buckets S3
+// only adds a delete marker, so the previous versions remain recoverable.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	return errorutils.Wrap(resource.Ignore(s3.IsObjectNotFound, err), errDelete)
+}
+
+// head returns the metadata of the Object including its checksum.
+func (e *external) head(ctx context.Context, cr *v1alpha3.Object) (*awss3.HeadObjectOutput, error) {
+	return e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket:       cr.Spec.ForProvider.Bucket,
+		Key:          aws.String(cr.Spec.ForProvider.Key),
+		ChecksumMode: s3types.ChecksumModeEnabled,
+	})
+}
+
+func (e *external) updateTags(ctx context.Context, p v1alpha3.ObjectParameters) error {
+	if len(p.Tags) == 0 {
+		_, err := e.client.DeleteObjectTagging(ctx, &awss3.DeleteObjectTaggingInput{Bucket: p.Bucket, Key: aws.String(p.Key)})
+		return errorutils.Wrap(err, errDeleteTagging)
+	}
+	_, err := e.client.PutObjectTagging(ctx, &awss3.PutObjectTaggingInput{
+		Bucket:  p.Bucket,
+		Key:     aws.String(p.Key),
+		Tagging: &s3types.Tagging{TagSet: s3.CopyTags(p.Tags)},
+	})
+	return errorutils.Wrap(err, errPutTagging)
+}
+
+// content returns the desired content of the Object from either the spec,
+// the referenced config map or the referenced secret.
+func (e *external) content(ctx context.Context, cr *v1alpha3.Object) ([]byte, error) {
+	p := cr.Spec.ForProvider
+	set := 0
+	for _, ok := range []bool{p.Content != nil, p.ContentConfigMapRef != nil, p.ContentSecretRef != nil} {
+		if ok {
+			set++
+		}
+	}
+	switch {
+	case set == 0:
+		return nil, errors.New(errNoContent)
+	case set > 1:
+		return nil, errors.New(errOnlyOneContent)
+	case p.Content != nil:
+		return []byte(*p.Content), nil
+	case p.ContentConfigMapRef != nil:
+		ref := p.ContentConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if content, ok := cm.Data[ref.Key]; ok {
+			return []byte(content), nil
+		}
+		if content, ok := cm.BinaryData[ref.Key]; ok {
+			return content, nil
+		}
+		return nil, errors.Errorf(errFmtKeyNotFound, ref.Key, contentKindConfigMap)
+	}
+	ref := p.ContentSecretRef
+	sc := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sc); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+	content, ok := sc.Data[ref.Key]
+	if !ok {
+		return nil, errors.Errorf(errFmtKeyNotFound, ref.Key, contentKindSecret)
+	}
+	return content, nil
+}
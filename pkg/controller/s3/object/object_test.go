@@ -0,0 +1,540 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	bucket         = "some-bucket"
+	key            = "config/app.json"
+	content        = `{"enabled":true}`
+	otherContent   = `{"enabled":false}`
+	contentKey     = "app.json"
+	versionID      = "v2"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   *fake.MockObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha3.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) objectModifier {
+	return func(r *v1alpha3.Object) { meta.SetExternalName(r, n) }
+}
+
+func withContent(c string) objectModifier {
+	return func(r *v1alpha3.Object) { r.Spec.ForProvider.Content = aws.String(c) }
+}
+
+func withConfigMapRef() objectModifier {
+	return func(r *v1alpha3.Object) {
+		r.Spec.ForProvider.ContentConfigMapRef = &common.ConfigMapKeySelector{Name: "app", Namespace: "default", Key: contentKey}
+	}
+}
+
+func withSecretRef() objectModifier {
+	return func(r *v1alpha3.Object) {
+		r.Spec.ForProvider.ContentSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "app", Namespace: "default"},
+			Key:             contentKey,
+		}
+	}
+}
+
+func withTags(tags ...v1beta1.Tag) objectModifier {
+	return func(r *v1alpha3.Object) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withAtProvider(o v1alpha3.ObjectObservation) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.AtProvider = o }
+}
+
+func object(m ...objectModifier) *v1alpha3.Object {
+	cr := &v1alpha3.Object{
+		Spec: v1alpha3.ObjectSpec{
+			ForProvider: v1alpha3.ObjectParameters{Bucket: aws.String(bucket), Key: key},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func headObject(c string) func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+	return func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+		return &awss3.HeadObjectOutput{ChecksumSHA256: aws.String(s3.ContentSHA256([]byte(c))), VersionId: aws.String(versionID)}, nil
+	}
+}
+
+func getObjectTagging(tags ...s3types.Tag) func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+	return func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+		return &awss3.GetObjectTaggingOutput{TagSet: tags}, nil
+	}
+}
+
+func getConfigMap(data map[string]string) func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.ConfigMap).Data = data
+		return nil
+	}
+}
+
+func getSecret(data map[string][]byte) func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha3.ObjectObservation{
+		ChecksumSHA256: aws.String(s3.ContentSHA256([]byte(content))),
+		VersionID:      aws.String(versionID),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content)),
+			},
+		},
+		"HeadError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: errorutils.Wrap(errBoom, errHead),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(s3types.Tag{Key: aws.String("team"), Value: aws.String("a")}),
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"}),
+					withConditions(xpv1.Available()), withAtProvider(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(s3types.Tag{Key: aws.String("team"), Value: aws.String("b")}),
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"}),
+					withConditions(xpv1.Available()), withAtProvider(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ConfigMapContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{contentKey: otherContent}),
+				},
+				cr: object(withConfigMapRef()),
+			},
+			want: want{
+				cr:     object(withConfigMapRef(), withConditions(xpv1.Available()), withAtProvider(observation)),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"SecretContentUpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				kube: &test.MockClient{
+					MockGet: getSecret(map[string][]byte{contentKey: []byte(content)}),
+				},
+				cr: object(withSecretRef()),
+			},
+			want: want{
+				cr: object(withSecretRef(), withConditions(xpv1.Available()), withAtProvider(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretKeyMissing": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(content),
+				},
+				kube: &test.MockClient{
+					MockGet: getSecret(map[string][]byte{}),
+				},
+				cr: object(withSecretRef()),
+			},
+			want: want{
+				cr:  object(withSecretRef(), withConditions(xpv1.Available()), withAtProvider(observation)),
+				err: errors.Errorf(errFmtKeyNotFound, contentKey, contentKindSecret),
+			},
+		},
+		"MultipleContentSources": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(content),
+				},
+				cr: object(withContent(content), withSecretRef()),
+			},
+			want: want{
+				cr:  object(withContent(content), withSecretRef(), withConditions(xpv1.Available()), withAtProvider(observation)),
+				err: errors.New(errOnlyOneContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.s3}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if aws.ToString(input.ChecksumSHA256) != s3.ContentSHA256([]byte(content)) || aws.ToString(input.Tagging) != "team=a" {
+							return nil, errBoom
+						}
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"}), withExternalName(key)),
+			},
+		},
+		"NoContent": {
+			args: args{
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: errors.New(errNoContent),
+			},
+		},
+		"ConfigMapError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: object(withConfigMapRef()),
+			},
+			want: want{
+				cr:  object(withConfigMapRef()),
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+		"PutError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: errorutils.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.s3}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(otherContent),
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if aws.ToString(input.ChecksumSHA256) != s3.ContentSHA256([]byte(content)) {
+							return nil, errBoom
+						}
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				cr: object(withContent(content), withExternalName(key)),
+			},
+		},
+		"PutError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(otherContent),
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content), withExternalName(key)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errPut),
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(),
+					MockPutObjectTagging: func(ctx context.Context, input *awss3.PutObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.PutObjectTaggingOutput, error) {
+						if len(input.Tagging.TagSet) != 1 {
+							return nil, errBoom
+						}
+						return &awss3.PutObjectTaggingOutput{}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "team", Value: "a"}), withExternalName(key)),
+			},
+		},
+		"TagsRemoved": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(s3types.Tag{Key: aws.String("team"), Value: aws.String("a")}),
+					MockDeleteObjectTagging: func(ctx context.Context, input *awss3.DeleteObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content), withExternalName(key)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errDeleteTagging),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.s3}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						if input.VersionId != nil {
+							return nil, errBoom
+						}
+						return &awss3.DeleteObjectOutput{DeleteMarker: aws.Bool(true)}, nil
+					},
+				},
+				cr: object(withExternalName(key)),
+			},
+			want: want{
+				cr: object(withExternalName(key), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, &s3types.NoSuchKey{}
+					},
+				},
+				cr: object(withExternalName(key)),
+			},
+			want: want{
+				cr: object(withExternalName(key), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withExternalName(key)),
+			},
+			want: want{
+				cr:  object(withExternalName(key), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.s3}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -99,6 +99,11 @@ func WithReplConfig(s *v1beta1.ReplicationConfiguration) BucketModifier {
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ReplicationConfiguration = s }
 }
 
+// WithReplObservation sets the observed replication status for an S3 Bucket
+func WithReplObservation(s *v1beta1.ReplicationObservation) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Status.AtProvider.Replication = s }
+}
+
 // WithLifecycleConfig sets the BucketLifecycleConfiguration for an S3 Bucket
 func WithLifecycleConfig(s *v1beta1.BucketLifecycleConfiguration) BucketModifier {
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.LifecycleConfiguration = s }
@@ -0,0 +1,305 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a MultiRegionAccessPoint resource"
+
+	errGet              = "cannot get MultiRegionAccessPoint in AWS"
+	errCreate           = "cannot create MultiRegionAccessPoint in AWS"
+	errDelete           = "cannot delete MultiRegionAccessPoint in AWS"
+	errDescribeRequest  = "cannot describe asynchronous request of MultiRegionAccessPoint in AWS"
+	errGetPolicy        = "cannot get policy of MultiRegionAccessPoint in AWS"
+	errPutPolicy        = "cannot put policy of MultiRegionAccessPoint in AWS"
+	errFormatPolicy     = "cannot format policy of MultiRegionAccessPoint"
+	errFmtRequestFailed = "request %s failed: %s: %s"
+)
+
+// SetupMultiRegionAccessPoint adds a controller that reconciles
+// MultiRegionAccessPoints.
+func SetupMultiRegionAccessPoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MultiRegionAccessPointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3control.NewMultiRegionAccessPointClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.MultiRegionAccessPointGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.MultiRegionAccessPoint{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3control.MultiRegionAccessPointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client s3control.MultiRegionAccessPointClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { //nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+
+	req, err := e.lastRequest(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.LastRequest = req
+
+	out, err := e.client.GetMultiRegionAccessPointWithContext(ctx, &svcsdk.GetMultiRegionAccessPointInput{
+		AccountId: p.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	if s3control.IsMultiRegionAccessPointNotFound(err) {
+		if req == nil || aws.StringValue(req.Operation) != s3control.OperationCreateMultiRegionAccessPoint {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		// The Multi-Region Access Point is not visible until its creation
+		// request has progressed, so it is reported as existing meanwhile
+		// to avoid a second creation request.
+		if s3control.IsRequestInProgress(req) {
+			cr.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		if aws.StringValue(req.Status) == s3control.RequestStatusFailed {
+			cr.SetConditions(xpv1.Unavailable().WithMessage(errors.Errorf(errFmtRequestFailed,
+				aws.StringValue(req.Operation), aws.StringValue(req.ErrorCode), aws.StringValue(req.ErrorMessage)).Error()))
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGet)
+	}
+
+	cr.Status.AtProvider = s3control.GenerateMultiRegionAccessPointObservation(p.Region, aws.StringValue(p.AccountID), out.AccessPoint, req)
+	status := aws.StringValue(out.AccessPoint.Status)
+	switch status {
+	case svcsdk.MultiRegionAccessPointStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.MultiRegionAccessPointStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.MultiRegionAccessPointStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(status))
+	}
+
+	// The policy can only be put once the Multi-Region Access Point is ready
+	// and there is no other request in progress. A policy cannot be removed,
+	// so an unset policy is not compared.
+	if p.Policy == nil || status != svcsdk.MultiRegionAccessPointStatusReady || s3control.IsRequestInProgress(req) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	policy, err := e.client.GetMultiRegionAccessPointPolicyWithContext(ctx, &svcsdk.GetMultiRegionAccessPointPolicyInput{
+		AccountId: p.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetPolicy)
+	}
+	diff, err := s3.DiffParsedPolicies(p.Policy, aws.String(s3control.MultiRegionAccessPointPolicy(policy.Policy)))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFormatPolicy)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: diff == "",
+		Diff:             diff,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateMultiRegionAccessPointWithContext(ctx,
+		s3control.GenerateCreateMultiRegionAccessPointInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	// The status is not persisted after a creation, so the request token is
+	// kept in an annotation until the creation request is observed.
+	meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyRequestTokenARN: aws.StringValue(out.RequestTokenARN)})
+	cr.Status.AtProvider.LastRequest = &svcapitypes.MultiRegionAccessPointRequest{
+		TokenARN:  out.RequestTokenARN,
+		Operation: aws.String(s3control.OperationCreateMultiRegionAccessPoint),
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Policy == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	policy, err := s3.FormatPolicy(cr.Spec.ForProvider.Policy)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFormatPolicy)
+	}
+	out, err := e.client.PutMultiRegionAccessPointPolicyWithContext(ctx, &svcsdk.PutMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &svcsdk.PutMultiRegionAccessPointPolicyInput_{
+			Name:   aws.String(meta.GetExternalName(cr)),
+			Policy: policy,
+		},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutPolicy)
+	}
+
+	cr.Status.AtProvider.LastRequest = &svcapitypes.MultiRegionAccessPointRequest{
+		TokenARN:  out.RequestTokenARN,
+		Operation: aws.String(s3control.OperationPutMultiRegionAccessPointPolicy),
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	req := cr.Status.AtProvider.LastRequest
+	if s3control.IsRequestInProgress(req) && aws.StringValue(req.Operation) == s3control.OperationDeleteMultiRegionAccessPoint {
+		return nil
+	}
+
+	out, err := e.client.DeleteMultiRegionAccessPointWithContext(ctx, &svcsdk.DeleteMultiRegionAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &svcsdk.DeleteMultiRegionAccessPointInput_{
+			Name: aws.String(meta.GetExternalName(cr)),
+		},
+	})
+	if err != nil {
+		return errorutils.Wrap(resource.Ignore(s3control.IsMultiRegionAccessPointNotFound, err), errDelete)
+	}
+
+	cr.Status.AtProvider.LastRequest = &svcapitypes.MultiRegionAccessPointRequest{
+		TokenARN:  out.RequestTokenARN,
+		Operation: aws.String(s3control.OperationDeleteMultiRegionAccessPoint),
+	}
+	return nil
+}
+
+// lastRequest returns the latest asynchronous request of the
+// MultiRegionAccessPoint and describes it while it is in progress. A creation
+// request is only known from the annotation.
+func (e *external) lastRequest(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint) (*svcapitypes.MultiRegionAccessPointRequest, error) {
+	req := cr.Status.AtProvider.LastRequest
+	if req == nil || req.TokenARN == nil {
+		token, ok := cr.GetAnnotations()[svcapitypes.AnnotationKeyRequestTokenARN]
+		if !ok || token == "" {
+			return req, nil
+		}
+		req = &svcapitypes.MultiRegionAccessPointRequest{
+			TokenARN:  aws.String(token),
+			Operation: aws.String(s3control.OperationCreateMultiRegionAccessPoint),
+		}
+	}
+	if !s3control.IsRequestInProgress(req) {
+		return req, nil
+	}
+
+	out, err := e.client.DescribeMultiRegionAccessPointOperationWithContext(ctx, &svcsdk.DescribeMultiRegionAccessPointOperationInput{
+		AccountId:       cr.Spec.ForProvider.AccountID,
+		RequestTokenARN: req.TokenARN,
+	})
+	if err != nil {
+		return nil, errorutils.Wrap(err, errDescribeRequest)
+	}
+	if out.AsyncOperation == nil {
+		return req, nil
+	}
+	return s3control.GenerateMultiRegionAccessPointRequest(out.AsyncOperation), nil
+}
@@ -0,0 +1,586 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	name           = "my-mrap"
+	accountID      = "123456789012"
+	alias          = "mfzwi23gnjvgw.mrap"
+	token          = "arn:aws:s3:us-west-2:123456789012:async-request/mrap/create/abc"
+	otherToken     = "arn:aws:s3:us-west-2:123456789012:async-request/mrap/delete/def"
+
+	policy = &common.BucketPolicyBody{
+		Version: "2012-10-17",
+		Statements: []common.BucketPolicyStatement{
+			{
+				Effect:    "Allow",
+				Principal: &common.BucketPrincipal{AWSPrincipals: []common.AWSPrincipal{{AWSAccountID: aws.String(accountID)}}},
+				Action:    []string{"s3:GetObject"},
+				Resource:  []string{"arn:aws:s3::123456789012:accesspoint/" + alias + "/object/*"},
+			},
+		},
+	}
+	otherPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"*"}]}`
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New("NoSuchMultiRegionAccessPoint", "not found", nil)
+)
+
+type args struct {
+	client *fake.MockMultiRegionAccessPointClient
+	cr     resource.Managed
+}
+
+type mrapModifier func(*svcapitypes.MultiRegionAccessPoint)
+
+func withConditions(c ...xpv1.Condition) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicy(p *common.BucketPolicyBody) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) { r.Spec.ForProvider.Policy = p }
+}
+
+func withTokenAnnotation(t string) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) {
+		meta.AddAnnotations(r, map[string]string{svcapitypes.AnnotationKeyRequestTokenARN: t})
+	}
+}
+
+func withLastRequest(t, op, status string) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) {
+		r.Status.AtProvider.LastRequest = &svcapitypes.MultiRegionAccessPointRequest{
+			TokenARN:  aws.String(t),
+			Operation: aws.String(op),
+		}
+		if status != "" {
+			r.Status.AtProvider.LastRequest.Status = aws.String(status)
+		}
+	}
+}
+
+func withAtProvider(status string) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) {
+		r.Status.AtProvider.Alias = aws.String(alias)
+		r.Status.AtProvider.ARN = aws.String("arn:aws:s3::" + accountID + ":accesspoint/" + alias)
+		r.Status.AtProvider.Status = aws.String(status)
+		r.Status.AtProvider.Regions = []svcapitypes.MultiRegionAccessPointRegionObservation{
+			{Bucket: aws.String("bucket-a"), Region: aws.String("eu-west-1")},
+		}
+	}
+}
+
+func mrap(m ...mrapModifier) *svcapitypes.MultiRegionAccessPoint {
+	cr := &svcapitypes.MultiRegionAccessPoint{
+		Spec: svcapitypes.MultiRegionAccessPointSpec{
+			ForProvider: svcapitypes.MultiRegionAccessPointParameters{
+				Region:    "us-west-2",
+				AccountID: aws.String(accountID),
+				Regions:   []svcapitypes.MultiRegionAccessPointRegion{{Bucket: aws.String("bucket-a")}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getMRAP(status string) func(context.Context, *svcsdk.GetMultiRegionAccessPointInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error) {
+	return func(_ context.Context, input *svcsdk.GetMultiRegionAccessPointInput, _ ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error) {
+		if aws.StringValue(input.Name) != name || aws.StringValue(input.AccountId) != accountID {
+			return nil, errBoom
+		}
+		return &svcsdk.GetMultiRegionAccessPointOutput{AccessPoint: &svcsdk.MultiRegionAccessPointReport{
+			Alias:   aws.String(alias),
+			Name:    aws.String(name),
+			Status:  aws.String(status),
+			Regions: []*svcsdk.RegionReport{{Bucket: aws.String("bucket-a"), Region: aws.String("eu-west-1")}},
+		}}, nil
+	}
+}
+
+func getMRAPNotFound(context.Context, *svcsdk.GetMultiRegionAccessPointInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error) {
+	return nil, errNotFound
+}
+
+func describeRequest(status string) func(context.Context, *svcsdk.DescribeMultiRegionAccessPointOperationInput, ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error) {
+	return func(_ context.Context, input *svcsdk.DescribeMultiRegionAccessPointOperationInput, _ ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error) {
+		op := &svcsdk.AsyncOperation{
+			Operation:       aws.String(s3control.OperationCreateMultiRegionAccessPoint),
+			RequestStatus:   aws.String(status),
+			RequestTokenARN: input.RequestTokenARN,
+		}
+		if status == s3control.RequestStatusFailed {
+			op.ResponseDetails = &svcsdk.AsyncResponseDetails{ErrorDetails: &svcsdk.AsyncErrorDetails{
+				Code:    aws.String("BucketNotFound"),
+				Message: aws.String("bucket does not exist"),
+			}}
+		}
+		return &svcsdk.DescribeMultiRegionAccessPointOperationOutput{AsyncOperation: op}, nil
+	}
+}
+
+func getPolicy(p string) func(context.Context, *svcsdk.GetMultiRegionAccessPointPolicyInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointPolicyOutput, error) {
+	return func(context.Context, *svcsdk.GetMultiRegionAccessPointPolicyInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointPolicyOutput, error) {
+		return &svcsdk.GetMultiRegionAccessPointPolicyOutput{Policy: &svcsdk.MultiRegionAccessPointPolicyDocument{
+			Established: &svcsdk.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String(p)},
+		}}, nil
+	}
+}
+
+func formattedPolicy() string {
+	p, _ := s3.FormatPolicy(policy)
+	return *p
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPointWithContext: getMRAPNotFound,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(),
+			},
+		},
+		"CreationInProgress": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDescribeMultiRegionAccessPointOperationWithContext: describeRequest("RUNNING"),
+					MockGetMultiRegionAccessPointWithContext:               getMRAPNotFound,
+				},
+				cr: mrap(withTokenAnnotation(token)),
+			},
+			want: want{
+				cr: mrap(withTokenAnnotation(token), withConditions(xpv1.Creating()),
+					withLastRequest(token, s3control.OperationCreateMultiRegionAccessPoint, "RUNNING")),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CreationFailed": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDescribeMultiRegionAccessPointOperationWithContext: describeRequest(s3control.RequestStatusFailed),
+					MockGetMultiRegionAccessPointWithContext:               getMRAPNotFound,
+				},
+				cr: mrap(withTokenAnnotation(token)),
+			},
+			want: want{
+				cr: mrap(withTokenAnnotation(token),
+					withConditions(xpv1.Unavailable().WithMessage(errors.Errorf(errFmtRequestFailed,
+						s3control.OperationCreateMultiRegionAccessPoint, "BucketNotFound", "bucket does not exist").Error())),
+					func(r *svcapitypes.MultiRegionAccessPoint) {
+						r.Status.AtProvider.LastRequest = &svcapitypes.MultiRegionAccessPointRequest{
+							TokenARN:     aws.String(token),
+							Operation:    aws.String(s3control.OperationCreateMultiRegionAccessPoint),
+							Status:       aws.String(s3control.RequestStatusFailed),
+							ErrorCode:    aws.String("BucketNotFound"),
+							ErrorMessage: aws.String("bucket does not exist"),
+						}
+					}),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDescribeMultiRegionAccessPointOperationWithContext: func(context.Context, *svcsdk.DescribeMultiRegionAccessPointOperationInput, ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(withTokenAnnotation(token)),
+			},
+			want: want{
+				cr:  mrap(withTokenAnnotation(token)),
+				err: errorutils.Wrap(errBoom, errDescribeRequest),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPointWithContext: func(context.Context, *svcsdk.GetMultiRegionAccessPointInput, ...request.Option) (*svcsdk.GetMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPointWithContext: getMRAP(svcsdk.MultiRegionAccessPointStatusCreating),
+				},
+				cr: mrap(withPolicy(policy), withLastRequest(token, s3control.OperationCreateMultiRegionAccessPoint, s3control.RequestStatusSucceeded)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withLastRequest(token, s3control.OperationCreateMultiRegionAccessPoint, s3control.RequestStatusSucceeded),
+					withAtProvider(svcsdk.MultiRegionAccessPointStatusCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Inconsistent": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPointWithContext: getMRAP(svcsdk.MultiRegionAccessPointStatusInconsistentAcrossRegions),
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withAtProvider(svcsdk.MultiRegionAccessPointStatusInconsistentAcrossRegions),
+					withConditions(xpv1.Unavailable().WithMessage(svcsdk.MultiRegionAccessPointStatusInconsistentAcrossRegions))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PolicyUpToDate": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPointWithContext:       getMRAP(svcsdk.MultiRegionAccessPointStatusReady),
+					MockGetMultiRegionAccessPointPolicyWithContext: getPolicy(formattedPolicy()),
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withAtProvider(svcsdk.MultiRegionAccessPointStatusReady),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PolicyChanged": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPointWithContext:       getMRAP(svcsdk.MultiRegionAccessPointStatusReady),
+					MockGetMultiRegionAccessPointPolicyWithContext: getPolicy(otherPolicy),
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withAtProvider(svcsdk.MultiRegionAccessPointStatusReady),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"PolicyRequestInProgress": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDescribeMultiRegionAccessPointOperationWithContext: func(_ context.Context, input *svcsdk.DescribeMultiRegionAccessPointOperationInput, _ ...request.Option) (*svcsdk.DescribeMultiRegionAccessPointOperationOutput, error) {
+						return &svcsdk.DescribeMultiRegionAccessPointOperationOutput{AsyncOperation: &svcsdk.AsyncOperation{
+							Operation:       aws.String(s3control.OperationPutMultiRegionAccessPointPolicy),
+							RequestStatus:   aws.String("RUNNING"),
+							RequestTokenARN: input.RequestTokenARN,
+						}}, nil
+					},
+					MockGetMultiRegionAccessPointWithContext: getMRAP(svcsdk.MultiRegionAccessPointStatusReady),
+				},
+				cr: mrap(withPolicy(policy), withLastRequest(otherToken, s3control.OperationPutMultiRegionAccessPointPolicy, "")),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withAtProvider(svcsdk.MultiRegionAccessPointStatusReady),
+					withLastRequest(otherToken, s3control.OperationPutMultiRegionAccessPointPolicy, "RUNNING"),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o, cmp.FilterPath(func(p cmp.Path) bool { return p.String() == "Diff" }, cmp.Ignore())); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockCreateMultiRegionAccessPointWithContext: func(_ context.Context, input *svcsdk.CreateMultiRegionAccessPointInput, _ ...request.Option) (*svcsdk.CreateMultiRegionAccessPointOutput, error) {
+						if aws.StringValue(input.Details.Name) != name || aws.StringValue(input.Details.Regions[0].Bucket) != "bucket-a" {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateMultiRegionAccessPointOutput{RequestTokenARN: aws.String(token)}, nil
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withTokenAnnotation(token), withConditions(xpv1.Creating()),
+					withLastRequest(token, s3control.OperationCreateMultiRegionAccessPoint, "")),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockCreateMultiRegionAccessPointWithContext: func(context.Context, *svcsdk.CreateMultiRegionAccessPointInput, ...request.Option) (*svcsdk.CreateMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PutPolicy": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockPutMultiRegionAccessPointPolicyWithContext: func(_ context.Context, input *svcsdk.PutMultiRegionAccessPointPolicyInput, _ ...request.Option) (*svcsdk.PutMultiRegionAccessPointPolicyOutput, error) {
+						if aws.StringValue(input.Details.Policy) != formattedPolicy() {
+							return nil, errBoom
+						}
+						return &svcsdk.PutMultiRegionAccessPointPolicyOutput{RequestTokenARN: aws.String(otherToken)}, nil
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withLastRequest(otherToken, s3control.OperationPutMultiRegionAccessPointPolicy, "")),
+			},
+		},
+		"PutPolicyError": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockPutMultiRegionAccessPointPolicyWithContext: func(context.Context, *svcsdk.PutMultiRegionAccessPointPolicyInput, ...request.Option) (*svcsdk.PutMultiRegionAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr:  mrap(withPolicy(policy)),
+				err: errorutils.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDeleteMultiRegionAccessPointWithContext: func(context.Context, *svcsdk.DeleteMultiRegionAccessPointInput, ...request.Option) (*svcsdk.DeleteMultiRegionAccessPointOutput, error) {
+						return &svcsdk.DeleteMultiRegionAccessPointOutput{RequestTokenARN: aws.String(otherToken)}, nil
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Deleting()),
+					withLastRequest(otherToken, s3control.OperationDeleteMultiRegionAccessPoint, "")),
+			},
+		},
+		"DeletionInProgress": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{},
+				cr:     mrap(withLastRequest(otherToken, s3control.OperationDeleteMultiRegionAccessPoint, "RUNNING")),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Deleting()),
+					withLastRequest(otherToken, s3control.OperationDeleteMultiRegionAccessPoint, "RUNNING")),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDeleteMultiRegionAccessPointWithContext: func(context.Context, *svcsdk.DeleteMultiRegionAccessPointInput, ...request.Option) (*svcsdk.DeleteMultiRegionAccessPointOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockMultiRegionAccessPointClient{
+					MockDeleteMultiRegionAccessPointWithContext: func(context.Context, *svcsdk.DeleteMultiRegionAccessPointInput, ...request.Option) (*svcsdk.DeleteMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
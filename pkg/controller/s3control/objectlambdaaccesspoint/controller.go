@@ -0,0 +1,258 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectlambdaaccesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an ObjectLambdaAccessPoint resource"
+
+	errGet             = "cannot get ObjectLambdaAccessPoint in AWS"
+	errCreate          = "cannot create ObjectLambdaAccessPoint in AWS"
+	errDelete          = "cannot delete ObjectLambdaAccessPoint in AWS"
+	errGetConfig       = "cannot get configuration of ObjectLambdaAccessPoint in AWS"
+	errPutConfig       = "cannot put configuration of ObjectLambdaAccessPoint in AWS"
+	errGetPolicy       = "cannot get policy of ObjectLambdaAccessPoint in AWS"
+	errPutPolicy       = "cannot put policy of ObjectLambdaAccessPoint in AWS"
+	errDeletePolicy    = "cannot delete policy of ObjectLambdaAccessPoint in AWS"
+	errFormatPolicy    = "cannot format policy of ObjectLambdaAccessPoint"
+	errNoSupportingARN = "the ARN of the supporting access point is not resolved yet"
+)
+
+// SetupObjectLambdaAccessPoint adds a controller that reconciles
+// ObjectLambdaAccessPoints.
+func SetupObjectLambdaAccessPoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ObjectLambdaAccessPointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3control.NewObjectLambdaAccessPointClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ObjectLambdaAccessPointGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.ObjectLambdaAccessPoint{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3control.ObjectLambdaAccessPointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client s3control.ObjectLambdaAccessPointClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+
+	out, err := e.client.GetAccessPointForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointForObjectLambdaInput{
+		AccountId: p.AccountID,
+		Name:      aws.String(name),
+	})
+	if s3control.IsObjectLambdaAccessPointNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGet)
+	}
+
+	cr.Status.AtProvider = s3control.GenerateObjectLambdaAccessPointObservation(
+		s3control.ObjectLambdaAccessPointARN(p.Region, aws.StringValue(p.AccountID), name), out)
+	if out.Alias == nil || aws.StringValue(out.Alias.Status) == s3control.ObjectLambdaAliasStatusReady {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	config, err := e.client.GetAccessPointConfigurationForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: p.AccountID,
+		Name:      aws.String(name),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetConfig)
+	}
+	policyUpToDate, err := e.isPolicyUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3control.IsObjectLambdaConfigurationUpToDate(p.Configuration, config.Configuration) && policyUpToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Configuration.SupportingAccessPoint == nil {
+		return managed.ExternalCreation{}, errors.New(errNoSupportingARN)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if _, err := e.client.CreateAccessPointForObjectLambdaWithContext(ctx, &svcsdk.CreateAccessPointForObjectLambdaInput{
+		AccountId:     cr.Spec.ForProvider.AccountID,
+		Name:          aws.String(meta.GetExternalName(cr)),
+		Configuration: s3control.GenerateObjectLambdaConfiguration(cr.Spec.ForProvider.Configuration),
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	if cr.Spec.ForProvider.Policy == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.putPolicy(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if _, err := e.client.PutAccessPointConfigurationForObjectLambdaWithContext(ctx, &svcsdk.PutAccessPointConfigurationForObjectLambdaInput{
+		AccountId:     cr.Spec.ForProvider.AccountID,
+		Name:          aws.String(meta.GetExternalName(cr)),
+		Configuration: s3control.GenerateObjectLambdaConfiguration(cr.Spec.ForProvider.Configuration),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutConfig)
+	}
+
+	if cr.Spec.ForProvider.Policy != nil {
+		return managed.ExternalUpdate{}, e.putPolicy(ctx, cr)
+	}
+	_, err := e.client.DeleteAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.DeleteAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(resource.Ignore(s3control.IsObjectLambdaAccessPointPolicyNotFound, err), errDeletePolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteAccessPointForObjectLambdaWithContext(ctx, &svcsdk.DeleteAccessPointForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	return errorutils.Wrap(resource.Ignore(s3control.IsObjectLambdaAccessPointNotFound, err), errDelete)
+}
+
+// isPolicyUpToDate returns true if the observed policy matches the desired
+// one. An unset policy is up to date if there is no policy in AWS.
+func (e *external) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.ObjectLambdaAccessPoint) (bool, error) {
+	out, err := e.client.GetAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	if s3control.IsObjectLambdaAccessPointPolicyNotFound(err) {
+		return cr.Spec.ForProvider.Policy == nil, nil
+	}
+	if err != nil {
+		return false, errorutils.Wrap(err, errGetPolicy)
+	}
+	if cr.Spec.ForProvider.Policy == nil {
+		return aws.StringValue(out.Policy) == "", nil
+	}
+	diff, err := s3.DiffParsedPolicies(cr.Spec.ForProvider.Policy, out.Policy)
+	if err != nil {
+		return false, errors.Wrap(err, errFormatPolicy)
+	}
+	return diff == "", nil
+}
+
+func (e *external) putPolicy(ctx context.Context, cr *svcapitypes.ObjectLambdaAccessPoint) error {
+	policy, err := s3.FormatPolicy(cr.Spec.ForProvider.Policy)
+	if err != nil {
+		return errors.Wrap(err, errFormatPolicy)
+	}
+	_, err = e.client.PutAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+		Policy:    policy,
+	})
+	return errorutils.Wrap(err, errPutPolicy)
+}
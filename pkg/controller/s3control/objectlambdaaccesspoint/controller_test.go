@@ -0,0 +1,518 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectlambdaaccesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	name           = "transform"
+	region         = "eu-central-1"
+	accountID      = "123456789012"
+	alias          = "transform-abc--ol-s3"
+	supportingAP   = "arn:aws:s3:eu-central-1:123456789012:accesspoint/data"
+	functionARN    = "arn:aws:lambda:eu-central-1:123456789012:function:redact"
+	otherFunction  = "arn:aws:lambda:eu-central-1:123456789012:function:other"
+
+	policy = &common.BucketPolicyBody{
+		Version: "2012-10-17",
+		Statements: []common.BucketPolicyStatement{
+			{
+				Effect:    "Allow",
+				Principal: &common.BucketPrincipal{AWSPrincipals: []common.AWSPrincipal{{AWSAccountID: aws.String(accountID)}}},
+				Action:    []string{"s3-object-lambda:GetObject"},
+				Resource:  []string{"arn:aws:s3-object-lambda:eu-central-1:123456789012:accesspoint/" + name},
+			},
+		},
+	}
+
+	errBoom           = errors.New("boom")
+	errNotFound       = awserr.New("NoSuchAccessPoint", "not found", nil)
+	errPolicyNotFound = awserr.New("NoSuchAccessPointPolicy", "not found", nil)
+)
+
+type args struct {
+	client *fake.MockObjectLambdaAccessPointClient
+	cr     resource.Managed
+}
+
+type olapModifier func(*svcapitypes.ObjectLambdaAccessPoint)
+
+func withConditions(c ...xpv1.Condition) olapModifier {
+	return func(r *svcapitypes.ObjectLambdaAccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicy(p *common.BucketPolicyBody) olapModifier {
+	return func(r *svcapitypes.ObjectLambdaAccessPoint) { r.Spec.ForProvider.Policy = p }
+}
+
+func withAtProvider(status string) olapModifier {
+	return func(r *svcapitypes.ObjectLambdaAccessPoint) {
+		r.Status.AtProvider = svcapitypes.ObjectLambdaAccessPointObservation{
+			ARN:         aws.String("arn:aws:s3-object-lambda:" + region + ":" + accountID + ":accesspoint/" + name),
+			Alias:       aws.String(alias),
+			AliasStatus: aws.String(status),
+		}
+	}
+}
+
+func olap(m ...olapModifier) *svcapitypes.ObjectLambdaAccessPoint {
+	cr := &svcapitypes.ObjectLambdaAccessPoint{
+		Spec: svcapitypes.ObjectLambdaAccessPointSpec{
+			ForProvider: svcapitypes.ObjectLambdaAccessPointParameters{
+				Region:    region,
+				AccountID: aws.String(accountID),
+				Configuration: svcapitypes.ObjectLambdaConfiguration{
+					SupportingAccessPoint: aws.String(supportingAP),
+					TransformationConfigurations: []svcapitypes.ObjectLambdaTransformationConfiguration{{
+						Actions: []string{"GetObject"},
+						ContentTransformation: svcapitypes.ObjectLambdaContentTransformation{
+							AWSLambda: svcapitypes.AWSLambdaTransformation{FunctionARN: aws.String(functionARN)},
+						},
+					}},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getOLAP(status string) func(context.Context, *svcsdk.GetAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error) {
+	return func(context.Context, *svcsdk.GetAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error) {
+		return &svcsdk.GetAccessPointForObjectLambdaOutput{
+			Name:  aws.String(name),
+			Alias: &svcsdk.ObjectLambdaAccessPointAlias{Value: aws.String(alias), Status: aws.String(status)},
+		}, nil
+	}
+}
+
+func getConfig(function string) func(context.Context, *svcsdk.GetAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointConfigurationForObjectLambdaOutput, error) {
+	return func(context.Context, *svcsdk.GetAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointConfigurationForObjectLambdaOutput, error) {
+		return &svcsdk.GetAccessPointConfigurationForObjectLambdaOutput{Configuration: &svcsdk.ObjectLambdaConfiguration{
+			CloudWatchMetricsEnabled: aws.Bool(false),
+			SupportingAccessPoint:    aws.String(supportingAP),
+			TransformationConfigurations: []*svcsdk.ObjectLambdaTransformationConfiguration{{
+				Actions: aws.StringSlice([]string{"GetObject"}),
+				ContentTransformation: &svcsdk.ObjectLambdaContentTransformation{
+					AwsLambda: &svcsdk.AwsLambdaTransformation{FunctionArn: aws.String(function)},
+				},
+			}},
+		}}, nil
+	}
+}
+
+func getPolicy(err error) func(context.Context, *svcsdk.GetAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointPolicyForObjectLambdaOutput, error) {
+	return func(context.Context, *svcsdk.GetAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointPolicyForObjectLambdaOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		p, _ := s3.FormatPolicy(policy)
+		return &svcsdk.GetAccessPointPolicyForObjectLambdaOutput{Policy: p}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext: func(context.Context, *svcsdk.GetAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext: func(context.Context, *svcsdk.GetAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.GetAccessPointForObjectLambdaOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext:              getOLAP(s3control.ObjectLambdaAliasStatusReady),
+					MockGetAccessPointConfigurationForObjectLambdaWithContext: getConfig(functionARN),
+					MockGetAccessPointPolicyForObjectLambdaWithContext:        getPolicy(nil),
+				},
+				cr: olap(withPolicy(policy)),
+			},
+			want: want{
+				cr: olap(withPolicy(policy), withAtProvider(s3control.ObjectLambdaAliasStatusReady),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Provisioning": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext:              getOLAP("PROVISIONING"),
+					MockGetAccessPointConfigurationForObjectLambdaWithContext: getConfig(functionARN),
+					MockGetAccessPointPolicyForObjectLambdaWithContext:        getPolicy(errPolicyNotFound),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:     olap(withAtProvider("PROVISIONING"), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ConfigurationChanged": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext:              getOLAP(s3control.ObjectLambdaAliasStatusReady),
+					MockGetAccessPointConfigurationForObjectLambdaWithContext: getConfig(otherFunction),
+					MockGetAccessPointPolicyForObjectLambdaWithContext:        getPolicy(errPolicyNotFound),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:     olap(withAtProvider(s3control.ObjectLambdaAliasStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"PolicyRemoved": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext:              getOLAP(s3control.ObjectLambdaAliasStatusReady),
+					MockGetAccessPointConfigurationForObjectLambdaWithContext: getConfig(functionARN),
+					MockGetAccessPointPolicyForObjectLambdaWithContext:        getPolicy(nil),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:     olap(withAtProvider(s3control.ObjectLambdaAliasStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"GetPolicyError": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockGetAccessPointForObjectLambdaWithContext:              getOLAP(s3control.ObjectLambdaAliasStatusReady),
+					MockGetAccessPointConfigurationForObjectLambdaWithContext: getConfig(functionARN),
+					MockGetAccessPointPolicyForObjectLambdaWithContext:        getPolicy(errBoom),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(withAtProvider(s3control.ObjectLambdaAliasStatusReady), withConditions(xpv1.Available())),
+				err: errorutils.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"SuccessfulWithPolicy": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockCreateAccessPointForObjectLambdaWithContext: func(_ context.Context, input *svcsdk.CreateAccessPointForObjectLambdaInput, _ ...request.Option) (*svcsdk.CreateAccessPointForObjectLambdaOutput, error) {
+						if aws.StringValue(input.Configuration.TransformationConfigurations[0].ContentTransformation.AwsLambda.FunctionArn) != functionARN {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateAccessPointForObjectLambdaOutput{}, nil
+					},
+					MockPutAccessPointPolicyForObjectLambdaWithContext: func(context.Context, *svcsdk.PutAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.PutAccessPointPolicyForObjectLambdaOutput, error) {
+						return &svcsdk.PutAccessPointPolicyForObjectLambdaOutput{}, nil
+					},
+				},
+				cr: olap(withPolicy(policy)),
+			},
+			want: want{
+				cr: olap(withPolicy(policy), withConditions(xpv1.Creating())),
+			},
+		},
+		"SupportingAccessPointUnresolved": {
+			args: args{
+				cr: olap(func(r *svcapitypes.ObjectLambdaAccessPoint) {
+					r.Spec.ForProvider.Configuration.SupportingAccessPoint = nil
+				}),
+			},
+			want: want{
+				cr: olap(func(r *svcapitypes.ObjectLambdaAccessPoint) {
+					r.Spec.ForProvider.Configuration.SupportingAccessPoint = nil
+				}),
+				err: errors.New(errNoSupportingARN),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockCreateAccessPointForObjectLambdaWithContext: func(context.Context, *svcsdk.CreateAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.CreateAccessPointForObjectLambdaOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	putConfig := func(context.Context, *svcsdk.PutAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*svcsdk.PutAccessPointConfigurationForObjectLambdaOutput, error) {
+		return &svcsdk.PutAccessPointConfigurationForObjectLambdaOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PutConfigurationError": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockPutAccessPointConfigurationForObjectLambdaWithContext: func(context.Context, *svcsdk.PutAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*svcsdk.PutAccessPointConfigurationForObjectLambdaOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(),
+				err: errorutils.Wrap(errBoom, errPutConfig),
+			},
+		},
+		"PutPolicyError": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockPutAccessPointConfigurationForObjectLambdaWithContext: putConfig,
+					MockPutAccessPointPolicyForObjectLambdaWithContext: func(context.Context, *svcsdk.PutAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.PutAccessPointPolicyForObjectLambdaOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: olap(withPolicy(policy)),
+			},
+			want: want{
+				cr:  olap(withPolicy(policy)),
+				err: errorutils.Wrap(errBoom, errPutPolicy),
+			},
+		},
+		"DeletePolicyNotFound": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockPutAccessPointConfigurationForObjectLambdaWithContext: putConfig,
+					MockDeleteAccessPointPolicyForObjectLambdaWithContext: func(context.Context, *svcsdk.DeleteAccessPointPolicyForObjectLambdaInput, ...request.Option) (*svcsdk.DeleteAccessPointPolicyForObjectLambdaOutput, error) {
+						return nil, errPolicyNotFound
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockDeleteAccessPointForObjectLambdaWithContext: func(context.Context, *svcsdk.DeleteAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.DeleteAccessPointForObjectLambdaOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockObjectLambdaAccessPointClient{
+					MockDeleteAccessPointForObjectLambdaWithContext: func(context.Context, *svcsdk.DeleteAccessPointForObjectLambdaInput, ...request.Option) (*svcsdk.DeleteAccessPointForObjectLambdaOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
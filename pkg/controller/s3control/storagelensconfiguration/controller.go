@@ -0,0 +1,219 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagelensconfiguration
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a StorageLensConfiguration resource"
+
+	errGet           = "cannot get StorageLensConfiguration in AWS"
+	errPut           = "cannot put StorageLensConfiguration in AWS"
+	errDelete        = "cannot delete StorageLensConfiguration in AWS"
+	errGetTagging    = "cannot get tags of StorageLensConfiguration in AWS"
+	errPutTagging    = "cannot put tags of StorageLensConfiguration in AWS"
+	errDeleteTagging = "cannot delete tags of StorageLensConfiguration in AWS"
+)
+
+// SetupStorageLensConfiguration adds a controller that reconciles
+// StorageLensConfigurations.
+func SetupStorageLensConfiguration(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.StorageLensConfigurationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3control.NewStorageLensClient}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.StorageLensConfigurationGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.StorageLensConfiguration{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3control.StorageLensClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.StorageLensConfiguration)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client s3control.StorageLensClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.StorageLensConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+
+	out, err := e.client.GetStorageLensConfigurationWithContext(ctx, &svcsdk.GetStorageLensConfigurationInput{
+		AccountId: p.AccountID,
+		ConfigId:  aws.String(meta.GetExternalName(cr)),
+	})
+	if s3control.IsStorageLensConfigurationNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGet)
+	}
+
+	cr.Status.AtProvider.ARN = out.StorageLensConfiguration.StorageLensArn
+	cr.SetConditions(xpv1.Available())
+
+	tagging, err := e.client.GetStorageLensConfigurationTaggingWithContext(ctx, &svcsdk.GetStorageLensConfigurationTaggingInput{
+		AccountId: p.AccountID,
+		ConfigId:  aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetTagging)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: s3control.IsStorageLensConfigurationUpToDate(p, out.StorageLensConfiguration) &&
+			s3control.AreStorageLensTagsUpToDate(p.Tags, tagging.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.StorageLensConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	input := &svcsdk.PutStorageLensConfigurationInput{
+		AccountId:                cr.Spec.ForProvider.AccountID,
+		ConfigId:                 aws.String(meta.GetExternalName(cr)),
+		StorageLensConfiguration: s3control.GenerateStorageLensConfiguration(meta.GetExternalName(cr), cr.Spec.ForProvider),
+	}
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		input.Tags = s3control.GenerateStorageLensTags(cr.Spec.ForProvider.Tags)
+	}
+	_, err := e.client.PutStorageLensConfigurationWithContext(ctx, input)
+	return managed.ExternalCreation{}, errorutils.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.StorageLensConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+
+	if _, err := e.client.PutStorageLensConfigurationWithContext(ctx, &svcsdk.PutStorageLensConfigurationInput{
+		AccountId:                p.AccountID,
+		ConfigId:                 aws.String(meta.GetExternalName(cr)),
+		StorageLensConfiguration: s3control.GenerateStorageLensConfiguration(meta.GetExternalName(cr), p),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errPut)
+	}
+
+	tagging, err := e.client.GetStorageLensConfigurationTaggingWithContext(ctx, &svcsdk.GetStorageLensConfigurationTaggingInput{
+		AccountId: p.AccountID,
+		ConfigId:  aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGetTagging)
+	}
+	if s3control.AreStorageLensTagsUpToDate(p.Tags, tagging.Tags) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if len(p.Tags) == 0 {
+		_, err := e.client.DeleteStorageLensConfigurationTaggingWithContext(ctx, &svcsdk.DeleteStorageLensConfigurationTaggingInput{
+			AccountId: p.AccountID,
+			ConfigId:  aws.String(meta.GetExternalName(cr)),
+		})
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTagging)
+	}
+	_, err = e.client.PutStorageLensConfigurationTaggingWithContext(ctx, &svcsdk.PutStorageLensConfigurationTaggingInput{
+		AccountId: p.AccountID,
+		ConfigId:  aws.String(meta.GetExternalName(cr)),
+		Tags:      s3control.GenerateStorageLensTags(p.Tags),
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutTagging)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.StorageLensConfiguration)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteStorageLensConfigurationWithContext(ctx, &svcsdk.DeleteStorageLensConfigurationInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		ConfigId:  aws.String(meta.GetExternalName(cr)),
+	})
+	return errorutils.Wrap(resource.Ignore(s3control.IsStorageLensConfigurationNotFound, err), errDelete)
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagelensconfiguration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	configID       = "org-dashboard"
+	accountID      = "123456789012"
+	arn            = "arn:aws:s3:us-east-1:123456789012:storage-lens/org-dashboard"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New("NoSuchConfiguration", "not found", nil)
+)
+
+type args struct {
+	client *fake.MockStorageLensClient
+	cr     resource.Managed
+}
+
+type configModifier func(*svcapitypes.StorageLensConfiguration)
+
+func withConditions(c ...xpv1.Condition) configModifier {
+	return func(r *svcapitypes.StorageLensConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tags ...svcapitypes.StorageLensTag) configModifier {
+	return func(r *svcapitypes.StorageLensConfiguration) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withARN() configModifier {
+	return func(r *svcapitypes.StorageLensConfiguration) { r.Status.AtProvider.ARN = aws.String(arn) }
+}
+
+func storageLensConfiguration(m ...configModifier) *svcapitypes.StorageLensConfiguration {
+	cr := &svcapitypes.StorageLensConfiguration{
+		Spec: svcapitypes.StorageLensConfigurationSpec{
+			ForProvider: svcapitypes.StorageLensConfigurationParameters{
+				Region:    "us-east-1",
+				AccountID: aws.String(accountID),
+				IsEnabled: true,
+				AccountLevel: svcapitypes.StorageLensAccountLevel{
+					ActivityMetrics: &svcapitypes.StorageLensMetrics{IsEnabled: true},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, configID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getConfiguration(activity bool) func(context.Context, *svcsdk.GetStorageLensConfigurationInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error) {
+	return func(_ context.Context, input *svcsdk.GetStorageLensConfigurationInput, _ ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error) {
+		if aws.StringValue(input.ConfigId) != configID {
+			return nil, errBoom
+		}
+		return &svcsdk.GetStorageLensConfigurationOutput{StorageLensConfiguration: &svcsdk.StorageLensConfiguration{
+			Id:             aws.String(configID),
+			IsEnabled:      aws.Bool(true),
+			StorageLensArn: aws.String(arn),
+			AccountLevel: &svcsdk.AccountLevel{
+				ActivityMetrics: &svcsdk.ActivityMetrics{IsEnabled: aws.Bool(activity)},
+				BucketLevel: &svcsdk.BucketLevel{
+					ActivityMetrics: &svcsdk.ActivityMetrics{IsEnabled: aws.Bool(false)},
+				},
+			},
+		}}, nil
+	}
+}
+
+func getTagging(tags ...*svcsdk.StorageLensTag) func(context.Context, *svcsdk.GetStorageLensConfigurationTaggingInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationTaggingOutput, error) {
+	return func(context.Context, *svcsdk.GetStorageLensConfigurationTaggingInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationTaggingOutput, error) {
+		return &svcsdk.GetStorageLensConfigurationTaggingOutput{Tags: tags}, nil
+	}
+}
+
+func putConfiguration(context.Context, *svcsdk.PutStorageLensConfigurationInput, ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error) {
+	return &svcsdk.PutStorageLensConfigurationOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockGetStorageLensConfigurationWithContext: func(context.Context, *svcsdk.GetStorageLensConfigurationInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr: storageLensConfiguration(),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockGetStorageLensConfigurationWithContext: func(context.Context, *svcsdk.GetStorageLensConfigurationInput, ...request.Option) (*svcsdk.GetStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr:  storageLensConfiguration(),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockGetStorageLensConfigurationWithContext:        getConfiguration(true),
+					MockGetStorageLensConfigurationTaggingWithContext: getTagging(&svcsdk.StorageLensTag{Key: aws.String("team"), Value: aws.String("a")}),
+				},
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"}),
+					withARN(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ConfigurationChanged": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockGetStorageLensConfigurationWithContext:        getConfiguration(false),
+					MockGetStorageLensConfigurationTaggingWithContext: getTagging(),
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr:     storageLensConfiguration(withARN(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockGetStorageLensConfigurationWithContext:        getConfiguration(true),
+					MockGetStorageLensConfigurationTaggingWithContext: getTagging(&svcsdk.StorageLensTag{Key: aws.String("team"), Value: aws.String("b")}),
+				},
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"}),
+					withARN(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockPutStorageLensConfigurationWithContext: func(_ context.Context, input *svcsdk.PutStorageLensConfigurationInput, _ ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error) {
+						if aws.StringValue(input.StorageLensConfiguration.Id) != configID || len(input.Tags) != 1 {
+							return nil, errBoom
+						}
+						return &svcsdk.PutStorageLensConfigurationOutput{}, nil
+					},
+				},
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"}), withConditions(xpv1.Creating())),
+			},
+		},
+		"PutError": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockPutStorageLensConfigurationWithContext: func(context.Context, *svcsdk.PutStorageLensConfigurationInput, ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr:  storageLensConfiguration(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PutTags": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockPutStorageLensConfigurationWithContext:        putConfiguration,
+					MockGetStorageLensConfigurationTaggingWithContext: getTagging(),
+					MockPutStorageLensConfigurationTaggingWithContext: func(_ context.Context, input *svcsdk.PutStorageLensConfigurationTaggingInput, _ ...request.Option) (*svcsdk.PutStorageLensConfigurationTaggingOutput, error) {
+						if len(input.Tags) != 1 {
+							return nil, errBoom
+						}
+						return &svcsdk.PutStorageLensConfigurationTaggingOutput{}, nil
+					},
+				},
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: storageLensConfiguration(withTags(svcapitypes.StorageLensTag{Key: "team", Value: "a"})),
+			},
+		},
+		"DeleteTags": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockPutStorageLensConfigurationWithContext:        putConfiguration,
+					MockGetStorageLensConfigurationTaggingWithContext: getTagging(&svcsdk.StorageLensTag{Key: aws.String("team"), Value: aws.String("a")}),
+					MockDeleteStorageLensConfigurationTaggingWithContext: func(context.Context, *svcsdk.DeleteStorageLensConfigurationTaggingInput, ...request.Option) (*svcsdk.DeleteStorageLensConfigurationTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr:  storageLensConfiguration(),
+				err: errorutils.Wrap(errBoom, errDeleteTagging),
+			},
+		},
+		"PutError": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockPutStorageLensConfigurationWithContext: func(context.Context, *svcsdk.PutStorageLensConfigurationInput, ...request.Option) (*svcsdk.PutStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr:  storageLensConfiguration(),
+				err: errorutils.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockDeleteStorageLensConfigurationWithContext: func(context.Context, *svcsdk.DeleteStorageLensConfigurationInput, ...request.Option) (*svcsdk.DeleteStorageLensConfigurationOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr: storageLensConfiguration(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockStorageLensClient{
+					MockDeleteStorageLensConfigurationWithContext: func(context.Context, *svcsdk.DeleteStorageLensConfigurationInput, ...request.Option) (*svcsdk.DeleteStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: storageLensConfiguration(),
+			},
+			want: want{
+				cr:  storageLensConfiguration(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
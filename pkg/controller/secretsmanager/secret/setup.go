@@ -33,6 +33,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -63,6 +64,8 @@ const (
 	errOnlyOneSecretRef     = "only one of binarySecretRef or stringSecretRef must be set"
 	errParseSpecPolicy      = "cannot parse spec policy"
 	errParseExternalPolicy  = "cannot parse external policy"
+	errRotateSecret         = "cannot rotate secret"
+	errCancelRotateSecret   = "cannot cancel secret rotation"
 )
 
 // SetupSecret adds a controller that reconciles a Secret.
@@ -252,6 +255,10 @@ func (e *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.Secret, resp *sv
 		return false, "", nil
 	}
 
+	if !isRotationUpToDate(cr.Spec.ForProvider.Rotation, resp) {
+		return false, "", nil
+	}
+
 	isPolicyUpToDate, err := e.isPolicyUpToDate(ctx, cr)
 	if err != nil {
 		return false, "", err
@@ -260,10 +267,80 @@ func (e *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.Secret, resp *sv
 		return false, "", nil
 	}
 
+	// The rotation function owns the value of a rotated secret, so the
+	// referenced Kubernetes secret is only used for its initial value.
+	if isRotationEnabled(cr.Spec.ForProvider.Rotation) {
+		return true, "", nil
+	}
+
 	isPayloadUpToDate, err := e.isPayloadUpToDate(ctx, cr)
 	return isPayloadUpToDate, "", err
 }
 
+// isRotationEnabled returns whether the rotation of the secret is managed and
+// should be turned on.
+func isRotationEnabled(r *svcapitypes.SecretRotation) bool {
+	return r != nil && ptr.Deref(r.Enabled, true)
+}
+
+// isRotationUpToDate checks whether the rotation configuration of the secret
+// matches the desired one. Rotation rules that are not set are not compared
+// since AWS derives them from the ones that are.
+func isRotationUpToDate(r *svcapitypes.SecretRotation, resp *svcsdk.DescribeSecretOutput) bool {
+	if r == nil {
+		return true
+	}
+	if !isRotationEnabled(r) {
+		return !pointer.BoolValue(resp.RotationEnabled)
+	}
+	if !pointer.BoolValue(resp.RotationEnabled) {
+		return false
+	}
+	if r.RotationLambdaARN != nil && pointer.StringValue(r.RotationLambdaARN) != pointer.StringValue(resp.RotationLambdaARN) {
+		return false
+	}
+	rules := resp.RotationRules
+	if rules == nil {
+		rules = &svcsdk.RotationRulesType{}
+	}
+	if r.AutomaticallyAfterDays != nil && pointer.Int64Value(r.AutomaticallyAfterDays) != pointer.Int64Value(rules.AutomaticallyAfterDays) {
+		return false
+	}
+	if r.ScheduleExpression != nil && pointer.StringValue(r.ScheduleExpression) != pointer.StringValue(rules.ScheduleExpression) {
+		return false
+	}
+	if r.Duration != nil && pointer.StringValue(r.Duration) != pointer.StringValue(rules.Duration) {
+		return false
+	}
+	return true
+}
+
+// updateRotation turns the rotation of the secret on or off and applies the
+// desired rotation configuration.
+func (e *hooks) updateRotation(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput) error {
+	r := cr.Spec.ForProvider.Rotation
+	if isRotationUpToDate(r, resp) {
+		return nil
+	}
+	if !isRotationEnabled(r) {
+		_, err := e.client.CancelRotateSecretWithContext(ctx, &svcsdk.CancelRotateSecretInput{
+			SecretId: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		})
+		return errorutils.Wrap(err, errCancelRotateSecret)
+	}
+	_, err := e.client.RotateSecretWithContext(ctx, &svcsdk.RotateSecretInput{
+		SecretId:          pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		RotationLambdaARN: r.RotationLambdaARN,
+		RotationRules: &svcsdk.RotationRulesType{
+			AutomaticallyAfterDays: r.AutomaticallyAfterDays,
+			Duration:               r.Duration,
+			ScheduleExpression:     r.ScheduleExpression,
+		},
+		RotateImmediately: r.RotateImmediately,
+	})
+	return errorutils.Wrap(err, errRotateSecret)
+}
+
 func (e *hooks) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.Secret) (bool, error) {
 	res, err := e.client.GetResourcePolicyWithContext(ctx, &svcsdk.GetResourcePolicyInput{
 		SecretId: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
@@ -396,15 +473,21 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 		}
 	}
 
-	payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
-	if err != nil {
+	if err := e.updateRotation(ctx, cr, resp); err != nil {
 		return err
 	}
-	switch {
-	case cr.Spec.ForProvider.StringSecretRef != nil:
-		obj.SecretString = pointer.ToOrNilIfZeroValue(string(payload))
-	case cr.Spec.ForProvider.BinarySecretRef != nil:
-		obj.SecretBinary = payload
+
+	if !isRotationEnabled(cr.Spec.ForProvider.Rotation) {
+		payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
+		if err != nil {
+			return err
+		}
+		switch {
+		case cr.Spec.ForProvider.StringSecretRef != nil:
+			obj.SecretString = pointer.ToOrNilIfZeroValue(string(payload))
+		case cr.Spec.ForProvider.BinarySecretRef != nil:
+			obj.SecretBinary = payload
+		}
 	}
 	obj.SecretId = pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr))
 	obj.Description = cr.Spec.ForProvider.Description
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -167,3 +168,149 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRotationUpToDate(t *testing.T) {
+	lambdaARN := "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	enabled := &secretsmanager.DescribeSecretOutput{
+		RotationEnabled:   aws.Bool(true),
+		RotationLambdaARN: aws.String(lambdaARN),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(30),
+			ScheduleExpression:     aws.String("rate(30 days)"),
+		},
+	}
+
+	cases := map[string]struct {
+		rotation *v1beta1.SecretRotation
+		resp     *secretsmanager.DescribeSecretOutput
+		want     bool
+	}{
+		"Unmanaged": {
+			resp: enabled,
+			want: true,
+		},
+		"UpToDate": {
+			rotation: &v1beta1.SecretRotation{
+				RotationLambdaARN:  aws.String(lambdaARN),
+				ScheduleExpression: aws.String("rate(30 days)"),
+			},
+			resp: enabled,
+			want: true,
+		},
+		"NotEnabledYet": {
+			rotation: &v1beta1.SecretRotation{
+				RotationLambdaARN:  aws.String(lambdaARN),
+				ScheduleExpression: aws.String("rate(30 days)"),
+			},
+			resp: &secretsmanager.DescribeSecretOutput{},
+			want: false,
+		},
+		"ScheduleChanged": {
+			rotation: &v1beta1.SecretRotation{
+				RotationLambdaARN:  aws.String(lambdaARN),
+				ScheduleExpression: aws.String("rate(10 days)"),
+			},
+			resp: enabled,
+			want: false,
+		},
+		"DurationAdded": {
+			rotation: &v1beta1.SecretRotation{
+				RotationLambdaARN:  aws.String(lambdaARN),
+				ScheduleExpression: aws.String("rate(30 days)"),
+				Duration:           aws.String("3h"),
+			},
+			resp: enabled,
+			want: false,
+		},
+		"DisabledButEnabled": {
+			rotation: &v1beta1.SecretRotation{Enabled: aws.Bool(false)},
+			resp:     enabled,
+			want:     false,
+		},
+		"Disabled": {
+			rotation: &v1beta1.SecretRotation{Enabled: aws.Bool(false)},
+			resp:     &secretsmanager.DescribeSecretOutput{RotationEnabled: aws.Bool(false)},
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isRotationUpToDate(tc.rotation, tc.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateRotation(t *testing.T) {
+	lambdaARN := "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+
+	type want struct {
+		rotate *secretsmanager.RotateSecretInput
+		cancel *secretsmanager.CancelRotateSecretInput
+	}
+
+	cases := map[string]struct {
+		rotation *v1beta1.SecretRotation
+		resp     *secretsmanager.DescribeSecretOutput
+		want     want
+	}{
+		"Enable": {
+			rotation: &v1beta1.SecretRotation{
+				RotationLambdaARN:  aws.String(lambdaARN),
+				ScheduleExpression: aws.String("cron(0 8 1 * ? *)"),
+				Duration:           aws.String("2h"),
+				RotateImmediately:  aws.Bool(false),
+			},
+			resp: &secretsmanager.DescribeSecretOutput{},
+			want: want{
+				rotate: &secretsmanager.RotateSecretInput{
+					SecretId:          aws.String("test"),
+					RotationLambdaARN: aws.String(lambdaARN),
+					RotationRules: &secretsmanager.RotationRulesType{
+						ScheduleExpression: aws.String("cron(0 8 1 * ? *)"),
+						Duration:           aws.String("2h"),
+					},
+					RotateImmediately: aws.Bool(false),
+				},
+			},
+		},
+		"Disable": {
+			rotation: &v1beta1.SecretRotation{Enabled: aws.Bool(false)},
+			resp:     &secretsmanager.DescribeSecretOutput{RotationEnabled: aws.Bool(true)},
+			want: want{
+				cancel: &secretsmanager.CancelRotateSecretInput{SecretId: aws.String("test")},
+			},
+		},
+		"Unmanaged": {
+			resp: &secretsmanager.DescribeSecretOutput{RotationEnabled: aws.Bool(true)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			h := &hooks{client: &fake.MockSecretsManagerClient{
+				MockRotateSecretWithContext: func(in *secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error) {
+					got.rotate = in
+					return &secretsmanager.RotateSecretOutput{}, nil
+				},
+				MockCancelRotateSecretWithContext: func(in *secretsmanager.CancelRotateSecretInput) (*secretsmanager.CancelRotateSecretOutput, error) {
+					got.cancel = in
+					return &secretsmanager.CancelRotateSecretOutput{}, nil
+				},
+			}}
+			cr := secret(withExternalName("test"), withSpec(v1beta1.SecretParameters{
+				CustomSecretParameters: v1beta1.CustomSecretParameters{Rotation: tc.rotation},
+			}))
+			if err := h.updateRotation(context.Background(), cr, tc.resp); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
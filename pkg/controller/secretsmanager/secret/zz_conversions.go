@@ -56,6 +56,16 @@ func GenerateSecret(resp *svcsdk.DescribeSecretOutput) *svcapitypes.Secret {
 	} else {
 		cr.Spec.ForProvider.KMSKeyID = nil
 	}
+	if resp.LastRotatedDate != nil {
+		cr.Status.AtProvider.LastRotatedDate = &metav1.Time{*resp.LastRotatedDate}
+	} else {
+		cr.Status.AtProvider.LastRotatedDate = nil
+	}
+	if resp.NextRotationDate != nil {
+		cr.Status.AtProvider.NextRotationDate = &metav1.Time{*resp.NextRotationDate}
+	} else {
+		cr.Status.AtProvider.NextRotationDate = nil
+	}
 	if resp.ReplicationStatus != nil {
 		f11 := []*svcapitypes.ReplicationStatusType{}
 		for _, f11iter := range resp.ReplicationStatus {
@@ -81,6 +91,11 @@ func GenerateSecret(resp *svcsdk.DescribeSecretOutput) *svcapitypes.Secret {
 	} else {
 		cr.Status.AtProvider.ReplicationStatus = nil
 	}
+	if resp.RotationEnabled != nil {
+		cr.Status.AtProvider.RotationEnabled = resp.RotationEnabled
+	} else {
+		cr.Status.AtProvider.RotationEnabled = nil
+	}
 	if resp.Tags != nil {
 		f15 := []*svcapitypes.Tag{}
 		for _, f15iter := range resp.Tags {
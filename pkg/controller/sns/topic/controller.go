@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -55,6 +56,7 @@ const (
 // SetupSNSTopic adds a controller that reconciles Topic.
 func SetupSNSTopic(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.TopicGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -63,13 +65,13 @@ func SetupSNSTopic(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewTopicClient}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewTopicClient, recorder: recorder}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithInitializers(),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -92,6 +94,7 @@ func SetupSNSTopic(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) sns.TopicClient
+	recorder    event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -103,12 +106,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, recorder: c.recorder}, nil
 }
 
 type external struct {
-	client snsclient.TopicClient
-	kube   client.Client
+	client   snsclient.TopicClient
+	kube     client.Client
+	recorder event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -147,6 +151,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		ResourceExists:          true,
 		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !reflect.DeepEqual(current, &cr.Spec.ForProvider),
+		Diff:                    snsclient.PolicyDiff(cr.Spec.ForProvider, res.Attributes),
 	}, nil
 }
 
@@ -184,6 +189,10 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetChangedAttr)
 	}
+	policyDiff := snsclient.PolicyDiff(cr.Spec.ForProvider, resp.Attributes)
+	if policyDiff != "" {
+		e.recorder.Event(cr, event.Normal(policyutils.ReasonPolicyDiff, policyutils.WithDiff("Updating the topic policy", policyDiff)))
+	}
 	for k, v := range attrs {
 		_, err = e.client.SetTopicAttributes(ctx, &awssns.SetTopicAttributesInput{
 			AttributeName:  aws.String(k),
@@ -191,7 +200,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			TopicArn:       aws.String(meta.GetExternalName(cr)),
 		})
 	}
-	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, policyutils.WithDiff(errUpdate, policyDiff))
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -59,6 +60,7 @@ const (
 // SetupQueue adds a controller that reconciles Queue.
 func SetupQueue(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.QueueGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -67,10 +69,10 @@ func SetupQueue(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient, recorder: recorder}),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -93,6 +95,7 @@ func SetupQueue(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(aws.Config) sqs.Client
+	recorder    event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -104,12 +107,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, recorder: c.recorder}, nil
 }
 
 type external struct {
-	client sqs.Client
-	kube   client.Client
+	client   sqs.Client
+	kube     client.Client
+	recorder event.Recorder
+
+	// policyDiff is the diff of the queue policy that is observed before an
+	// update.
+	policyDiff string
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -157,6 +165,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errIsUpToDate)
 	}
+	e.policyDiff = sqs.PolicyDiff(cr.Spec.ForProvider, resAttributes.Attributes)
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
@@ -198,12 +207,16 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
+	if e.policyDiff != "" {
+		e.recorder.Event(cr, event.Normal(policyutils.ReasonPolicyDiff, policyutils.WithDiff("Updating the queue policy", e.policyDiff)))
+	}
+
 	_, err := e.client.SetQueueAttributes(ctx, &awssqs.SetQueueAttributesInput{
 		QueueUrl:   aws.String(cr.Status.AtProvider.URL),
 		Attributes: sqs.GenerateQueueAttributes(&cr.Spec.ForProvider),
 	})
 	if err != nil {
-		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdateFailed)
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, policyutils.WithDiff(errUpdateFailed, e.policyDiff))
 	}
 
 	resTags, err := e.client.ListQueueTags(ctx, &awssqs.ListQueueTagsInput{
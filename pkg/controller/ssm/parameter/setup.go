@@ -0,0 +1,346 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/tags"
+)
+
+const (
+	// ConnectionDetailsValueKey is the key of the connection secret that
+	// holds the current value of the parameter.
+	ConnectionDetailsValueKey = "value"
+
+	errDescribeParameters = "cannot describe parameter metadata"
+	errListTags           = "cannot list tags of the parameter"
+	errAddTags            = "cannot add tags to the parameter"
+	errRemoveTags         = "cannot remove tags from the parameter"
+	errGetValueSecret     = "cannot get the Kubernetes secret holding the parameter value"
+	errFmtKeyNotFound     = "key %s is not found in referenced Kubernetes secret"
+	errNoValue            = "neither value nor valueSecretRef is given"
+	errOnlyOneValue       = "only one of value or valueSecretRef must be set"
+	errParseSpecPolicies  = "cannot parse spec policies"
+	errParsePolicy        = "cannot parse parameter policy"
+)
+
+// SetupParameter adds a controller that reconciles Parameter.
+func SetupParameter(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ParameterGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ParameterGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.Parameter{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client, kube: e.kube}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = h.preCreate
+	e.preUpdate = h.preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.SSMAPI
+	kube   client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Parameter, obj *svcsdk.GetParameterInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.WithDecryption = aws.Bool(true)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Parameter, resp *svcsdk.GetParameterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		ConnectionDetailsValueKey: []byte(pointer.StringValue(resp.Parameter.Value)),
+	}
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.Parameter, resp *svcsdk.GetParameterOutput) (bool, string, error) {
+	md, err := h.describeParameter(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return false, "", err
+	}
+	if md != nil {
+		upToDate, diff, err := isMetadataUpToDate(cr.Spec.ForProvider, md)
+		if err != nil || !upToDate {
+			return false, diff, err
+		}
+	}
+
+	add, remove, err := h.diffTags(ctx, cr)
+	if err != nil {
+		return false, "", err
+	}
+	if len(add) != 0 || len(remove) != 0 {
+		return false, "", nil
+	}
+
+	// The value is compared without ever surfacing it, only the version that
+	// drifted is reported.
+	value, err := h.getValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return false, "", err
+	}
+	if value != pointer.StringValue(resp.Parameter.Value) {
+		return false, fmt.Sprintf("value of version %d differs from the desired value", pointer.Int64Value(resp.Parameter.Version)), nil
+	}
+	return true, "", nil
+}
+
+func (h *hooks) describeParameter(ctx context.Context, name string) (*svcsdk.ParameterMetadata, error) {
+	resp, err := h.client.DescribeParametersWithContext(ctx, &svcsdk.DescribeParametersInput{
+		ParameterFilters: []*svcsdk.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: []*string{aws.String(name)},
+		}},
+	})
+	if err != nil {
+		return nil, errorutils.Wrap(err, errDescribeParameters)
+	}
+	for _, p := range resp.Parameters {
+		if pointer.StringValue(p.Name) == name {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// isMetadataUpToDate compares the optional parameter settings. Settings that
+// are not given in the spec are left to AWS.
+func isMetadataUpToDate(spec svcapitypes.ParameterParameters, md *svcsdk.ParameterMetadata) (bool, string, error) {
+	switch {
+	case spec.Description != nil && *spec.Description != pointer.StringValue(md.Description),
+		spec.AllowedPattern != nil && *spec.AllowedPattern != pointer.StringValue(md.AllowedPattern),
+		spec.DataType != nil && *spec.DataType != pointer.StringValue(md.DataType),
+		spec.KeyID != nil && *spec.KeyID != pointer.StringValue(md.KeyId),
+		spec.Type != nil && *spec.Type != pointer.StringValue(md.Type):
+		return false, "", nil
+	}
+	// Intelligent-Tiering resolves to either Standard or Advanced.
+	if spec.Tier != nil && *spec.Tier != svcsdk.ParameterTierIntelligentTiering && *spec.Tier != pointer.StringValue(md.Tier) {
+		return false, "", nil
+	}
+	if spec.Policies == nil {
+		return true, "", nil
+	}
+	return arePoliciesUpToDate(*spec.Policies, md.Policies)
+}
+
+// arePoliciesUpToDate compares the JSON array of desired policies with the
+// policies attached to the parameter, ignoring formatting and order.
+func arePoliciesUpToDate(desired string, current []*svcsdk.ParameterInlinePolicy) (bool, string, error) {
+	var d []any
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return false, "", errors.Wrap(err, errParseSpecPolicies)
+	}
+	c := make([]any, len(current))
+	for i, p := range current {
+		if err := json.Unmarshal([]byte(pointer.StringValue(p.PolicyText)), &c[i]); err != nil {
+			return false, "", errors.Wrap(err, errParsePolicy)
+		}
+	}
+	less := func(a, b any) bool {
+		ja, _ := json.Marshal(a)
+		jb, _ := json.Marshal(b)
+		return string(ja) < string(jb)
+	}
+	diff := cmp.Diff(d, c, cmpopts.EquateEmpty(), cmpopts.SortSlices(less))
+	return diff == "", diff, nil
+}
+
+// getValue returns the desired value of the parameter from either the spec or
+// the referenced Kubernetes secret.
+func (h *hooks) getValue(ctx context.Context, params *svcapitypes.ParameterParameters) (string, error) {
+	switch {
+	case params.Value != nil && params.ValueSecretRef != nil:
+		return "", errors.New(errOnlyOneValue)
+	case params.Value != nil:
+		return *params.Value, nil
+	case params.ValueSecretRef == nil:
+		return "", errors.New(errNoValue)
+	}
+	ref := params.ValueSecretRef
+	sc := &corev1.Secret{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sc); err != nil {
+		return "", errors.Wrap(err, errGetValueSecret)
+	}
+	val, ok := sc.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errFmtKeyNotFound, ref.Key)
+	}
+	return string(val), nil
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.Parameter, obj *svcsdk.PutParameterInput) error {
+	value, err := h.getValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Value = aws.String(value)
+	return nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Parameter, obj *svcsdk.PutParameterInput) error {
+	value, err := h.getValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Value = aws.String(value)
+	obj.Overwrite = aws.Bool(true)
+	// Tags cannot be given together with Overwrite, they are updated in
+	// postUpdate instead.
+	obj.Tags = nil
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Parameter, resp *svcsdk.PutParameterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cr.Status.AtProvider.Version = resp.Version
+
+	add, remove, err := h.diffTags(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(remove) != 0 {
+		if _, err := h.client.RemoveTagsFromResourceWithContext(ctx, &svcsdk.RemoveTagsFromResourceInput{
+			ResourceType: aws.String(svcsdk.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(meta.GetExternalName(cr)),
+			TagKeys:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := h.client.AddTagsToResourceWithContext(ctx, &svcsdk.AddTagsToResourceInput{
+			ResourceType: aws.String(svcsdk.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(meta.GetExternalName(cr)),
+			Tags:         add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTags)
+		}
+	}
+	return upd, nil
+}
+
+func (h *hooks) diffTags(ctx context.Context, cr *svcapitypes.Parameter) ([]*svcsdk.Tag, []*string, error) {
+	resp, err := h.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceType: aws.String(svcsdk.ResourceTypeForTaggingParameter),
+		ResourceId:   aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, nil, errorutils.Wrap(err, errListTags)
+	}
+	addMap, removeKeys := tags.DiffTags(tagMap(cr.Spec.ForProvider.Tags), sdkTagMap(resp.TagList))
+	add := make([]*svcsdk.Tag, 0, len(addMap))
+	for k, v := range addMap {
+		add = append(add, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(add, func(i, j int) bool { return pointer.StringValue(add[i].Key) < pointer.StringValue(add[j].Key) })
+	sort.Strings(removeKeys)
+	return add, aws.StringSlice(removeKeys), nil
+}
+
+func tagMap(in []*svcapitypes.Tag) map[string]string {
+	res := make(map[string]string, len(in))
+	for _, t := range in {
+		res[pointer.StringValue(t.Key)] = pointer.StringValue(t.Value)
+	}
+	return res
+}
+
+func sdkTagMap(in []*svcsdk.Tag) map[string]string {
+	res := make(map[string]string, len(in))
+	for _, t := range in {
+		res[pointer.StringValue(t.Key)] = pointer.StringValue(t.Value)
+	}
+	return res
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Parameter, obj *svcsdk.DeleteParameterInput) (bool, error) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
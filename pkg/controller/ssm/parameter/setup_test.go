@@ -0,0 +1,205 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+)
+
+const (
+	testName   = "/app/db/password"
+	testSecret = "s3cr3t"
+)
+
+type mockSSM struct {
+	svcsdkapi.SSMAPI
+	metadata *svcsdk.ParameterMetadata
+	tags     []*svcsdk.Tag
+}
+
+func (m *mockSSM) DescribeParametersWithContext(context.Context, *svcsdk.DescribeParametersInput, ...request.Option) (*svcsdk.DescribeParametersOutput, error) {
+	return &svcsdk.DescribeParametersOutput{Parameters: []*svcsdk.ParameterMetadata{m.metadata}}, nil
+}
+
+func (m *mockSSM) ListTagsForResourceWithContext(context.Context, *svcsdk.ListTagsForResourceInput, ...request.Option) (*svcsdk.ListTagsForResourceOutput, error) {
+	return &svcsdk.ListTagsForResourceOutput{TagList: m.tags}, nil
+}
+
+func parameter(m ...func(*svcapitypes.Parameter)) *svcapitypes.Parameter {
+	cr := &svcapitypes.Parameter{}
+	meta.SetExternalName(cr, testName)
+	cr.Spec.ForProvider.Type = aws.String(svcsdk.ParameterTypeSecureString)
+	cr.Spec.ForProvider.ValueSecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "db", Namespace: "default"},
+		Key:             "password",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func metadata() *svcsdk.ParameterMetadata {
+	return &svcsdk.ParameterMetadata{
+		Name: aws.String(testName),
+		Type: aws.String(svcsdk.ParameterTypeSecureString),
+		Tier: aws.String(svcsdk.ParameterTierStandard),
+	}
+}
+
+func output(value string) *svcsdk.GetParameterOutput {
+	return &svcsdk.GetParameterOutput{Parameter: &svcsdk.Parameter{
+		Name:    aws.String(testName),
+		Value:   aws.String(value),
+		Version: aws.Int64(3),
+	}}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(testSecret)}
+			return nil
+		},
+	}
+	type want struct {
+		upToDate bool
+		diff     string
+	}
+	cases := map[string]struct {
+		cr       *svcapitypes.Parameter
+		metadata *svcsdk.ParameterMetadata
+		tags     []*svcsdk.Tag
+		resp     *svcsdk.GetParameterOutput
+		want     want
+	}{
+		"UpToDate": {
+			cr:       parameter(),
+			metadata: metadata(),
+			resp:     output(testSecret),
+			want:     want{upToDate: true},
+		},
+		"ValueDrifted": {
+			cr:       parameter(),
+			metadata: metadata(),
+			resp:     output("changed-outside"),
+			want: want{
+				upToDate: false,
+				diff:     "value of version 3 differs from the desired value",
+			},
+		},
+		"InlineValue": {
+			cr: parameter(func(cr *svcapitypes.Parameter) {
+				cr.Spec.ForProvider.ValueSecretRef = nil
+				cr.Spec.ForProvider.Value = aws.String("plain")
+			}),
+			metadata: metadata(),
+			resp:     output("plain"),
+			want:     want{upToDate: true},
+		},
+		"IntelligentTieringIgnored": {
+			cr: parameter(func(cr *svcapitypes.Parameter) {
+				cr.Spec.ForProvider.Tier = aws.String(svcsdk.ParameterTierIntelligentTiering)
+			}),
+			metadata: metadata(),
+			resp:     output(testSecret),
+			want:     want{upToDate: true},
+		},
+		"TierChanged": {
+			cr: parameter(func(cr *svcapitypes.Parameter) {
+				cr.Spec.ForProvider.Tier = aws.String(svcsdk.ParameterTierAdvanced)
+			}),
+			metadata: metadata(),
+			resp:     output(testSecret),
+			want:     want{upToDate: false},
+		},
+		"PoliciesReordered": {
+			cr: parameter(func(cr *svcapitypes.Parameter) {
+				cr.Spec.ForProvider.Policies = aws.String(`[
+  {"Type":"NoChangeNotification","Version":"1.0","Attributes":{"After":"60","Unit":"Days"}},
+  {"Type":"Expiration","Version":"1.0","Attributes":{"Timestamp":"2030-01-01T00:00:00.000Z"}}
+]`)
+			}),
+			metadata: func() *svcsdk.ParameterMetadata {
+				md := metadata()
+				md.Policies = []*svcsdk.ParameterInlinePolicy{
+					{PolicyText: aws.String(`{"Type":"Expiration","Version":"1.0","Attributes":{"Timestamp":"2030-01-01T00:00:00.000Z"}}`)},
+					{PolicyText: aws.String(`{"Type":"NoChangeNotification","Version":"1.0","Attributes":{"After":"60","Unit":"Days"}}`)},
+				}
+				return md
+			}(),
+			resp: output(testSecret),
+			want: want{upToDate: true},
+		},
+		"TagsChanged": {
+			cr: parameter(func(cr *svcapitypes.Parameter) {
+				cr.Spec.ForProvider.Tags = []*svcapitypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+			}),
+			metadata: metadata(),
+			resp:     output(testSecret),
+			want:     want{upToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: &mockSSM{metadata: tc.metadata, tags: tc.tags}, kube: kube}
+			upToDate, diff, err := h.isUpToDate(context.TODO(), tc.cr, tc.resp)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want.upToDate, upToDate); d != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", d)
+			}
+			if d := cmp.Diff(tc.want.diff, diff); d != "" {
+				t.Errorf("diff: -want, +got:\n%s", d)
+			}
+			if strings.Contains(diff, testSecret) {
+				t.Errorf("diff must not contain the parameter value")
+			}
+		})
+	}
+}
+
+func TestGetValue(t *testing.T) {
+	h := &hooks{}
+	cr := parameter(func(cr *svcapitypes.Parameter) {
+		cr.Spec.ForProvider.Value = aws.String("plain")
+	})
+	if _, err := h.getValue(context.TODO(), &cr.Spec.ForProvider); err == nil || err.Error() != errOnlyOneValue {
+		t.Errorf("expected %q, got %v", errOnlyOneValue, err)
+	}
+	cr.Spec.ForProvider.Value, cr.Spec.ForProvider.ValueSecretRef = nil, nil
+	if _, err := h.getValue(context.TODO(), &cr.Spec.ForProvider); err == nil || err.Error() != errNoValue {
+		t.Errorf("expected %q, got %v", errNoValue, err)
+	}
+}
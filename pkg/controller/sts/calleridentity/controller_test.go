@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calleridentity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/sts/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+	accountID      = "123456789012"
+	arn            = "arn:aws:iam::123456789012:user/crossplane"
+	userID         = "AIDAEXAMPLE"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sts *fake.MockSTSClient
+	cr  resource.Managed
+}
+
+type identityModifier func(*svcapitypes.CallerIdentity)
+
+func withConditions(c ...xpv1.Condition) identityModifier {
+	return func(r *svcapitypes.CallerIdentity) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o svcapitypes.CallerIdentityObservation) identityModifier {
+	return func(r *svcapitypes.CallerIdentity) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() identityModifier {
+	return func(r *svcapitypes.CallerIdentity) {
+		t := metav1.Unix(1, 0)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func callerIdentity(m ...identityModifier) *svcapitypes.CallerIdentity {
+	cr := &svcapitypes.CallerIdentity{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: callerIdentity(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: callerIdentity(withDeletionTimestamp()),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: callerIdentity(),
+			},
+			want: want{
+				cr:  callerIdentity(),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"Identity": {
+			args: args{
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+						return &sts.GetCallerIdentityOutput{Account: aws.String(accountID), Arn: aws.String(arn), UserId: aws.String(userID)}, nil
+					},
+				},
+				cr: callerIdentity(),
+			},
+			want: want{
+				cr: callerIdentity(withConditions(xpv1.Available()), withObservation(svcapitypes.CallerIdentityObservation{
+					AccountID: accountID,
+					ARN:       arn,
+					UserID:    userID,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyAccountID: []byte(accountID),
+						keyARN:       []byte(arn),
+						keyUserID:    []byte(userID),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sts}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package regexpatternset
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+	wafv2 "github.com/crossplane-contrib/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errGetRegexPatternSet = "cannot get regex pattern set"
+)
+
+// SetupRegexPatternSet adds a controller that reconciles RegexPatternSet.
+func SetupRegexPatternSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.RegexPatternSetGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.RegexPatternSetGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.RegexPatternSet{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = h.preObserve
+	e.postObserve = postObserve
+	e.filterList = filterList
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.preUpdate = preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.WAFV2API
+}
+
+func (h *hooks) preObserve(ctx context.Context, cr *svcapitypes.RegexPatternSet, obj *svcsdk.ListRegexPatternSetsInput) error {
+	obj.Limit = aws.Int64(wafv2.ListLimit)
+	marker, err := wafv2.FindPageMarker(ctx, meta.GetExternalName(cr), func(ctx context.Context, marker *string) ([]*string, *string, error) {
+		resp, err := h.client.ListRegexPatternSetsWithContext(ctx, &svcsdk.ListRegexPatternSetsInput{Scope: obj.Scope, Limit: obj.Limit, NextMarker: marker})
+		if err != nil {
+			return nil, nil, err
+		}
+		names := make([]*string, len(resp.RegexPatternSets))
+		for i, s := range resp.RegexPatternSets {
+			names[i] = s.Name
+		}
+		return names, resp.NextMarker, nil
+	})
+	obj.NextMarker = marker
+	return err
+}
+
+func filterList(cr *svcapitypes.RegexPatternSet, list *svcsdk.ListRegexPatternSetsOutput) *svcsdk.ListRegexPatternSetsOutput {
+	res := &svcsdk.ListRegexPatternSetsOutput{}
+	for _, s := range list.RegexPatternSets {
+		if aws.StringValue(s.Name) == meta.GetExternalName(cr) {
+			res.RegexPatternSets = append(res.RegexPatternSets, s)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.RegexPatternSet, _ *svcsdk.ListRegexPatternSetsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.RegexPatternSet, _ *svcsdk.ListRegexPatternSetsOutput) (bool, string, error) {
+	resp, err := h.client.GetRegexPatternSetWithContext(ctx, &svcsdk.GetRegexPatternSetInput{
+		Name:  aws.String(meta.GetExternalName(cr)),
+		Scope: cr.Spec.ForProvider.Scope,
+		Id:    cr.Status.AtProvider.ID,
+	})
+	if err != nil {
+		return false, "", errors.Wrap(err, errGetRegexPatternSet)
+	}
+	if resp.RegexPatternSet == nil {
+		return false, "", nil
+	}
+	if resp.LockToken != nil {
+		cr.Status.AtProvider.LockToken = resp.LockToken
+	}
+	spec := cr.Spec.ForProvider
+	if diff := cmp.Diff(regexStrings(spec.RegularExpressionList), sdkRegexStrings(resp.RegexPatternSet.RegularExpressionList), cmpopts.EquateEmpty()); diff != "" {
+		return false, "regularExpressionList: " + diff, nil
+	}
+	if diff := cmp.Diff(aws.StringValue(spec.Description), aws.StringValue(resp.RegexPatternSet.Description)); diff != "" {
+		return false, "description: " + diff, nil
+	}
+	tags, err := wafv2.ListTags(ctx, h.client, cr.Status.AtProvider.ARN)
+	if err != nil {
+		return false, "", err
+	}
+	if add, remove := wafv2.DiffTags(spec.Tags, tags); len(add) > 0 || len(remove) > 0 {
+		return false, "tags differ", nil
+	}
+	return true, "", nil
+}
+
+func regexStrings(in []*svcapitypes.Regex) []string {
+	res := make([]string, 0, len(in))
+	for _, r := range in {
+		res = append(res, aws.StringValue(r.RegexString))
+	}
+	sort.Strings(res)
+	return res
+}
+
+func sdkRegexStrings(in []*svcsdk.Regex) []string {
+	res := make([]string, 0, len(in))
+	for _, r := range in {
+		res = append(res, aws.StringValue(r.RegexString))
+	}
+	sort.Strings(res)
+	return res
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.RegexPatternSet, obj *svcsdk.CreateRegexPatternSetInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.RegexPatternSet, obj *svcsdk.UpdateRegexPatternSetInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Id = cr.Status.AtProvider.ID
+	obj.LockToken = cr.Status.AtProvider.LockToken
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.RegexPatternSet, obj *svcsdk.UpdateRegexPatternSetOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if obj.NextLockToken != nil {
+		cr.Status.AtProvider.LockToken = obj.NextLockToken
+	}
+	return upd, wafv2.UpdateTags(ctx, h.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.Tags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.RegexPatternSet, obj *svcsdk.DeleteRegexPatternSetInput) (bool, error) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Id = cr.Status.AtProvider.ID
+	obj.LockToken = cr.Status.AtProvider.LockToken
+	return false, nil
+}
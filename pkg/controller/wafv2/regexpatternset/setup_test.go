@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package regexpatternset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+)
+
+const (
+	testName = "regex-pattern-set"
+	testID   = "1234"
+)
+
+var errBoom = errors.New("boom")
+
+type mockWAFV2 struct {
+	svcsdkapi.WAFV2API
+	updateErr   error
+	updateInput *svcsdk.UpdateRegexPatternSetInput
+}
+
+func (m *mockWAFV2) GetRegexPatternSetWithContext(context.Context, *svcsdk.GetRegexPatternSetInput, ...request.Option) (*svcsdk.GetRegexPatternSetOutput, error) {
+	return &svcsdk.GetRegexPatternSetOutput{RegexPatternSet: &svcsdk.RegexPatternSet{Name: aws.String(testName)}, LockToken: aws.String("token")}, nil
+}
+
+func (m *mockWAFV2) UpdateRegexPatternSetWithContext(_ context.Context, in *svcsdk.UpdateRegexPatternSetInput, _ ...request.Option) (*svcsdk.UpdateRegexPatternSetOutput, error) {
+	m.updateInput = in
+	if m.updateErr != nil {
+		return nil, m.updateErr
+	}
+	return &svcsdk.UpdateRegexPatternSetOutput{NextLockToken: aws.String("next-token")}, nil
+}
+
+func (m *mockWAFV2) ListTagsForResourceWithContext(context.Context, *svcsdk.ListTagsForResourceInput, ...request.Option) (*svcsdk.ListTagsForResourceOutput, error) {
+	return &svcsdk.ListTagsForResourceOutput{TagInfoForResource: &svcsdk.TagInfoForResource{}}, nil
+}
+
+func regexPatternSet() *svcapitypes.RegexPatternSet {
+	cr := &svcapitypes.RegexPatternSet{}
+	meta.SetExternalName(cr, testName)
+	cr.Spec.ForProvider.Scope = aws.String(svcsdk.ScopeRegional)
+	cr.Spec.ForProvider.RegularExpressionList = []*svcapitypes.Regex{{RegexString: aws.String("^/api/")}}
+	cr.Status.AtProvider.ID = aws.String(testID)
+	cr.Status.AtProvider.LockToken = aws.String("stale-token")
+	return cr
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		inputLockToken string
+		lockToken      string
+		err            error
+	}
+
+	cases := map[string]struct {
+		updateErr error
+		want      want
+	}{
+		"ObservedLockTokenRotated": {
+			want: want{
+				inputLockToken: "token",
+				lockToken:      "next-token",
+			},
+		},
+		"UpdateFailed": {
+			updateErr: errBoom,
+			want: want{
+				inputLockToken: "token",
+				lockToken:      "token",
+				err:            errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := regexPatternSet()
+			m := &mockWAFV2{updateErr: tc.updateErr}
+			e := newExternal(nil, m, []option{setupExternal})
+			if _, _, err := e.isUpToDate(context.Background(), cr, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.inputLockToken, aws.StringValue(m.updateInput.LockToken)); diff != "" {
+				t.Errorf("input lockToken: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.lockToken, aws.StringValue(cr.Status.AtProvider.LockToken)); diff != "" {
+				t.Errorf("lockToken: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulegroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+	wafv2 "github.com/crossplane-contrib/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errGetRuleGroup = "cannot get rule group"
+)
+
+// SetupRuleGroup adds a controller that reconciles RuleGroup.
+func SetupRuleGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.RuleGroupGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.RuleGroupGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.RuleGroup{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = h.preObserve
+	e.postObserve = postObserve
+	e.filterList = filterList
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.preUpdate = preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.WAFV2API
+}
+
+func (h *hooks) preObserve(ctx context.Context, cr *svcapitypes.RuleGroup, obj *svcsdk.ListRuleGroupsInput) error {
+	obj.Limit = aws.Int64(wafv2.ListLimit)
+	marker, err := wafv2.FindPageMarker(ctx, meta.GetExternalName(cr), func(ctx context.Context, marker *string) ([]*string, *string, error) {
+		resp, err := h.client.ListRuleGroupsWithContext(ctx, &svcsdk.ListRuleGroupsInput{Scope: obj.Scope, Limit: obj.Limit, NextMarker: marker})
+		if err != nil {
+			return nil, nil, err
+		}
+		names := make([]*string, len(resp.RuleGroups))
+		for i, s := range resp.RuleGroups {
+			names[i] = s.Name
+		}
+		return names, resp.NextMarker, nil
+	})
+	obj.NextMarker = marker
+	return err
+}
+
+func filterList(cr *svcapitypes.RuleGroup, list *svcsdk.ListRuleGroupsOutput) *svcsdk.ListRuleGroupsOutput {
+	res := &svcsdk.ListRuleGroupsOutput{}
+	for _, s := range list.RuleGroups {
+		if aws.StringValue(s.Name) == meta.GetExternalName(cr) {
+			res.RuleGroups = append(res.RuleGroups, s)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.RuleGroup, _ *svcsdk.ListRuleGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.RuleGroup, _ *svcsdk.ListRuleGroupsOutput) (bool, string, error) {
+	resp, err := h.client.GetRuleGroupWithContext(ctx, &svcsdk.GetRuleGroupInput{
+		Name:  aws.String(meta.GetExternalName(cr)),
+		Scope: cr.Spec.ForProvider.Scope,
+		Id:    cr.Status.AtProvider.ID,
+	})
+	if err != nil {
+		return false, "", errors.Wrap(err, errGetRuleGroup)
+	}
+	if resp.RuleGroup == nil {
+		return false, "", nil
+	}
+	if resp.LockToken != nil {
+		cr.Status.AtProvider.LockToken = resp.LockToken
+	}
+	cr.Status.AtProvider.LabelNamespace = resp.RuleGroup.LabelNamespace
+	spec := cr.Spec.ForProvider
+	if diff := cmp.Diff(aws.StringValue(spec.Description), aws.StringValue(resp.RuleGroup.Description)); diff != "" {
+		return false, "description: " + diff, nil
+	}
+	desired := &svcsdk.UpdateRuleGroupInput{}
+	if err := generateRuleGroupConfig(cr, desired); err != nil {
+		return false, "", err
+	}
+	if diff := wafv2.DiffRules(desired.Rules, resp.RuleGroup.Rules); diff != "" {
+		return false, "rules: " + diff, nil
+	}
+	if diff := cmp.Diff(desired.VisibilityConfig, resp.RuleGroup.VisibilityConfig, cmpopts.EquateEmpty()); diff != "" {
+		return false, "visibilityConfig: " + diff, nil
+	}
+	if diff := cmp.Diff(desired.CustomResponseBodies, resp.RuleGroup.CustomResponseBodies, cmpopts.EquateEmpty()); diff != "" {
+		return false, "customResponseBodies: " + diff, nil
+	}
+	tags, err := wafv2.ListTags(ctx, h.client, cr.Status.AtProvider.ARN)
+	if err != nil {
+		return false, "", err
+	}
+	if add, remove := wafv2.DiffTags(spec.Tags, tags); len(add) > 0 || len(remove) > 0 {
+		return false, "tags differ", nil
+	}
+	return true, "", nil
+}
+
+// generateRuleGroupConfig fills the rule configuration of the given update
+// input, which has the same shape as the one of the create input.
+func generateRuleGroupConfig(cr *svcapitypes.RuleGroup, obj *svcsdk.UpdateRuleGroupInput) error {
+	rules, err := wafv2.GenerateRules(cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return err
+	}
+	obj.Rules = rules
+	if cr.Spec.ForProvider.VisibilityConfig != nil {
+		if err := wafv2.Convert(cr.Spec.ForProvider.VisibilityConfig, &obj.VisibilityConfig); err != nil {
+			return err
+		}
+	}
+	obj.CustomResponseBodies = wafv2.GenerateCustomResponseBodies(cr.Spec.ForProvider.CustomResponseBodies)
+	return nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.RuleGroup, obj *svcsdk.CreateRuleGroupInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	cfg := &svcsdk.UpdateRuleGroupInput{}
+	if err := generateRuleGroupConfig(cr, cfg); err != nil {
+		return err
+	}
+	obj.Rules = cfg.Rules
+	obj.VisibilityConfig = cfg.VisibilityConfig
+	obj.CustomResponseBodies = cfg.CustomResponseBodies
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.RuleGroup, obj *svcsdk.UpdateRuleGroupInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Id = cr.Status.AtProvider.ID
+	obj.LockToken = cr.Status.AtProvider.LockToken
+	return generateRuleGroupConfig(cr, obj)
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.RuleGroup, obj *svcsdk.UpdateRuleGroupOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if obj.NextLockToken != nil {
+		cr.Status.AtProvider.LockToken = obj.NextLockToken
+	}
+	return upd, wafv2.UpdateTags(ctx, h.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.Tags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.RuleGroup, obj *svcsdk.DeleteRuleGroupInput) (bool, error) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Id = cr.Status.AtProvider.ID
+	obj.LockToken = cr.Status.AtProvider.LockToken
+	return false, nil
+}
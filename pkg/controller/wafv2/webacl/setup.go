@@ -0,0 +1,264 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+	wafv2 "github.com/crossplane-contrib/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errGetWebACL = "cannot get web ACL"
+)
+
+// SetupWebACL adds a controller that reconciles WebACL.
+func SetupWebACL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.WebACLGroupKind)
+	opts := []option{setupExternal}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.WebACLGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.WebACL{}).
+		Complete(r)
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = h.preObserve
+	e.postObserve = postObserve
+	e.filterList = filterList
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.preUpdate = preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.WAFV2API
+}
+
+func (h *hooks) preObserve(ctx context.Context, cr *svcapitypes.WebACL, obj *svcsdk.ListWebACLsInput) error {
+	obj.Limit = aws.Int64(wafv2.ListLimit)
+	marker, err := wafv2.FindPageMarker(ctx, meta.GetExternalName(cr), func(ctx context.Context, marker *string) ([]*string, *string, error) {
+		resp, err := h.client.ListWebACLsWithContext(ctx, &svcsdk.ListWebACLsInput{Scope: obj.Scope, Limit: obj.Limit, NextMarker: marker})
+		if err != nil {
+			return nil, nil, err
+		}
+		names := make([]*string, len(resp.WebACLs))
+		for i, s := range resp.WebACLs {
+			names[i] = s.Name
+		}
+		return names, resp.NextMarker, nil
+	})
+	obj.NextMarker = marker
+	return err
+}
+
+func filterList(cr *svcapitypes.WebACL, list *svcsdk.ListWebACLsOutput) *svcsdk.ListWebACLsOutput {
+	res := &svcsdk.ListWebACLsOutput{}
+	for _, s := range list.WebACLs {
+		if aws.StringValue(s.Name) == meta.GetExternalName(cr) {
+			res.WebACLs = append(res.WebACLs, s)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.WebACL, _ *svcsdk.ListWebACLsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.WebACL, _ *svcsdk.ListWebACLsOutput) (bool, string, error) {
+	resp, err := h.client.GetWebACLWithContext(ctx, &svcsdk.GetWebACLInput{
+		Name:  aws.String(meta.GetExternalName(cr)),
+		Scope: cr.Spec.ForProvider.Scope,
+		Id:    cr.Status.AtProvider.ID,
+	})
+	if err != nil {
+		return false, "", errors.Wrap(err, errGetWebACL)
+	}
+	if resp.WebACL == nil {
+		return false, "", nil
+	}
+	if resp.LockToken != nil {
+		cr.Status.AtProvider.LockToken = resp.LockToken
+	}
+	cr.Status.AtProvider.Capacity = resp.WebACL.Capacity
+	cr.Status.AtProvider.LabelNamespace = resp.WebACL.LabelNamespace
+	cr.Status.AtProvider.ManagedByFirewallManager = resp.WebACL.ManagedByFirewallManager
+	spec := cr.Spec.ForProvider
+	if diff := cmp.Diff(aws.StringValue(spec.Description), aws.StringValue(resp.WebACL.Description)); diff != "" {
+		return false, "description: " + diff, nil
+	}
+	desired := &svcsdk.UpdateWebACLInput{}
+	if err := generateWebACLConfig(cr, desired); err != nil {
+		return false, "", err
+	}
+	if diff := wafv2.DiffRules(desired.Rules, resp.WebACL.Rules); diff != "" {
+		return false, "rules: " + diff, nil
+	}
+	if diff := cmp.Diff(desired.VisibilityConfig, resp.WebACL.VisibilityConfig, cmpopts.EquateEmpty()); diff != "" {
+		return false, "visibilityConfig: " + diff, nil
+	}
+	if diff := cmp.Diff(desired.DefaultAction, resp.WebACL.DefaultAction, cmpopts.EquateEmpty()); diff != "" {
+		return false, "defaultAction: " + diff, nil
+	}
+	if diff := cmp.Diff(desired.CustomResponseBodies, resp.WebACL.CustomResponseBodies, cmpopts.EquateEmpty()); diff != "" {
+		return false, "customResponseBodies: " + diff, nil
+	}
+	if desired.CaptchaConfig != nil {
+		if diff := cmp.Diff(desired.CaptchaConfig, resp.WebACL.CaptchaConfig, cmpopts.EquateEmpty()); diff != "" {
+			return false, "captchaConfig: " + diff, nil
+		}
+	}
+	if desired.ChallengeConfig != nil {
+		if diff := cmp.Diff(desired.ChallengeConfig, resp.WebACL.ChallengeConfig, cmpopts.EquateEmpty()); diff != "" {
+			return false, "challengeConfig: " + diff, nil
+		}
+	}
+	if diff := cmp.Diff(aws.StringValueSlice(spec.TokenDomains), aws.StringValueSlice(resp.WebACL.TokenDomains), cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		return false, "tokenDomains: " + diff, nil
+	}
+	tags, err := wafv2.ListTags(ctx, h.client, cr.Status.AtProvider.ARN)
+	if err != nil {
+		return false, "", err
+	}
+	if add, remove := wafv2.DiffTags(spec.Tags, tags); len(add) > 0 || len(remove) > 0 {
+		return false, "tags differ", nil
+	}
+	return true, "", nil
+}
+
+// generateWebACLConfig fills the rule and action configuration of the given
+// update input, which has the same shape as the one of the create input.
+func generateWebACLConfig(cr *svcapitypes.WebACL, obj *svcsdk.UpdateWebACLInput) error {
+	rules, err := wafv2.GenerateRules(cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return err
+	}
+	obj.Rules = rules
+	if cr.Spec.ForProvider.VisibilityConfig != nil {
+		if err := wafv2.Convert(cr.Spec.ForProvider.VisibilityConfig, &obj.VisibilityConfig); err != nil {
+			return err
+		}
+	}
+	if cr.Spec.ForProvider.DefaultAction != nil {
+		if err := wafv2.Convert(cr.Spec.ForProvider.DefaultAction, &obj.DefaultAction); err != nil {
+			return err
+		}
+	}
+	if cr.Spec.ForProvider.CaptchaConfig != nil {
+		if err := wafv2.Convert(cr.Spec.ForProvider.CaptchaConfig, &obj.CaptchaConfig); err != nil {
+			return err
+		}
+	}
+	if cr.Spec.ForProvider.ChallengeConfig != nil {
+		if err := wafv2.Convert(cr.Spec.ForProvider.ChallengeConfig, &obj.ChallengeConfig); err != nil {
+			return err
+		}
+	}
+	obj.CustomResponseBodies = wafv2.GenerateCustomResponseBodies(cr.Spec.ForProvider.CustomResponseBodies)
+	return nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.WebACL, obj *svcsdk.CreateWebACLInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	cfg := &svcsdk.UpdateWebACLInput{}
+	if err := generateWebACLConfig(cr, cfg); err != nil {
+		return err
+	}
+	obj.Rules = cfg.Rules
+	obj.VisibilityConfig = cfg.VisibilityConfig
+	obj.DefaultAction = cfg.DefaultAction
+	obj.CaptchaConfig = cfg.CaptchaConfig
+	obj.ChallengeConfig = cfg.ChallengeConfig
+	obj.CustomResponseBodies = cfg.CustomResponseBodies
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.WebACL, obj *svcsdk.UpdateWebACLInput) error {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Id = cr.Status.AtProvider.ID
+	obj.LockToken = cr.Status.AtProvider.LockToken
+	return generateWebACLConfig(cr, obj)
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.WebACL, obj *svcsdk.UpdateWebACLOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if obj.NextLockToken != nil {
+		cr.Status.AtProvider.LockToken = obj.NextLockToken
+	}
+	return upd, wafv2.UpdateTags(ctx, h.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.Tags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.WebACL, obj *svcsdk.DeleteWebACLInput) (bool, error) {
+	obj.Name = aws.String(meta.GetExternalName(cr))
+	obj.Id = cr.Status.AtProvider.ID
+	obj.LockToken = cr.Status.AtProvider.LockToken
+	return false, nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+)
+
+const (
+	testName = "web-acl"
+	testARN  = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/web-acl/1234"
+)
+
+type mockWAFV2 struct {
+	svcsdkapi.WAFV2API
+	webACL *svcsdk.WebACL
+	tags   []*svcsdk.Tag
+}
+
+func (m *mockWAFV2) GetWebACLWithContext(context.Context, *svcsdk.GetWebACLInput, ...request.Option) (*svcsdk.GetWebACLOutput, error) {
+	return &svcsdk.GetWebACLOutput{WebACL: m.webACL, LockToken: aws.String("next-token")}, nil
+}
+
+func (m *mockWAFV2) ListTagsForResourceWithContext(context.Context, *svcsdk.ListTagsForResourceInput, ...request.Option) (*svcsdk.ListTagsForResourceOutput, error) {
+	return &svcsdk.ListTagsForResourceOutput{TagInfoForResource: &svcsdk.TagInfoForResource{TagList: m.tags}}, nil
+}
+
+func webACL(m ...func(*svcapitypes.WebACL)) *svcapitypes.WebACL {
+	cr := &svcapitypes.WebACL{}
+	meta.SetExternalName(cr, testName)
+	cr.Spec.ForProvider.Scope = aws.String(svcsdk.ScopeRegional)
+	cr.Spec.ForProvider.DefaultAction = &svcapitypes.DefaultAction{Allow: &svcapitypes.AllowAction{}}
+	cr.Spec.ForProvider.VisibilityConfig = &svcapitypes.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(true),
+		MetricName:               aws.String(testName),
+		SampledRequestsEnabled:   aws.Bool(true),
+	}
+	cr.Spec.ForProvider.Rules = []*svcapitypes.Rule{{
+		Name:      "geo",
+		Priority:  1,
+		Statement: `{"geoMatchStatement":{"countryCodes":["DE"]}}`,
+		Action:    &svcapitypes.RuleAction{Block: &svcapitypes.BlockAction{}},
+		VisibilityConfig: svcapitypes.VisibilityConfig{
+			CloudWatchMetricsEnabled: aws.Bool(false),
+			MetricName:               aws.String("geo"),
+			SampledRequestsEnabled:   aws.Bool(false),
+		},
+	}}
+	cr.Status.AtProvider.ARN = aws.String(testARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func remote(m ...func(*svcsdk.WebACL)) *svcsdk.WebACL {
+	acl := &svcsdk.WebACL{
+		ARN:           aws.String(testARN),
+		Name:          aws.String(testName),
+		Capacity:      aws.Int64(1),
+		DefaultAction: &svcsdk.DefaultAction{Allow: &svcsdk.AllowAction{}},
+		VisibilityConfig: &svcsdk.VisibilityConfig{
+			CloudWatchMetricsEnabled: aws.Bool(true),
+			MetricName:               aws.String(testName),
+			SampledRequestsEnabled:   aws.Bool(true),
+		},
+		Rules: []*svcsdk.Rule{{
+			Name:      aws.String("geo"),
+			Priority:  aws.Int64(1),
+			Statement: &svcsdk.Statement{GeoMatchStatement: &svcsdk.GeoMatchStatement{CountryCodes: aws.StringSlice([]string{"DE"})}},
+			Action:    &svcsdk.RuleAction{Block: &svcsdk.BlockAction{}},
+			VisibilityConfig: &svcsdk.VisibilityConfig{
+				CloudWatchMetricsEnabled: aws.Bool(false),
+				MetricName:               aws.String("geo"),
+				SampledRequestsEnabled:   aws.Bool(false),
+			},
+		}},
+	}
+	for _, f := range m {
+		f(acl)
+	}
+	return acl
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr       *svcapitypes.WebACL
+		webACL   *svcsdk.WebACL
+		tags     []*svcsdk.Tag
+		upToDate bool
+	}{
+		"UpToDate": {
+			cr:       webACL(),
+			webACL:   remote(),
+			upToDate: true,
+		},
+		"DefaultActionChanged": {
+			cr: webACL(func(cr *svcapitypes.WebACL) {
+				cr.Spec.ForProvider.DefaultAction = &svcapitypes.DefaultAction{Block: &svcapitypes.BlockAction{}}
+			}),
+			webACL:   remote(),
+			upToDate: false,
+		},
+		"RuleStatementChanged": {
+			cr: webACL(),
+			webACL: remote(func(acl *svcsdk.WebACL) {
+				acl.Rules[0].Statement.GeoMatchStatement.CountryCodes = aws.StringSlice([]string{"FR"})
+			}),
+			upToDate: false,
+		},
+		"RuleRemoved": {
+			cr: webACL(func(cr *svcapitypes.WebACL) {
+				cr.Spec.ForProvider.Rules = nil
+			}),
+			webACL:   remote(),
+			upToDate: false,
+		},
+		"TagAdded": {
+			cr: webACL(func(cr *svcapitypes.WebACL) {
+				cr.Spec.ForProvider.Tags = []*svcapitypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+			}),
+			webACL:   remote(),
+			upToDate: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: &mockWAFV2{webACL: tc.webACL, tags: tc.tags}}
+			got, reason, err := h.isUpToDate(context.Background(), tc.cr, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.upToDate, got); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			if got && reason != "" {
+				t.Errorf("unexpected diff for up to date resource: %s", reason)
+			}
+			if diff := cmp.Diff("next-token", aws.StringValue(tc.cr.Status.AtProvider.LockToken)); diff != "" {
+				t.Errorf("lockToken: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webaclassociation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/wafv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/wafv2/v1alpha1"
+)
+
+const (
+	testResourceARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/lb/1234"
+	testWebACLARN   = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/web-acl/1234"
+	otherWebACLARN  = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/other/5678"
+)
+
+var errBoom = errors.New("boom")
+
+type mockWAFV2 struct {
+	svcsdkapi.WAFV2API
+	out *svcsdk.GetWebACLForResourceOutput
+	err error
+}
+
+func (m *mockWAFV2) GetWebACLForResourceWithContext(context.Context, *svcsdk.GetWebACLForResourceInput, ...request.Option) (*svcsdk.GetWebACLForResourceOutput, error) {
+	return m.out, m.err
+}
+
+func association(m ...func(*svcapitypes.WebACLAssociation)) *svcapitypes.WebACLAssociation {
+	cr := &svcapitypes.WebACLAssociation{}
+	meta.SetExternalName(cr, testResourceARN)
+	cr.Spec.ForProvider.ResourceARN = aws.String(testResourceARN)
+	cr.Spec.ForProvider.WebACLARN = aws.String(testWebACLARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		cr     *svcapitypes.WebACLAssociation
+		client *mockWAFV2
+		want   want
+	}{
+		"NoExternalName": {
+			cr: association(func(cr *svcapitypes.WebACLAssociation) {
+				meta.SetExternalName(cr, "")
+			}),
+			client: &mockWAFV2{},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ResourceNotFound": {
+			cr: association(),
+			client: &mockWAFV2{
+				err: awserr.New(svcsdk.ErrCodeWAFNonexistentItemException, "", nil),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AssociationMissing": {
+			cr: association(),
+			client: &mockWAFV2{
+				out: &svcsdk.GetWebACLForResourceOutput{},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DifferentWebACL": {
+			cr: association(),
+			client: &mockWAFV2{
+				out: &svcsdk.GetWebACLForResourceOutput{WebACL: &svcsdk.WebACL{ARN: aws.String(otherWebACLARN)}},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UpToDate": {
+			cr: association(),
+			client: &mockWAFV2{
+				out: &svcsdk.GetWebACLForResourceOutput{WebACL: &svcsdk.WebACL{ARN: aws.String(testWebACLARN)}},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetFailed": {
+			cr: association(),
+			client: &mockWAFV2{
+				err: errBoom,
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			obs, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("obs: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
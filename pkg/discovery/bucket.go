@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3/bucket"
+)
+
+const (
+	errListBuckets       = "cannot list buckets"
+	errFmtBucketLocation = "cannot get the location of bucket %s"
+	errFmtBucketLateInit = "cannot late initialize bucket %s"
+
+	// regionUSEast1 is the region of the buckets without a location
+	// constraint.
+	regionUSEast1 = "us-east-1"
+	// regionEUWest1 is the region of the buckets with the legacy EU location
+	// constraint.
+	regionEUWest1 = "eu-west-1"
+)
+
+// A BucketDiscoverer discovers S3 buckets.
+type BucketDiscoverer struct {
+	client             s3.BucketClient
+	subresourceClients []bucket.SubresourceClient
+	log                logging.Logger
+}
+
+// NewBucketDiscoverer returns a new BucketDiscoverer. It late initializes
+// the buckets with the same subresource clients as the Bucket controller.
+func NewBucketDiscoverer(client s3.BucketClient, log logging.Logger) *BucketDiscoverer {
+	return &BucketDiscoverer{client: client, subresourceClients: bucket.NewSubresourceClients(client), log: log}
+}
+
+// Discover lists the buckets in the given region. Buckets whose location
+// cannot be read, e.g. because access is denied or they were deleted in the
+// meantime, are skipped and logged.
+func (d *BucketDiscoverer) Discover(ctx context.Context, region string) ([]resource.Managed, error) {
+	out, err := d.client.ListBuckets(ctx, &awss3.ListBucketsInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, errListBuckets)
+	}
+
+	var mgs []resource.Managed
+	for _, b := range out.Buckets {
+		name := aws.ToString(b.Name)
+		loc, err := d.client.GetBucketLocation(ctx, &awss3.GetBucketLocationInput{Bucket: b.Name})
+		if err != nil {
+			d.log.Info("Skipping bucket", "bucket", name, "error", errors.Wrapf(err, errFmtBucketLocation, name))
+			continue
+		}
+		if bucketRegion(loc.LocationConstraint) != region {
+			continue
+		}
+
+		cr := &v1beta1.Bucket{
+			Spec: v1beta1.BucketSpec{
+				ForProvider: v1beta1.BucketParameters{LocationConstraint: region},
+			},
+		}
+		cr.SetGroupVersionKind(v1beta1.BucketGroupVersionKind)
+		meta.SetExternalName(cr, name)
+		for _, c := range d.subresourceClients {
+			if err := c.LateInitialize(ctx, cr); err != nil {
+				return nil, errors.Wrapf(err, errFmtBucketLateInit, name)
+			}
+		}
+		mgs = append(mgs, cr)
+	}
+	return mgs, nil
+}
+
+// bucketRegion returns the region of a bucket with the given location
+// constraint.
+func bucketRegion(c s3types.BucketLocationConstraint) string {
+	switch c {
+	case "":
+		return regionUSEast1
+	case s3types.BucketLocationConstraintEu:
+		return regionEUWest1
+	default:
+		return string(c)
+	}
+}
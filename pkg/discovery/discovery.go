@@ -0,0 +1,186 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package discovery lists existing AWS resources as managed resources, so that
+// they can be adopted by Crossplane.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	ec2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	s3v1beta1 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errFmtUnknownKind = "unknown kind %q, supported kinds are %s"
+	errConvert        = "cannot convert managed resource"
+	errMarshal        = "cannot marshal managed resource"
+
+	// maxNameLength is the maximum length of the name of a Kubernetes
+	// object.
+	maxNameLength = 253
+)
+
+// invalidNameChars matches the characters that are not allowed in the name of
+// a Kubernetes object.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// Options configure the managed resources that adopt the discovered
+// resources.
+type Options struct {
+	// ProviderConfig is the name of the ProviderConfig that the managed
+	// resources reference.
+	ProviderConfig string
+
+	// Region is the region in which resources are discovered.
+	Region string
+
+	// ManagementPolicies are the management policies of the managed
+	// resources. Resources are only observed if none are given.
+	ManagementPolicies xpv1.ManagementPolicies
+}
+
+// A Discoverer lists the existing external resources of one kind as managed
+// resources. The managed resources have their external name and their late
+// initialized parameters set.
+type Discoverer interface {
+	Discover(ctx context.Context, region string) ([]resource.Managed, error)
+}
+
+// A NewDiscovererFn returns the Discoverer of a kind that uses the given
+// config. Resources that are skipped are logged to the given logger.
+type NewDiscovererFn func(cfg aws.Config, log logging.Logger) Discoverer
+
+// Discoverers maps the kinds that can be discovered to their Discoverers.
+var Discoverers = map[string]NewDiscovererFn{
+	s3v1beta1.BucketKind: func(cfg aws.Config, log logging.Logger) Discoverer {
+		return NewBucketDiscoverer(s3.NewClient(cfg), log)
+	},
+	ec2v1beta1.VPCKind: func(cfg aws.Config, _ logging.Logger) Discoverer {
+		return NewVPCDiscoverer(ec2.NewVPCClient(cfg))
+	},
+	iamv1beta1.RoleKind: func(cfg aws.Config, _ logging.Logger) Discoverer {
+		return NewRoleDiscoverer(iam.NewRoleClient(cfg))
+	},
+}
+
+// Kinds returns the sorted kinds that can be discovered.
+func Kinds() []string {
+	kinds := make([]string, 0, len(Discoverers))
+	for k := range Discoverers {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// New returns the Discoverers of the given kinds. Kinds are matched case
+// insensitively.
+func New(cfg aws.Config, log logging.Logger, kinds []string) ([]Discoverer, error) {
+	discoverers := make([]Discoverer, 0, len(kinds))
+	for _, kind := range kinds {
+		fn, err := lookup(kind)
+		if err != nil {
+			return nil, err
+		}
+		discoverers = append(discoverers, fn(cfg, log))
+	}
+	return discoverers, nil
+}
+
+func lookup(kind string) (NewDiscovererFn, error) {
+	for k, fn := range Discoverers {
+		if strings.EqualFold(k, kind) {
+			return fn, nil
+		}
+	}
+	return nil, errors.Errorf(errFmtUnknownKind, kind, strings.Join(Kinds(), ", "))
+}
+
+// Discover lists the external resources with the given Discoverers and
+// prepares the managed resources for the adoption.
+func Discover(ctx context.Context, o Options, discoverers ...Discoverer) ([]resource.Managed, error) {
+	policies := o.ManagementPolicies
+	if len(policies) == 0 {
+		policies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+	}
+
+	var mgs []resource.Managed
+	for _, d := range discoverers {
+		discovered, err := d.Discover(ctx, o.Region)
+		if err != nil {
+			return nil, err
+		}
+		for _, mg := range discovered {
+			mg.SetName(Name(meta.GetExternalName(mg)))
+			mg.SetProviderConfigReference(&xpv1.Reference{Name: o.ProviderConfig})
+			mg.SetManagementPolicies(policies)
+		}
+		mgs = append(mgs, discovered...)
+	}
+	return mgs, nil
+}
+
+// Name returns a valid name of a Kubernetes object for the given external
+// name.
+func Name(externalName string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(externalName), "-")
+	if len(name) > maxNameLength {
+		name = name[:maxNameLength]
+	}
+	return strings.Trim(name, ".-")
+}
+
+// Write writes the managed resources as a stream of YAML documents. The status
+// of the managed resources is omitted.
+func Write(w io.Writer, mgs []resource.Managed) error {
+	for _, mg := range mgs {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+		if err != nil {
+			return errors.Wrap(err, errConvert)
+		}
+		delete(u, "status")
+		unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+		b, err := yaml.Marshal(u)
+		if err != nil {
+			return errors.Wrap(err, errMarshal)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ec2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	s3v1beta1 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	ec2fake "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	iamfake "github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	s3fake "github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3/bucket"
+)
+
+var (
+	region  = "eu-central-1"
+	errBoom = errors.New("boom")
+)
+
+// lateInitClient is a bucket.SubresourceClient that only late initializes.
+type lateInitClient struct {
+	bucket.SubresourceClient
+	err error
+}
+
+func (c lateInitClient) LateInitialize(_ context.Context, cr *s3v1beta1.Bucket) error {
+	cr.Spec.ForProvider.ObjectLockEnabledForBucket = aws.Bool(true)
+	return c.err
+}
+
+func TestBucketDiscoverer(t *testing.T) {
+	buckets := s3fake.MockBucketClient{
+		MockListBuckets: func(_ context.Context, _ *awss3.ListBucketsInput, _ []func(*awss3.Options)) (*awss3.ListBucketsOutput, error) {
+			return &awss3.ListBucketsOutput{Buckets: []s3types.Bucket{{Name: aws.String("local")}, {Name: aws.String("global")}}}, nil
+		},
+		MockGetBucketLocation: func(_ context.Context, input *awss3.GetBucketLocationInput, _ []func(*awss3.Options)) (*awss3.GetBucketLocationOutput, error) {
+			if aws.ToString(input.Bucket) == "local" {
+				return &awss3.GetBucketLocationOutput{LocationConstraint: s3types.BucketLocationConstraintEuCentral1}, nil
+			}
+			return &awss3.GetBucketLocationOutput{}, nil
+		},
+	}
+	localBucket := func() *s3v1beta1.Bucket {
+		cr := &s3v1beta1.Bucket{}
+		cr.SetGroupVersionKind(s3v1beta1.BucketGroupVersionKind)
+		meta.SetExternalName(cr, "local")
+		cr.Spec.ForProvider.LocationConstraint = region
+		cr.Spec.ForProvider.ObjectLockEnabledForBucket = aws.Bool(true)
+		return cr
+	}
+
+	type want struct {
+		mgs []resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		d      *BucketDiscoverer
+		region string
+		want   want
+	}{
+		"Region": {
+			d:      &BucketDiscoverer{client: buckets, subresourceClients: []bucket.SubresourceClient{lateInitClient{}}},
+			region: region,
+			want:   want{mgs: []resource.Managed{localBucket()}},
+		},
+		"DefaultRegion": {
+			d:      &BucketDiscoverer{client: buckets},
+			region: regionUSEast1,
+			want: want{mgs: []resource.Managed{func() resource.Managed {
+				cr := &s3v1beta1.Bucket{}
+				cr.SetGroupVersionKind(s3v1beta1.BucketGroupVersionKind)
+				meta.SetExternalName(cr, "global")
+				cr.Spec.ForProvider.LocationConstraint = regionUSEast1
+				return cr
+			}()}},
+		},
+		"ListError": {
+			d: &BucketDiscoverer{client: s3fake.MockBucketClient{
+				MockListBuckets: func(_ context.Context, _ *awss3.ListBucketsInput, _ []func(*awss3.Options)) (*awss3.ListBucketsOutput, error) {
+					return nil, errBoom
+				},
+			}},
+			region: region,
+			want:   want{err: errors.Wrap(errBoom, errListBuckets)},
+		},
+		"SkipLocationError": {
+			d: &BucketDiscoverer{client: s3fake.MockBucketClient{
+				MockListBuckets: func(_ context.Context, _ *awss3.ListBucketsInput, _ []func(*awss3.Options)) (*awss3.ListBucketsOutput, error) {
+					return &awss3.ListBucketsOutput{Buckets: []s3types.Bucket{{Name: aws.String("denied")}, {Name: aws.String("local")}, {Name: aws.String("deleted")}}}, nil
+				},
+				MockGetBucketLocation: func(_ context.Context, input *awss3.GetBucketLocationInput, _ []func(*awss3.Options)) (*awss3.GetBucketLocationOutput, error) {
+					if aws.ToString(input.Bucket) == "local" {
+						return &awss3.GetBucketLocationOutput{LocationConstraint: s3types.BucketLocationConstraintEuCentral1}, nil
+					}
+					return nil, errBoom
+				},
+			}, subresourceClients: []bucket.SubresourceClient{lateInitClient{}}, log: logging.NewNopLogger()},
+			region: region,
+			want:   want{mgs: []resource.Managed{localBucket()}},
+		},
+		"LateInitError": {
+			d:      &BucketDiscoverer{client: buckets, subresourceClients: []bucket.SubresourceClient{lateInitClient{err: errBoom}}},
+			region: region,
+			want:   want{err: errors.Wrapf(errBoom, errFmtBucketLateInit, "local")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mgs, err := tc.d.Discover(context.Background(), tc.region)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mgs, mgs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVPCDiscoverer(t *testing.T) {
+	vpc := func(id string) *ec2v1beta1.VPC {
+		cr := &ec2v1beta1.VPC{
+			Spec: ec2v1beta1.VPCSpec{
+				ForProvider: ec2v1beta1.VPCParameters{
+					Region:             aws.String(region),
+					CIDRBlock:          "10.0.0.0/16",
+					EnableDNSSupport:   aws.Bool(true),
+					EnableDNSHostNames: aws.Bool(false),
+					InstanceTenancy:    aws.String("default"),
+					Tags:               []ec2v1beta1.Tag{{Key: "Name", Value: id}},
+				},
+			},
+		}
+		cr.SetGroupVersionKind(ec2v1beta1.VPCGroupVersionKind)
+		meta.SetExternalName(cr, id)
+		return cr
+	}
+
+	type want struct {
+		mgs []resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client *ec2fake.MockVPCClient
+		want   want
+	}{
+		"Paginated": {
+			client: &ec2fake.MockVPCClient{
+				MockDescribe: func(_ context.Context, input *awsec2.DescribeVpcsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+					id, next := "vpc-1", aws.String("next")
+					if aws.ToString(input.NextToken) == "next" {
+						id, next = "vpc-2", nil
+					}
+					return &awsec2.DescribeVpcsOutput{
+						Vpcs: []awsec2types.Vpc{{
+							VpcId:           aws.String(id),
+							CidrBlock:       aws.String("10.0.0.0/16"),
+							InstanceTenancy: awsec2types.TenancyDefault,
+							Tags:            []awsec2types.Tag{{Key: aws.String("Name"), Value: aws.String(id)}},
+						}},
+						NextToken: next,
+					}, nil
+				},
+				MockDescribeVpcAttribute: func(_ context.Context, input *awsec2.DescribeVpcAttributeInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVpcAttributeOutput, error) {
+					if input.Attribute == awsec2types.VpcAttributeNameEnableDnsSupport {
+						return &awsec2.DescribeVpcAttributeOutput{EnableDnsSupport: &awsec2types.AttributeBooleanValue{Value: aws.Bool(true)}}, nil
+					}
+					return &awsec2.DescribeVpcAttributeOutput{EnableDnsHostnames: &awsec2types.AttributeBooleanValue{Value: aws.Bool(false)}}, nil
+				},
+			},
+			want: want{mgs: []resource.Managed{vpc("vpc-1"), vpc("vpc-2")}},
+		},
+		"DescribeError": {
+			client: &ec2fake.MockVPCClient{
+				MockDescribe: func(_ context.Context, _ *awsec2.DescribeVpcsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errDescribeVPCs)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mgs, err := NewVPCDiscoverer(tc.client).Discover(context.Background(), region)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mgs, mgs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRoleDiscoverer(t *testing.T) {
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	type want struct {
+		mgs []resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client *iamfake.MockRoleClient
+		want   want
+	}{
+		"SkipsServiceLinkedRoles": {
+			client: &iamfake.MockRoleClient{
+				MockListRoles: func(_ context.Context, _ *awsiam.ListRolesInput, _ []func(*awsiam.Options)) (*awsiam.ListRolesOutput, error) {
+					return &awsiam.ListRolesOutput{Roles: []awsiamtypes.Role{
+						{RoleName: aws.String("app"), Path: aws.String("/")},
+						{RoleName: aws.String("AWSServiceRoleForSupport"), Path: aws.String("/aws-service-role/support.amazonaws.com/")},
+					}}, nil
+				},
+				MockGetRole: func(_ context.Context, input *awsiam.GetRoleInput, _ []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+					return &awsiam.GetRoleOutput{Role: &awsiamtypes.Role{
+						RoleName:                 input.RoleName,
+						Path:                     aws.String("/"),
+						AssumeRolePolicyDocument: aws.String(strings.ReplaceAll(document, ":", "%3A")),
+					}}, nil
+				},
+			},
+			want: want{mgs: []resource.Managed{func() resource.Managed {
+				cr := &iamv1beta1.Role{}
+				cr.SetGroupVersionKind(iamv1beta1.RoleGroupVersionKind)
+				meta.SetExternalName(cr, "app")
+				cr.Spec.ForProvider.AssumeRolePolicyDocument = document
+				cr.Spec.ForProvider.Path = aws.String("/")
+				return cr
+			}()}},
+		},
+		"GetError": {
+			client: &iamfake.MockRoleClient{
+				MockListRoles: func(_ context.Context, _ *awsiam.ListRolesInput, _ []func(*awsiam.Options)) (*awsiam.ListRolesOutput, error) {
+					return &awsiam.ListRolesOutput{Roles: []awsiamtypes.Role{{RoleName: aws.String("app")}}}, nil
+				},
+				MockGetRole: func(_ context.Context, _ *awsiam.GetRoleInput, _ []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{err: errors.Wrapf(errBoom, errFmtGetRole, "app")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mgs, err := NewRoleDiscoverer(tc.client).Discover(context.Background(), region)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mgs, mgs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	vpcs := &ec2fake.MockVPCClient{
+		MockDescribe: func(_ context.Context, _ *awsec2.DescribeVpcsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+			return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{VpcId: aws.String("vpc-1A2b")}}}, nil
+		},
+		MockDescribeVpcAttribute: func(_ context.Context, _ *awsec2.DescribeVpcAttributeInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVpcAttributeOutput, error) {
+			return &awsec2.DescribeVpcAttributeOutput{}, nil
+		},
+	}
+
+	cases := map[string]struct {
+		o    Options
+		want xpv1.ManagementPolicies
+	}{
+		"ObserveByDefault": {
+			o:    Options{ProviderConfig: "example", Region: region},
+			want: xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+		},
+		"ManagementPolicies": {
+			o:    Options{ProviderConfig: "example", Region: region, ManagementPolicies: xpv1.ManagementPolicies{xpv1.ManagementActionAll}},
+			want: xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mgs, err := Discover(context.Background(), tc.o, NewVPCDiscoverer(vpcs))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(mgs) != 1 {
+				t.Fatalf("expected one managed resource, got: %d", len(mgs))
+			}
+			mg := mgs[0]
+			if diff := cmp.Diff("vpc-1a2b", mg.GetName()); diff != "" {
+				t.Errorf("name: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(&xpv1.Reference{Name: "example"}, mg.GetProviderConfigReference()); diff != "" {
+				t.Errorf("providerConfigRef: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, mg.GetManagementPolicies()); diff != "" {
+				t.Errorf("managementPolicies: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	cases := map[string]struct {
+		externalName string
+		want         string
+	}{
+		"Valid":        {externalName: "my-bucket.example", want: "my-bucket.example"},
+		"Uppercase":    {externalName: "MyRole", want: "myrole"},
+		"InvalidChars": {externalName: "my_role+name@example", want: "my-role-name-example"},
+		"Trimmed":      {externalName: "_role_", want: "role"},
+		"TooLong":      {externalName: strings.Repeat("a", 300), want: strings.Repeat("a", maxNameLength)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Name(tc.externalName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(aws.Config{}, logging.NewNopLogger(), []string{"bucket", "VPC"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := New(aws.Config{}, logging.NewNopLogger(), []string{"Unknown"}); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	cr := &ec2v1beta1.VPC{}
+	cr.SetGroupVersionKind(ec2v1beta1.VPCGroupVersionKind)
+	cr.SetName("vpc-1")
+	meta.SetExternalName(cr, "vpc-1")
+	cr.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionObserve})
+	cr.Spec.ForProvider.Region = aws.String(region)
+	cr.Spec.ForProvider.CIDRBlock = "10.0.0.0/16"
+
+	want := `---
+apiVersion: ec2.aws.crossplane.io/v1beta1
+kind: VPC
+metadata:
+  annotations:
+    crossplane.io/external-name: vpc-1
+  name: vpc-1
+spec:
+  forProvider:
+    cidrBlock: 10.0.0.0/16
+    region: eu-central-1
+  managementPolicies:
+  - Observe
+`
+	b := &bytes.Buffer{}
+	if err := Write(b, []resource.Managed{cr}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
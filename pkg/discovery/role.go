@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errListRoles           = "cannot list roles"
+	errFmtGetRole          = "cannot get role %s"
+	errFmtDecodeRolePolicy = "cannot decode the assume role policy document of role %s"
+
+	// serviceLinkedRolePath is the path of service-linked roles. They are
+	// managed by the ServiceLinkedRole kind.
+	serviceLinkedRolePath = "/aws-service-role/"
+)
+
+// A RoleDiscoverer discovers IAM roles.
+type RoleDiscoverer struct {
+	client iam.RoleClient
+}
+
+// NewRoleDiscoverer returns a new RoleDiscoverer.
+func NewRoleDiscoverer(client iam.RoleClient) *RoleDiscoverer {
+	return &RoleDiscoverer{client: client}
+}
+
+// Discover lists the IAM roles. Roles are global, so the region is ignored.
+func (d *RoleDiscoverer) Discover(ctx context.Context, _ string) ([]resource.Managed, error) {
+	var mgs []resource.Managed
+	var marker *string
+	for {
+		out, err := d.client.ListRoles(ctx, &awsiam.ListRolesInput{Marker: marker})
+		if err != nil {
+			return nil, errors.Wrap(err, errListRoles)
+		}
+		for _, r := range out.Roles {
+			if strings.HasPrefix(aws.ToString(r.Path), serviceLinkedRolePath) {
+				continue
+			}
+			cr, err := d.role(ctx, aws.ToString(r.RoleName))
+			if err != nil {
+				return nil, err
+			}
+			mgs = append(mgs, cr)
+		}
+		if !out.IsTruncated {
+			return mgs, nil
+		}
+		marker = out.Marker
+	}
+}
+
+// role returns the Role of the given name. The role is fetched because listed
+// roles have neither tags nor a permissions boundary.
+func (d *RoleDiscoverer) role(ctx context.Context, name string) (*v1beta1.Role, error) {
+	out, err := d.client.GetRole(ctx, &awsiam.GetRoleInput{RoleName: aws.String(name)})
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtGetRole, name)
+	}
+
+	cr := &v1beta1.Role{}
+	cr.SetGroupVersionKind(v1beta1.RoleGroupVersionKind)
+	meta.SetExternalName(cr, name)
+	iam.LateInitializeRole(&cr.Spec.ForProvider, out.Role)
+	// IAM returns url-encoded policy documents.
+	doc, err := url.QueryUnescape(cr.Spec.ForProvider.AssumeRolePolicyDocument)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtDecodeRolePolicy, name)
+	}
+	cr.Spec.ForProvider.AssumeRolePolicyDocument = doc
+	return cr, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errDescribeVPCs       = "cannot describe VPCs"
+	errFmtDescribeVPCAttr = "cannot describe the attributes of VPC %s"
+)
+
+// A VPCDiscoverer discovers VPCs.
+type VPCDiscoverer struct {
+	client ec2.VPCClient
+}
+
+// NewVPCDiscoverer returns a new VPCDiscoverer.
+func NewVPCDiscoverer(client ec2.VPCClient) *VPCDiscoverer {
+	return &VPCDiscoverer{client: client}
+}
+
+// Discover lists the VPCs in the given region.
+func (d *VPCDiscoverer) Discover(ctx context.Context, region string) ([]resource.Managed, error) {
+	var mgs []resource.Managed
+	var token *string
+	for {
+		out, err := d.client.DescribeVpcs(ctx, &awsec2.DescribeVpcsInput{NextToken: token})
+		if err != nil {
+			return nil, errors.Wrap(err, errDescribeVPCs)
+		}
+		for i := range out.Vpcs {
+			cr, err := d.vpc(ctx, region, out.Vpcs[i])
+			if err != nil {
+				return nil, err
+			}
+			mgs = append(mgs, cr)
+		}
+		if aws.ToString(out.NextToken) == "" {
+			return mgs, nil
+		}
+		token = out.NextToken
+	}
+}
+
+func (d *VPCDiscoverer) vpc(ctx context.Context, region string, v awsec2types.Vpc) (*v1beta1.VPC, error) {
+	attributes := awsec2.DescribeVpcAttributeOutput{}
+	for _, a := range []awsec2types.VpcAttributeName{
+		awsec2types.VpcAttributeNameEnableDnsSupport,
+		awsec2types.VpcAttributeNameEnableDnsHostnames,
+	} {
+		r, err := d.client.DescribeVpcAttribute(ctx, &awsec2.DescribeVpcAttributeInput{VpcId: v.VpcId, Attribute: a})
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtDescribeVPCAttr, aws.ToString(v.VpcId))
+		}
+		if r.EnableDnsHostnames != nil {
+			attributes.EnableDnsHostnames = r.EnableDnsHostnames
+		}
+		if r.EnableDnsSupport != nil {
+			attributes.EnableDnsSupport = r.EnableDnsSupport
+		}
+	}
+
+	cr := &v1beta1.VPC{
+		Spec: v1beta1.VPCSpec{
+			ForProvider: v1beta1.VPCParameters{
+				Region: aws.String(region),
+				Tags:   ec2.BuildFromEC2TagsV1Beta1(v.Tags),
+			},
+		},
+	}
+	cr.SetGroupVersionKind(v1beta1.VPCGroupVersionKind)
+	meta.SetExternalName(cr, aws.ToString(v.VpcId))
+	ec2.LateInitializeVPC(&cr.Spec.ForProvider, &v, &attributes)
+	return cr, nil
+}
@@ -103,7 +103,7 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { //nolint:gocyclo
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced Provider")
@@ -114,6 +114,14 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return UseProviderConfigCredentials(ctx, c, pc, region)
+}
+
+// UseProviderConfigCredentials produces a config that can be used to
+// authenticate to AWS with the credentials of the given ProviderConfig. Unlike
+// UseProviderConfig it does not track the usage of the ProviderConfig, so it
+// can be used without a managed resource.
+func UseProviderConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) { //nolint:gocyclo
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
@@ -0,0 +1,302 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// MaxDiffLength is the maximum length of the description of a Diff. It
+	// keeps condition messages and events readable for large policies.
+	MaxDiffLength = 1024
+
+	// ReasonPolicyDiff is the reason of the events that report why the
+	// policy of a resource is updated.
+	ReasonPolicyDiff event.Reason = "PolicyDiff"
+
+	// maxDiffItems is the maximum number of items that are listed per
+	// category of a Diff.
+	maxDiffItems = 5
+
+	diffTruncated = "..."
+)
+
+// Diff is the statement-level difference between a desired and an observed
+// policy. Statements are normalized before they are compared, so neither the
+// order of statements nor the order of their elements cause a difference.
+type Diff struct {
+	// AddedStatements are the desired statements that are not observed.
+	AddedStatements []string
+
+	// RemovedStatements are the observed statements that are not desired.
+	RemovedStatements []string
+
+	// AddedActions are the desired actions, prefixed with their effect, that
+	// are not observed.
+	AddedActions []string
+
+	// RemovedActions are the observed actions, prefixed with their effect,
+	// that are not desired.
+	RemovedActions []string
+
+	// AddedPrincipals are the desired principals that are not observed.
+	AddedPrincipals []string
+
+	// RemovedPrincipals are the observed principals that are not desired.
+	RemovedPrincipals []string
+
+	// ChangedAttributes are the top-level attributes of the policy, i.e.
+	// Version and Id, that differ.
+	ChangedAttributes []string
+}
+
+// IsEmpty returns true if both policies are equal.
+func (d Diff) IsEmpty() bool {
+	return len(d.AddedStatements) == 0 && len(d.RemovedStatements) == 0 &&
+		len(d.AddedActions) == 0 && len(d.RemovedActions) == 0 &&
+		len(d.AddedPrincipals) == 0 && len(d.RemovedPrincipals) == 0 &&
+		len(d.ChangedAttributes) == 0
+}
+
+// String returns a human readable description of the diff that is at most
+// MaxDiffLength characters long.
+func (d Diff) String() string {
+	if d.IsEmpty() {
+		return "statements are equal"
+	}
+	var parts []string
+	for _, c := range []struct {
+		name  string
+		items []string
+	}{
+		{name: "added statements", items: d.AddedStatements},
+		{name: "removed statements", items: d.RemovedStatements},
+		{name: "added actions", items: d.AddedActions},
+		{name: "removed actions", items: d.RemovedActions},
+		{name: "added principals", items: d.AddedPrincipals},
+		{name: "removed principals", items: d.RemovedPrincipals},
+		{name: "changed attributes", items: d.ChangedAttributes},
+	} {
+		if len(c.items) != 0 {
+			parts = append(parts, fmt.Sprintf("%s: %s", c.name, formatDiffItems(c.items)))
+		}
+	}
+	return truncate(strings.Join(parts, "; "), MaxDiffLength)
+}
+
+// truncate shortens s to at most n characters. It never splits a multi-byte
+// character.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return string(r[:n-len(diffTruncated)]) + diffTruncated
+}
+
+// WithDiff returns msg followed by the given policy diff description, if any.
+// It is used to include the diff in the events and errors of updates.
+func WithDiff(msg, diff string) string {
+	if diff == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s (policy diff: %s)", msg, diff)
+}
+
+// formatDiffItems lists at most maxDiffItems items.
+func formatDiffItems(items []string) string {
+	if len(items) <= maxDiffItems {
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	return fmt.Sprintf("[%s, and %d more]", strings.Join(items[:maxDiffItems], ", "), len(items)-maxDiffItems)
+}
+
+// DiffPolicies returns the statement-level difference between the desired and
+// the observed policy. A nil policy has no statements.
+func DiffPolicies(desired, observed *Policy) Diff {
+	var desiredStatements, observedStatements StatementList
+	if desired != nil {
+		desiredStatements = desired.Statements
+	}
+	if observed != nil {
+		observedStatements = observed.Statements
+	}
+
+	d := Diff{}
+	d.AddedStatements, d.RemovedStatements = diffStatements(desiredStatements, observedStatements)
+	d.AddedActions, d.RemovedActions = diffSets(statementActions(desiredStatements), statementActions(observedStatements))
+	d.AddedPrincipals, d.RemovedPrincipals = diffSets(statementPrincipals(desiredStatements), statementPrincipals(observedStatements))
+	d.ChangedAttributes = diffAttributes(desired, observed)
+	return d
+}
+
+// DiffPolicyDocuments returns the statement-level difference between the
+// desired and the observed policy document. An empty document has no
+// statements.
+func DiffPolicyDocuments(desired, observed string) (Diff, error) {
+	desiredPolicy, err := parseOptionalPolicyString(desired)
+	if err != nil {
+		return Diff{}, err
+	}
+	observedPolicy, err := parseOptionalPolicyString(observed)
+	if err != nil {
+		return Diff{}, err
+	}
+	return DiffPolicies(desiredPolicy, observedPolicy), nil
+}
+
+// diffAttributes returns the names of the top-level attributes that differ.
+// A missing policy only differs by its statements.
+func diffAttributes(desired, observed *Policy) []string {
+	if desired == nil || observed == nil {
+		return nil
+	}
+	var changed []string
+	if desired.Version != observed.Version {
+		changed = append(changed, "Version")
+	}
+	if pointer.StringValue(desired.ID) != pointer.StringValue(observed.ID) {
+		changed = append(changed, "Id")
+	}
+	return changed
+}
+
+func parseOptionalPolicyString(raw string) (*Policy, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	p, err := ParsePolicyString(raw)
+	return &p, err
+}
+
+// diffStatements returns the descriptions of the added and removed statements.
+// Equal statements are matched by their normalized form, so duplicates are
+// counted.
+func diffStatements(desired, observed StatementList) (added, removed []string) {
+	observedKeys := map[string]int{}
+	for _, s := range observed {
+		observedKeys[statementKey(s)]++
+	}
+	desiredKeys := map[string]int{}
+	for i, s := range desired {
+		k := statementKey(s)
+		desiredKeys[k]++
+		if desiredKeys[k] > observedKeys[k] {
+			added = append(added, describeStatement(i, s))
+		}
+	}
+	seen := map[string]int{}
+	for i, s := range observed {
+		k := statementKey(s)
+		seen[k]++
+		if seen[k] > desiredKeys[k] {
+			removed = append(removed, describeStatement(i, s))
+		}
+	}
+	return added, removed
+}
+
+// statementKey returns the normalized JSON representation of a statement.
+func statementKey(s Statement) string {
+	n := s
+	n.Action = sortedCopy(s.Action)
+	n.NotAction = sortedCopy(s.NotAction)
+	n.Resource = sortedCopy(s.Resource)
+	n.NotResource = sortedCopy(s.NotResource)
+	// AllowAnon is not marshalled, but distinguishes statements.
+	key := struct {
+		Statement
+		AnonPrincipal    bool `json:"anonPrincipal,omitempty"`
+		AnonNotPrincipal bool `json:"anonNotPrincipal,omitempty"`
+	}{
+		Statement:        n,
+		AnonPrincipal:    s.Principal != nil && s.Principal.AllowAnon,
+		AnonNotPrincipal: s.NotPrincipal != nil && s.NotPrincipal.AllowAnon,
+	}
+	raw, err := json.Marshal(key)
+	if err != nil {
+		// Statements consist of JSON values only.
+		return fmt.Sprintf("%v", key)
+	}
+	return string(raw)
+}
+
+// describeStatement returns the Sid of a statement, or its position, effect
+// and actions if it has none.
+func describeStatement(i int, s Statement) string {
+	if s.SID != nil && *s.SID != "" {
+		return *s.SID
+	}
+	return fmt.Sprintf("#%d %s %v", i, s.Effect, []string(sortedCopy(s.Action)))
+}
+
+// statementActions returns the actions of the statements prefixed with their
+// effect.
+func statementActions(statements StatementList) map[string]struct{} {
+	actions := map[string]struct{}{}
+	for _, s := range statements {
+		for _, a := range s.Action {
+			actions[fmt.Sprintf("%s %s", s.Effect, a)] = struct{}{}
+		}
+	}
+	return actions
+}
+
+// statementPrincipals returns the principals of the statements prefixed with
+// their type.
+func statementPrincipals(statements StatementList) map[string]struct{} {
+	principals := map[string]struct{}{}
+	for _, s := range statements {
+		p := s.Principal
+		if p == nil {
+			continue
+		}
+		if p.AllowAnon {
+			principals["*"] = struct{}{}
+		}
+		for a := range p.AWSPrincipals {
+			principals["AWS:"+a] = struct{}{}
+		}
+		for svc := range p.Service {
+			principals["Service:"+svc] = struct{}{}
+		}
+		if p.Federated != nil {
+			principals["Federated:"+*p.Federated] = struct{}{}
+		}
+	}
+	return principals
+}
+
+// diffSets returns the sorted items that are only in desired and only in
+// observed.
+func diffSets(desired, observed map[string]struct{}) (added, removed []string) {
+	for k := range desired {
+		if _, ok := observed[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	for k := range observed {
+		if _, ok := desired[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+func sortedCopy(s StringOrArray) StringOrArray {
+	if s == nil {
+		return nil
+	}
+	c := append(StringOrArray{}, s...)
+	sort.Strings(c)
+	return c
+}
@@ -0,0 +1,180 @@
+package policy
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffPolicyDocuments(t *testing.T) {
+	type args struct {
+		desired  string
+		observed string
+	}
+	type want struct {
+		diff Diff
+		err  bool
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Equal": {
+			args: args{
+				desired:  `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:root"]},"Action":["s3:ListBucket","s3:GetObject"],"Resource":"*"}]}`,
+				observed: `{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::210987654321:root","arn:aws:iam::123456789012:root"]},"Action":["s3:GetObject","s3:ListBucket"],"Resource":["*"]}}`,
+			},
+			want: want{
+				diff: Diff{},
+			},
+		},
+		"ReorderedStatements": {
+			args: args{
+				desired:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+				observed: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			want: want{
+				diff: Diff{},
+			},
+		},
+		"ChangedStatement": {
+			args: args{
+				desired:  `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Principal":"*","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+				observed: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			want: want{
+				diff: Diff{
+					AddedStatements:   []string{"Read"},
+					RemovedStatements: []string{"Read"},
+					AddedActions:      []string{"Allow s3:PutObject"},
+					AddedPrincipals:   []string{"*"},
+					RemovedPrincipals: []string{"Service:s3.amazonaws.com"},
+				},
+			},
+		},
+		"AddedAndRemovedStatements": {
+			args: args{
+				desired:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`,
+				observed: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"},{"Effect":"Deny","Action":["sqs:SendMessage","sqs:DeleteQueue"],"Resource":"*"}]}`,
+			},
+			want: want{
+				diff: Diff{
+					RemovedStatements: []string{"#1 Deny [sqs:DeleteQueue sqs:SendMessage]"},
+					RemovedActions:    []string{"Deny sqs:DeleteQueue", "Deny sqs:SendMessage"},
+				},
+			},
+		},
+		"EmptyObserved": {
+			args: args{
+				desired: `{"Version":"2012-10-17","Statement":[{"Sid":"Publish","Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+			},
+			want: want{
+				diff: Diff{
+					AddedStatements: []string{"Publish"},
+					AddedActions:    []string{"Allow sns:Publish"},
+				},
+			},
+		},
+		"ChangedVersion": {
+			args: args{
+				desired:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				observed: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			want: want{
+				diff: Diff{ChangedAttributes: []string{"Version"}},
+			},
+		},
+		"InvalidDocument": {
+			args: args{
+				desired:  `{"Version":"2012-10-17","Statement":[`,
+				observed: `{"Version":"2012-10-17"}`,
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DiffPolicyDocuments(tc.args.desired, tc.args.observed)
+			if (err != nil) != tc.want.err {
+				t.Errorf("expected error %t, got: %v", tc.want.err, err)
+			}
+			if diff := cmp.Diff(tc.want.diff, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	cases := map[string]struct {
+		diff Diff
+		want string
+	}{
+		"Empty": {
+			diff: Diff{},
+			want: "statements are equal",
+		},
+		"Changes": {
+			diff: Diff{
+				AddedStatements:   []string{"Read"},
+				RemovedActions:    []string{"Deny s3:DeleteObject"},
+				RemovedPrincipals: []string{"*"},
+			},
+			want: "added statements: [Read]; removed actions: [Deny s3:DeleteObject]; removed principals: [*]",
+		},
+		"TooManyItems": {
+			diff: Diff{
+				AddedActions: []string{"Allow a:1", "Allow a:2", "Allow a:3", "Allow a:4", "Allow a:5", "Allow a:6", "Allow a:7"},
+			},
+			want: "added actions: [Allow a:1, Allow a:2, Allow a:3, Allow a:4, Allow a:5, and 2 more]",
+		},
+		"ChangedAttributes": {
+			diff: Diff{ChangedAttributes: []string{"Version"}},
+			want: "changed attributes: [Version]",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.diff.String()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithDiff(t *testing.T) {
+	if got := WithDiff("cannot update", ""); got != "cannot update" {
+		t.Errorf("expected message without diff, got: %s", got)
+	}
+	if got := WithDiff("cannot update", "added statements: [Read]"); got != "cannot update (policy diff: added statements: [Read])" {
+		t.Errorf("expected message with diff, got: %s", got)
+	}
+}
+
+func TestDiffStringIsBounded(t *testing.T) {
+	long := strings.Repeat("x", 2*MaxDiffLength)
+	got := Diff{AddedStatements: []string{long}}.String()
+	if len(got) != MaxDiffLength {
+		t.Errorf("expected length %d, got: %d", MaxDiffLength, len(got))
+	}
+	if !strings.HasSuffix(got, diffTruncated) {
+		t.Errorf("expected truncated diff, got: %s", got)
+	}
+}
+
+func TestDiffStringIsTruncatedOnCharacterBoundary(t *testing.T) {
+	long := strings.Repeat("ü", 2*MaxDiffLength)
+	got := Diff{AddedStatements: []string{long}}.String()
+	if !utf8.ValidString(got) {
+		t.Errorf("expected valid UTF-8, got: %q", got)
+	}
+	if n := utf8.RuneCountInString(got); n != MaxDiffLength {
+		t.Errorf("expected %d characters, got: %d", MaxDiffLength, n)
+	}
+}
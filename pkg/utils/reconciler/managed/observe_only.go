@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// ObserveOnlyClient implements the Create, Update and Delete methods of an
+// ExternalClient whose managed resource only reads external state, e.g. a
+// lookup. It is embedded by clients that implement Observe.
+type ObserveOnlyClient struct{}
+
+// Create never creates anything.
+func (ObserveOnlyClient) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update never updates anything.
+func (ObserveOnlyClient) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete never deletes anything.
+func (ObserveOnlyClient) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}